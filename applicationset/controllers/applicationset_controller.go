@@ -741,10 +741,10 @@ func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context,
 				}
 			}
 
-			// Preserve post-delete finalizers:
+			// Preserve pre-delete and post-delete finalizers:
 			//   https://github.com/argoproj/argo-cd/issues/17181
 			for _, finalizer := range found.Finalizers {
-				if strings.HasPrefix(finalizer, argov1alpha1.PostDeleteFinalizerName) {
+				if finalizer == argov1alpha1.PreDeleteFinalizerName || strings.HasPrefix(finalizer, argov1alpha1.PostDeleteFinalizerName) {
 					if generatedApp.Finalizers == nil {
 						generatedApp.Finalizers = []string{}
 					}
@@ -1010,7 +1010,7 @@ func TestCreateOrUpdateInCluster(t *testing.T) {
 			},
 		},
 		{
-			name: "Ensure that argocd post-delete finalizers are preserved from an existing app",
+			name: "Ensure that argocd pre-delete and post-delete finalizers are preserved from an existing app",
 			appSet: v1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "name",
@@ -1035,6 +1035,7 @@ func TestCreateOrUpdateInCluster(t *testing.T) {
 						Namespace:       "namespace",
 						ResourceVersion: "2",
 						Finalizers: []string{
+							v1alpha1.PreDeleteFinalizerName,
 							v1alpha1.PostDeleteFinalizerName,
 							v1alpha1.PostDeleteFinalizerName + "/mystage",
 						},
@@ -1066,6 +1067,7 @@ func TestCreateOrUpdateInCluster(t *testing.T) {
 						Namespace:       "namespace",
 						ResourceVersion: "2",
 						Finalizers: []string{
+							v1alpha1.PreDeleteFinalizerName,
 							v1alpha1.PostDeleteFinalizerName,
 							v1alpha1.PostDeleteFinalizerName + "/mystage",
 						},
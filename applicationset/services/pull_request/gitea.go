@@ -75,6 +75,7 @@ func (g *GiteaService) List(ctx context.Context) ([]*PullRequest, error) {
 			HeadSHA:      pr.Head.Sha,
 			Labels:       getGiteaPRLabelNames(pr.Labels),
 			Author:       pr.Poster.UserName,
+			URL:          pr.HTMLURL,
 		})
 	}
 	return list, nil
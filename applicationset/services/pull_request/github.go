@@ -18,7 +18,7 @@ type GithubService struct {
 	labels []string
 }
 
-var _ PullRequestService = (*GithubService)(nil)
+var _ PullRequestWriter = (*GithubService)(nil)
 
 func NewGithubService(token, url, owner, repo string, labels []string, optionalHTTPClient ...*http.Client) (PullRequestService, error) {
 	// Undocumented environment variable to set a default token, to be used in testing to dodge anonymous rate limits.
@@ -75,15 +75,7 @@ func (g *GithubService) List(ctx context.Context) ([]*PullRequest, error) {
 			if !containLabels(g.labels, pull.Labels) {
 				continue
 			}
-			pullRequests = append(pullRequests, &PullRequest{
-				Number:       *pull.Number,
-				Title:        *pull.Title,
-				Branch:       *pull.Head.Ref,
-				TargetBranch: *pull.Base.Ref,
-				HeadSHA:      *pull.Head.SHA,
-				Labels:       getGithubPRLabelNames(pull.Labels),
-				Author:       *pull.User.Login,
-			})
+			pullRequests = append(pullRequests, toGithubPullRequest(pull))
 		}
 		if resp.NextPage == 0 {
 			break
@@ -93,6 +85,91 @@ func (g *GithubService) List(ctx context.Context) ([]*PullRequest, error) {
 	return pullRequests, nil
 }
 
+// Find returns the open pull request from branch into targetBranch, regardless of its labels.
+func (g *GithubService) Find(ctx context.Context, branch, targetBranch string) (*PullRequest, error) {
+	pulls, _, err := g.client.PullRequests.List(ctx, g.owner, g.repo, &github.PullRequestListOptions{
+		State: "open",
+		Head:  g.owner + ":" + branch,
+		Base:  targetBranch,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing pull requests from %s to %s for %s/%s: %w", branch, targetBranch, g.owner, g.repo, err)
+	}
+	for _, pull := range pulls {
+		if pull.GetHead().GetRef() == branch && pull.GetBase().GetRef() == targetBranch {
+			return toGithubPullRequest(pull), nil
+		}
+	}
+	return nil, nil
+}
+
+// Create opens a pull request from branch into targetBranch and applies the service's labels to it.
+func (g *GithubService) Create(ctx context.Context, title, body, branch, targetBranch string) (*PullRequest, error) {
+	pull, _, err := g.client.PullRequests.Create(ctx, g.owner, g.repo, &github.NewPullRequest{
+		Title: &title,
+		Body:  &body,
+		Head:  &branch,
+		Base:  &targetBranch,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating pull request for %s/%s: %w", g.owner, g.repo, err)
+	}
+	if len(g.labels) > 0 {
+		labels, _, err := g.client.Issues.AddLabelsToIssue(ctx, g.owner, g.repo, pull.GetNumber(), g.labels)
+		if err != nil {
+			return nil, fmt.Errorf("error labeling pull request #%d for %s/%s: %w", pull.GetNumber(), g.owner, g.repo, err)
+		}
+		pull.Labels = labels
+	}
+	return toGithubPullRequest(pull), nil
+}
+
+// Update sets the title and body of the pull request with the given number.
+func (g *GithubService) Update(ctx context.Context, number int, title, body string) (*PullRequest, error) {
+	pull, _, err := g.client.PullRequests.Edit(ctx, g.owner, g.repo, number, &github.PullRequest{
+		Title: &title,
+		Body:  &body,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error updating pull request #%d for %s/%s: %w", number, g.owner, g.repo, err)
+	}
+	return toGithubPullRequest(pull), nil
+}
+
+// Close closes the pull request with the given number without merging it.
+func (g *GithubService) Close(ctx context.Context, number int) error {
+	_, _, err := g.client.PullRequests.Edit(ctx, g.owner, g.repo, number, &github.PullRequest{
+		State: github.Ptr("closed"),
+	})
+	if err != nil {
+		return fmt.Errorf("error closing pull request #%d for %s/%s: %w", number, g.owner, g.repo, err)
+	}
+	return nil
+}
+
+// AddLabels adds the labels to the pull request with the given number.
+func (g *GithubService) AddLabels(ctx context.Context, number int, labels []string) error {
+	_, _, err := g.client.Issues.AddLabelsToIssue(ctx, g.owner, g.repo, number, labels)
+	if err != nil {
+		return fmt.Errorf("error labeling pull request #%d for %s/%s: %w", number, g.owner, g.repo, err)
+	}
+	return nil
+}
+
+// toGithubPullRequest converts a GitHub pull request to a PullRequest.
+func toGithubPullRequest(pull *github.PullRequest) *PullRequest {
+	return &PullRequest{
+		Number:       pull.GetNumber(),
+		Title:        pull.GetTitle(),
+		Branch:       pull.GetHead().GetRef(),
+		TargetBranch: pull.GetBase().GetRef(),
+		HeadSHA:      pull.GetHead().GetSHA(),
+		Labels:       getGithubPRLabelNames(pull.Labels),
+		Author:       pull.GetUser().GetLogin(),
+		URL:          pull.GetHTMLURL(),
+	}
+}
+
 // containLabels returns true if gotLabels contains expectedLabels
 func containLabels(expectedLabels []string, gotLabels []*github.Label) bool {
 	for _, expected := range expectedLabels {
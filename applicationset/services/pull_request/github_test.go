@@ -1,8 +1,11 @@
 package pull_request
 
 import (
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/google/go-github/v69/github"
@@ -115,3 +118,98 @@ func TestGitHubListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestGitHubCreateUpdateClose(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	pullJSON := `{"number": 7, "title": "%s", "html_url": "https://github.com/owner/repo/pull/7", "state": "%s", "head": {"ref": "env/dev-next", "sha": "abc"}, "base": {"ref": "env/dev"}, "user": {"login": "argo"}}`
+
+	mux.HandleFunc("/api/v3/repos/owner/repo/pulls", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"title": "hydrate", "body": "details", "head": "env/dev-next", "base": "env/dev"}`, string(body))
+		_, _ = fmt.Fprintf(w, pullJSON, "hydrate", "open")
+	})
+	mux.HandleFunc("/api/v3/repos/owner/repo/issues/7/labels", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		_, _ = w.Write([]byte(`[{"name": "hydrator"}]`))
+	})
+	mux.HandleFunc("/api/v3/repos/owner/repo/pulls/7", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		if strings.Contains(string(body), "closed") {
+			assert.JSONEq(t, `{"state": "closed"}`, string(body))
+			_, _ = fmt.Fprintf(w, pullJSON, "hydrate", "closed")
+			return
+		}
+		assert.JSONEq(t, `{"title": "hydrate again", "body": "more details"}`, string(body))
+		_, _ = fmt.Fprintf(w, pullJSON, "hydrate again", "open")
+	})
+
+	svc, err := NewGithubService("", server.URL, "owner", "repo", []string{"hydrator"}, nil)
+	require.NoError(t, err)
+	writer, ok := svc.(PullRequestWriter)
+	require.True(t, ok)
+
+	pr, err := writer.Create(t.Context(), "hydrate", "details", "env/dev-next", "env/dev")
+	require.NoError(t, err)
+	assert.Equal(t, &PullRequest{
+		Number:       7,
+		Title:        "hydrate",
+		Branch:       "env/dev-next",
+		TargetBranch: "env/dev",
+		HeadSHA:      "abc",
+		Labels:       []string{"hydrator"},
+		Author:       "argo",
+		URL:          "https://github.com/owner/repo/pull/7",
+	}, pr)
+
+	pr, err = writer.Update(t.Context(), 7, "hydrate again", "more details")
+	require.NoError(t, err)
+	assert.Equal(t, "hydrate again", pr.Title)
+
+	require.NoError(t, writer.Close(t.Context(), 7))
+}
+
+func TestGitHubFindAddLabels(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v3/repos/owner/repo/pulls", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "open", r.URL.Query().Get("state"))
+		assert.Equal(t, "owner:env/dev-next", r.URL.Query().Get("head"))
+		assert.NotEmpty(t, r.URL.Query().Get("base"))
+		// The pull request is returned although its labels do not contain the labels of the service. Pull requests
+		// into another base branch are filtered out.
+		_, _ = w.Write([]byte(`[{"number": 7, "title": "hydrate", "head": {"ref": "env/dev-next", "sha": "abc"}, "base": {"ref": "env/dev"}, "labels": []}]`))
+	})
+	mux.HandleFunc("/api/v3/repos/owner/repo/issues/7/labels", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `["hydrator"]`, string(body))
+		_, _ = w.Write([]byte(`[{"name": "hydrator"}]`))
+	})
+
+	svc, err := NewGithubService("", server.URL, "owner", "repo", []string{"hydrator"}, nil)
+	require.NoError(t, err)
+	writer, ok := svc.(PullRequestWriter)
+	require.True(t, ok)
+
+	pr, err := writer.Find(t.Context(), "env/dev-next", "env/dev")
+	require.NoError(t, err)
+	require.NotNil(t, pr)
+	assert.Equal(t, 7, pr.Number)
+
+	pr, err = writer.Find(t.Context(), "env/dev-next", "env/prod")
+	require.NoError(t, err)
+	assert.Nil(t, pr)
+
+	require.NoError(t, writer.AddLabels(t.Context(), 7, []string{"hydrator"}))
+}
@@ -19,7 +19,7 @@ type GitLabService struct {
 	pullRequestState string
 }
 
-var _ PullRequestService = (*GitLabService)(nil)
+var _ PullRequestWriter = (*GitLabService)(nil)
 
 func NewGitLabService(token, url, project string, labels []string, pullRequestState string, scmRootCAPath string, insecure bool, caCerts []byte) (PullRequestService, error) {
 	var clientOptionFns []gitlab.ClientOptionFunc
@@ -84,15 +84,7 @@ func (g *GitLabService) List(ctx context.Context) ([]*PullRequest, error) {
 			return nil, fmt.Errorf("error listing merge requests for project '%s': %w", g.project, err)
 		}
 		for _, mr := range mrs {
-			pullRequests = append(pullRequests, &PullRequest{
-				Number:       mr.IID,
-				Title:        mr.Title,
-				Branch:       mr.SourceBranch,
-				TargetBranch: mr.TargetBranch,
-				HeadSHA:      mr.SHA,
-				Labels:       mr.Labels,
-				Author:       mr.Author.Username,
-			})
+			pullRequests = append(pullRequests, toGitLabPullRequest(mr))
 		}
 		if resp.NextPage == 0 {
 			break
@@ -101,3 +93,92 @@ func (g *GitLabService) List(ctx context.Context) ([]*PullRequest, error) {
 	}
 	return pullRequests, nil
 }
+
+// Find returns the open merge request from branch into targetBranch, regardless of its labels.
+func (g *GitLabService) Find(ctx context.Context, branch, targetBranch string) (*PullRequest, error) {
+	mrs, _, err := g.client.MergeRequests.ListProjectMergeRequests(g.project, &gitlab.ListProjectMergeRequestsOptions{
+		State:        gitlab.Ptr("opened"),
+		SourceBranch: &branch,
+		TargetBranch: &targetBranch,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error listing merge requests from %s to %s for project '%s': %w", branch, targetBranch, g.project, err)
+	}
+	for _, mr := range mrs {
+		if mr.SourceBranch == branch && mr.TargetBranch == targetBranch {
+			return toGitLabPullRequest(mr), nil
+		}
+	}
+	return nil, nil
+}
+
+// Create opens a merge request from branch into targetBranch with the service's labels.
+func (g *GitLabService) Create(ctx context.Context, title, body, branch, targetBranch string) (*PullRequest, error) {
+	opts := &gitlab.CreateMergeRequestOptions{
+		Title:        &title,
+		Description:  &body,
+		SourceBranch: &branch,
+		TargetBranch: &targetBranch,
+	}
+	if len(g.labels) > 0 {
+		var labels gitlab.LabelOptions = g.labels
+		opts.Labels = &labels
+	}
+	mr, _, err := g.client.MergeRequests.CreateMergeRequest(g.project, opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error creating merge request for project '%s': %w", g.project, err)
+	}
+	return toGitLabPullRequest(&mr.BasicMergeRequest), nil
+}
+
+// Update sets the title and description of the merge request with the given IID.
+func (g *GitLabService) Update(ctx context.Context, number int, title, body string) (*PullRequest, error) {
+	mr, _, err := g.client.MergeRequests.UpdateMergeRequest(g.project, number, &gitlab.UpdateMergeRequestOptions{
+		Title:       &title,
+		Description: &body,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error updating merge request !%d for project '%s': %w", number, g.project, err)
+	}
+	return toGitLabPullRequest(&mr.BasicMergeRequest), nil
+}
+
+// Close closes the merge request with the given IID without merging it.
+func (g *GitLabService) Close(ctx context.Context, number int) error {
+	_, _, err := g.client.MergeRequests.UpdateMergeRequest(g.project, number, &gitlab.UpdateMergeRequestOptions{
+		StateEvent: gitlab.Ptr("close"),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("error closing merge request !%d for project '%s': %w", number, g.project, err)
+	}
+	return nil
+}
+
+// AddLabels adds the labels to the merge request with the given IID.
+func (g *GitLabService) AddLabels(ctx context.Context, number int, labels []string) error {
+	var addLabels gitlab.LabelOptions = labels
+	_, _, err := g.client.MergeRequests.UpdateMergeRequest(g.project, number, &gitlab.UpdateMergeRequestOptions{
+		AddLabels: &addLabels,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("error labeling merge request !%d for project '%s': %w", number, g.project, err)
+	}
+	return nil
+}
+
+// toGitLabPullRequest converts a GitLab merge request to a PullRequest.
+func toGitLabPullRequest(mr *gitlab.BasicMergeRequest) *PullRequest {
+	pr := &PullRequest{
+		Number:       mr.IID,
+		Title:        mr.Title,
+		Branch:       mr.SourceBranch,
+		TargetBranch: mr.TargetBranch,
+		HeadSHA:      mr.SHA,
+		Labels:       mr.Labels,
+		URL:          mr.WebURL,
+	}
+	if mr.Author != nil {
+		pr.Author = mr.Author.Username
+	}
+	return pr
+}
@@ -3,10 +3,12 @@ package pull_request
 import (
 	"crypto/x509"
 	"encoding/pem"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -217,3 +219,96 @@ func TestGitLabListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestGitLabCreateUpdateClose(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mrJSON := `{"iid": 12, "title": "%s", "source_branch": "env/dev-next", "target_branch": "env/dev", "sha": "abc", "labels": ["hydrator"], "web_url": "https://gitlab.com/group/project/-/merge_requests/12", "author": {"username": "argo"}}`
+
+	mux.HandleFunc("/api/v4/projects/278964/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"title": "hydrate", "description": "details", "source_branch": "env/dev-next", "target_branch": "env/dev", "labels": "hydrator"}`, string(body))
+		_, _ = fmt.Fprintf(w, mrJSON, "hydrate")
+	})
+	mux.HandleFunc("/api/v4/projects/278964/merge_requests/12", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		if strings.Contains(string(body), "state_event") {
+			assert.JSONEq(t, `{"state_event": "close"}`, string(body))
+			_, _ = fmt.Fprintf(w, mrJSON, "hydrate")
+			return
+		}
+		assert.JSONEq(t, `{"title": "hydrate again", "description": "more details"}`, string(body))
+		_, _ = fmt.Fprintf(w, mrJSON, "hydrate again")
+	})
+
+	svc, err := NewGitLabService("", server.URL, "278964", []string{"hydrator"}, "", "", false, nil)
+	require.NoError(t, err)
+	writer, ok := svc.(PullRequestWriter)
+	require.True(t, ok)
+
+	pr, err := writer.Create(t.Context(), "hydrate", "details", "env/dev-next", "env/dev")
+	require.NoError(t, err)
+	assert.Equal(t, &PullRequest{
+		Number:       12,
+		Title:        "hydrate",
+		Branch:       "env/dev-next",
+		TargetBranch: "env/dev",
+		HeadSHA:      "abc",
+		Labels:       []string{"hydrator"},
+		Author:       "argo",
+		URL:          "https://gitlab.com/group/project/-/merge_requests/12",
+	}, pr)
+
+	pr, err = writer.Update(t.Context(), 12, "hydrate again", "more details")
+	require.NoError(t, err)
+	assert.Equal(t, "hydrate again", pr.Title)
+
+	require.NoError(t, writer.Close(t.Context(), 12))
+}
+
+func TestGitLabFindAddLabels(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v4/projects/278964/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "opened", r.URL.Query().Get("state"))
+		assert.Equal(t, "env/dev-next", r.URL.Query().Get("source_branch"))
+		assert.Empty(t, r.URL.Query().Get("labels"))
+		if r.URL.Query().Get("target_branch") != "env/dev" {
+			_, _ = w.Write([]byte(`[]`))
+			return
+		}
+		_, _ = w.Write([]byte(`[{"iid": 12, "title": "hydrate", "source_branch": "env/dev-next", "target_branch": "env/dev", "sha": "abc", "labels": []}]`))
+	})
+	mux.HandleFunc("/api/v4/projects/278964/merge_requests/12", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"add_labels": "hydrator"}`, string(body))
+		_, _ = w.Write([]byte(`{"iid": 12, "title": "hydrate", "source_branch": "env/dev-next", "target_branch": "env/dev", "labels": ["hydrator"]}`))
+	})
+
+	svc, err := NewGitLabService("", server.URL, "278964", []string{"hydrator"}, "", "", false, nil)
+	require.NoError(t, err)
+	writer, ok := svc.(PullRequestWriter)
+	require.True(t, ok)
+
+	pr, err := writer.Find(t.Context(), "env/dev-next", "env/dev")
+	require.NoError(t, err)
+	require.NotNil(t, pr)
+	assert.Equal(t, 12, pr.Number)
+
+	pr, err = writer.Find(t.Context(), "env/dev-next", "env/prod")
+	require.NoError(t, err)
+	assert.Nil(t, pr)
+
+	require.NoError(t, writer.AddLabels(t.Context(), 12, []string{"hydrator"}))
+}
@@ -20,6 +20,8 @@ type PullRequest struct {
 	Labels []string
 	// Author is the author of the pull request.
 	Author string
+	// URL is the web URL of the pull request.
+	URL string
 }
 
 type PullRequestService interface {
@@ -27,6 +29,23 @@ type PullRequestService interface {
 	List(ctx context.Context) ([]*PullRequest, error)
 }
 
+// PullRequestWriter is a PullRequestService which is also able to open, update and close pull requests.
+type PullRequestWriter interface {
+	PullRequestService
+	// Find returns the open pull request from branch into targetBranch, or nil if there is none. Unlike List, the pull
+	// requests are not filtered by the service's labels.
+	Find(ctx context.Context, branch, targetBranch string) (*PullRequest, error)
+	// Create opens a pull request from branch into targetBranch. The service's labels are applied to the new pull
+	// request.
+	Create(ctx context.Context, title, body, branch, targetBranch string) (*PullRequest, error)
+	// Update sets the title and body of the pull request with the given number.
+	Update(ctx context.Context, number int, title, body string) (*PullRequest, error)
+	// Close closes the pull request with the given number without merging it.
+	Close(ctx context.Context, number int) error
+	// AddLabels adds the labels to the pull request with the given number.
+	AddLabels(ctx context.Context, number int, labels []string) error
+}
+
 type Filter struct {
 	BranchMatch       *regexp.Regexp
 	TargetBranchMatch *regexp.Regexp
@@ -0,0 +1,461 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewPullRequestWriter creates a new instance of PullRequestWriter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPullRequestWriter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PullRequestWriter {
+	mock := &PullRequestWriter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// PullRequestWriter is an autogenerated mock type for the PullRequestWriter type
+type PullRequestWriter struct {
+	mock.Mock
+}
+
+type PullRequestWriter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PullRequestWriter) EXPECT() *PullRequestWriter_Expecter {
+	return &PullRequestWriter_Expecter{mock: &_m.Mock}
+}
+
+// AddLabels provides a mock function for the type PullRequestWriter
+func (_mock *PullRequestWriter) AddLabels(ctx context.Context, number int, labels []string) error {
+	ret := _mock.Called(ctx, number, labels)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddLabels")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, []string) error); ok {
+		r0 = returnFunc(ctx, number, labels)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// PullRequestWriter_AddLabels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddLabels'
+type PullRequestWriter_AddLabels_Call struct {
+	*mock.Call
+}
+
+// AddLabels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - number int
+//   - labels []string
+func (_e *PullRequestWriter_Expecter) AddLabels(ctx interface{}, number interface{}, labels interface{}) *PullRequestWriter_AddLabels_Call {
+	return &PullRequestWriter_AddLabels_Call{Call: _e.mock.On("AddLabels", ctx, number, labels)}
+}
+
+func (_c *PullRequestWriter_AddLabels_Call) Run(run func(ctx context.Context, number int, labels []string)) *PullRequestWriter_AddLabels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *PullRequestWriter_AddLabels_Call) Return(err error) *PullRequestWriter_AddLabels_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *PullRequestWriter_AddLabels_Call) RunAndReturn(run func(ctx context.Context, number int, labels []string) error) *PullRequestWriter_AddLabels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Close provides a mock function for the type PullRequestWriter
+func (_mock *PullRequestWriter) Close(ctx context.Context, number int) error {
+	ret := _mock.Called(ctx, number)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = returnFunc(ctx, number)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// PullRequestWriter_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type PullRequestWriter_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+//   - ctx context.Context
+//   - number int
+func (_e *PullRequestWriter_Expecter) Close(ctx interface{}, number interface{}) *PullRequestWriter_Close_Call {
+	return &PullRequestWriter_Close_Call{Call: _e.mock.On("Close", ctx, number)}
+}
+
+func (_c *PullRequestWriter_Close_Call) Run(run func(ctx context.Context, number int)) *PullRequestWriter_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *PullRequestWriter_Close_Call) Return(err error) *PullRequestWriter_Close_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *PullRequestWriter_Close_Call) RunAndReturn(run func(ctx context.Context, number int) error) *PullRequestWriter_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type PullRequestWriter
+func (_mock *PullRequestWriter) Create(ctx context.Context, title string, body string, branch string, targetBranch string) (*pull_request.PullRequest, error) {
+	ret := _mock.Called(ctx, title, body, branch, targetBranch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *pull_request.PullRequest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string) (*pull_request.PullRequest, error)); ok {
+		return returnFunc(ctx, title, body, branch, targetBranch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string) *pull_request.PullRequest); ok {
+		r0 = returnFunc(ctx, title, body, branch, targetBranch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pull_request.PullRequest)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = returnFunc(ctx, title, body, branch, targetBranch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// PullRequestWriter_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type PullRequestWriter_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - title string
+//   - body string
+//   - branch string
+//   - targetBranch string
+func (_e *PullRequestWriter_Expecter) Create(ctx interface{}, title interface{}, body interface{}, branch interface{}, targetBranch interface{}) *PullRequestWriter_Create_Call {
+	return &PullRequestWriter_Create_Call{Call: _e.mock.On("Create", ctx, title, body, branch, targetBranch)}
+}
+
+func (_c *PullRequestWriter_Create_Call) Run(run func(ctx context.Context, title string, body string, branch string, targetBranch string)) *PullRequestWriter_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *PullRequestWriter_Create_Call) Return(pullRequest *pull_request.PullRequest, err error) *PullRequestWriter_Create_Call {
+	_c.Call.Return(pullRequest, err)
+	return _c
+}
+
+func (_c *PullRequestWriter_Create_Call) RunAndReturn(run func(ctx context.Context, title string, body string, branch string, targetBranch string) (*pull_request.PullRequest, error)) *PullRequestWriter_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function for the type PullRequestWriter
+func (_mock *PullRequestWriter) Find(ctx context.Context, branch string, targetBranch string) (*pull_request.PullRequest, error) {
+	ret := _mock.Called(ctx, branch, targetBranch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 *pull_request.PullRequest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*pull_request.PullRequest, error)); ok {
+		return returnFunc(ctx, branch, targetBranch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *pull_request.PullRequest); ok {
+		r0 = returnFunc(ctx, branch, targetBranch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pull_request.PullRequest)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, branch, targetBranch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// PullRequestWriter_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type PullRequestWriter_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - branch string
+//   - targetBranch string
+func (_e *PullRequestWriter_Expecter) Find(ctx interface{}, branch interface{}, targetBranch interface{}) *PullRequestWriter_Find_Call {
+	return &PullRequestWriter_Find_Call{Call: _e.mock.On("Find", ctx, branch, targetBranch)}
+}
+
+func (_c *PullRequestWriter_Find_Call) Run(run func(ctx context.Context, branch string, targetBranch string)) *PullRequestWriter_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *PullRequestWriter_Find_Call) Return(pullRequest *pull_request.PullRequest, err error) *PullRequestWriter_Find_Call {
+	_c.Call.Return(pullRequest, err)
+	return _c
+}
+
+func (_c *PullRequestWriter_Find_Call) RunAndReturn(run func(ctx context.Context, branch string, targetBranch string) (*pull_request.PullRequest, error)) *PullRequestWriter_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type PullRequestWriter
+func (_mock *PullRequestWriter) List(ctx context.Context) ([]*pull_request.PullRequest, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*pull_request.PullRequest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*pull_request.PullRequest, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*pull_request.PullRequest); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*pull_request.PullRequest)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// PullRequestWriter_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type PullRequestWriter_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PullRequestWriter_Expecter) List(ctx interface{}) *PullRequestWriter_List_Call {
+	return &PullRequestWriter_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *PullRequestWriter_List_Call) Run(run func(ctx context.Context)) *PullRequestWriter_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *PullRequestWriter_List_Call) Return(pullRequests []*pull_request.PullRequest, err error) *PullRequestWriter_List_Call {
+	_c.Call.Return(pullRequests, err)
+	return _c
+}
+
+func (_c *PullRequestWriter_List_Call) RunAndReturn(run func(ctx context.Context) ([]*pull_request.PullRequest, error)) *PullRequestWriter_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type PullRequestWriter
+func (_mock *PullRequestWriter) Update(ctx context.Context, number int, title string, body string) (*pull_request.PullRequest, error) {
+	ret := _mock.Called(ctx, number, title, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *pull_request.PullRequest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string, string) (*pull_request.PullRequest, error)); ok {
+		return returnFunc(ctx, number, title, body)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string, string) *pull_request.PullRequest); ok {
+		r0 = returnFunc(ctx, number, title, body)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pull_request.PullRequest)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, string, string) error); ok {
+		r1 = returnFunc(ctx, number, title, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// PullRequestWriter_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type PullRequestWriter_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - number int
+//   - title string
+//   - body string
+func (_e *PullRequestWriter_Expecter) Update(ctx interface{}, number interface{}, title interface{}, body interface{}) *PullRequestWriter_Update_Call {
+	return &PullRequestWriter_Update_Call{Call: _e.mock.On("Update", ctx, number, title, body)}
+}
+
+func (_c *PullRequestWriter_Update_Call) Run(run func(ctx context.Context, number int, title string, body string)) *PullRequestWriter_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *PullRequestWriter_Update_Call) Return(pullRequest *pull_request.PullRequest, err error) *PullRequestWriter_Update_Call {
+	_c.Call.Return(pullRequest, err)
+	return _c
+}
+
+func (_c *PullRequestWriter_Update_Call) RunAndReturn(run func(ctx context.Context, number int, title string, body string) (*pull_request.PullRequest, error)) *PullRequestWriter_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/env"
 	"github.com/argoproj/argo-cd/v3/util/errors"
+	"github.com/argoproj/argo-cd/v3/util/gpg"
 	"github.com/argoproj/argo-cd/v3/util/healthz"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 )
@@ -29,10 +30,11 @@ import (
 // NewCommand returns a new instance of an argocd-commit-server command
 func NewCommand() *cobra.Command {
 	var (
-		listenHost  string
-		listenPort  int
-		metricsPort int
-		metricsHost string
+		listenHost     string
+		listenPort     int
+		metricsPort    int
+		metricsHost    string
+		signingKeyPath string
 	)
 	command := &cobra.Command{
 		Use:   "argocd-commit-server",
@@ -57,7 +59,10 @@ func NewCommand() *cobra.Command {
 			askPassServer := askpass.NewServer(askpass.CommitServerSocketPath)
 			go func() { errors.CheckError(askPassServer.Run()) }()
 
-			server := commitserver.NewServer(askPassServer, metricsServer)
+			signingKey, err := loadSigningKey(signingKeyPath)
+			errors.CheckError(err)
+
+			server := commitserver.NewServer(askPassServer, metricsServer, signingKey)
 			grpc := server.CreateGRPC()
 			ctx := cmd.Context()
 
@@ -114,6 +119,32 @@ func NewCommand() *cobra.Command {
 	command.Flags().IntVar(&listenPort, "port", common.DefaultPortCommitServer, "Listen on given port for incoming connections")
 	command.Flags().StringVar(&metricsHost, "metrics-address", env.StringFromEnv("ARGOCD_COMMIT_SERVER_METRICS_LISTEN_ADDRESS", common.DefaultAddressCommitServerMetrics), "Listen on given address for metrics")
 	command.Flags().IntVar(&metricsPort, "metrics-port", common.DefaultPortCommitServerMetrics, "Start metrics server on given port")
+	command.Flags().StringVar(&signingKeyPath, "signing-key-path", env.StringFromEnv("ARGOCD_COMMIT_SERVER_SIGNING_KEY_PATH", common.DefaultCommitServerSigningKeyPath), "Path to an ASCII-armored GnuPG private key used to sign hydrated commits. Commits are not signed if the file does not exist")
 
 	return command
 }
+
+// loadSigningKey imports the GnuPG private key at the given path into the keyring and returns its fingerprint. An empty
+// fingerprint is returned if no key is configured.
+func loadSigningKey(path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			log.Infof("No signing key found at %s, hydrated commits will not be signed", path)
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to read signing key: %w", err)
+	}
+	if !gpg.IsGPGEnabled() {
+		log.Warnf("Signing key found at %s, but GnuPG is disabled. Hydrated commits will not be signed", path)
+		return "", nil
+	}
+	fingerprint, err := gpg.ImportPGPSigningKey(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to import signing key: %w", err)
+	}
+	log.Infof("Signing hydrated commits with GnuPG key %s", fingerprint)
+	return fingerprint, nil
+}
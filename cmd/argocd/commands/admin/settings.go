@@ -5,6 +5,7 @@ import (
 	"context"
 	stderrors "errors"
 	"fmt"
+	"io"
 	"os"
 	"reflect"
 	"sort"
@@ -24,9 +25,12 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v3/common"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
 	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo/drift"
 	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
+	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	"github.com/argoproj/argo-cd/v3/util/lua"
@@ -149,7 +153,7 @@ func (opts *settingsOpts) getK8sClient() (*kubernetes.Clientset, string, error)
 	return realClientset, namespace, nil
 }
 
-func NewSettingsCommand() *cobra.Command {
+func NewSettingsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var opts settingsOpts
 
 	command := &cobra.Command{
@@ -164,6 +168,7 @@ func NewSettingsCommand() *cobra.Command {
 	command.AddCommand(NewValidateSettingsCommand(&opts))
 	command.AddCommand(NewResourceOverridesCommand(&opts))
 	command.AddCommand(NewRBACCommand())
+	command.AddCommand(NewSuggestIgnoreDifferencesCommand(&opts, clientOpts))
 
 	opts.clientConfig = cli.AddKubectlFlagsToCmd(command)
 	command.PersistentFlags().StringVar(&opts.argocdCMPath, "argocd-cm-path", "", "Path to local argocd-cm.yaml file")
@@ -639,3 +644,75 @@ argocd admin settings resource-overrides action /tmp/deploy.yaml restart --argoc
 	command.Flags().StringArrayVar(&resourceActionParameters, "param", []string{}, "Action parameters (e.g. --param key1=value1)")
 	return command
 }
+
+// NewSuggestIgnoreDifferencesCommand returns a new instance of the `argocd admin settings suggest-ignore-differences` command
+func NewSuggestIgnoreDifferencesCommand(opts *settingsOpts, clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		minObservations  int64
+		minApps          int
+		output           string
+		cacheSrc         func() (*appstatecache.Cache, error)
+		portForwardRedis bool
+	)
+	command := &cobra.Command{
+		Use:   "suggest-ignore-differences",
+		Short: "Suggest ignoreDifferences rules for fields which keep differing after successful syncs",
+		Long: "Suggests 'ignoreDifferences' rules for the fields which the application controller observed to keep differing " +
+			"from the desired state after the applications were successfully synced. Such fields are typically set by mutating " +
+			"webhooks or other controllers.",
+		Example: `
+#Print the fields which differed in at least 10 reconciliations of at least 2 applications
+argocd admin settings suggest-ignore-differences --min-observations 10 --min-apps 2
+
+#Print the suggested rules as YAML which can be added to the 'resource.customizations.ignoreDifferences' settings
+argocd admin settings suggest-ignore-differences -o yaml`,
+		Run: func(c *cobra.Command, _ []string) {
+			ctx := c.Context()
+
+			kubeClient, namespace, err := opts.getK8sClient()
+			errors.CheckError(err)
+			cache, err := newAppStateCache(ctx, kubeClient, namespace, portForwardRedis, cacheSrc, clientOpts.RedisName, clientOpts.RedisHaProxyName, clientOpts.RedisCompression)
+			errors.CheckError(err)
+
+			records, err := drift.GetRecords(cache)
+			errors.CheckError(err)
+			errors.CheckError(printIgnoreDifferencesSuggestions(os.Stdout, drift.Suggest(records, minObservations, minApps), output))
+		},
+	}
+	command.Flags().Int64Var(&minObservations, "min-observations", 10, "Minimum number of reconciliations in which a field differed")
+	command.Flags().IntVar(&minApps, "min-apps", 1, "Minimum number of applications in which a field differed")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|yaml")
+	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
+	cacheSrc = appstatecache.AddCacheFlagsToCmd(command)
+
+	// parse all added flags so far to get the redis-compression flag that was added by AddCacheFlagsToCmd() above
+	// we can ignore unchecked error here as the command will be parsed again and checked when command.Execute() is run later
+	//nolint:errcheck
+	command.ParseFlags(os.Args[1:])
+	return command
+}
+
+func printIgnoreDifferencesSuggestions(out io.Writer, records []*drift.Record, output string) error {
+	switch output {
+	case "yaml":
+		data, err := yaml.Marshal(drift.IgnoreDifferences(records))
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(data)
+		return err
+	case "wide", "":
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintf(w, "GROUP\tKIND\tJSON POINTER\tOBSERVATIONS\tAPPS\tMANAGERS\n")
+		for _, r := range records {
+			managers := strings.Join(r.Managers, ",")
+			if managers == "" {
+				managers = "<none>"
+			}
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%s\n", r.Group, r.Kind, r.JSONPointer, r.Observations, len(r.Apps), managers)
+		}
+		return w.Flush()
+	default:
+		return fmt.Errorf("unknown output format: %s", output)
+	}
+}
@@ -1,12 +1,15 @@
 package admin
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/argo/drift"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 
@@ -448,3 +451,46 @@ resume   false
 		assert.Contains(t, out, "false")
 	})
 }
+
+func TestPrintIgnoreDifferencesSuggestions(t *testing.T) {
+	records := []*drift.Record{{
+		Group:        "apps",
+		Kind:         "Deployment",
+		JSONPointer:  "/spec/replicas",
+		Observations: 12,
+		Apps:         []string{"argocd/guestbook", "argocd/helm-guestbook"},
+		Managers:     []string{"kube-controller-manager"},
+		LastObserved: time.Now(),
+	}, {
+		Kind:         "Service",
+		JSONPointer:  "/spec/clusterIP",
+		Observations: 10,
+		Apps:         []string{"argocd/guestbook"},
+	}}
+
+	t.Run("Wide", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, printIgnoreDifferencesSuggestions(&out, records, "wide"))
+		assert.Equal(t, `GROUP  KIND        JSON POINTER     OBSERVATIONS  APPS  MANAGERS
+apps   Deployment  /spec/replicas   12            2     kube-controller-manager
+       Service     /spec/clusterIP  10            1     <none>
+`, out.String())
+	})
+
+	t.Run("YAML", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, printIgnoreDifferencesSuggestions(&out, records, "yaml"))
+		assert.Equal(t, `- group: apps
+  jsonPointers:
+  - /spec/replicas
+  kind: Deployment
+- jsonPointers:
+  - /spec/clusterIP
+  kind: Service
+`, out.String())
+	})
+
+	t.Run("UnknownOutput", func(t *testing.T) {
+		require.Error(t, printIgnoreDifferencesSuggestions(io.Discard, records, "json"))
+	})
+}
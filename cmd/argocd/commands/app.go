@@ -17,6 +17,7 @@ import (
 	"time"
 	"unicode/utf8"
 
+	"github.com/argoproj/gitops-engine/pkg/diff"
 	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/sync/hook"
@@ -96,7 +97,9 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationDeleteCommand(clientOpts))
 	command.AddCommand(NewApplicationWaitCommand(clientOpts))
 	command.AddCommand(NewApplicationManifestsCommand(clientOpts))
+	command.AddCommand(NewApplicationHydrateCommand(clientOpts))
 	command.AddCommand(NewApplicationTerminateOpCommand(clientOpts))
+	command.AddCommand(NewApplicationOperationQueueCommand(clientOpts))
 	command.AddCommand(NewApplicationEditCommand(clientOpts))
 	command.AddCommand(NewApplicationPatchCommand(clientOpts))
 	command.AddCommand(NewApplicationGetResourceCommand(clientOpts))
@@ -119,6 +122,8 @@ type watchOpts struct {
 	degraded  bool
 	delete    bool
 	hydrated  bool
+	// dryRun prints the plan of the dry-run sync operation instead of the application resources
+	dryRun bool
 }
 
 // NewApplicationCreateCommand returns a new instance of an `argocd app create` command
@@ -1293,12 +1298,23 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		sourcePositions      []int64
 		sourceNames          []string
 		ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts
+		showFieldOwners      bool
+		output               string
+		semantic             bool
 	)
 	shortDesc := "Perform a diff against the target and live state."
 	command := &cobra.Command{
 		Use:   "diff APPNAME",
 		Short: shortDesc,
 		Long:  shortDesc + "\nUses 'diff' to render the difference. KUBECTL_EXTERNAL_DIFF environment variable can be used to select your own diff tool.\nReturns the following exit codes: 2 on general errors, 1 when a diff is found, and 0 when no diff is found\nKubernetes Secrets are ignored from this diff.",
+		Example: `  # Print a unified diff of each resource of an app
+  argocd app diff my-app
+
+  # Print the JSON patch operations which sync each resource of an app
+  argocd app diff my-app -o json
+
+  # Print the number of modified, added and removed resources of an app per kind, ignoring equivalent values
+  argocd app diff my-app -o summary --semantic`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -1348,7 +1364,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			defer utilio.Close(conn)
 			argoSettings, err := settingsIf.Get(ctx, &settings.SettingsQuery{})
 			errors.CheckError(err)
-			diffOption := &DifferenceOption{}
+			diffOption := &DifferenceOption{showFieldOwners: showFieldOwners, output: output, semantic: semantic}
 
 			hasServerSideDiffAnnotation := resourceutil.HasAnnotationOption(app, argocommon.AnnotationCompareOptions, "ServerSideDiff=true")
 
@@ -1444,17 +1460,221 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().Int64SliceVar(&sourcePositions, "source-positions", []int64{}, "List of source positions. Default is empty array. Counting start at 1.")
 	command.Flags().StringArrayVar(&sourceNames, "source-names", []string{}, "List of source names. Default is an empty array.")
 	command.Flags().DurationVar(&ignoreNormalizerOpts.JQExecutionTimeout, "ignore-normalizer-jq-execution-timeout", normalizers.DefaultJQExecutionTimeout, "Set ignore normalizer JQ execution timeout")
+	command.Flags().BoolVar(&showFieldOwners, "show-field-owners", false, "Show the field managers which own the differing fields in the live resources")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|summary. Prints a unified diff of each resource by default")
+	command.Flags().BoolVar(&semantic, "semantic", false, "Ignore differences between equivalent values of quantity and duration fields, such as the resource limits 1000m and 1 or the timeouts 60s and 1m")
 	return command
 }
 
-// printResourceDiff prints the diff header and calls cli.PrintDiff for a resource
-func printResourceDiff(group, kind, namespace, name string, live, target *unstructured.Unstructured) {
+const (
+	diffStatusAdded    = "Added"
+	diffStatusRemoved  = "Removed"
+	diffStatusModified = "Modified"
+)
+
+// diffStatus returns whether a resource is added, removed or modified by the sync of an application
+func diffStatus(live, target *unstructured.Unstructured) string {
+	switch {
+	case live == nil:
+		return diffStatusAdded
+	case target == nil:
+		return diffStatusRemoved
+	default:
+		return diffStatusModified
+	}
+}
+
+// diffPrinter prints the differences between the live and the target states of the resources of an application
+type diffPrinter interface {
+	// printResourceDiff prints the difference of a resource. The live state is nil if the resource is added and the
+	// target state is nil if the resource is removed.
+	printResourceDiff(group, kind, namespace, name string, live, target *unstructured.Unstructured, fieldOwners []argoappv1.ResourceFieldOwner)
+	// flush prints the differences which are collected until all resources are compared
+	flush()
+}
+
+// newDiffPrinter returns the diff printer of the given output format
+func newDiffPrinter(output string, out io.Writer) (diffPrinter, error) {
+	switch output {
+	case "":
+		return &textDiffPrinter{}, nil
+	case "json":
+		return &jsonDiffPrinter{out: out, diffs: []resourceDiffResult{}}, nil
+	case "summary":
+		return &summaryDiffPrinter{out: out, counts: make(map[schema.GroupKind]*diffCounts)}, nil
+	default:
+		return nil, fmt.Errorf("unknown output format: %s", output)
+	}
+}
+
+// textDiffPrinter prints a unified diff of each resource
+type textDiffPrinter struct{}
+
+func (p *textDiffPrinter) printResourceDiff(group, kind, namespace, name string, live, target *unstructured.Unstructured, fieldOwners []argoappv1.ResourceFieldOwner) {
+	printResourceDiff(group, kind, namespace, name, live, target, fieldOwners)
+}
+
+func (p *textDiffPrinter) flush() {}
+
+// resourceDiffResult holds the difference of a resource printed by the json output format
+type resourceDiffResult struct {
+	Group     string `json:"group,omitempty"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// Status is either Added, Removed or Modified
+	Status string `json:"status"`
+	// Patch holds the JSON patch operations which transform the live state into the target state of a modified resource
+	Patch       []argodiff.PatchOperation      `json:"patch,omitempty"`
+	FieldOwners []argoappv1.ResourceFieldOwner `json:"fieldOwners,omitempty"`
+}
+
+// jsonDiffPrinter prints the JSON patch operations of all resources as a JSON list
+type jsonDiffPrinter struct {
+	out   io.Writer
+	diffs []resourceDiffResult
+}
+
+func (p *jsonDiffPrinter) printResourceDiff(group, kind, namespace, name string, live, target *unstructured.Unstructured, fieldOwners []argoappv1.ResourceFieldOwner) {
+	res := resourceDiffResult{
+		Group:       group,
+		Kind:        kind,
+		Namespace:   namespace,
+		Name:        name,
+		Status:      diffStatus(live, target),
+		FieldOwners: fieldOwners,
+	}
+	if res.Status == diffStatusModified {
+		patch, err := argodiff.JSONPatch(live, target)
+		errors.CheckError(err)
+		res.Patch = patch
+	}
+	p.diffs = append(p.diffs, res)
+}
+
+func (p *jsonDiffPrinter) flush() {
+	data, err := json.MarshalIndent(p.diffs, "", "  ")
+	errors.CheckError(err)
+	_, _ = fmt.Fprintln(p.out, string(data))
+}
+
+type diffCounts struct {
+	modified int
+	added    int
+	removed  int
+}
+
+// summaryDiffPrinter prints the number of modified, added and removed resources per kind
+type summaryDiffPrinter struct {
+	out    io.Writer
+	counts map[schema.GroupKind]*diffCounts
+}
+
+func (p *summaryDiffPrinter) printResourceDiff(group, kind, _, _ string, live, target *unstructured.Unstructured, _ []argoappv1.ResourceFieldOwner) {
+	gk := schema.GroupKind{Group: group, Kind: kind}
+	counts, ok := p.counts[gk]
+	if !ok {
+		counts = &diffCounts{}
+		p.counts[gk] = counts
+	}
+	switch diffStatus(live, target) {
+	case diffStatusAdded:
+		counts.added++
+	case diffStatusRemoved:
+		counts.removed++
+	default:
+		counts.modified++
+	}
+}
+
+func (p *summaryDiffPrinter) flush() {
+	kinds := make([]schema.GroupKind, 0, len(p.counts))
+	for gk := range p.counts {
+		kinds = append(kinds, gk)
+	}
+	slices.SortFunc(kinds, func(a, b schema.GroupKind) int {
+		if a.Group != b.Group {
+			return strings.Compare(a.Group, b.Group)
+		}
+		return strings.Compare(a.Kind, b.Kind)
+	})
+	w := tabwriter.NewWriter(p.out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "GROUP\tKIND\tMODIFIED\tADDED\tREMOVED\n")
+	for _, gk := range kinds {
+		counts := p.counts[gk]
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\n", gk.Group, gk.Kind, counts.modified, counts.added, counts.removed)
+	}
+	_ = w.Flush()
+}
+
+// semanticDiff replaces the values of the target state which are equivalent to the live values, and returns the
+// resulting target state and whether it still differs from the live state
+func semanticDiff(live, target *unstructured.Unstructured) (*unstructured.Unstructured, bool) {
+	if live == nil || target == nil {
+		return target, true
+	}
+	target = target.DeepCopy()
+	argodiff.NormalizeEquivalentValues(live, target)
+	return target, !reflect.DeepEqual(live.Object, target.Object)
+}
+
+// printResourceDiff prints the diff header and calls cli.PrintDiff for a resource, followed by the owners of the
+// differing fields if any are given
+func printResourceDiff(group, kind, namespace, name string, live, target *unstructured.Unstructured, fieldOwners []argoappv1.ResourceFieldOwner) {
 	fmt.Printf("\n===== %s/%s %s/%s ======\n", group, kind, namespace, name)
 	_ = cli.PrintDiff(name, live, target)
+	if len(fieldOwners) > 0 {
+		fmt.Println()
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		printFieldOwners(w, fieldOwners)
+		_ = w.Flush()
+	}
+}
+
+// printFieldOwners prints the field managers which own the differing fields of a resource
+func printFieldOwners(w io.Writer, fieldOwners []argoappv1.ResourceFieldOwner) {
+	_, _ = fmt.Fprintf(w, "FIELD\tMANAGER\tOPERATION\tSUBRESOURCE\n")
+	for _, owner := range fieldOwners {
+		manager := owner.Manager
+		if manager == "" {
+			manager = "<none>"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", owner.Path, manager, owner.Operation, owner.Subresource)
+	}
+}
+
+// findResourceDiff returns the managed resource with the given key, or nil if the resource is not managed
+func findResourceDiff(resources *application.ManagedResourcesResponse, key kube.ResourceKey) *argoappv1.ResourceDiff {
+	for _, res := range resources.Items {
+		if res.Group == key.Group && res.Kind == key.Kind && res.Namespace == key.Namespace && res.Name == key.Name {
+			return res
+		}
+	}
+	return nil
+}
+
+// getFieldOwners returns the owners of the differing fields of a resource. The owners reported by the controller are
+// used when the live state is compared to the target state of the application, otherwise the owners are computed
+// from the managed fields of the live resource.
+func getFieldOwners(res *argoappv1.ResourceDiff, diffOptions *DifferenceOption, diffRes *diff.DiffResult) []argoappv1.ResourceFieldOwner {
+	if res == nil || res.LiveState == "" || res.LiveState == "null" {
+		return nil
+	}
+	if diffOptions.local == "" && diffOptions.res == nil && diffOptions.serversideRes == nil {
+		return res.FieldOwners
+	}
+	live := &unstructured.Unstructured{}
+	err := json.Unmarshal([]byte(res.LiveState), live)
+	errors.CheckError(err)
+	fieldOwners, err := argodiff.FieldOwners(live, diffRes, nil)
+	if err != nil {
+		log.Warnf("Failed to get field owners of %s/%s: %v", res.Kind, res.Name, err)
+	}
+	return fieldOwners
 }
 
 // findAndPrintServerSideDiff performs a server-side diff by making requests to the api server and prints the response
-func findAndPrintServerSideDiff(ctx context.Context, app *argoappv1.Application, items []objKeyLiveTarget, resources *application.ManagedResourcesResponse, appIf application.ApplicationServiceClient, appName, appNs string) bool {
+func findAndPrintServerSideDiff(ctx context.Context, app *argoappv1.Application, items []objKeyLiveTarget, resources *application.ManagedResourcesResponse, appIf application.ApplicationServiceClient, appName, appNs string, diffOptions *DifferenceOption, printer diffPrinter) bool {
 	// Process each item for server-side diff
 	foundDiffs := false
 	for _, item := range items {
@@ -1467,13 +1687,7 @@ func findAndPrintServerSideDiff(ctx context.Context, app *argoappv1.Application,
 		var targetManifest string
 
 		if item.live != nil {
-			for _, res := range resources.Items {
-				if res.Group == item.key.Group && res.Kind == item.key.Kind &&
-					res.Namespace == item.key.Namespace && res.Name == item.key.Name {
-					liveResource = res
-					break
-				}
-			}
+			liveResource = findResourceDiff(resources, item.key)
 		}
 
 		if liveResource == nil {
@@ -1532,9 +1746,20 @@ func findAndPrintServerSideDiff(ctx context.Context, app *argoappv1.Application,
 					errors.CheckError(err)
 				}
 
+				if diffOptions.semantic {
+					var modified bool
+					if target, modified = semanticDiff(live, target); !modified {
+						continue
+					}
+				}
+
 				// Print resulting diff for this resource
 				foundDiffs = true
-				printResourceDiff(resultItem.Group, resultItem.Kind, resultItem.Namespace, resultItem.Name, live, target)
+				var fieldOwners []argoappv1.ResourceFieldOwner
+				if diffOptions.showFieldOwners {
+					fieldOwners = resultItem.FieldOwners
+				}
+				printer.printResourceDiff(resultItem.Group, resultItem.Kind, resultItem.Namespace, resultItem.Name, live, target, fieldOwners)
 			}
 		}
 	}
@@ -1544,24 +1769,31 @@ func findAndPrintServerSideDiff(ctx context.Context, app *argoappv1.Application,
 
 // DifferenceOption struct to store diff options
 type DifferenceOption struct {
-	local         string
-	localRepoRoot string
-	revision      string
-	cluster       *argoappv1.Cluster
-	res           *repoapiclient.ManifestResponse
-	serversideRes *repoapiclient.ManifestResponse
-	revisions     []string
+	local           string
+	localRepoRoot   string
+	revision        string
+	cluster         *argoappv1.Cluster
+	res             *repoapiclient.ManifestResponse
+	serversideRes   *repoapiclient.ManifestResponse
+	revisions       []string
+	showFieldOwners bool
+	output          string
+	semantic        bool
 }
 
 // findAndPrintDiff ... Prints difference between application current state and state stored in git or locally, returns boolean as true if difference is found else returns false
 func findAndPrintDiff(ctx context.Context, app *argoappv1.Application, proj *argoappv1.AppProject, resources *application.ManagedResourcesResponse, argoSettings *settings.Settings, diffOptions *DifferenceOption, ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts, useServerSideDiff bool, appIf application.ApplicationServiceClient, appName, appNs string) bool {
 	var foundDiffs bool
 
+	printer, err := newDiffPrinter(diffOptions.output, os.Stdout)
+	errors.CheckError(err)
+	defer printer.flush()
+
 	items, err := prepareObjectsForDiff(ctx, app, proj, resources, argoSettings, diffOptions)
 	errors.CheckError(err)
 
 	if useServerSideDiff {
-		return findAndPrintServerSideDiff(ctx, app, items, resources, appIf, appName, appNs)
+		return findAndPrintServerSideDiff(ctx, app, items, resources, appIf, appName, appNs, diffOptions, printer)
 	}
 
 	for _, item := range items {
@@ -1599,8 +1831,18 @@ func findAndPrintDiff(ctx context.Context, app *argoappv1.Application, proj *arg
 				live = item.live
 				target = item.target
 			}
+			if diffOptions.semantic {
+				var modified bool
+				if target, modified = semanticDiff(live, target); !modified {
+					continue
+				}
+			}
 			foundDiffs = true
-			printResourceDiff(item.key.Group, item.key.Kind, item.key.Namespace, item.key.Name, live, target)
+			var fieldOwners []argoappv1.ResourceFieldOwner
+			if diffOptions.showFieldOwners && diffRes.Modified {
+				fieldOwners = getFieldOwners(findResourceDiff(resources, item.key), diffOptions, &diffRes)
+			}
+			printer.printResourceDiff(item.key.Group, item.key.Kind, item.key.Namespace, item.key.Name, live, target, fieldOwners)
 		}
 	}
 	return foundDiffs
@@ -1953,6 +2195,40 @@ func parseSelectedResources(resources []string) ([]*argoappv1.SyncOperationResou
 	return selectedResources, nil
 }
 
+// parseSyncWaves parses a sync wave range formatted as FROM:TO. Either bound may be left blank, and a single wave may
+// be given as WAVE.
+func parseSyncWaves(waves string) (*argoappv1.SyncWaveRange, error) {
+	if waves == "" {
+		return nil, nil
+	}
+	from, to, found := strings.Cut(waves, ":")
+	if !found {
+		to = from
+	}
+	parseBound := func(bound string) (*int64, error) {
+		if bound == "" {
+			return nil, nil
+		}
+		wave, err := strconv.ParseInt(bound, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("sync waves should be formatted as FROM:TO, but instead got: %s", waves)
+		}
+		return &wave, nil
+	}
+	syncWaves := &argoappv1.SyncWaveRange{}
+	var err error
+	if syncWaves.From, err = parseBound(from); err != nil {
+		return nil, err
+	}
+	if syncWaves.To, err = parseBound(to); err != nil {
+		return nil, err
+	}
+	if syncWaves.From == nil && syncWaves.To == nil {
+		return nil, fmt.Errorf("sync waves should be formatted as FROM:TO, but instead got: %s", waves)
+	}
+	return syncWaves, nil
+}
+
 func getWatchOpts(watch watchOpts) watchOpts {
 	// if no opts are defined should wait for sync,health,operation
 	if (watch == watchOpts{}) {
@@ -2054,6 +2330,13 @@ func printAppResources(w io.Writer, app *argoappv1.Application) {
 	}
 }
 
+func printSyncPlan(w io.Writer, plan []argoappv1.SyncPlanResource) {
+	_, _ = fmt.Fprintf(w, "ACTION\tPHASE\tWAVE\tGROUP\tKIND\tNAMESPACE\tNAME\n")
+	for _, res := range plan {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%s\t%s\n", res.Action, res.SyncPhase, res.SyncWave, res.Group, res.Kind, res.Namespace, res.Name)
+	}
+}
+
 func printTreeView(nodeMapping map[string]argoappv1.ResourceNode, parentChildMapping map[string][]string, parentNodes map[string]struct{}, mapNodeNameToResourceState map[string]*resourceState) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	_, _ = fmt.Fprintf(w, "KIND/NAME\tSTATUS\tHEALTH\tMESSAGE\n")
@@ -2090,6 +2373,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		replace                 bool
 		serverSideApply         bool
 		applyOutOfSyncOnly      bool
+		continueOnError         bool
 		async                   bool
 		retryLimit              int64
 		retryRefresh            bool
@@ -2105,6 +2389,9 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		output                  string
 		appNamespace            string
 		ignoreNormalizerOpts    normalizers.IgnoreNormalizerOpts
+		syncWaves               string
+		syncPhases              []string
+		approve                 bool
 	)
 	command := &cobra.Command{
 		Use:   "sync [APPNAME... | -l selector | --project project-name]",
@@ -2134,7 +2421,19 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
   argocd app sync my-app --resource apps:Deployment:my-service --resource :Service:my-service
   argocd app sync my-app --resource '!*:Service:*'
   # Specify namespace if the application has resources with the same name in different namespaces
-  argocd app sync my-app --resource argoproj.io:Rollout:my-namespace/my-rollout`,
+  argocd app sync my-app --resource argoproj.io:Rollout:my-namespace/my-rollout
+
+  # Sync only the resources of sync waves 0 to 2
+  argocd app sync my-app --sync-waves 0:2
+
+  # Continue a sync from sync wave 3, skipping the PreSync hooks
+  argocd app sync my-app --sync-waves 3: --sync-phases Sync,PostSync
+
+  # Approve the sync wave the running sync waits for, and wait for the sync to continue
+  argocd app sync my-app --approve
+
+  # Print the plan of a dry-run sync, including the server-side dry-run diff of each resource, as JSON
+  argocd app sync my-app --dry-run -o json`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 			if len(args) == 0 && selector == "" && len(projects) == 0 {
@@ -2171,6 +2470,9 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				}
 			}
 
+			syncWaveRange, err := parseSyncWaves(syncWaves)
+			errors.CheckError(err)
+
 			acdClient := headless.NewClientOrDie(clientOpts, c)
 			conn, appIf := acdClient.NewApplicationClientOrDie()
 			defer utilio.Close(conn)
@@ -2331,6 +2633,9 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					if applyOutOfSyncOnly {
 						items = append(items, common.SyncOptionApplyOutOfSyncOnly)
 					}
+					if continueOnError {
+						items = append(items, common.SyncOptionContinueOnError)
+					}
 
 					if len(items) == 0 {
 						// for prevent send even empty array if not need
@@ -2352,6 +2657,8 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					SyncOptions:     syncOptionsFactory(),
 					Revisions:       revisions,
 					SourcePositions: sourcePositions,
+					SyncWaves:       syncWaveRange,
+					SyncPhases:      syncPhases,
 				}
 
 				switch strategy {
@@ -2406,15 +2713,30 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 						}
 					}
 				}
-				_, err = appIf.Sync(ctx, &syncReq)
+				if approve {
+					_, err = appIf.ApproveOperation(ctx, &application.OperationApproveRequest{
+						Name:         &appName,
+						AppNamespace: &appNs,
+					})
+				} else {
+					var syncedApp *argoappv1.Application
+					syncedApp, err = appIf.Sync(ctx, &syncReq)
+					if err == nil && len(syncedApp.Status.OperationQueue) > 0 {
+						queued := syncedApp.Status.OperationQueue[len(syncedApp.Status.OperationQueue)-1]
+						fmt.Printf("Operation queued as %s behind the operation in progress. Run 'argocd app queue list %s' to list the queued operations.\n", queued.ID, appQualifiedName)
+						continue
+					}
+				}
 				errors.CheckError(err)
 
 				if !async {
-					app, opState, err := waitOnApplicationStatus(ctx, acdClient, appQualifiedName, timeout, watchOpts{operation: true}, selectedResources, output)
+					app, opState, err := waitOnApplicationStatus(ctx, acdClient, appQualifiedName, timeout, watchOpts{operation: true, dryRun: dryRun}, selectedResources, output)
 					errors.CheckError(err)
 
 					if !dryRun {
-						if !opState.Phase.Successful() {
+						if opState.Phase == common.OperationWaitingForApproval {
+							fmt.Printf("Operation is %s. Run 'argocd app sync %s --approve' to continue.\n", opState.Message, appQualifiedName)
+						} else if !opState.Phase.Successful() {
 							log.Fatalf("Operation has completed with phase: %s", opState.Phase)
 						} else if len(selectedResources) == 0 && app.Status.Sync.Status != argoappv1.SyncStatusCodeSynced {
 							// Only get resources to be pruned if sync was application-wide and final status is not synced
@@ -2428,7 +2750,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			}
 		},
 	}
-	command.Flags().BoolVar(&dryRun, "dry-run", false, "Preview apply without affecting cluster")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Preview apply without affecting cluster, and print the plan of the sync")
 	command.Flags().BoolVar(&prune, "prune", false, "Allow deleting unexpected resources")
 	command.Flags().StringVar(&revision, "revision", "", "Sync to a specific revision. Preserves parameter overrides")
 	command.Flags().StringArrayVar(&resources, "resource", []string{}, fmt.Sprintf("Sync only specific resources as GROUP%[1]sKIND%[1]sNAME or %[2]sGROUP%[1]sKIND%[1]sNAME. Fields may be blank and '*' can be used. This option may be specified repeatedly", resourceFieldDelimiter, resourceExcludeIndicator))
@@ -2445,6 +2767,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().BoolVar(&replace, "replace", false, "Use a kubectl create/replace instead apply")
 	command.Flags().BoolVar(&serverSideApply, "server-side", false, "Use server-side apply while syncing the application")
 	command.Flags().BoolVar(&applyOutOfSyncOnly, "apply-out-of-sync-only", false, "Sync only out-of-sync resources")
+	command.Flags().BoolVar(&continueOnError, "continue-on-error", false, "Keep applying the other resources of a sync wave when some of its resources fail")
 	command.Flags().BoolVar(&async, "async", false, "Do not wait for application to sync before continuing")
 	command.Flags().StringVar(&local, "local", "", "Path to a local directory. When this flag is present no git queries will be made")
 	command.Flags().StringVar(&localRepoRoot, "local-repo-root", "/", "Path to the repository root. Used together with --local allows setting the repository root")
@@ -2458,6 +2781,9 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().StringArrayVar(&revisions, "revisions", []string{}, "Show manifests at specific revisions for source position in source-positions")
 	command.Flags().Int64SliceVar(&sourcePositions, "source-positions", []int64{}, "List of source positions. Default is empty array. Counting start at 1.")
 	command.Flags().StringArrayVar(&sourceNames, "source-names", []string{}, "List of source names. Default is an empty array.")
+	command.Flags().StringVar(&syncWaves, "sync-waves", "", "Sync only the resources of the sync waves in this range, formatted as FROM:TO. Either bound may be left blank (e.g. 3: syncs wave 3 and later)")
+	command.Flags().StringSliceVar(&syncPhases, "sync-phases", []string{}, "Sync only the resources of these phases. One or more of: PreSync|Sync|PostSync")
+	command.Flags().BoolVar(&approve, "approve", false, "Approve the sync wave the running operation waits for instead of starting a new sync")
 	return command
 }
 
@@ -2723,12 +3049,29 @@ func waitOnApplicationStatus(ctx context.Context, acdClient argocdclient.Client,
 			}
 		}
 
+		var plan []argoappv1.SyncPlanResource
+		if watch.dryRun && app.Status.OperationState != nil && app.Status.OperationState.SyncResult != nil {
+			plan = app.Status.OperationState.SyncResult.Plan
+		}
+
 		switch output {
 		case "yaml", "json":
-			err := PrintResource(app, output)
+			var err error
+			if watch.dryRun {
+				err = PrintResource(plan, output)
+			} else {
+				err = PrintResource(app, output)
+			}
 			errors.CheckError(err)
 		case "wide", "":
-			if len(app.Status.Resources) > 0 {
+			if watch.dryRun {
+				if len(plan) > 0 {
+					fmt.Println()
+					w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+					printSyncPlan(w, plan)
+					_ = w.Flush()
+				}
+			} else if len(app.Status.Resources) > 0 {
 				fmt.Println()
 				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 				printAppResources(w, app)
@@ -2859,6 +3202,12 @@ func waitOnApplicationStatus(ctx context.Context, acdClient argocdclient.Client,
 			return app, finalOperationState, nil
 		}
 
+		// an operation which waits for approval does not make progress until it is approved
+		if watch.operation && app.Status.OperationState != nil && app.Status.OperationState.Phase == common.OperationWaitingForApproval {
+			app = printFinalStatus(app)
+			return app, finalOperationState, nil
+		}
+
 		newStates := groupResourceStates(app, selectedResources)
 		for _, newState := range newStates {
 			var doPrint bool
@@ -3285,6 +3634,99 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 	return command
 }
 
+// NewApplicationHydrateCommand returns a new instance of an `argocd app hydrate` command
+func NewApplicationHydrateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		dryRun       bool
+		revision     string
+		output       string
+		appNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "hydrate APPNAME",
+		Short: "Hydrate the manifests of an application using the source hydrator",
+		Example: templates.Examples(`
+  # Request hydration of an application
+  argocd app hydrate my-app
+
+  # Preview the changes hydration would commit to the sync branch
+  argocd app hydrate my-app --dry-run
+
+  # Preview the files hydration of a specific dry source revision would commit
+  argocd app hydrate my-app --dry-run --revision my-feature-branch -o files
+  		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			if revision != "" && !dryRun {
+				errors.Fatal(errors.ErrorGeneric, "--revision can only be used together with --dry-run")
+			}
+
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+
+			if !dryRun {
+				// Refreshing an application through the API also requests its hydration.
+				_, err := appIf.Get(ctx, &application.ApplicationQuery{
+					Name:         &appName,
+					AppNamespace: &appNs,
+					Refresh:      getRefreshType(true, false),
+				})
+				errors.CheckError(err)
+				fmt.Printf("Requested hydration of application '%s'\n", appName)
+				return
+			}
+
+			preview, err := appIf.HydratePreview(ctx, &application.ApplicationHydrateQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Revision:     &revision,
+			})
+			errors.CheckError(err)
+			errors.CheckError(printHydratePreview(preview, output))
+		},
+	}
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Preview the files hydration would commit to the sync branch, without committing them")
+	command.Flags().StringVar(&revision, "revision", "", "Revision of the dry source to preview, defaults to the target revision of the dry source")
+	command.Flags().StringVarP(&output, "output", "o", "diff", "Output format of the preview. One of: diff|files|json|yaml")
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only hydrate an application in namespace")
+	return command
+}
+
+// printHydratePreview prints a hydration preview in the given output format
+func printHydratePreview(preview *application.ApplicationHydrateResponse, output string) error {
+	switch output {
+	case "diff":
+		changed := false
+		for _, file := range preview.Files {
+			if file.GetDiff() == "" {
+				continue
+			}
+			changed = true
+			fmt.Print(file.GetDiff())
+		}
+		if !changed {
+			fmt.Printf("Hydrating dry revision %s does not change sync branch %s\n", preview.GetDrySha(), preview.GetSyncBranch())
+		}
+	case "files":
+		for _, file := range preview.Files {
+			// Files without content would be removed from the sync branch.
+			if file.GetContent() == "" {
+				continue
+			}
+			fmt.Printf("===== %s =====\n%s\n", file.GetPath(), file.GetContent())
+		}
+	default:
+		return PrintResource(preview, output)
+	}
+	return nil
+}
+
 // NewApplicationTerminateOpCommand returns a new instance of an `argocd app terminate-op` command
 func NewApplicationTerminateOpCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
@@ -0,0 +1,141 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+var appQueueExample = templates.Examples(`
+	# List the operations queued behind the operation in progress
+	argocd app queue list APPNAME
+
+	# Cancel a queued operation
+	argocd app queue cancel APPNAME ID
+	`)
+
+// NewApplicationOperationQueueCommand returns a new instance of an `argocd app queue` command
+func NewApplicationOperationQueueCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:     "queue",
+		Short:   "Manage the operations queued behind the operation in progress",
+		Example: appQueueExample,
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewApplicationOperationQueueListCommand(clientOpts))
+	command.AddCommand(NewApplicationOperationQueueCancelCommand(clientOpts))
+	return command
+}
+
+// NewApplicationOperationQueueListCommand returns a new instance of an `argocd app queue list` command
+func NewApplicationOperationQueueListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "list APPNAME",
+		Short: "List the operations queued behind the operation in progress",
+		Example: templates.Examples(`
+	# List the queued operations of an application
+	argocd app queue list APPNAME
+	`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+			queue, err := appIf.ListQueuedOperations(ctx, &applicationpkg.ApplicationOperationQueueRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			})
+			errors.CheckError(err)
+
+			switch output {
+			case "yaml":
+				yamlBytes, err := yaml.Marshal(queue.Items)
+				errors.CheckError(err)
+				fmt.Println(string(yamlBytes))
+			case "json":
+				jsonBytes, err := json.MarshalIndent(queue.Items, "", "  ")
+				errors.CheckError(err)
+				fmt.Println(string(jsonBytes))
+			case "":
+				printQueuedOperationsTable(os.Stdout, queue.Items)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "out", "o", "", "Output format. One of: yaml, json")
+	return command
+}
+
+// NewApplicationOperationQueueCancelCommand returns a new instance of an `argocd app queue cancel` command
+func NewApplicationOperationQueueCancelCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "cancel APPNAME ID",
+		Short: "Cancel a queued operation",
+		Example: templates.Examples(`
+	# Cancel a queued operation of an application
+	argocd app queue cancel APPNAME ID
+	`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+			id := args[1]
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+			_, err := appIf.CancelQueuedOperation(ctx, &applicationpkg.QueuedOperationCancelRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Id:           &id,
+			})
+			errors.CheckError(err)
+			fmt.Printf("Application '%s' queued operation %s canceled\n", appName, id)
+		},
+	}
+	return command
+}
+
+func printQueuedOperationsTable(out io.Writer, queue []*v1alpha1.QueuedOperation) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "ID\tQUEUED AT\tINITIATED BY\tREVISION\n")
+	for _, op := range queue {
+		revision := ""
+		if op.Operation.Sync != nil {
+			revision = op.Operation.Sync.Revision
+			if len(op.Operation.Sync.Revisions) > 0 {
+				revision = strings.Join(op.Operation.Sync.Revisions, ",")
+			}
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", op.ID, op.QueuedAt.Format(time.RFC3339), op.Operation.InitiatedBy.Username, revision)
+	}
+	_ = w.Flush()
+}
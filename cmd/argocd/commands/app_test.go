@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -10,9 +11,11 @@ import (
 	"slices"
 	"strings"
 	"testing"
+	"text/tabwriter"
 	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/google/go-cmp/cmp"
@@ -27,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/yaml"
 
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
@@ -173,6 +177,49 @@ func TestPrintTreeViewAppGet(t *testing.T) {
 	assert.Contains(t, output, "numalogic-rollout-demo-5dcd5457d5-6trpt")
 }
 
+func TestPrintHydratePreview(t *testing.T) {
+	preview := &applicationpkg.ApplicationHydrateResponse{
+		DrySha:     ptr.To("abc123"),
+		SyncBranch: ptr.To("env/test"),
+		Files: []*applicationpkg.HydratedFile{
+			{Path: ptr.To("README.md"), Content: ptr.To("readme\n"), CurrentContent: ptr.To("readme\n")},
+			{Path: ptr.To("manifest.yaml"), Content: ptr.To("new\n"), CurrentContent: ptr.To("old\n"), Diff: ptr.To("-old\n+new\n")},
+			{Path: ptr.To("stale.yaml"), CurrentContent: ptr.To("stale\n"), Diff: ptr.To("-stale\n")},
+		},
+	}
+
+	t.Run("diff", func(t *testing.T) {
+		output, err := captureOutput(func() error {
+			return printHydratePreview(preview, "diff")
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "-old\n+new\n-stale\n", output)
+	})
+
+	t.Run("diff without changes", func(t *testing.T) {
+		output, err := captureOutput(func() error {
+			return printHydratePreview(&applicationpkg.ApplicationHydrateResponse{DrySha: ptr.To("abc123"), SyncBranch: ptr.To("env/test")}, "diff")
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Hydrating dry revision abc123 does not change sync branch env/test\n", output)
+	})
+
+	t.Run("files", func(t *testing.T) {
+		output, err := captureOutput(func() error {
+			return printHydratePreview(preview, "files")
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "===== README.md =====\nreadme\n\n===== manifest.yaml =====\nnew\n\n", output)
+	})
+
+	t.Run("unknown output", func(t *testing.T) {
+		_, err := captureOutput(func() error {
+			return printHydratePreview(preview, "table")
+		})
+		require.ErrorContains(t, err, "unknown output format")
+	})
+}
+
 func TestPrintTreeViewDetailedAppGet(t *testing.T) {
 	var nodes [3]v1alpha1.ResourceNode
 	nodes[0].ResourceRef = v1alpha1.ResourceRef{Group: "", Version: "v1", Kind: "Pod", Namespace: "sandbox-rollout-numalogic-demo", Name: "numalogic-rollout-demo-5dcd5457d5-6trpt", UID: "92c3a5fe-d13e-4ae2-b8ec-c10dd3543b28"}
@@ -586,6 +633,140 @@ func TestPrintApplicationHistoryTable(t *testing.T) {
 	require.Equalf(t, output, expectation, "Incorrect print operation output %q, should be %q", output, expectation)
 }
 
+func TestPrintQueuedOperationsTable(t *testing.T) {
+	queuedAt := metav1.NewTime(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
+	queue := []*v1alpha1.QueuedOperation{
+		{
+			ID:        "a1b2c3d4",
+			QueuedAt:  queuedAt,
+			Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Revision: "abc"}, InitiatedBy: v1alpha1.OperationInitiator{Username: "admin"}},
+		},
+		{
+			ID:        "e5f6a7b8",
+			QueuedAt:  queuedAt,
+			Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Revisions: []string{"abc", "def"}}, InitiatedBy: v1alpha1.OperationInitiator{Username: "ci"}},
+		},
+	}
+
+	var out bytes.Buffer
+	printQueuedOperationsTable(&out, queue)
+
+	expectation := "ID        QUEUED AT             INITIATED BY  REVISION\na1b2c3d4  2025-01-02T03:04:05Z  admin         abc\ne5f6a7b8  2025-01-02T03:04:05Z  ci            abc,def\n"
+	assert.Equal(t, expectation, out.String())
+}
+
+func TestPrintSyncPlan(t *testing.T) {
+	plan := []v1alpha1.SyncPlanResource{
+		{Action: synccommon.SyncActionHook, SyncPhase: synccommon.SyncPhasePreSync, Group: "batch", Kind: "Job", Namespace: "default", Name: "migrate"},
+		{Action: synccommon.SyncActionPatch, SyncPhase: synccommon.SyncPhaseSync, SyncWave: 1, Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook"},
+	}
+
+	var out bytes.Buffer
+	w := tabwriter.NewWriter(&out, 0, 0, 2, ' ', 0)
+	printSyncPlan(w, plan)
+	require.NoError(t, w.Flush())
+
+	expectation := "ACTION  PHASE    WAVE  GROUP  KIND        NAMESPACE  NAME\nHook    PreSync  0     batch  Job         default    migrate\nPatch   Sync     1     apps   Deployment  default    guestbook\n"
+	assert.Equal(t, expectation, out.String())
+}
+
+func TestPrintFieldOwners(t *testing.T) {
+	fieldOwners := []v1alpha1.ResourceFieldOwner{
+		{Path: ".metadata.labels.team"},
+		{Path: ".spec.replicas", Manager: "kube-controller-manager", Operation: "Update", Subresource: "scale"},
+	}
+
+	var out bytes.Buffer
+	w := tabwriter.NewWriter(&out, 0, 0, 2, ' ', 0)
+	printFieldOwners(w, fieldOwners)
+	require.NoError(t, w.Flush())
+
+	expectation := "FIELD                  MANAGER                  OPERATION  SUBRESOURCE\n.metadata.labels.team  <none>                              \n.spec.replicas         kube-controller-manager  Update     scale\n"
+	assert.Equal(t, expectation, out.String())
+}
+
+func TestDiffPrinters(t *testing.T) {
+	live := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "guestbook", "namespace": "default"},
+		"spec":       map[string]any{"replicas": int64(1)},
+	}}
+	target := live.DeepCopy()
+	target.Object["spec"] = map[string]any{"replicas": int64(2)}
+	service := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata":   map[string]any{"name": "guestbook", "namespace": "default"},
+	}}
+	printDiffs := func(printer diffPrinter) {
+		printer.printResourceDiff("apps", "Deployment", "default", "guestbook", live, target, []v1alpha1.ResourceFieldOwner{{Path: ".spec.replicas", Manager: "kubectl-edit"}})
+		printer.printResourceDiff("", "Service", "default", "guestbook", nil, service, nil)
+		printer.printResourceDiff("", "Service", "default", "guestbook-old", service, nil, nil)
+		printer.flush()
+	}
+
+	t.Run("JSON", func(t *testing.T) {
+		var out bytes.Buffer
+		printer, err := newDiffPrinter("json", &out)
+		require.NoError(t, err)
+		printDiffs(printer)
+		assert.JSONEq(t, `[
+  {"group": "apps", "kind": "Deployment", "namespace": "default", "name": "guestbook", "status": "Modified",
+   "patch": [{"op": "replace", "path": "/spec/replicas", "value": 2}],
+   "fieldOwners": [{"path": ".spec.replicas", "manager": "kubectl-edit"}]},
+  {"kind": "Service", "namespace": "default", "name": "guestbook", "status": "Added"},
+  {"kind": "Service", "namespace": "default", "name": "guestbook-old", "status": "Removed"}
+]`, out.String())
+	})
+
+	t.Run("JSONNoDiffs", func(t *testing.T) {
+		var out bytes.Buffer
+		printer, err := newDiffPrinter("json", &out)
+		require.NoError(t, err)
+		printer.flush()
+		assert.Equal(t, "[]\n", out.String())
+	})
+
+	t.Run("Summary", func(t *testing.T) {
+		var out bytes.Buffer
+		printer, err := newDiffPrinter("summary", &out)
+		require.NoError(t, err)
+		printDiffs(printer)
+		expectation := "GROUP  KIND        MODIFIED  ADDED  REMOVED\n       Service     0         1      1\napps   Deployment  1         0      0\n"
+		assert.Equal(t, expectation, out.String())
+	})
+
+	t.Run("UnknownOutput", func(t *testing.T) {
+		_, err := newDiffPrinter("yaml", io.Discard)
+		require.Error(t, err)
+	})
+}
+
+func TestSemanticDiff(t *testing.T) {
+	resources := func(cpu string) map[string]any {
+		return map[string]any{"requests": map[string]any{"cpu": cpu}}
+	}
+	live := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{"resources": resources("1000m"), "timeout": "60s"},
+	}}
+
+	target, modified := semanticDiff(live, &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{"resources": resources("1"), "timeout": "1m"},
+	}})
+	assert.False(t, modified)
+	assert.Equal(t, live.Object, target.Object)
+
+	target, modified = semanticDiff(live, &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{"resources": resources("2"), "timeout": "1m"},
+	}})
+	assert.True(t, modified)
+	assert.Equal(t, map[string]any{"spec": map[string]any{"resources": resources("2"), "timeout": "60s"}}, target.Object)
+
+	_, modified = semanticDiff(nil, live)
+	assert.True(t, modified)
+}
+
 func TestPrintApplicationHistoryTableWithMultipleSources(t *testing.T) {
 	histories := []v1alpha1.RevisionHistory{
 		{
@@ -1545,6 +1726,33 @@ func TestParseSelectedResourcesEmptyList(t *testing.T) {
 	assert.Empty(t, operationResources)
 }
 
+func TestParseSyncWaves(t *testing.T) {
+	tests := []struct {
+		waves    string
+		expected *v1alpha1.SyncWaveRange
+	}{
+		{"", nil},
+		{"0:2", &v1alpha1.SyncWaveRange{From: ptr.To(int64(0)), To: ptr.To(int64(2))}},
+		{"3:", &v1alpha1.SyncWaveRange{From: ptr.To(int64(3))}},
+		{":-1", &v1alpha1.SyncWaveRange{To: ptr.To(int64(-1))}},
+		{"5", &v1alpha1.SyncWaveRange{From: ptr.To(int64(5)), To: ptr.To(int64(5))}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.waves, func(t *testing.T) {
+			syncWaves, err := parseSyncWaves(tt.waves)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, syncWaves)
+		})
+	}
+}
+
+func TestParseSyncWavesIncorrect(t *testing.T) {
+	for _, waves := range []string{":", "a:2", "1:2:3", "1:b"} {
+		_, err := parseSyncWaves(waves)
+		assert.ErrorContains(t, err, waves)
+	}
+}
+
 func TestPrintApplicationTableNotWide(t *testing.T) {
 	output, err := captureOutput(func() error {
 		app := &v1alpha1.Application{
@@ -2067,6 +2275,10 @@ func (f fakeSettingsServiceClient) GetPlugins(_ context.Context, _ *settingspkg.
 	return nil, nil
 }
 
+func (f fakeSettingsServiceClient) GetIgnoreDifferencesSuggestions(_ context.Context, _ *settingspkg.IgnoreDifferencesSuggestionsQuery, _ ...grpc.CallOption) (*settingspkg.IgnoreDifferencesSuggestionsResponse, error) {
+	return nil, nil
+}
+
 type fakeAppServiceClient struct{}
 
 func (c *fakeAppServiceClient) Get(_ context.Context, _ *applicationpkg.ApplicationQuery, _ ...grpc.CallOption) (*v1alpha1.Application, error) {
@@ -2172,6 +2384,10 @@ func (c *fakeAppServiceClient) GetManifests(_ context.Context, _ *applicationpkg
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) HydratePreview(_ context.Context, _ *applicationpkg.ApplicationHydrateQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationHydrateResponse, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) GetManifestsWithFiles(_ context.Context, _ ...grpc.CallOption) (applicationpkg.ApplicationService_GetManifestsWithFilesClient, error) {
 	return nil, nil
 }
@@ -2216,6 +2432,22 @@ func (c *fakeAppServiceClient) TerminateOperation(_ context.Context, _ *applicat
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) ApproveOperation(_ context.Context, _ *applicationpkg.OperationApproveRequest, _ ...grpc.CallOption) (*applicationpkg.OperationApproveResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) DependencyGraph(_ context.Context, _ *applicationpkg.ApplicationDependencyGraphQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationDependencyGraph, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) ListQueuedOperations(_ context.Context, _ *applicationpkg.ApplicationOperationQueueRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationOperationQueueResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) CancelQueuedOperation(_ context.Context, _ *applicationpkg.QueuedOperationCancelRequest, _ ...grpc.CallOption) (*applicationpkg.QueuedOperationCancelResponse, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) GetResource(_ context.Context, _ *applicationpkg.ApplicationResourceRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationResourceResponse, error) {
 	return nil, nil
 }
@@ -91,6 +91,9 @@ type AppOptions struct {
 	retryBackoffMaxDuration         time.Duration
 	retryBackoffFactor              int64
 	retryRefresh                    bool
+	rollback                        bool
+	rollbackHealthTimeout           time.Duration
+	dependsOn                       []string
 	ref                             string
 	SourceName                      string
 	drySourceRepo                   string
@@ -170,6 +173,9 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().DurationVar(&opts.retryBackoffMaxDuration, "sync-retry-backoff-max-duration", argoappv1.DefaultSyncRetryMaxDuration, "Max sync retry backoff duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().Int64Var(&opts.retryBackoffFactor, "sync-retry-backoff-factor", argoappv1.DefaultSyncRetryFactor, "Factor multiplies the base duration after each failed sync retry")
 	command.Flags().BoolVar(&opts.retryRefresh, "sync-retry-refresh", false, "Indicates if the latest revision should be used on retry instead of the initial one")
+	command.Flags().BoolVar(&opts.rollback, "sync-rollback", false, "Automatically roll back failed syncs to the last successful sync")
+	command.Flags().DurationVar(&opts.rollbackHealthTimeout, "sync-rollback-health-timeout", 0, "Roll back syncs after which the application is not Healthy within the duration (e.g. 5m, 1h)")
+	command.Flags().StringArrayVar(&opts.dependsOn, "depends-on", []string{}, "Application which must be Synced and Healthy before this application is synced, as [NAMESPACE/]NAME. This option may be specified repeatedly")
 	command.Flags().StringVar(&opts.ref, "ref", "", "Ref is reference to another source within sources field")
 	command.Flags().StringVar(&opts.SourceName, "source-name", "", "Name of the source from the list of sources of the app.")
 }
@@ -209,6 +215,15 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 		case "revision-history-limit":
 			i := int64(appOpts.revisionHistoryLimit)
 			spec.RevisionHistoryLimit = &i
+		case "depends-on":
+			spec.DependsOn = nil
+			for _, dep := range appOpts.dependsOn {
+				namespace, name, found := strings.Cut(dep, "/")
+				if !found {
+					namespace, name = "", dep
+				}
+				spec.DependsOn = append(spec.DependsOn, argoappv1.ApplicationDependency{Name: name, Namespace: namespace})
+			}
 		case "dest-name":
 			spec.Destination.Name = appOpts.destName
 		case "dest-server":
@@ -282,6 +297,31 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 				spec.SyncPolicy.Retry = &argoappv1.RetryStrategy{}
 			}
 			spec.SyncPolicy.Retry.Refresh = appOpts.retryRefresh
+		case "sync-rollback":
+			if appOpts.rollback {
+				if spec.SyncPolicy == nil {
+					spec.SyncPolicy = &argoappv1.SyncPolicy{}
+				}
+				if spec.SyncPolicy.Rollback == nil {
+					spec.SyncPolicy.Rollback = &argoappv1.SyncPolicyRollback{}
+				}
+			} else if spec.SyncPolicy != nil {
+				spec.SyncPolicy.Rollback = nil
+				if spec.SyncPolicy.IsZero() {
+					spec.SyncPolicy = nil
+				}
+			}
+		case "sync-rollback-health-timeout":
+			if spec.SyncPolicy == nil {
+				spec.SyncPolicy = &argoappv1.SyncPolicy{}
+			}
+			if spec.SyncPolicy.Rollback == nil {
+				spec.SyncPolicy.Rollback = &argoappv1.SyncPolicyRollback{}
+			}
+			spec.SyncPolicy.Rollback.HealthTimeout = ""
+			if appOpts.rollbackHealthTimeout > 0 {
+				spec.SyncPolicy.Rollback.HealthTimeout = appOpts.rollbackHealthTimeout.String()
+			}
 		}
 	})
 
@@ -322,6 +322,29 @@ func Test_setAppSpecOptions(t *testing.T) {
 		require.NoError(t, f.SetFlag("sync-retry-refresh", "false"))
 		assert.False(t, f.spec.SyncPolicy.Retry.Refresh)
 	})
+	t.Run("Rollback", func(t *testing.T) {
+		f := newAppOptionsFixture()
+
+		require.NoError(t, f.SetFlag("sync-rollback", "true"))
+		require.NotNil(t, f.spec.SyncPolicy.Rollback)
+		assert.Empty(t, f.spec.SyncPolicy.Rollback.HealthTimeout)
+
+		require.NoError(t, f.SetFlag("sync-rollback", "false"))
+		assert.Nil(t, f.spec.SyncPolicy)
+	})
+	t.Run("RollbackHealthTimeout", func(t *testing.T) {
+		f := newAppOptionsFixture()
+
+		require.NoError(t, f.SetFlag("sync-rollback-health-timeout", "5m"))
+		assert.Equal(t, "5m0s", f.spec.SyncPolicy.Rollback.HealthTimeout)
+	})
+	t.Run("DependsOn", func(t *testing.T) {
+		f := newAppOptionsFixture()
+
+		require.NoError(t, f.SetFlag("depends-on", "crds"))
+		require.NoError(t, f.SetFlag("depends-on", "platform/operators"))
+		assert.Equal(t, []v1alpha1.ApplicationDependency{{Name: "crds"}, {Name: "operators", Namespace: "platform"}}, f.spec.DependsOn)
+	})
 	t.Run("Kustomize", func(t *testing.T) {
 		require.NoError(t, f.SetFlag("kustomize-replica", "my-deployment=2"))
 		require.NoError(t, f.SetFlag("kustomize-replica", "my-statefulset=4"))
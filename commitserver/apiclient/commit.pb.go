@@ -43,10 +43,16 @@ type CommitHydratedManifestsRequest struct {
 	// Paths contains the paths to write hydrated manifests to, along with the manifests and commands to execute.
 	Paths []*PathDetails `protobuf:"bytes,6,rep,name=paths,proto3" json:"paths,omitempty"`
 	// DryCommitMetadata contains metadata about the DRY commit, such as the author and committer.
-	DryCommitMetadata    *v1alpha1.RevisionMetadata `protobuf:"bytes,7,opt,name=dryCommitMetadata,proto3" json:"dryCommitMetadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	DryCommitMetadata *v1alpha1.RevisionMetadata `protobuf:"bytes,7,opt,name=dryCommitMetadata,proto3" json:"dryCommitMetadata,omitempty"`
+	// PullRequest, if set, configures the pull request to open from the TargetBranch to the SyncBranch after the
+	// hydrated manifests are pushed.
+	PullRequest *v1alpha1.HydrateToPullRequest `protobuf:"bytes,8,opt,name=pullRequest,proto3" json:"pullRequest,omitempty"`
+	// DryRepoURL is the URL of the repository containing the dry source. If empty, the Repo URL is assumed to be the
+	// dry source repository.
+	DryRepoURL           string   `protobuf:"bytes,9,opt,name=dryRepoURL,proto3" json:"dryRepoURL,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CommitHydratedManifestsRequest) Reset()         { *m = CommitHydratedManifestsRequest{} }
@@ -131,6 +137,20 @@ func (m *CommitHydratedManifestsRequest) GetDryCommitMetadata() *v1alpha1.Revisi
 	return nil
 }
 
+func (m *CommitHydratedManifestsRequest) GetPullRequest() *v1alpha1.HydrateToPullRequest {
+	if m != nil {
+		return m.PullRequest
+	}
+	return nil
+}
+
+func (m *CommitHydratedManifestsRequest) GetDryRepoURL() string {
+	if m != nil {
+		return m.DryRepoURL
+	}
+	return ""
+}
+
 // PathDetails holds information about hydrated manifests to be written to a particular path in the hydrated manifests
 // commit.
 type PathDetails struct {
@@ -139,7 +159,13 @@ type PathDetails struct {
 	// Manifests contains the manifests to write to the path.
 	Manifests []*HydratedManifestDetails `protobuf:"bytes,2,rep,name=manifests,proto3" json:"manifests,omitempty"`
 	// Commands contains the commands executed when hydrating the manifests.
-	Commands             []string `protobuf:"bytes,3,rep,name=commands,proto3" json:"commands,omitempty"`
+	Commands []string `protobuf:"bytes,3,rep,name=commands,proto3" json:"commands,omitempty"`
+	// Layout is the layout of the manifest files written to the path. See the layout of the application's sync source.
+	Layout string `protobuf:"bytes,4,opt,name=layout,proto3" json:"layout,omitempty"`
+	// DisableReadme disables writing the README.md file to the path.
+	DisableReadme bool `protobuf:"varint,5,opt,name=disableReadme,proto3" json:"disableReadme,omitempty"`
+	// AdditionalDrySHAs contains the resolved revisions of the additional dry sources rendered to the path.
+	AdditionalDrySHAs    []string `protobuf:"bytes,6,rep,name=additionalDrySHAs,proto3" json:"additionalDrySHAs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -199,6 +225,27 @@ func (m *PathDetails) GetCommands() []string {
 	return nil
 }
 
+func (m *PathDetails) GetLayout() string {
+	if m != nil {
+		return m.Layout
+	}
+	return ""
+}
+
+func (m *PathDetails) GetDisableReadme() bool {
+	if m != nil {
+		return m.DisableReadme
+	}
+	return false
+}
+
+func (m *PathDetails) GetAdditionalDrySHAs() []string {
+	if m != nil {
+		return m.AdditionalDrySHAs
+	}
+	return nil
+}
+
 // ManifestDetails contains the hydrated manifests.
 type HydratedManifestDetails struct {
 	// ManifestJSON is the hydrated manifest as JSON.
@@ -250,11 +297,15 @@ func (m *HydratedManifestDetails) GetManifestJSON() string {
 
 // ManifestsResponse is the response to the ManifestsRequest.
 type CommitHydratedManifestsResponse struct {
-	// HydratedSha is the commit SHA of the hydrated manifests commit.
-	HydratedSha          string   `protobuf:"bytes,1,opt,name=hydratedSha,proto3" json:"hydratedSha,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// HydratedSha is the commit SHA of the hydrated manifests commit. If the manifests were published to a target other
+	// than git, it is the revision returned by that target, e.g. the digest of an OCI artifact.
+	HydratedSha string `protobuf:"bytes,1,opt,name=hydratedSha,proto3" json:"hydratedSha,omitempty"`
+	// PullRequest is the status of the pull request from the TargetBranch to the SyncBranch. It is only set if the
+	// request configured a pull request and one was opened, updated or closed.
+	PullRequest          *v1alpha1.HydratorPullRequestStatus `protobuf:"bytes,2,opt,name=pullRequest,proto3" json:"pullRequest,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
 }
 
 func (m *CommitHydratedManifestsResponse) Reset()         { *m = CommitHydratedManifestsResponse{} }
@@ -297,6 +348,13 @@ func (m *CommitHydratedManifestsResponse) GetHydratedSha() string {
 	return ""
 }
 
+func (m *CommitHydratedManifestsResponse) GetPullRequest() *v1alpha1.HydratorPullRequestStatus {
+	if m != nil {
+		return m.PullRequest
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CommitHydratedManifestsRequest)(nil), "CommitHydratedManifestsRequest")
 	proto.RegisterType((*PathDetails)(nil), "PathDetails")
@@ -307,37 +365,44 @@ func init() {
 func init() { proto.RegisterFile("commitserver/commit/commit.proto", fileDescriptor_cf3a3abbc35e3069) }
 
 var fileDescriptor_cf3a3abbc35e3069 = []byte{
-	// 479 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x95, 0xeb, 0x34, 0x90, 0x49, 0x7b, 0x60, 0x0f, 0xd4, 0xca, 0xc1, 0xb5, 0x2c, 0x0e, 0xb9,
-	0xb0, 0x56, 0x13, 0xc1, 0x8d, 0x4b, 0xc3, 0xa1, 0x42, 0xb4, 0x20, 0xe7, 0x86, 0x2a, 0xa1, 0xa9,
-	0xbd, 0xd8, 0x4b, 0x63, 0xef, 0xb2, 0xbb, 0xb1, 0x64, 0x89, 0xaf, 0xe1, 0x6b, 0x38, 0xf2, 0x09,
-	0x28, 0x5f, 0x82, 0xbc, 0xb6, 0x69, 0x02, 0x0a, 0x39, 0x70, 0xf2, 0xce, 0xbc, 0xf1, 0x7b, 0xb3,
-	0xf3, 0x76, 0x20, 0x48, 0x44, 0x51, 0x70, 0xa3, 0x99, 0xaa, 0x98, 0x8a, 0xda, 0xa0, 0xfb, 0x50,
-	0xa9, 0x84, 0x11, 0x93, 0xb7, 0x19, 0x37, 0xf9, 0xfa, 0x8e, 0x26, 0xa2, 0x88, 0x50, 0x65, 0x42,
-	0x2a, 0xf1, 0xd9, 0x1e, 0x9e, 0x27, 0x69, 0x54, 0xcd, 0x23, 0x79, 0x9f, 0x45, 0x28, 0xb9, 0x8e,
-	0x50, 0xca, 0x15, 0x4f, 0xd0, 0x70, 0x51, 0x46, 0xd5, 0x05, 0xae, 0x64, 0x8e, 0x17, 0x51, 0xc6,
-	0x4a, 0xa6, 0xd0, 0xb0, 0xb4, 0x65, 0x0b, 0xbf, 0xb9, 0xe0, 0x2f, 0x2c, 0xfd, 0x55, 0x9d, 0x5a,
-	0xe0, 0x1a, 0x4b, 0xfe, 0x89, 0x69, 0xa3, 0x63, 0xf6, 0x65, 0xcd, 0xb4, 0x21, 0xb7, 0x30, 0x50,
-	0x4c, 0x0a, 0xcf, 0x09, 0x9c, 0xe9, 0x78, 0x76, 0x45, 0x1f, 0xf4, 0x69, 0xaf, 0x6f, 0x0f, 0x1f,
-	0x93, 0x94, 0x56, 0x73, 0x2a, 0xef, 0x33, 0xda, 0xe8, 0xd3, 0x2d, 0x7d, 0xda, 0xeb, 0xd3, 0x98,
-	0x49, 0xa1, 0xb9, 0x11, 0xaa, 0x8e, 0x2d, 0x2b, 0xf1, 0x01, 0x74, 0x5d, 0x26, 0x97, 0x0a, 0xcb,
-	0x24, 0xf7, 0x8e, 0x02, 0x67, 0x3a, 0x8a, 0xb7, 0x32, 0x24, 0x84, 0x13, 0x83, 0x2a, 0x63, 0xa6,
-	0xab, 0x70, 0x6d, 0xc5, 0x4e, 0x8e, 0x3c, 0x85, 0x61, 0xaa, 0xea, 0x65, 0x8e, 0xde, 0xc0, 0xa2,
-	0x5d, 0x44, 0x9e, 0xc1, 0x69, 0x3b, 0xba, 0x6b, 0xa6, 0x35, 0x66, 0xcc, 0x3b, 0xb6, 0xf0, 0x6e,
-	0x92, 0x84, 0x70, 0x2c, 0xd1, 0xe4, 0xda, 0x1b, 0x06, 0xee, 0x74, 0x3c, 0x3b, 0xa1, 0xef, 0xd1,
-	0xe4, 0xaf, 0x99, 0x41, 0xbe, 0xd2, 0x71, 0x0b, 0x91, 0xaf, 0xf0, 0x24, 0x55, 0xf5, 0xa2, 0xfb,
-	0xcf, 0x60, 0x8a, 0x06, 0xbd, 0x47, 0x76, 0x20, 0x37, 0xff, 0x3b, 0x90, 0x8a, 0x6b, 0x2e, 0xca,
-	0x9e, 0x35, 0xfe, 0x5b, 0x28, 0x5c, 0xc3, 0x78, 0xab, 0x27, 0x42, 0x60, 0xd0, 0x74, 0x65, 0x0d,
-	0x19, 0xc5, 0xf6, 0x4c, 0x5e, 0xc2, 0xa8, 0xe8, 0x8d, 0xf3, 0x8e, 0xec, 0x45, 0x3c, 0xfa, 0xa7,
-	0xa5, 0xfd, 0xa5, 0x1e, 0x4a, 0xc9, 0x04, 0x1e, 0x37, 0xd3, 0xc0, 0x32, 0xd5, 0x9e, 0x1b, 0xb8,
-	0xd3, 0x51, 0xfc, 0x3b, 0x0e, 0x5f, 0xc1, 0xd9, 0x1e, 0x86, 0xc6, 0x95, 0x9e, 0xe3, 0xcd, 0xf2,
-	0xdd, 0x4d, 0xd7, 0xca, 0x4e, 0x2e, 0x5c, 0xc0, 0xf9, 0xde, 0x97, 0xa5, 0xa5, 0x28, 0x35, 0x23,
-	0x01, 0x8c, 0xf3, 0x0e, 0x6c, 0xdc, 0x6b, 0x59, 0xb6, 0x53, 0xb3, 0x02, 0x4e, 0x5b, 0x92, 0x25,
-	0x53, 0x15, 0x4f, 0x18, 0xb9, 0x85, 0xb3, 0x3d, 0xac, 0xe4, 0x9c, 0xfe, 0xfb, 0x25, 0x4f, 0x02,
-	0x7a, 0xa0, 0xa1, 0xcb, 0xc5, 0xf7, 0x8d, 0xef, 0xfc, 0xd8, 0xf8, 0xce, 0xcf, 0x8d, 0xef, 0x7c,
-	0x78, 0x71, 0x60, 0xd5, 0x76, 0x76, 0x15, 0x25, 0x4f, 0x56, 0x9c, 0x95, 0xe6, 0x6e, 0x68, 0x57,
-	0x6b, 0xfe, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x21, 0x3b, 0xa8, 0x3c, 0xcc, 0x03, 0x00, 0x00,
+	// 592 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xc1, 0x6e, 0xd4, 0x3c,
+	0x10, 0xc7, 0x95, 0xee, 0xb6, 0x5f, 0xd7, 0xdb, 0x1e, 0xea, 0xc3, 0xd7, 0xa8, 0x87, 0x6d, 0xb4,
+	0xe2, 0xb0, 0x07, 0x70, 0xd4, 0x56, 0x70, 0xe3, 0x40, 0xdb, 0x43, 0x85, 0xda, 0x52, 0x79, 0x41,
+	0x48, 0xa8, 0x12, 0x9a, 0xc6, 0x26, 0x31, 0x4d, 0x62, 0x63, 0x7b, 0x23, 0x45, 0xe2, 0xc1, 0x38,
+	0x71, 0xe6, 0xc8, 0x23, 0x40, 0x9f, 0x04, 0xc5, 0x49, 0xd8, 0x84, 0xaa, 0xf4, 0x50, 0x4e, 0x6b,
+	0xcf, 0x78, 0xe7, 0x6f, 0xff, 0x7f, 0x93, 0x41, 0x41, 0x24, 0xb3, 0x4c, 0x58, 0xc3, 0x75, 0xc1,
+	0x75, 0x58, 0x6f, 0x9a, 0x1f, 0xa2, 0xb4, 0xb4, 0x72, 0xe7, 0x34, 0x16, 0x36, 0x59, 0x5c, 0x91,
+	0x48, 0x66, 0x21, 0xe8, 0x58, 0x2a, 0x2d, 0x3f, 0xba, 0xc5, 0x93, 0x88, 0x85, 0xc5, 0x41, 0xa8,
+	0xae, 0xe3, 0x10, 0x94, 0x30, 0x21, 0x28, 0x95, 0x8a, 0x08, 0xac, 0x90, 0x79, 0x58, 0xec, 0x41,
+	0xaa, 0x12, 0xd8, 0x0b, 0x63, 0x9e, 0x73, 0x0d, 0x96, 0xb3, 0xba, 0xda, 0xf4, 0xcb, 0x10, 0x4d,
+	0x8e, 0x5c, 0xf9, 0x93, 0x92, 0xb9, 0xc4, 0x19, 0xe4, 0xe2, 0x03, 0x37, 0xd6, 0x50, 0xfe, 0x69,
+	0xc1, 0x8d, 0xc5, 0x97, 0x68, 0xa8, 0xb9, 0x92, 0xbe, 0x17, 0x78, 0xb3, 0xf1, 0xfe, 0x09, 0x59,
+	0xea, 0x93, 0x56, 0xdf, 0x2d, 0xde, 0x47, 0x8c, 0x14, 0x07, 0x44, 0x5d, 0xc7, 0xa4, 0xd2, 0x27,
+	0x1d, 0x7d, 0xd2, 0xea, 0x13, 0xca, 0x95, 0x34, 0xc2, 0x4a, 0x5d, 0x52, 0x57, 0x15, 0x4f, 0x10,
+	0x32, 0x65, 0x1e, 0x1d, 0x6a, 0xc8, 0xa3, 0xc4, 0x5f, 0x09, 0xbc, 0xd9, 0x88, 0x76, 0x22, 0x78,
+	0x8a, 0x36, 0x2c, 0xe8, 0x98, 0xdb, 0xe6, 0xc4, 0xc0, 0x9d, 0xe8, 0xc5, 0xf0, 0xff, 0x68, 0x8d,
+	0xe9, 0x72, 0x9e, 0x80, 0x3f, 0x74, 0xd9, 0x66, 0x87, 0x1f, 0xa1, 0xcd, 0xda, 0xba, 0x33, 0x6e,
+	0x0c, 0xc4, 0xdc, 0x5f, 0x75, 0xe9, 0x7e, 0x10, 0x4f, 0xd1, 0xaa, 0x02, 0x9b, 0x18, 0x7f, 0x2d,
+	0x18, 0xcc, 0xc6, 0xfb, 0x1b, 0xe4, 0x02, 0x6c, 0x72, 0xcc, 0x2d, 0x88, 0xd4, 0xd0, 0x3a, 0x85,
+	0x3f, 0xa3, 0x2d, 0xa6, 0xcb, 0xa3, 0xe6, 0x7f, 0x16, 0x18, 0x58, 0xf0, 0xff, 0x73, 0x86, 0x9c,
+	0x3f, 0xd4, 0x90, 0x42, 0x18, 0x21, 0xf3, 0xb6, 0x2a, 0xbd, 0x2d, 0x84, 0x2d, 0x1a, 0xab, 0x45,
+	0x9a, 0x36, 0x40, 0xfc, 0x75, 0xa7, 0x4b, 0x1f, 0xa6, 0xdb, 0xe0, 0x7e, 0x2d, 0x2f, 0x96, 0x95,
+	0x69, 0x57, 0xa6, 0x22, 0xc3, 0x74, 0x59, 0x01, 0x7b, 0x43, 0x4f, 0xfd, 0x51, 0x4d, 0x66, 0x19,
+	0x99, 0xfe, 0xf4, 0xd0, 0xb8, 0x63, 0x15, 0xc6, 0x68, 0x58, 0x99, 0xe5, 0xfa, 0x64, 0x44, 0xdd,
+	0x1a, 0x3f, 0x43, 0xa3, 0xac, 0xed, 0x27, 0x7f, 0xc5, 0xf9, 0xeb, 0x93, 0x3f, 0x3b, 0xad, 0xf5,
+	0x7a, 0x79, 0x14, 0xef, 0xa0, 0xf5, 0x0a, 0x12, 0xe4, 0xcc, 0xf8, 0x83, 0x60, 0x30, 0x1b, 0xd1,
+	0xdf, 0xfb, 0x8a, 0x76, 0x0a, 0xa5, 0x5c, 0xd8, 0x96, 0x76, 0xbd, 0xab, 0x68, 0x33, 0x61, 0xe0,
+	0x2a, 0xe5, 0x94, 0x03, 0xcb, 0x6a, 0xda, 0xeb, 0xb4, 0x1f, 0xc4, 0x8f, 0xd1, 0x16, 0x30, 0x26,
+	0x2a, 0x33, 0x20, 0x3d, 0xd6, 0xe5, 0xfc, 0xe4, 0x45, 0x4d, 0x7e, 0x44, 0x6f, 0x27, 0xa6, 0xcf,
+	0xd1, 0xf6, 0x1d, 0xb7, 0xad, 0x1a, 0xb3, 0xbd, 0xef, 0xcb, 0xf9, 0xab, 0xf3, 0xe6, 0xd9, 0xbd,
+	0xd8, 0xf4, 0xab, 0x87, 0x76, 0xef, 0xfc, 0xba, 0x8c, 0x92, 0xb9, 0xe1, 0x38, 0x40, 0xe3, 0xa4,
+	0x49, 0x56, 0x1d, 0x5c, 0x97, 0xe9, 0x86, 0x70, 0xd9, 0xc7, 0xbf, 0xe2, 0xf0, 0xbf, 0xfd, 0x17,
+	0xf8, 0xa5, 0xee, 0xd0, 0x9f, 0x5b, 0xb0, 0x0b, 0xd3, 0xeb, 0x81, 0xfd, 0x0c, 0x6d, 0xd6, 0xf7,
+	0x9f, 0x73, 0x5d, 0x88, 0x88, 0xe3, 0x4b, 0xb4, 0x7d, 0xc7, 0x83, 0xf0, 0x2e, 0xf9, 0xfb, 0x20,
+	0xd9, 0x09, 0xc8, 0x3d, 0x5e, 0x1c, 0x1e, 0x7d, 0xbb, 0x99, 0x78, 0xdf, 0x6f, 0x26, 0xde, 0x8f,
+	0x9b, 0x89, 0xf7, 0xee, 0xe9, 0x3d, 0x93, 0xae, 0x37, 0x2a, 0x41, 0x89, 0x28, 0x15, 0x3c, 0xb7,
+	0x57, 0x6b, 0x6e, 0xb2, 0x1d, 0xfc, 0x1a, 0x00, 0x27, 0xe9, 0x17, 0x83, 0x4b, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -446,6 +511,25 @@ func (m *CommitHydratedManifestsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.DryRepoURL) > 0 {
+		i -= len(m.DryRepoURL)
+		copy(dAtA[i:], m.DryRepoURL)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.DryRepoURL)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.PullRequest != nil {
+		{
+			size, err := m.PullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.DryCommitMetadata != nil {
 		{
 			size, err := m.DryCommitMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -539,6 +623,32 @@ func (m *PathDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.AdditionalDrySHAs) > 0 {
+		for iNdEx := len(m.AdditionalDrySHAs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AdditionalDrySHAs[iNdEx])
+			copy(dAtA[i:], m.AdditionalDrySHAs[iNdEx])
+			i = encodeVarintCommit(dAtA, i, uint64(len(m.AdditionalDrySHAs[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.DisableReadme {
+		i--
+		if m.DisableReadme {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Layout) > 0 {
+		i -= len(m.Layout)
+		copy(dAtA[i:], m.Layout)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.Layout)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Commands) > 0 {
 		for iNdEx := len(m.Commands) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Commands[iNdEx])
@@ -630,6 +740,18 @@ func (m *CommitHydratedManifestsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.PullRequest != nil {
+		{
+			size, err := m.PullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.HydratedSha) > 0 {
 		i -= len(m.HydratedSha)
 		copy(dAtA[i:], m.HydratedSha)
@@ -687,6 +809,14 @@ func (m *CommitHydratedManifestsRequest) Size() (n int) {
 		l = m.DryCommitMetadata.Size()
 		n += 1 + l + sovCommit(uint64(l))
 	}
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.DryRepoURL)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -715,6 +845,19 @@ func (m *PathDetails) Size() (n int) {
 			n += 1 + l + sovCommit(uint64(l))
 		}
 	}
+	l = len(m.Layout)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if m.DisableReadme {
+		n += 2
+	}
+	if len(m.AdditionalDrySHAs) > 0 {
+		for _, s := range m.AdditionalDrySHAs {
+			l = len(s)
+			n += 1 + l + sovCommit(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -747,6 +890,10 @@ func (m *CommitHydratedManifestsResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCommit(uint64(l))
 	}
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1022,6 +1169,74 @@ func (m *CommitHydratedManifestsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PullRequest == nil {
+				m.PullRequest = &v1alpha1.HydrateToPullRequest{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DryRepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommit(dAtA[iNdEx:])
@@ -1171,6 +1386,90 @@ func (m *PathDetails) Unmarshal(dAtA []byte) error {
 			}
 			m.Commands = append(m.Commands, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Layout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Layout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableReadme", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableReadme = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalDrySHAs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalDrySHAs = append(m.AdditionalDrySHAs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommit(dAtA[iNdEx:])
@@ -1337,6 +1636,42 @@ func (m *CommitHydratedManifestsResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.HydratedSha = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PullRequest == nil {
+				m.PullRequest = &v1alpha1.HydratorPullRequestStatus{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommit(dAtA[iNdEx:])
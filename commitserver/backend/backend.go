@@ -0,0 +1,63 @@
+package backend
+
+import (
+	"context"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/oci"
+)
+
+// PublishRequest describes a set of hydrated manifests to be published by a Backend.
+type PublishRequest struct {
+	// Dir is the local directory containing the hydrated manifests, laid out as they would be committed to git.
+	Dir string
+	// Ref identifies the published manifests within the target, e.g. the OCI tag or the S3 key prefix. It is taken
+	// from the target branch of the commit request.
+	Ref string
+	// DryRepoURL is the URL of the repository containing the dry source.
+	DryRepoURL string
+	// DrySHA is the dry commit the manifests were hydrated from.
+	DrySHA string
+	// Message is the templated commit message describing the hydrated manifests.
+	Message string
+}
+
+// Backend publishes hydrated manifests to a target other than a git repository.
+type Backend interface {
+	// Publish publishes the hydrated manifests in the request. It returns the revision identifying the published
+	// manifests, e.g. the digest of an OCI artifact.
+	Publish(ctx context.Context, r *PublishRequest) (string, error)
+}
+
+// Factory is a factory for creating backends for a repository.
+type Factory interface {
+	// NewBackend returns the backend for the given repository. It returns false if the repository is a git repository,
+	// in which case hydrated manifests should be committed and pushed with git.
+	NewBackend(repo *v1alpha1.Repository) (Backend, bool, error)
+}
+
+type factory struct{}
+
+// NewFactory returns a new instance of the backend factory.
+func NewFactory() Factory {
+	return &factory{}
+}
+
+// NewBackend returns an OCI backend for oci:// repositories, an S3 backend for s3:// buckets, and false otherwise.
+func (f *factory) NewBackend(repo *v1alpha1.Repository) (Backend, bool, error) {
+	switch {
+	case strings.HasPrefix(repo.Repo, "oci://"):
+		return newOCIBackend(repo, func(repo *v1alpha1.Repository) (oci.Client, error) {
+			return oci.NewClient(repo.Repo, repo.GetOCICreds(), repo.Proxy, repo.NoProxy, nil)
+		}), true, nil
+	case strings.HasPrefix(repo.Repo, "s3://"):
+		backend, err := newS3Backend(repo)
+		if err != nil {
+			return nil, false, err
+		}
+		return backend, true, nil
+	default:
+		return nil, false, nil
+	}
+}
@@ -0,0 +1,80 @@
+package backend
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestFactory_NewBackend(t *testing.T) {
+	t.Parallel()
+
+	factory := NewFactory()
+
+	t.Run("git", func(t *testing.T) {
+		t.Parallel()
+		backend, ok, err := factory.NewBackend(&v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps.git"})
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.Nil(t, backend)
+	})
+
+	t.Run("oci", func(t *testing.T) {
+		t.Parallel()
+		backend, ok, err := factory.NewBackend(&v1alpha1.Repository{Repo: "oci://registry.example.com/hydrated"})
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.IsType(t, &ociBackend{}, backend)
+	})
+
+	t.Run("s3", func(t *testing.T) {
+		t.Parallel()
+		backend, ok, err := factory.NewBackend(&v1alpha1.Repository{Repo: "s3://hydrated/guestbook/?endpoint=http://localhost:9000&region=us-east-1", Username: "access-key", Password: "secret-key"})
+		require.NoError(t, err)
+		assert.True(t, ok)
+		require.IsType(t, &s3Backend{}, backend)
+		assert.Equal(t, "hydrated", backend.(*s3Backend).bucket)
+		assert.Equal(t, "guestbook", backend.(*s3Backend).prefix)
+	})
+
+	t.Run("s3 insecure", func(t *testing.T) {
+		t.Parallel()
+		backend, _, err := factory.NewBackend(&v1alpha1.Repository{Repo: "s3://hydrated/guestbook?endpoint=minio.example.com:9000&region=us-east-1", Username: "access-key", Password: "secret-key", Insecure: true})
+		require.NoError(t, err)
+		require.IsType(t, &s3Backend{}, backend)
+		client := backend.(*s3Backend).client.(*s3.S3)
+		// Insecure skips the verification of the TLS certificate, but the credentials are still sent over HTTPS.
+		assert.Equal(t, "https://minio.example.com:9000", client.Endpoint)
+		require.IsType(t, &http.Transport{}, client.Config.HTTPClient.Transport)
+		assert.True(t, client.Config.HTTPClient.Transport.(*http.Transport).TLSClientConfig.InsecureSkipVerify)
+	})
+
+	t.Run("s3 without bucket", func(t *testing.T) {
+		t.Parallel()
+		_, _, err := factory.NewBackend(&v1alpha1.Repository{Repo: "s3:///guestbook"})
+		require.ErrorContains(t, err, "does not contain a bucket")
+	})
+
+	t.Run("s3 without credentials", func(t *testing.T) {
+		t.Parallel()
+		_, _, err := factory.NewBackend(&v1alpha1.Repository{Repo: "s3://hydrated/guestbook"})
+		require.ErrorContains(t, err, "requires an access key ID and a secret access key")
+	})
+
+	t.Run("s3 with endpoint and inherited credentials", func(t *testing.T) {
+		t.Parallel()
+		_, _, err := factory.NewBackend(&v1alpha1.Repository{Repo: "s3://hydrated/guestbook?endpoint=https://attacker.example.com", Username: "access-key", Password: "secret-key", InheritedCreds: true})
+		require.ErrorContains(t, err, "must be set in the URL of a repository-write Secret")
+	})
+
+	t.Run("s3 with invalid prefix", func(t *testing.T) {
+		t.Parallel()
+		_, _, err := factory.NewBackend(&v1alpha1.Repository{Repo: "s3://hydrated/guestbook/../other", Username: "access-key", Password: "secret-key"})
+		require.ErrorContains(t, err, "contains an invalid prefix")
+	})
+}
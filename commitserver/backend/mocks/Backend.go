@@ -0,0 +1,105 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-cd/v3/commitserver/backend"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewBackend creates a new instance of Backend. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBackend(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Backend {
+	mock := &Backend{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// Backend is an autogenerated mock type for the Backend type
+type Backend struct {
+	mock.Mock
+}
+
+type Backend_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Backend) EXPECT() *Backend_Expecter {
+	return &Backend_Expecter{mock: &_m.Mock}
+}
+
+// Publish provides a mock function for the type Backend
+func (_mock *Backend) Publish(ctx context.Context, r *backend.PublishRequest) (string, error) {
+	ret := _mock.Called(ctx, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *backend.PublishRequest) (string, error)); ok {
+		return returnFunc(ctx, r)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *backend.PublishRequest) string); ok {
+		r0 = returnFunc(ctx, r)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *backend.PublishRequest) error); ok {
+		r1 = returnFunc(ctx, r)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Backend_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type Backend_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - r *backend.PublishRequest
+func (_e *Backend_Expecter) Publish(ctx interface{}, r interface{}) *Backend_Publish_Call {
+	return &Backend_Publish_Call{Call: _e.mock.On("Publish", ctx, r)}
+}
+
+func (_c *Backend_Publish_Call) Run(run func(ctx context.Context, r *backend.PublishRequest)) *Backend_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *backend.PublishRequest
+		if args[1] != nil {
+			arg1 = args[1].(*backend.PublishRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Backend_Publish_Call) Return(s string, err error) *Backend_Publish_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *Backend_Publish_Call) RunAndReturn(run func(ctx context.Context, r *backend.PublishRequest) (string, error)) *Backend_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
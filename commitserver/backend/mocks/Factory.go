@@ -0,0 +1,106 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/argoproj/argo-cd/v3/commitserver/backend"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewFactory creates a new instance of Factory. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFactory(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Factory {
+	mock := &Factory{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// Factory is an autogenerated mock type for the Factory type
+type Factory struct {
+	mock.Mock
+}
+
+type Factory_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Factory) EXPECT() *Factory_Expecter {
+	return &Factory_Expecter{mock: &_m.Mock}
+}
+
+// NewBackend provides a mock function for the type Factory
+func (_mock *Factory) NewBackend(repo *v1alpha1.Repository) (backend.Backend, bool, error) {
+	ret := _mock.Called(repo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewBackend")
+	}
+
+	var r0 backend.Backend
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(*v1alpha1.Repository) (backend.Backend, bool, error)); ok {
+		return returnFunc(repo)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*v1alpha1.Repository) backend.Backend); ok {
+		r0 = returnFunc(repo)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(backend.Backend)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*v1alpha1.Repository) bool); ok {
+		r1 = returnFunc(repo)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(*v1alpha1.Repository) error); ok {
+		r2 = returnFunc(repo)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// Factory_NewBackend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NewBackend'
+type Factory_NewBackend_Call struct {
+	*mock.Call
+}
+
+// NewBackend is a helper method to define mock.On call
+//   - repo *v1alpha1.Repository
+func (_e *Factory_Expecter) NewBackend(repo interface{}) *Factory_NewBackend_Call {
+	return &Factory_NewBackend_Call{Call: _e.mock.On("NewBackend", repo)}
+}
+
+func (_c *Factory_NewBackend_Call) Run(run func(repo *v1alpha1.Repository)) *Factory_NewBackend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *v1alpha1.Repository
+		if args[0] != nil {
+			arg0 = args[0].(*v1alpha1.Repository)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *Factory_NewBackend_Call) Return(backend1 backend.Backend, b bool, err error) *Factory_NewBackend_Call {
+	_c.Call.Return(backend1, b, err)
+	return _c
+}
+
+func (_c *Factory_NewBackend_Call) RunAndReturn(run func(repo *v1alpha1.Repository) (backend.Backend, bool, error)) *Factory_NewBackend_Call {
+	_c.Call.Return(run)
+	return _c
+}
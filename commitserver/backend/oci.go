@@ -0,0 +1,45 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/oci"
+)
+
+// ociTagRegex matches valid OCI tags, see https://github.com/opencontainers/distribution-spec/blob/main/spec.md#pulling-manifests
+var ociTagRegex = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9._-]{0,127}$`)
+
+type ociBackend struct {
+	repo      *v1alpha1.Repository
+	newClient func(repo *v1alpha1.Repository) (oci.Client, error)
+}
+
+func newOCIBackend(repo *v1alpha1.Repository, newClient func(repo *v1alpha1.Repository) (oci.Client, error)) *ociBackend {
+	return &ociBackend{repo: repo, newClient: newClient}
+}
+
+// Publish pushes the hydrated manifests as a single layer OCI artifact tagged with the request ref.
+func (b *ociBackend) Publish(ctx context.Context, r *PublishRequest) (string, error) {
+	if !ociTagRegex.MatchString(r.Ref) {
+		return "", fmt.Errorf("%q is not a valid OCI tag", r.Ref)
+	}
+	client, err := b.newClient(b.repo)
+	if err != nil {
+		return "", fmt.Errorf("failed to create OCI client: %w", err)
+	}
+	annotations := map[string]string{
+		imagev1.AnnotationRevision:    r.DrySHA,
+		imagev1.AnnotationSource:      r.DryRepoURL,
+		imagev1.AnnotationDescription: r.Message,
+	}
+	digest, err := client.Push(ctx, r.Dir, r.Ref, annotations)
+	if err != nil {
+		return "", fmt.Errorf("failed to push to %s: %w", b.repo.Repo, err)
+	}
+	return digest, nil
+}
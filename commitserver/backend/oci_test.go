@@ -0,0 +1,57 @@
+package backend
+
+import (
+	"testing"
+
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/oci"
+	ocimocks "github.com/argoproj/argo-cd/v3/util/oci/mocks"
+)
+
+func Test_ociBackend_Publish(t *testing.T) {
+	t.Parallel()
+
+	repo := &v1alpha1.Repository{Repo: "oci://registry.example.com/hydrated"}
+	publishRequest := &PublishRequest{
+		Dir:        "/tmp/hydrated",
+		Ref:        "environments-dev",
+		DryRepoURL: "https://github.com/argoproj/argocd-example-apps.git",
+		DrySHA:     "abc123",
+		Message:    "hydrate abc123",
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+		client := ocimocks.NewClient(t)
+		client.EXPECT().Push(mock.Anything, "/tmp/hydrated", "environments-dev", map[string]string{
+			imagev1.AnnotationRevision:    "abc123",
+			imagev1.AnnotationSource:      "https://github.com/argoproj/argocd-example-apps.git",
+			imagev1.AnnotationDescription: "hydrate abc123",
+		}).Return("sha256:def456", nil).Once()
+		backend := newOCIBackend(repo, func(*v1alpha1.Repository) (oci.Client, error) {
+			return client, nil
+		})
+
+		digest, err := backend.Publish(t.Context(), publishRequest)
+		require.NoError(t, err)
+		assert.Equal(t, "sha256:def456", digest)
+	})
+
+	t.Run("invalid tag", func(t *testing.T) {
+		t.Parallel()
+		backend := newOCIBackend(repo, func(*v1alpha1.Repository) (oci.Client, error) {
+			t.Fatal("client should not be created")
+			return nil, nil
+		})
+
+		invalid := *publishRequest
+		invalid.Ref = "environments/dev"
+		_, err := backend.Publish(t.Context(), &invalid)
+		require.EqualError(t, err, `"environments/dev" is not a valid OCI tag`)
+	})
+}
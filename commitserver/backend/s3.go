@@ -0,0 +1,258 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// s3ObjectsManifest is the name of the object below <prefix>/<ref>/ which lists the keys of the objects uploaded by the
+// last publication. Only these objects are deleted by the next publication.
+const s3ObjectsManifest = ".argocd-hydrator-objects.json"
+
+// s3Client is the subset of the S3 API used by the S3 backend.
+type s3Client interface {
+	PutObjectWithContext(ctx aws.Context, input *s3.PutObjectInput, opts ...request.Option) (*s3.PutObjectOutput, error)
+	GetObjectWithContext(ctx aws.Context, input *s3.GetObjectInput, opts ...request.Option) (*s3.GetObjectOutput, error)
+	DeleteObjectsWithContext(ctx aws.Context, input *s3.DeleteObjectsInput, opts ...request.Option) (*s3.DeleteObjectsOutput, error)
+}
+
+type s3Backend struct {
+	client s3Client
+	bucket string
+	prefix string
+}
+
+// newS3Backend returns a backend for the S3-compatible bucket referenced by the repository URL. The URL has the form
+// s3://bucket/prefix, and the optional endpoint and region query parameters configure S3-compatible storage. The
+// repository username and password are used as access key ID and secret access key and are required, so that the
+// commit server never writes to buckets with its own AWS identity. The endpoint is only accepted if the URL is the URL
+// of a repository-write Secret rather than the URL of an application inheriting repository-write credentials.
+func newS3Backend(repo *v1alpha1.Repository) (*s3Backend, error) {
+	u, err := url.Parse(repo.Repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse S3 URL: %w", err)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("S3 URL %q does not contain a bucket", repo.Repo)
+	}
+	prefix := strings.Trim(u.Path, "/")
+	if prefix != "" && !isValidS3KeyPath(prefix) {
+		return nil, fmt.Errorf("S3 URL %q contains an invalid prefix", repo.Repo)
+	}
+	if repo.Username == "" || repo.Password == "" {
+		return nil, fmt.Errorf("S3 bucket %q requires an access key ID and a secret access key in a repository-write Secret", repo.Repo)
+	}
+
+	config := aws.NewConfig().WithCredentials(credentials.NewStaticCredentials(repo.Username, repo.Password, ""))
+	if region := u.Query().Get("region"); region != "" {
+		config = config.WithRegion(region)
+	}
+	if endpoint := u.Query().Get("endpoint"); endpoint != "" {
+		if repo.InheritedCreds {
+			return nil, fmt.Errorf("the endpoint of S3 bucket %q must be set in the URL of a repository-write Secret", repo.Repo)
+		}
+		// S3-compatible stores generally don't support virtual-hosted-style addressing.
+		config = config.WithEndpoint(endpoint).WithS3ForcePathStyle(true)
+	}
+	if repo.Insecure {
+		// As for other repositories, insecure only skips the verification of the TLS certificate and keeps HTTPS.
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+		config = config.WithHTTPClient(&http.Client{Transport: transport})
+	}
+	sess, err := session.NewSession(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AWS session: %w", err)
+	}
+
+	return &s3Backend{
+		client: s3.New(sess),
+		bucket: u.Host,
+		prefix: prefix,
+	}, nil
+}
+
+// isValidS3KeyPath returns whether the slash separated path has no empty, "." or ".." segments, so that it cannot
+// escape the prefix it is joined to.
+func isValidS3KeyPath(p string) bool {
+	for _, segment := range strings.Split(p, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return false
+		}
+	}
+	return true
+}
+
+// Publish uploads the hydrated manifests below <prefix>/<ref>/ and removes the objects uploaded by the previous
+// publication which are no longer part of the manifests. Objects which were not uploaded by the backend are never
+// removed. Since S3 has no notion of a commit, the returned revision is a digest of the uploaded keys and contents.
+func (b *s3Backend) Publish(ctx context.Context, r *PublishRequest) (string, error) {
+	if r.Ref == "" {
+		return "", errors.New("ref is required")
+	}
+	if !isValidS3KeyPath(r.Ref) {
+		return "", fmt.Errorf("invalid ref %q", r.Ref)
+	}
+	keyPrefix := path.Join(b.prefix, r.Ref) + "/"
+	manifestKey := keyPrefix + s3ObjectsManifest
+
+	previous, err := b.getObjectsManifest(ctx, manifestKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the objects uploaded by the previous publication: %w", err)
+	}
+
+	var keys []string
+	fileDigests := map[string]string{}
+	err = filepath.WalkDir(r.Dir, func(p string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil || d.IsDir() {
+			return walkErr
+		}
+		relPath, err := filepath.Rel(r.Dir, p)
+		if err != nil {
+			return err
+		}
+		key := keyPrefix + filepath.ToSlash(relPath)
+		if key == manifestKey {
+			return fmt.Errorf("%s is reserved", relPath)
+		}
+		fileDigest, err := b.upload(ctx, p, key, r)
+		if err != nil {
+			return fmt.Errorf("failed to upload %s: %w", key, err)
+		}
+		keys = append(keys, key)
+		fileDigests[key] = fileDigest
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	sort.Strings(keys)
+
+	if err = b.deleteStale(ctx, keyPrefix, previous, fileDigests); err != nil {
+		return "", fmt.Errorf("failed to delete stale objects: %w", err)
+	}
+	if err = b.putObjectsManifest(ctx, manifestKey, keys); err != nil {
+		return "", fmt.Errorf("failed to store the uploaded objects: %w", err)
+	}
+
+	h := sha256.New()
+	for _, key := range keys {
+		_, _ = fmt.Fprintf(h, "%s %s\n", fileDigests[key], key)
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// getObjectsManifest returns the keys of the objects uploaded by the previous publication.
+func (b *s3Backend) getObjectsManifest(ctx context.Context, manifestKey string) ([]string, error) {
+	out, err := b.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(manifestKey),
+	})
+	if err != nil {
+		var awsErr awserr.Error
+		if errors.As(err, &awsErr) && awsErr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer utilio.Close(out.Body)
+	var keys []string
+	if err = json.NewDecoder(out.Body).Decode(&keys); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", manifestKey, err)
+	}
+	return keys, nil
+}
+
+// putObjectsManifest stores the keys of the objects uploaded by the current publication.
+func (b *s3Backend) putObjectsManifest(ctx context.Context, manifestKey string, keys []string) error {
+	data, err := json.Marshal(keys)
+	if err != nil {
+		return err
+	}
+	_, err = b.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(b.bucket),
+		Key:         aws.String(manifestKey),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String("application/json"),
+	})
+	return err
+}
+
+// upload uploads a single file and returns the hex encoded sha256 digest of its contents.
+func (b *s3Backend) upload(ctx context.Context, filePath, key string, r *PublishRequest) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer utilio.Close(f)
+
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return "", err
+	}
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	_, err = b.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(key),
+		Body:   f,
+		Metadata: map[string]*string{
+			"Dry-Repo-Url": aws.String(r.DryRepoURL),
+			"Dry-Sha":      aws.String(r.DrySHA),
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// deleteStale deletes the objects below the key prefix which were uploaded by the previous publication but not by the
+// current one.
+func (b *s3Backend) deleteStale(ctx context.Context, keyPrefix string, previous []string, uploaded map[string]string) error {
+	var stale []*s3.ObjectIdentifier
+	for _, key := range previous {
+		if _, ok := uploaded[key]; !ok && strings.HasPrefix(key, keyPrefix) {
+			stale = append(stale, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+	}
+
+	// DeleteObjects accepts at most 1000 keys per request.
+	for start := 0; start < len(stale); start += 1000 {
+		end := min(start+1000, len(stale))
+		_, err := b.client.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(b.bucket),
+			Delete: &s3.Delete{Objects: stale[start:end], Quiet: aws.Bool(true)},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
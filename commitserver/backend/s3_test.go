@@ -0,0 +1,95 @@
+package backend
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeS3Client struct {
+	objects map[string]string
+}
+
+func (f *fakeS3Client) PutObjectWithContext(_ aws.Context, input *s3.PutObjectInput, _ ...request.Option) (*s3.PutObjectOutput, error) {
+	b, err := io.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	f.objects[aws.StringValue(input.Key)] = string(b)
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (f *fakeS3Client) GetObjectWithContext(_ aws.Context, input *s3.GetObjectInput, _ ...request.Option) (*s3.GetObjectOutput, error) {
+	data, ok := f.objects[aws.StringValue(input.Key)]
+	if !ok {
+		return nil, awserr.New(s3.ErrCodeNoSuchKey, "not found", nil)
+	}
+	return &s3.GetObjectOutput{Body: io.NopCloser(strings.NewReader(data))}, nil
+}
+
+func (f *fakeS3Client) DeleteObjectsWithContext(_ aws.Context, input *s3.DeleteObjectsInput, _ ...request.Option) (*s3.DeleteObjectsOutput, error) {
+	for _, obj := range input.Delete.Objects {
+		delete(f.objects, aws.StringValue(obj.Key))
+	}
+	return &s3.DeleteObjectsOutput{}, nil
+}
+
+func Test_s3Backend_Publish(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "guestbook"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "guestbook", "manifest.yaml"), []byte("kind: ConfigMap\n"), 0o644))
+
+	client := &fakeS3Client{objects: map[string]string{
+		"prefix/env-dev/stale/manifest.yaml":        "kind: Secret\n",
+		"prefix/env-dev/foreign.yaml":               "kind: Namespace\n",
+		"prefix/env-dev/" + s3ObjectsManifest:       `["prefix/env-dev/stale/manifest.yaml","prefix/env-prod/manifest.yaml"]`,
+		"prefix/env-prod/manifest.yaml":             "kind: Deployment\n",
+		"prefix/env-dev/guestbook/other/extra.yaml": "kind: Service\n",
+	}}
+	backend := &s3Backend{client: client, bucket: "bucket", prefix: "prefix"}
+
+	revision, err := backend.Publish(t.Context(), &PublishRequest{Dir: dir, Ref: "env-dev", DrySHA: "abc123"})
+	require.NoError(t, err)
+	assert.Regexp(t, "^sha256:[0-9a-f]{64}$", revision)
+	// Only the objects uploaded by the previous publication below the ref are deleted.
+	assert.Equal(t, map[string]string{
+		"prefix/env-dev/guestbook/manifest.yaml":    "kind: ConfigMap\n",
+		"prefix/env-dev/foreign.yaml":               "kind: Namespace\n",
+		"prefix/env-dev/" + s3ObjectsManifest:       `["prefix/env-dev/guestbook/manifest.yaml"]`,
+		"prefix/env-prod/manifest.yaml":             "kind: Deployment\n",
+		"prefix/env-dev/guestbook/other/extra.yaml": "kind: Service\n",
+	}, client.objects)
+
+	// Publishing the same content again results in the same revision.
+	again, err := backend.Publish(t.Context(), &PublishRequest{Dir: dir, Ref: "env-dev", DrySHA: "abc123"})
+	require.NoError(t, err)
+	assert.Equal(t, revision, again)
+
+	// Files which are removed from the manifests are deleted by the next publication.
+	require.NoError(t, os.Remove(filepath.Join(dir, "guestbook", "manifest.yaml")))
+	_, err = backend.Publish(t.Context(), &PublishRequest{Dir: dir, Ref: "env-dev", DrySHA: "def456"})
+	require.NoError(t, err)
+	assert.NotContains(t, client.objects, "prefix/env-dev/guestbook/manifest.yaml")
+	assert.Contains(t, client.objects, "prefix/env-dev/foreign.yaml")
+}
+
+func Test_s3Backend_Publish_InvalidRef(t *testing.T) {
+	t.Parallel()
+
+	backend := &s3Backend{client: &fakeS3Client{objects: map[string]string{}}, bucket: "bucket", prefix: "prefix"}
+	for _, ref := range []string{"../other", "env/../../other", "/env", "env//dev"} {
+		_, err := backend.Publish(t.Context(), &PublishRequest{Dir: t.TempDir(), Ref: ref})
+		require.ErrorContains(t, err, "invalid ref", ref)
+	}
+}
@@ -7,73 +7,46 @@ import (
 	"os"
 	"time"
 
-	"github.com/argoproj/argo-cd/v3/controller/hydrator"
-
 	log "github.com/sirupsen/logrus"
 
 	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/commitserver/backend"
 	"github.com/argoproj/argo-cd/v3/commitserver/metrics"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/git"
+	"github.com/argoproj/argo-cd/v3/util/hydrator"
 	"github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/io/files"
 )
 
 // Service is the service that handles commit requests.
 type Service struct {
-	metricsServer     *metrics.Server
-	repoClientFactory RepoClientFactory
+	metricsServer             *metrics.Server
+	repoClientFactory         RepoClientFactory
+	pullRequestServiceFactory PullRequestServiceFactory
+	backendFactory            backend.Factory
+	// signingKey is the ID of the GnuPG key used to sign hydrated commits. Commits are not signed if it is empty.
+	signingKey string
 }
 
-// NewService returns a new instance of the commit service.
-func NewService(gitCredsStore git.CredsStore, metricsServer *metrics.Server) *Service {
+// NewService returns a new instance of the commit service. If signingKey is not empty, all commits made by the service
+// are signed with the GnuPG key of that ID, which must be present in the local keyring.
+func NewService(gitCredsStore git.CredsStore, metricsServer *metrics.Server, signingKey string) *Service {
 	return &Service{
-		metricsServer:     metricsServer,
-		repoClientFactory: NewRepoClientFactory(gitCredsStore, metricsServer),
+		signingKey:                signingKey,
+		metricsServer:             metricsServer,
+		repoClientFactory:         NewRepoClientFactory(gitCredsStore, metricsServer),
+		pullRequestServiceFactory: NewPullRequestServiceFactory(),
+		backendFactory:            backend.NewFactory(),
 	}
 }
 
-type hydratorMetadataFile struct {
-	RepoURL  string   `json:"repoURL,omitempty"`
-	DrySHA   string   `json:"drySha,omitempty"`
-	Commands []string `json:"commands,omitempty"`
-	Author   string   `json:"author,omitempty"`
-	Date     string   `json:"date,omitempty"`
-	// Subject is the subject line of the DRY commit message, i.e. `git show --format=%s`.
-	Subject string `json:"subject,omitempty"`
-	// Body is the body of the DRY commit message, excluding the subject line, i.e. `git show --format=%b`.
-	// Known Argocd- trailers with valid values are removed, but all other trailers are kept.
-	Body       string                       `json:"body,omitempty"`
-	References []v1alpha1.RevisionReference `json:"references,omitempty"`
-}
-
-// TODO: make this configurable via ConfigMap.
-var manifestHydrationReadmeTemplate = `# Manifest Hydration
-
-To hydrate the manifests in this repository, run the following commands:
-
-` + "```shell" + `
-git clone {{ .RepoURL }}
-# cd into the cloned directory
-git checkout {{ .DrySHA }}
-{{ range $command := .Commands -}}
-{{ $command }}
-{{ end -}}` + "```" + `
-{{ if .References -}}
-
-## References
-
-{{ range $ref := .References -}}
-{{ if $ref.Commit -}}
-* [{{ $ref.Commit.SHA | mustRegexFind "[0-9a-f]+" | trunc 7 }}]({{ $ref.Commit.RepoURL }}): {{ $ref.Commit.Subject }} ({{ $ref.Commit.Author }})
-{{ end -}}
-{{ end -}}
-{{ end -}}`
-
 // CommitHydratedManifests handles a commit request. It clones the repository, checks out the sync branch, checks out
 // the target branch, clears the repository contents, writes the manifests to the repository, commits the changes, and
-// pushes the changes. It returns the hydrated revision SHA and an error if one occurred.
-func (s *Service) CommitHydratedManifests(_ context.Context, r *apiclient.CommitHydratedManifestsRequest) (*apiclient.CommitHydratedManifestsResponse, error) {
+// pushes the changes. If requested, it then opens, updates or closes the pull request from the target branch to the
+// sync branch. If the repository is not a git repository, the manifests are published by the matching backend instead.
+// It returns the hydrated revision SHA and an error if one occurred.
+func (s *Service) CommitHydratedManifests(ctx context.Context, r *apiclient.CommitHydratedManifestsRequest) (*apiclient.CommitHydratedManifestsResponse, error) {
 	// This method is intentionally short. It's a wrapper around handleCommitRequest that adds metrics and logging.
 	// Keep logic here minimal and put most of the logic in handleCommitRequest.
 	startTime := time.Now()
@@ -99,7 +72,7 @@ func (s *Service) CommitHydratedManifests(_ context.Context, r *apiclient.Commit
 
 	logCtx := log.WithFields(log.Fields{"branch": r.TargetBranch, "drySHA": r.DrySha})
 
-	out, sha, err := s.handleCommitRequest(logCtx, r)
+	out, sha, pullRequest, err := s.handleCommitRequest(ctx, logCtx, r)
 	if err != nil {
 		logCtx.WithError(err).WithField("output", out).Error("failed to handle commit request")
 
@@ -110,37 +83,56 @@ func (s *Service) CommitHydratedManifests(_ context.Context, r *apiclient.Commit
 	logCtx.Info("Successfully handled commit request")
 	return &apiclient.CommitHydratedManifestsResponse{
 		HydratedSha: sha,
+		PullRequest: pullRequest,
 	}, nil
 }
 
 // handleCommitRequest handles the commit request. It clones the repository, checks out the sync branch, checks out the
-// target branch, clears the repository contents, writes the manifests to the repository, commits the changes, and pushes
-// the changes. It returns the output of the git commands and an error if one occurred.
-func (s *Service) handleCommitRequest(logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (string, string, error) {
+// target branch, clears the repository contents, writes the manifests to the repository, commits the changes, pushes
+// the changes and reconciles the pull request if one is configured. It returns the output of the git commands, the
+// hydrated commit SHA, the pull request status and an error if one occurred.
+func (s *Service) handleCommitRequest(ctx context.Context, logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (string, string, *v1alpha1.HydratorPullRequestStatus, error) {
 	if r.Repo == nil {
-		return "", "", errors.New("repo is required")
+		return "", "", nil, errors.New("repo is required")
 	}
 	if r.Repo.Repo == "" {
-		return "", "", errors.New("repo URL is required")
+		return "", "", nil, errors.New("repo URL is required")
 	}
 	if r.TargetBranch == "" {
-		return "", "", errors.New("target branch is required")
+		return "", "", nil, errors.New("target branch is required")
 	}
 	if r.SyncBranch == "" {
-		return "", "", errors.New("sync branch is required")
+		return "", "", nil, errors.New("sync branch is required")
 	}
 
 	logCtx = logCtx.WithField("repo", r.Repo.Repo)
+
+	publisher, ok, err := s.backendFactory.NewBackend(r.Repo)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("failed to create backend: %w", err)
+	}
+	if ok {
+		if r.PullRequest != nil {
+			return "", "", nil, errors.New("pull requests are only supported for git repositories")
+		}
+		logCtx.Debug("Publishing manifests")
+		revision, err := s.publish(ctx, logCtx, publisher, r)
+		if err != nil {
+			return "", "", nil, fmt.Errorf("failed to publish manifests: %w", err)
+		}
+		return "", revision, nil, nil
+	}
+
 	logCtx.Debug("Initiating git client")
 	gitClient, dirPath, cleanup, err := s.initGitClient(logCtx, r)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to init git client: %w", err)
+		return "", "", nil, fmt.Errorf("failed to init git client: %w", err)
 	}
 	defer cleanup()
 
 	root, err := os.OpenRoot(dirPath)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to open root dir: %w", err)
+		return "", "", nil, fmt.Errorf("failed to open root dir: %w", err)
 	}
 	defer io.Close(root)
 
@@ -148,13 +140,13 @@ func (s *Service) handleCommitRequest(logCtx *log.Entry, r *apiclient.CommitHydr
 	var out string
 	out, err = gitClient.CheckoutOrOrphan(r.SyncBranch, false)
 	if err != nil {
-		return out, "", fmt.Errorf("failed to checkout sync branch: %w", err)
+		return out, "", nil, fmt.Errorf("failed to checkout sync branch: %w", err)
 	}
 
 	logCtx.Debugf("Checking out target branch %s", r.TargetBranch)
 	out, err = gitClient.CheckoutOrNew(r.TargetBranch, r.SyncBranch, false)
 	if err != nil {
-		return out, "", fmt.Errorf("failed to checkout target branch: %w", err)
+		return out, "", nil, fmt.Errorf("failed to checkout target branch: %w", err)
 	}
 
 	logCtx.Debug("Clearing and preparing paths")
@@ -163,6 +155,13 @@ func (s *Service) handleCommitRequest(logCtx *log.Entry, r *apiclient.CommitHydr
 	// paths that are referencing to root path
 	for _, p := range r.Paths {
 		if hydrator.IsRootPath(p.Path) {
+			// The root directory is not cleared, so only the files which are always overwritten may be written to it.
+			if layout := v1alpha1.HydratedManifestsLayout(p.Layout); layout != "" && layout != v1alpha1.HydratedManifestsLayoutSingle {
+				return "", "", nil, fmt.Errorf("layout %s is not supported for the root path", layout)
+			}
+			if p.DisableReadme {
+				return "", "", nil, errors.New("disabling the README is not supported for the root path")
+			}
 			// skip adding paths that are referencing root directory
 			logCtx.Debugf("Path %s is referencing root directory, ignoring the path", p.Path)
 			continue
@@ -174,29 +173,83 @@ func (s *Service) handleCommitRequest(logCtx *log.Entry, r *apiclient.CommitHydr
 		logCtx.Debugf("Clearing paths: %v", pathsToClear)
 		out, err := gitClient.RemoveContents(pathsToClear)
 		if err != nil {
-			return out, "", fmt.Errorf("failed to clear paths %v: %w", pathsToClear, err)
+			return out, "", nil, fmt.Errorf("failed to clear paths %v: %w", pathsToClear, err)
 		}
 	}
 
 	logCtx.Debug("Writing manifests")
-	err = WriteForPaths(root, r.Repo.Repo, r.DrySha, r.DryCommitMetadata, r.Paths)
+	err = hydrator.WriteForPaths(root, getDryRepoURL(r), r.DrySha, r.DryCommitMetadata, r.Paths)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to write manifests: %w", err)
+		return "", "", nil, fmt.Errorf("failed to write manifests: %w", err)
 	}
 
 	logCtx.Debug("Committing and pushing changes")
 	out, err = gitClient.CommitAndPush(r.TargetBranch, r.CommitMessage)
 	if err != nil {
-		return out, "", fmt.Errorf("failed to commit and push: %w", err)
+		return out, "", nil, fmt.Errorf("failed to commit and push: %w", err)
 	}
 
 	logCtx.Debug("Getting commit SHA")
 	sha, err := gitClient.CommitSHA()
 	if err != nil {
-		return "", "", fmt.Errorf("failed to get commit SHA: %w", err)
+		return "", "", nil, fmt.Errorf("failed to get commit SHA: %w", err)
+	}
+
+	if r.PullRequest == nil {
+		return "", sha, nil, nil
+	}
+
+	logCtx.Debug("Reconciling pull request")
+	pullRequest, err := s.reconcilePullRequest(ctx, logCtx, gitClient, r, sha)
+	if err != nil {
+		return "", sha, nil, fmt.Errorf("failed to reconcile pull request: %w", err)
 	}
 
-	return "", sha, nil
+	return "", sha, pullRequest, nil
+}
+
+// publish writes the manifests to a temporary directory and publishes them with the given backend. It returns the
+// revision of the published manifests.
+func (s *Service) publish(ctx context.Context, logCtx *log.Entry, publisher backend.Backend, r *apiclient.CommitHydratedManifestsRequest) (string, error) {
+	dirPath, err := files.CreateTempDir("/tmp/_commit-service")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dirPath); err != nil {
+			logCtx.WithError(err).Error("failed to cleanup temp dir")
+		}
+	}()
+
+	root, err := os.OpenRoot(dirPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open root dir: %w", err)
+	}
+	defer io.Close(root)
+
+	logCtx.Debug("Writing manifests")
+	dryRepoURL := getDryRepoURL(r)
+	err = hydrator.WriteForPaths(root, dryRepoURL, r.DrySha, r.DryCommitMetadata, r.Paths)
+	if err != nil {
+		return "", fmt.Errorf("failed to write manifests: %w", err)
+	}
+
+	return publisher.Publish(ctx, &backend.PublishRequest{
+		Dir:        dirPath,
+		Ref:        r.TargetBranch,
+		DryRepoURL: dryRepoURL,
+		DrySHA:     r.DrySha,
+		Message:    r.CommitMessage,
+	})
+}
+
+// getDryRepoURL returns the URL of the dry source repository of the request. Older clients don't set it, in which case
+// the dry source and hydrated manifests live in the same repository.
+func getDryRepoURL(r *apiclient.CommitHydratedManifestsRequest) string {
+	if r.DryRepoURL != "" {
+		return r.DryRepoURL
+	}
+	return r.Repo.Repo
 }
 
 // initGitClient initializes a git client for the given repository and returns the client, the path to the directory where
@@ -262,5 +315,14 @@ func (s *Service) initGitClient(logCtx *log.Entry, r *apiclient.CommitHydratedMa
 		return nil, "", nil, fmt.Errorf("failed to set author: %w", err)
 	}
 
+	if s.signingKey != "" {
+		logCtx.Debugf("Setting signing key %s", s.signingKey)
+		_, err = gitClient.SetSigningKey(s.signingKey)
+		if err != nil {
+			cleanupOrLog()
+			return nil, "", nil, fmt.Errorf("failed to set signing key: %w", err)
+		}
+	}
+
 	return gitClient, dirPath, cleanupOrLog, nil
 }
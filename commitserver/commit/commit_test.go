@@ -1,6 +1,9 @@
 package commit
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -8,6 +11,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/commitserver/backend"
+	backendmocks "github.com/argoproj/argo-cd/v3/commitserver/backend/mocks"
 	"github.com/argoproj/argo-cd/v3/commitserver/commit/mocks"
 	"github.com/argoproj/argo-cd/v3/commitserver/metrics"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -109,6 +114,28 @@ func Test_CommitHydratedManifests(t *testing.T) {
 		assert.Equal(t, "it-worked!", resp.HydratedSha)
 	})
 
+	t.Run("signs commits with the configured key", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		service.signingKey = "1749CADFDEE429E5B35C047E766D199567C2FE59"
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor("Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().SetSigningKey("1749CADFDEE429E5B35C047E766D199567C2FE59").Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrOrphan("env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrNew("main", "env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CommitAndPush("main", "test commit message").Return("", nil).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("signed-sha", nil).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		resp, err := service.CommitHydratedManifests(t.Context(), validRequest)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, "signed-sha", resp.HydratedSha)
+	})
+
 	t.Run("root path with dot and blank - no directory removal", func(t *testing.T) {
 		t.Parallel()
 
@@ -196,6 +223,79 @@ func Test_CommitHydratedManifests(t *testing.T) {
 		assert.Equal(t, "subdir-path-sha", resp.HydratedSha)
 	})
 
+	t.Run("PerResource layout - removes the files of deleted resources", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		var dirPath string
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).RunAndReturn(func(_ *v1alpha1.Repository, path string) (git.Client, error) {
+			dirPath = path
+			return mockGitClient, nil
+		}).Once()
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor("Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrOrphan("env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrNew("main", "env/test", false).RunAndReturn(func(_, _ string, _ bool) (string, error) {
+			// the files of the previous hydration
+			require.NoError(t, os.MkdirAll(filepath.Join(dirPath, "apps/staging"), 0o755))
+			require.NoError(t, os.WriteFile(filepath.Join(dirPath, "apps/staging/configmap-deleted.yaml"), []byte("kind: ConfigMap"), 0o644))
+			require.NoError(t, os.WriteFile(filepath.Join(dirPath, "apps/staging/configmap-kept.yaml"), []byte("kind: ConfigMap"), 0o644))
+			return "", nil
+		}).Once()
+		mockGitClient.EXPECT().RemoveContents([]string{"apps/staging"}).RunAndReturn(func(paths []string) (string, error) {
+			for _, p := range paths {
+				require.NoError(t, os.RemoveAll(filepath.Join(dirPath, p)))
+			}
+			return "", nil
+		}).Once()
+		mockGitClient.EXPECT().CommitAndPush("main", "test commit message").RunAndReturn(func(_, _ string) (string, error) {
+			assert.NoFileExists(t, filepath.Join(dirPath, "apps/staging/configmap-deleted.yaml"))
+			assert.FileExists(t, filepath.Join(dirPath, "apps/staging/configmap-kept.yaml"))
+			return "", nil
+		}).Once()
+		mockGitClient.EXPECT().CommitSHA().Return("per-resource-sha", nil).Once()
+
+		resp, err := service.CommitHydratedManifests(t.Context(), &apiclient.CommitHydratedManifestsRequest{
+			Repo:          validRequest.Repo,
+			TargetBranch:  "main",
+			SyncBranch:    "env/test",
+			CommitMessage: "test commit message",
+			Paths: []*apiclient.PathDetails{{
+				Path:   "apps/staging",
+				Layout: string(v1alpha1.HydratedManifestsLayoutPerResource),
+				Manifests: []*apiclient.HydratedManifestDetails{{
+					ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"kept"}}`,
+				}},
+			}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "per-resource-sha", resp.HydratedSha)
+	})
+
+	t.Run("root path with PerResource layout", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor("Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrOrphan("env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrNew("main", "env/test", false).Return("", nil).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		_, err := service.CommitHydratedManifests(t.Context(), &apiclient.CommitHydratedManifestsRequest{
+			Repo:          validRequest.Repo,
+			TargetBranch:  "main",
+			SyncBranch:    "env/test",
+			CommitMessage: "test commit message",
+			Paths:         []*apiclient.PathDetails{{Path: ".", Layout: string(v1alpha1.HydratedManifestsLayoutPerResource)}},
+		})
+		require.ErrorContains(t, err, "layout PerResource is not supported for the root path")
+	})
+
 	t.Run("mixed paths - root and subdirectory", func(t *testing.T) {
 		t.Parallel()
 
@@ -280,6 +380,63 @@ func Test_CommitHydratedManifests(t *testing.T) {
 		require.NotNil(t, resp)
 		assert.Equal(t, "it-worked!", resp.HydratedSha)
 	})
+
+	t.Run("publishes to a non-git backend", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newServiceWithMocks(t)
+		mockBackendFactory := backendmocks.NewFactory(t)
+		mockBackend := backendmocks.NewBackend(t)
+		service.backendFactory = mockBackendFactory
+
+		request := &apiclient.CommitHydratedManifestsRequest{
+			Repo: &v1alpha1.Repository{
+				Repo: "oci://registry.example.com/hydrated",
+			},
+			TargetBranch:  "environments-dev",
+			SyncBranch:    "environments-dev",
+			DrySha:        "abc123",
+			DryRepoURL:    "https://github.com/argoproj/argocd-example-apps.git",
+			CommitMessage: "test commit message",
+			Paths: []*apiclient.PathDetails{
+				{Path: "guestbook"},
+			},
+		}
+		mockBackendFactory.EXPECT().NewBackend(request.Repo).Return(mockBackend, true, nil).Once()
+		mockBackend.EXPECT().Publish(mock.Anything, mock.Anything).Run(func(_ context.Context, r *backend.PublishRequest) {
+			assert.Equal(t, "environments-dev", r.Ref)
+			assert.Equal(t, "abc123", r.DrySHA)
+			assert.Equal(t, "https://github.com/argoproj/argocd-example-apps.git", r.DryRepoURL)
+			assert.Equal(t, "test commit message", r.Message)
+			assert.FileExists(t, filepath.Join(r.Dir, "guestbook", "manifest.yaml"))
+		}).Return("sha256:def456", nil).Once()
+
+		resp, err := service.CommitHydratedManifests(t.Context(), request)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, "sha256:def456", resp.HydratedSha)
+	})
+
+	t.Run("pull request with a non-git backend", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newServiceWithMocks(t)
+		mockBackendFactory := backendmocks.NewFactory(t)
+		service.backendFactory = mockBackendFactory
+
+		request := &apiclient.CommitHydratedManifestsRequest{
+			Repo: &v1alpha1.Repository{
+				Repo: "oci://registry.example.com/hydrated",
+			},
+			TargetBranch: "environments-dev-next",
+			SyncBranch:   "environments-dev",
+			PullRequest:  &v1alpha1.HydrateToPullRequest{Provider: v1alpha1.HydrateToPullRequestProviderGitHub},
+		}
+		mockBackendFactory.EXPECT().NewBackend(request.Repo).Return(backendmocks.NewBackend(t), true, nil).Once()
+
+		_, err := service.CommitHydratedManifests(t.Context(), request)
+		require.ErrorContains(t, err, "pull requests are only supported for git repositories")
+	})
 }
 
 func newServiceWithMocks(t *testing.T) (*Service, *mocks.RepoClientFactory) {
@@ -287,7 +444,7 @@ func newServiceWithMocks(t *testing.T) (*Service, *mocks.RepoClientFactory) {
 
 	metricsServer := metrics.NewMetricsServer()
 	mockCredsStore := git.NoopCredsStore{}
-	service := NewService(mockCredsStore, metricsServer)
+	service := NewService(mockCredsStore, metricsServer, "")
 	mockRepoClientFactory := mocks.NewRepoClientFactory(t)
 	service.repoClientFactory = mockRepoClientFactory
 
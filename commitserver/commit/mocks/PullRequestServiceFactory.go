@@ -0,0 +1,106 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewPullRequestServiceFactory creates a new instance of PullRequestServiceFactory. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPullRequestServiceFactory(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PullRequestServiceFactory {
+	mock := &PullRequestServiceFactory{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// PullRequestServiceFactory is an autogenerated mock type for the PullRequestServiceFactory type
+type PullRequestServiceFactory struct {
+	mock.Mock
+}
+
+type PullRequestServiceFactory_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PullRequestServiceFactory) EXPECT() *PullRequestServiceFactory_Expecter {
+	return &PullRequestServiceFactory_Expecter{mock: &_m.Mock}
+}
+
+// NewService provides a mock function for the type PullRequestServiceFactory
+func (_mock *PullRequestServiceFactory) NewService(repo *v1alpha1.Repository, pullRequest *v1alpha1.HydrateToPullRequest) (pull_request.PullRequestWriter, error) {
+	ret := _mock.Called(repo, pullRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewService")
+	}
+
+	var r0 pull_request.PullRequestWriter
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*v1alpha1.Repository, *v1alpha1.HydrateToPullRequest) (pull_request.PullRequestWriter, error)); ok {
+		return returnFunc(repo, pullRequest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*v1alpha1.Repository, *v1alpha1.HydrateToPullRequest) pull_request.PullRequestWriter); ok {
+		r0 = returnFunc(repo, pullRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(pull_request.PullRequestWriter)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*v1alpha1.Repository, *v1alpha1.HydrateToPullRequest) error); ok {
+		r1 = returnFunc(repo, pullRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// PullRequestServiceFactory_NewService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NewService'
+type PullRequestServiceFactory_NewService_Call struct {
+	*mock.Call
+}
+
+// NewService is a helper method to define mock.On call
+//   - repo *v1alpha1.Repository
+//   - pullRequest *v1alpha1.HydrateToPullRequest
+func (_e *PullRequestServiceFactory_Expecter) NewService(repo interface{}, pullRequest interface{}) *PullRequestServiceFactory_NewService_Call {
+	return &PullRequestServiceFactory_NewService_Call{Call: _e.mock.On("NewService", repo, pullRequest)}
+}
+
+func (_c *PullRequestServiceFactory_NewService_Call) Run(run func(repo *v1alpha1.Repository, pullRequest *v1alpha1.HydrateToPullRequest)) *PullRequestServiceFactory_NewService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *v1alpha1.Repository
+		if args[0] != nil {
+			arg0 = args[0].(*v1alpha1.Repository)
+		}
+		var arg1 *v1alpha1.HydrateToPullRequest
+		if args[1] != nil {
+			arg1 = args[1].(*v1alpha1.HydrateToPullRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *PullRequestServiceFactory_NewService_Call) Return(pullRequestWriter pull_request.PullRequestWriter, err error) *PullRequestServiceFactory_NewService_Call {
+	_c.Call.Return(pullRequestWriter, err)
+	return _c
+}
+
+func (_c *PullRequestServiceFactory_NewService_Call) RunAndReturn(run func(repo *v1alpha1.Repository, pullRequest *v1alpha1.HydrateToPullRequest) (pull_request.PullRequestWriter, error)) *PullRequestServiceFactory_NewService_Call {
+	_c.Call.Return(run)
+	return _c
+}
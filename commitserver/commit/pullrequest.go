@@ -0,0 +1,267 @@
+package commit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/github_app_auth"
+	"github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+// PullRequestServiceFactory is a factory for creating SCM clients which manage the pull request from the hydrated
+// branch to the sync branch.
+type PullRequestServiceFactory interface {
+	NewService(repo *v1alpha1.Repository, pullRequest *v1alpha1.HydrateToPullRequest) (pull_request.PullRequestWriter, error)
+}
+
+type pullRequestServiceFactory struct{}
+
+// NewPullRequestServiceFactory returns a new instance of the pull request service factory.
+func NewPullRequestServiceFactory() PullRequestServiceFactory {
+	return &pullRequestServiceFactory{}
+}
+
+// NewService creates a new SCM client for the repository. The client authenticates with the repository's write
+// credentials, i.e. either the password/token or the GitHub App credentials.
+func (f *pullRequestServiceFactory) NewService(repo *v1alpha1.Repository, pullRequest *v1alpha1.HydrateToPullRequest) (pull_request.PullRequestWriter, error) {
+	repoPath, err := getRepoPath(repo.Repo)
+	if err != nil {
+		return nil, err
+	}
+	api, err := getPullRequestAPI(repo, pullRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	var service pull_request.PullRequestService
+	switch pullRequest.Provider {
+	case v1alpha1.HydrateToPullRequestProviderGitHub:
+		owner, name, _ := strings.Cut(repoPath, "/")
+		if repo.GithubAppId != 0 {
+			auth := github_app_auth.Authentication{
+				Id:                repo.GithubAppId,
+				InstallationId:    repo.GithubAppInstallationId,
+				EnterpriseBaseURL: repo.GitHubAppEnterpriseBaseURL,
+				PrivateKey:        repo.GithubAppPrivateKey,
+			}
+			service, err = pull_request.NewGithubAppService(auth, api, owner, name, pullRequest.Labels)
+		} else {
+			service, err = pull_request.NewGithubService(repo.Password, api, owner, name, pullRequest.Labels)
+		}
+	case v1alpha1.HydrateToPullRequestProviderGitLab:
+		service, err = pull_request.NewGitLabService(repo.Password, api, repoPath, pullRequest.Labels, "opened", "", repo.IsInsecure(), nil)
+	default:
+		return nil, fmt.Errorf("unsupported pull request provider %q", pullRequest.Provider)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s pull request service: %w", pullRequest.Provider, err)
+	}
+
+	writer, ok := service.(pull_request.PullRequestWriter)
+	if !ok {
+		return nil, fmt.Errorf("pull request provider %q does not support opening pull requests", pullRequest.Provider)
+	}
+	return writer, nil
+}
+
+// getRepoPath returns the path of the repository on the SCM provider, e.g. "owner/repo" for
+// https://github.com/owner/repo.git or git@github.com:owner/repo.git.
+func getRepoPath(repoURL string) (string, error) {
+	u, err := parseRepoURL(repoURL)
+	if err != nil {
+		return "", err
+	}
+	repoPath := strings.TrimSuffix(strings.Trim(u.Path, "/"), ".git")
+	if !strings.Contains(repoPath, "/") {
+		return "", fmt.Errorf("repo URL %q does not contain an owner and a repository name", repoURL)
+	}
+	return repoPath, nil
+}
+
+func parseRepoURL(repoURL string) (*url.URL, error) {
+	repoURL = strings.TrimSpace(repoURL)
+	if isSSH, _ := git.IsSSHURL(repoURL); isSSH && !strings.HasPrefix(repoURL, "ssh://") {
+		// We need to replace the first colon in git@server... style SSH URLs with a slash, otherwise net/url.Parse
+		// will interpret it incorrectly as the port.
+		repoURL = "ssh://" + strings.Replace(repoURL, ":", "/", 1)
+	}
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse repo URL %q: %w", repoURL, err)
+	}
+	return u, nil
+}
+
+// getPullRequestAPI returns the URL of the SCM provider's API. The pull request service authenticates with the write
+// credentials of the repository, so the API must be hosted by the SCM provider hosting the repository: the host of the
+// API must be the host of the repository URL, its "api." subdomain or the host of the GitHub App enterprise URL of the
+// credentials. If no API is configured, it is derived from the repository URL.
+func getPullRequestAPI(repo *v1alpha1.Repository, pullRequest *v1alpha1.HydrateToPullRequest) (string, error) {
+	repoURL, err := parseRepoURL(repo.Repo)
+	if err != nil {
+		return "", err
+	}
+	repoHost := strings.ToLower(repoURL.Hostname())
+	if repoHost == "" {
+		return "", fmt.Errorf("repo URL %q does not contain a host", repo.Repo)
+	}
+
+	if pullRequest.API == "" {
+		scheme := "https"
+		if repoURL.Scheme == "http" {
+			scheme = "http"
+		}
+		switch {
+		case pullRequest.Provider == v1alpha1.HydrateToPullRequestProviderGitHub && (repoHost == "github.com" || repo.GithubAppId != 0 && repo.GitHubAppEnterpriseBaseURL != ""):
+			// The public API, or the enterprise URL of the GitHub App credentials
+			return "", nil
+		case pullRequest.Provider == v1alpha1.HydrateToPullRequestProviderGitHub:
+			return fmt.Sprintf("%s://%s/api/v3", scheme, repoURL.Host), nil
+		case pullRequest.Provider == v1alpha1.HydrateToPullRequestProviderGitLab && repoHost == "gitlab.com":
+			return "", nil
+		default:
+			return fmt.Sprintf("%s://%s", scheme, repoURL.Host), nil
+		}
+	}
+
+	apiURL, err := url.Parse(pullRequest.API)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse pull request API URL %q: %w", pullRequest.API, err)
+	}
+	if apiURL.Scheme != "https" && apiURL.Scheme != "http" {
+		return "", fmt.Errorf("pull request API URL %q must use http or https", pullRequest.API)
+	}
+	allowedHosts := []string{repoHost, "api." + repoHost}
+	if repo.GitHubAppEnterpriseBaseURL != "" {
+		if enterpriseURL, err := url.Parse(repo.GitHubAppEnterpriseBaseURL); err == nil {
+			allowedHosts = append(allowedHosts, strings.ToLower(enterpriseURL.Hostname()))
+		}
+	}
+	if !slices.Contains(allowedHosts, strings.ToLower(apiURL.Hostname())) {
+		return "", fmt.Errorf("host of pull request API URL %q does not match the host %q of repository %q", pullRequest.API, repoHost, repo.Repo)
+	}
+	return pullRequest.API, nil
+}
+
+// reconcilePullRequest makes sure that a pull request from the target branch to the sync branch is open if, and only
+// if, the hydrated manifests on the two branches differ. An open pull request is updated to reflect the latest hydrated
+// commit. It returns the status of the pull request, or nil if no pull request is open.
+func (s *Service) reconcilePullRequest(ctx context.Context, logCtx *log.Entry, gitClient git.Client, r *apiclient.CommitHydratedManifestsRequest, hydratedSHA string) (*v1alpha1.HydratorPullRequestStatus, error) {
+	if r.TargetBranch == r.SyncBranch {
+		return nil, errors.New("cannot open a pull request when the target branch is the sync branch")
+	}
+
+	refs, err := gitClient.LsRefs()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list remote refs: %w", err)
+	}
+	if !slices.Contains(refs.Branches, r.SyncBranch) {
+		// A pull request cannot be opened against a branch which does not exist. The pull request is opened by the
+		// first hydration after the sync branch has been created.
+		logCtx.Infof("Sync branch %s does not exist, skipping pull request", r.SyncBranch)
+		return nil, nil
+	}
+	syncSHA, err := gitClient.LsRemote(r.SyncBranch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve sync branch %q: %w", r.SyncBranch, err)
+	}
+	if !gitClient.IsRevisionPresent(syncSHA) {
+		logCtx.Debugf("Fetching sync branch %s", r.SyncBranch)
+		err = gitClient.Fetch(r.SyncBranch, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch sync branch %q: %w", r.SyncBranch, err)
+		}
+	}
+	changedFiles, err := gitClient.ChangedFiles(syncSHA, hydratedSHA)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get changed files between %q and %q: %w", r.SyncBranch, r.TargetBranch, err)
+	}
+
+	service, err := s.pullRequestServiceFactory.NewService(r.Repo, r.PullRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pull request service: %w", err)
+	}
+	// The pull request is looked up by its branches only. Looking it up by its labels would miss a pull request whose
+	// labels were removed by hand, and a second pull request would be opened for the same branches.
+	existing, err := service.Find(ctx, r.TargetBranch, r.SyncBranch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pull request from %q to %q: %w", r.TargetBranch, r.SyncBranch, err)
+	}
+
+	if len(changedFiles) == 0 {
+		if existing == nil {
+			logCtx.Debug("Target branch does not differ from sync branch, no pull request needed")
+			return nil, nil
+		}
+		logCtx.Infof("Target branch no longer differs from sync branch, closing pull request #%d", existing.Number)
+		err = service.Close(ctx, existing.Number)
+		if err != nil {
+			return nil, fmt.Errorf("failed to close pull request #%d: %w", existing.Number, err)
+		}
+		return getPullRequestStatus(existing, v1alpha1.HydratorPullRequestStateClosed, hydratedSHA), nil
+	}
+
+	title, body := getPullRequestTitleAndBody(r)
+	var pr *pull_request.PullRequest
+	if existing == nil {
+		logCtx.Infof("Opening pull request from %s to %s", r.TargetBranch, r.SyncBranch)
+		pr, err = service.Create(ctx, title, body, r.TargetBranch, r.SyncBranch)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open pull request: %w", err)
+		}
+	} else {
+		var missingLabels []string
+		for _, label := range r.PullRequest.Labels {
+			if !slices.Contains(existing.Labels, label) {
+				missingLabels = append(missingLabels, label)
+			}
+		}
+		if len(missingLabels) > 0 {
+			logCtx.Infof("Adding labels %v to pull request #%d", missingLabels, existing.Number)
+			err = service.AddLabels(ctx, existing.Number, missingLabels)
+			if err != nil {
+				return nil, fmt.Errorf("failed to label pull request #%d: %w", existing.Number, err)
+			}
+		}
+		logCtx.Debugf("Updating pull request #%d", existing.Number)
+		pr, err = service.Update(ctx, existing.Number, title, body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update pull request #%d: %w", existing.Number, err)
+		}
+	}
+	return getPullRequestStatus(pr, v1alpha1.HydratorPullRequestStateOpen, hydratedSHA), nil
+}
+
+// getPullRequestTitleAndBody returns the title and body of the pull request. The title is the subject line of the
+// hydrated commit message and the body is the rest of the commit message.
+func getPullRequestTitleAndBody(r *apiclient.CommitHydratedManifestsRequest) (string, string) {
+	subject, body, _ := strings.Cut(strings.TrimSpace(r.CommitMessage), "\n")
+	subject = strings.TrimSpace(subject)
+	if subject == "" {
+		subject = "Promote hydrated manifests to " + r.SyncBranch
+	}
+	footer := fmt.Sprintf("This pull request was opened by the Argo CD source hydrator to promote the hydrated manifests of dry commit %s from `%s` to `%s`.", r.DrySha, r.TargetBranch, r.SyncBranch)
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return subject, footer
+	}
+	return subject, body + "\n\n---\n\n" + footer
+}
+
+func getPullRequestStatus(pr *pull_request.PullRequest, state v1alpha1.HydratorPullRequestState, hydratedSHA string) *v1alpha1.HydratorPullRequestStatus {
+	return &v1alpha1.HydratorPullRequestStatus{
+		Number:      int64(pr.Number),
+		URL:         pr.URL,
+		State:       state,
+		HydratedSHA: hydratedSHA,
+	}
+}
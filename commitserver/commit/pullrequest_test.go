@@ -0,0 +1,297 @@
+package commit
+
+import (
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
+	prmocks "github.com/argoproj/argo-cd/v3/applicationset/services/pull_request/mocks"
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/commitserver/commit/mocks"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	gitmocks "github.com/argoproj/argo-cd/v3/util/git/mocks"
+)
+
+const (
+	testSyncSHA     = "1111111111111111111111111111111111111111"
+	testHydratedSHA = "2222222222222222222222222222222222222222"
+)
+
+func Test_getRepoPath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		repoURL  string
+		expected string
+		errMsg   string
+	}{
+		{repoURL: "https://github.com/argoproj/argocd-example-apps.git", expected: "argoproj/argocd-example-apps"},
+		{repoURL: "https://github.com/argoproj/argocd-example-apps", expected: "argoproj/argocd-example-apps"},
+		{repoURL: "git@github.com:argoproj/argocd-example-apps.git", expected: "argoproj/argocd-example-apps"},
+		{repoURL: "ssh://git@gitlab.example.com:2222/group/subgroup/project.git", expected: "group/subgroup/project"},
+		{repoURL: "https://github.com/argoproj", errMsg: "does not contain an owner and a repository name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.repoURL, func(t *testing.T) {
+			t.Parallel()
+
+			repoPath, err := getRepoPath(tt.repoURL)
+			if tt.errMsg != "" {
+				require.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, repoPath)
+		})
+	}
+}
+
+func Test_getPullRequestAPI(t *testing.T) {
+	t.Parallel()
+
+	github := v1alpha1.HydrateToPullRequestProviderGitHub
+	gitlab := v1alpha1.HydrateToPullRequestProviderGitLab
+	tests := []struct {
+		name     string
+		repo     *v1alpha1.Repository
+		provider v1alpha1.HydrateToPullRequestProvider
+		api      string
+		expected string
+		errMsg   string
+	}{
+		{name: "public GitHub", repo: &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps.git"}, provider: github},
+		{name: "public GitHub API", repo: &v1alpha1.Repository{Repo: "git@github.com:argoproj/argocd-example-apps.git"}, provider: github, api: "https://api.github.com", expected: "https://api.github.com"},
+		{name: "GitHub Enterprise", repo: &v1alpha1.Repository{Repo: "https://github.example.com/argoproj/argocd-example-apps.git"}, provider: github, expected: "https://github.example.com/api/v3"},
+		{name: "GitHub Enterprise API", repo: &v1alpha1.Repository{Repo: "https://github.example.com/argoproj/argocd-example-apps.git"}, provider: github, api: "https://github.example.com/api/v3", expected: "https://github.example.com/api/v3"},
+		{name: "GitHub App enterprise URL", repo: &v1alpha1.Repository{Repo: "https://github.example.com/argoproj/argocd-example-apps.git", GithubAppId: 1, GitHubAppEnterpriseBaseURL: "https://ghe-api.example.com/api/v3"}, provider: github},
+		{name: "GitHub App enterprise URL API", repo: &v1alpha1.Repository{Repo: "https://github.example.com/argoproj/argocd-example-apps.git", GithubAppId: 1, GitHubAppEnterpriseBaseURL: "https://ghe-api.example.com/api/v3"}, provider: github, api: "https://ghe-api.example.com/api/v3", expected: "https://ghe-api.example.com/api/v3"},
+		{name: "public GitLab", repo: &v1alpha1.Repository{Repo: "https://gitlab.com/group/project.git"}, provider: gitlab},
+		{name: "self-hosted GitLab", repo: &v1alpha1.Repository{Repo: "ssh://git@gitlab.example.com:2222/group/project.git"}, provider: gitlab, expected: "https://gitlab.example.com:2222"},
+		{name: "foreign host", repo: &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps.git"}, provider: github, api: "https://attacker.example.com", errMsg: `does not match the host "github.com"`},
+		{name: "foreign subdomain", repo: &v1alpha1.Repository{Repo: "https://gitlab.com/group/project.git"}, provider: gitlab, api: "https://gitlab.com.attacker.example.com", errMsg: `does not match the host "gitlab.com"`},
+		{name: "unsupported scheme", repo: &v1alpha1.Repository{Repo: "https://gitlab.com/group/project.git"}, provider: gitlab, api: "file:///etc/passwd", errMsg: "must use http or https"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api, err := getPullRequestAPI(tt.repo, &v1alpha1.HydrateToPullRequest{Provider: tt.provider, API: tt.api})
+			if tt.errMsg != "" {
+				require.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, api)
+		})
+	}
+}
+
+func Test_pullRequestServiceFactory_NewService_RejectsForeignAPI(t *testing.T) {
+	t.Parallel()
+
+	repo := &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps.git", Password: "token"}
+	_, err := NewPullRequestServiceFactory().NewService(repo, &v1alpha1.HydrateToPullRequest{
+		Provider: v1alpha1.HydrateToPullRequestProviderGitHub,
+		API:      "https://attacker.example.com/api/v3",
+	})
+	require.ErrorContains(t, err, "does not match the host")
+}
+
+func Test_getPullRequestTitleAndBody(t *testing.T) {
+	t.Parallel()
+
+	r := &apiclient.CommitHydratedManifestsRequest{
+		TargetBranch:  "env/dev-next",
+		SyncBranch:    "env/dev",
+		DrySha:        "abc123",
+		CommitMessage: "Bump image\n\nSigned-off-by: CI <ci@example.com>\n",
+	}
+	title, body := getPullRequestTitleAndBody(r)
+	assert.Equal(t, "Bump image", title)
+	assert.Equal(t, "Signed-off-by: CI <ci@example.com>\n\n---\n\nThis pull request was opened by the Argo CD source hydrator to promote the hydrated manifests of dry commit abc123 from `env/dev-next` to `env/dev`.", body)
+
+	r.CommitMessage = ""
+	title, body = getPullRequestTitleAndBody(r)
+	assert.Equal(t, "Promote hydrated manifests to env/dev", title)
+	assert.Equal(t, "This pull request was opened by the Argo CD source hydrator to promote the hydrated manifests of dry commit abc123 from `env/dev-next` to `env/dev`.", body)
+}
+
+func Test_reconcilePullRequest(t *testing.T) {
+	t.Parallel()
+
+	request := &apiclient.CommitHydratedManifestsRequest{
+		Repo: &v1alpha1.Repository{
+			Repo: "https://github.com/argoproj/argocd-example-apps.git",
+		},
+		TargetBranch:  "env/dev-next",
+		SyncBranch:    "env/dev",
+		DrySha:        "abc123",
+		CommitMessage: "Bump image",
+		PullRequest: &v1alpha1.HydrateToPullRequest{
+			Provider: v1alpha1.HydrateToPullRequestProviderGitHub,
+		},
+	}
+	existing := &pull_request.PullRequest{
+		Number:       7,
+		Branch:       "env/dev-next",
+		TargetBranch: "env/dev",
+		URL:          "https://github.com/argoproj/argocd-example-apps/pull/7",
+	}
+
+	newService := func(t *testing.T, changedFiles []string) (*Service, *gitmocks.Client, *prmocks.PullRequestWriter) {
+		t.Helper()
+		service, _ := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().LsRefs().Return(&git.Refs{Branches: []string{"env/dev", "env/dev-next"}}, nil).Once()
+		mockGitClient.EXPECT().LsRemote("env/dev").Return(testSyncSHA, nil).Once()
+		mockGitClient.EXPECT().IsRevisionPresent(testSyncSHA).Return(true).Once()
+		mockGitClient.EXPECT().ChangedFiles(testSyncSHA, testHydratedSHA).Return(changedFiles, nil).Once()
+		mockPullRequestServiceFactory := mocks.NewPullRequestServiceFactory(t)
+		mockPullRequestWriter := prmocks.NewPullRequestWriter(t)
+		mockPullRequestServiceFactory.EXPECT().NewService(request.Repo, request.PullRequest).Return(mockPullRequestWriter, nil).Once()
+		service.pullRequestServiceFactory = mockPullRequestServiceFactory
+		return service, mockGitClient, mockPullRequestWriter
+	}
+
+	t.Run("opens pull request when branches differ", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockGitClient, mockPullRequestWriter := newService(t, []string{"guestbook/manifest.yaml"})
+		mockPullRequestWriter.EXPECT().Find(mock.Anything, "env/dev-next", "env/dev").Return(nil, nil).Once()
+		mockPullRequestWriter.EXPECT().Create(mock.Anything, "Bump image", mock.Anything, "env/dev-next", "env/dev").Return(existing, nil).Once()
+
+		status, err := service.reconcilePullRequest(t.Context(), log.NewEntry(log.New()), mockGitClient, request, testHydratedSHA)
+		require.NoError(t, err)
+		assert.Equal(t, &v1alpha1.HydratorPullRequestStatus{
+			Number:      7,
+			URL:         "https://github.com/argoproj/argocd-example-apps/pull/7",
+			State:       v1alpha1.HydratorPullRequestStateOpen,
+			HydratedSHA: testHydratedSHA,
+		}, status)
+	})
+
+	t.Run("updates existing pull request when branches differ", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockGitClient, mockPullRequestWriter := newService(t, []string{"guestbook/manifest.yaml"})
+		mockPullRequestWriter.EXPECT().Find(mock.Anything, "env/dev-next", "env/dev").Return(existing, nil).Once()
+		mockPullRequestWriter.EXPECT().Update(mock.Anything, 7, "Bump image", mock.Anything).Return(existing, nil).Once()
+
+		status, err := service.reconcilePullRequest(t.Context(), log.NewEntry(log.New()), mockGitClient, request, testHydratedSHA)
+		require.NoError(t, err)
+		require.NotNil(t, status)
+		assert.Equal(t, v1alpha1.HydratorPullRequestStateOpen, status.State)
+		assert.Equal(t, int64(7), status.Number)
+	})
+
+	t.Run("labels existing pull request whose labels were removed", func(t *testing.T) {
+		t.Parallel()
+
+		labeledRequest := *request
+		labeledRequest.PullRequest = &v1alpha1.HydrateToPullRequest{
+			Provider: v1alpha1.HydrateToPullRequestProviderGitHub,
+			Labels:   []string{"hydrator", "env/dev"},
+		}
+		unlabeled := &pull_request.PullRequest{
+			Number:       7,
+			Branch:       "env/dev-next",
+			TargetBranch: "env/dev",
+			Labels:       []string{"env/dev"},
+		}
+		service, _ := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().LsRefs().Return(&git.Refs{Branches: []string{"env/dev", "env/dev-next"}}, nil).Once()
+		mockGitClient.EXPECT().LsRemote("env/dev").Return(testSyncSHA, nil).Once()
+		mockGitClient.EXPECT().IsRevisionPresent(testSyncSHA).Return(true).Once()
+		mockGitClient.EXPECT().ChangedFiles(testSyncSHA, testHydratedSHA).Return([]string{"guestbook/manifest.yaml"}, nil).Once()
+		mockPullRequestServiceFactory := mocks.NewPullRequestServiceFactory(t)
+		mockPullRequestWriter := prmocks.NewPullRequestWriter(t)
+		mockPullRequestServiceFactory.EXPECT().NewService(labeledRequest.Repo, labeledRequest.PullRequest).Return(mockPullRequestWriter, nil).Once()
+		mockPullRequestWriter.EXPECT().Find(mock.Anything, "env/dev-next", "env/dev").Return(unlabeled, nil).Once()
+		mockPullRequestWriter.EXPECT().AddLabels(mock.Anything, 7, []string{"hydrator"}).Return(nil).Once()
+		mockPullRequestWriter.EXPECT().Update(mock.Anything, 7, "Bump image", mock.Anything).Return(unlabeled, nil).Once()
+		service.pullRequestServiceFactory = mockPullRequestServiceFactory
+
+		status, err := service.reconcilePullRequest(t.Context(), log.NewEntry(log.New()), mockGitClient, &labeledRequest, testHydratedSHA)
+		require.NoError(t, err)
+		require.NotNil(t, status)
+		assert.Equal(t, v1alpha1.HydratorPullRequestStateOpen, status.State)
+		assert.Equal(t, int64(7), status.Number)
+	})
+
+	t.Run("closes existing pull request when branches are equal", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockGitClient, mockPullRequestWriter := newService(t, []string{})
+		mockPullRequestWriter.EXPECT().Find(mock.Anything, "env/dev-next", "env/dev").Return(existing, nil).Once()
+		mockPullRequestWriter.EXPECT().Close(mock.Anything, 7).Return(nil).Once()
+
+		status, err := service.reconcilePullRequest(t.Context(), log.NewEntry(log.New()), mockGitClient, request, testHydratedSHA)
+		require.NoError(t, err)
+		require.NotNil(t, status)
+		assert.Equal(t, v1alpha1.HydratorPullRequestStateClosed, status.State)
+	})
+
+	t.Run("does nothing when branches are equal and no pull request is open", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockGitClient, mockPullRequestWriter := newService(t, []string{})
+		mockPullRequestWriter.EXPECT().Find(mock.Anything, "env/dev-next", "env/dev").Return(nil, nil).Once()
+
+		status, err := service.reconcilePullRequest(t.Context(), log.NewEntry(log.New()), mockGitClient, request, testHydratedSHA)
+		require.NoError(t, err)
+		assert.Nil(t, status)
+	})
+
+	t.Run("fetches sync branch which is not present locally", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().LsRefs().Return(&git.Refs{Branches: []string{"env/dev", "env/dev-next"}}, nil).Once()
+		mockGitClient.EXPECT().LsRemote("env/dev").Return(testSyncSHA, nil).Once()
+		mockGitClient.EXPECT().IsRevisionPresent(testSyncSHA).Return(false).Once()
+		mockGitClient.EXPECT().Fetch("env/dev", int64(0)).Return(nil).Once()
+		mockGitClient.EXPECT().ChangedFiles(testSyncSHA, testHydratedSHA).Return([]string{}, nil).Once()
+		mockPullRequestServiceFactory := mocks.NewPullRequestServiceFactory(t)
+		mockPullRequestWriter := prmocks.NewPullRequestWriter(t)
+		mockPullRequestServiceFactory.EXPECT().NewService(request.Repo, request.PullRequest).Return(mockPullRequestWriter, nil).Once()
+		mockPullRequestWriter.EXPECT().Find(mock.Anything, "env/dev-next", "env/dev").Return(nil, nil).Once()
+		service.pullRequestServiceFactory = mockPullRequestServiceFactory
+
+		status, err := service.reconcilePullRequest(t.Context(), log.NewEntry(log.New()), mockGitClient, request, testHydratedSHA)
+		require.NoError(t, err)
+		assert.Nil(t, status)
+	})
+
+	t.Run("skips pull request when sync branch does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().LsRefs().Return(&git.Refs{Branches: []string{"env/dev-next"}}, nil).Once()
+
+		status, err := service.reconcilePullRequest(t.Context(), log.NewEntry(log.New()), mockGitClient, request, testHydratedSHA)
+		require.NoError(t, err)
+		assert.Nil(t, status)
+	})
+
+	t.Run("target branch equals sync branch", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newServiceWithMocks(t)
+		sameBranchRequest := &apiclient.CommitHydratedManifestsRequest{
+			Repo:         request.Repo,
+			TargetBranch: "env/dev",
+			SyncBranch:   "env/dev",
+			PullRequest:  request.PullRequest,
+		}
+		_, err := service.reconcilePullRequest(t.Context(), log.NewEntry(log.New()), gitmocks.NewClient(t), sameBranchRequest, testHydratedSHA)
+		require.ErrorContains(t, err, "target branch is the sync branch")
+	})
+}
@@ -18,9 +18,10 @@ type ArgoCDCommitServer struct {
 	commitService *commit.Service
 }
 
-// NewServer returns a new instance of the commit server.
-func NewServer(gitCredsStore git.CredsStore, metricsServer *metrics.Server) *ArgoCDCommitServer {
-	return &ArgoCDCommitServer{commitService: commit.NewService(gitCredsStore, metricsServer)}
+// NewServer returns a new instance of the commit server. If signingKey is not empty, hydrated commits are signed with
+// the GnuPG key of that ID.
+func NewServer(gitCredsStore git.CredsStore, metricsServer *metrics.Server, signingKey string) *ArgoCDCommitServer {
+	return &ArgoCDCommitServer{commitService: commit.NewService(gitCredsStore, metricsServer, signingKey)}
 }
 
 // CreateGRPC creates a new gRPC server.
@@ -90,6 +90,8 @@ const (
 	DefaultSSHKnownHostsName = "ssh_known_hosts"
 	// DefaultGnuPgHomePath is the Default path to GnuPG home directory
 	DefaultGnuPgHomePath = "/app/config/gpg/keys"
+	// DefaultCommitServerSigningKeyPath is the default path to the GnuPG private key used by the commit server to sign commits
+	DefaultCommitServerSigningKeyPath = "/app/config/commit-server/signing/signing.key"
 	// DefaultAppConfigPath is the Default path to repo server TLS endpoint config
 	DefaultAppConfigPath = "/app/config"
 	// DefaultPluginSockFilePath is the Default path to cmp server plugin socket file
@@ -207,6 +209,17 @@ const (
 	// AnnotationClientSideApplyMigrationManager specifies a custom field manager for client-side apply migration
 	AnnotationClientSideApplyMigrationManager = "argocd.argoproj.io/client-side-apply-migration-manager"
 
+	// AnnotationSyncWaveReadiness contains a Lua script which checks whether the resources of the sync wave of the
+	// annotated resource are ready. The sync does not proceed to the next wave until the script returns true.
+	AnnotationSyncWaveReadiness = "argocd.argoproj.io/sync-wave-readiness"
+
+	// AnnotationKeyReleaseTo is set by the application which manages a resource to release the resource to the given
+	// application, which can then claim it with AnnotationKeyClaimFrom
+	AnnotationKeyReleaseTo = "argocd.argoproj.io/release-to"
+	// AnnotationKeyClaimFrom is set by an application on a resource to take over the resource released to it by the
+	// given application with AnnotationKeyReleaseTo
+	AnnotationKeyClaimFrom = "argocd.argoproj.io/claim-from"
+
 	// AnnotationIgnoreHealthCheck when set on an Application's immediate child indicates that its health check
 	// can be disregarded.
 	AnnotationIgnoreHealthCheck = "argocd.argoproj.io/ignore-healthcheck"
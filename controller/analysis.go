@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/analysis"
+)
+
+// syncAnalysisQueryTimeout is the timeout of the queries of the metrics of a sync analysis
+const syncAnalysisQueryTimeout = 30 * time.Second
+
+// analyzeSync analyzes the metrics of the sync policy once all the resources of the sync were synced, and records the
+// state of the analysis in the operation state. The metrics are only queried once the interval of the analysis has
+// elapsed since they were last checked, so that the analysis can be resumed each time the operation is processed.
+func (m *appStateManager) analyzeSync(policy *v1alpha1.SyncPolicyAnalysis, state *v1alpha1.OperationState) (common.OperationPhase, string) {
+	if state.Analysis != nil && state.Analysis.Phase.Completed() {
+		return state.Analysis.Phase, state.Analysis.Message
+	}
+	now := metav1.Now()
+	if state.Analysis == nil {
+		state.Analysis = &v1alpha1.SyncAnalysisStatus{Phase: common.OperationRunning, StartedAt: now}
+	}
+	status := state.Analysis
+
+	duration, err := policy.GetDuration()
+	if err != nil {
+		return completeSyncAnalysis(status, common.OperationFailed, fmt.Sprintf("invalid analysis duration: %v", err))
+	}
+	interval, err := policy.GetInterval()
+	if err != nil {
+		return completeSyncAnalysis(status, common.OperationFailed, fmt.Sprintf("invalid analysis interval: %v", err))
+	}
+	deadline := status.StartedAt.Add(duration)
+	if status.CheckedAt != nil && now.Sub(status.CheckedAt.Time) < interval && now.Time.Before(deadline) {
+		return status.Phase, status.Message
+	}
+
+	address, err := m.settingsMgr.GetSyncAnalysisAddress()
+	if err != nil {
+		return completeSyncAnalysis(status, common.OperationFailed, fmt.Sprintf("failed to get the analysis address: %v", err))
+	}
+	if address == "" {
+		return completeSyncAnalysis(status, common.OperationFailed, "no analysis address is configured in the argocd-cm ConfigMap")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), syncAnalysisQueryTimeout)
+	defer cancel()
+	client := analysis.NewPrometheusClient(address, &http.Client{Timeout: syncAnalysisQueryTimeout})
+	results, violation, err := analysis.Analyze(ctx, client, policy.Metrics)
+	status.CheckedAt = &now
+	status.Results = results
+	switch {
+	case err != nil:
+		return completeSyncAnalysis(status, common.OperationFailed, err.Error())
+	case violation != "":
+		return completeSyncAnalysis(status, common.OperationFailed, violation)
+	case !now.Time.Before(deadline):
+		return completeSyncAnalysis(status, common.OperationSucceeded, "all metrics are within their range")
+	}
+	status.Message = "analyzing metrics until " + deadline.UTC().Format(time.RFC3339)
+	return status.Phase, status.Message
+}
+
+func completeSyncAnalysis(status *v1alpha1.SyncAnalysisStatus, phase common.OperationPhase, message string) (common.OperationPhase, string) {
+	status.Phase = phase
+	status.Message = message
+	return phase, message
+}
@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestAnalyzeSync(t *testing.T) {
+	queries := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		queries++
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"value":[1700000000,"0.02"]}]}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	newManager := func(address string) *appStateManager {
+		configMapData := map[string]string{}
+		if address != "" {
+			configMapData["application.sync.analysis.address"] = address
+		}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{newFakeApp()}, configMapData: configMapData}, nil)
+		return ctrl.appStateManager.(*appStateManager)
+	}
+	newPolicy := func(duration, maxErrorRate string) *v1alpha1.SyncPolicyAnalysis {
+		return &v1alpha1.SyncPolicyAnalysis{
+			Duration: duration,
+			Interval: "1m",
+			Metrics:  []v1alpha1.SyncAnalysisMetric{{Name: "errors", Query: "error_rate", Max: maxErrorRate}},
+		}
+	}
+
+	t.Run("Running", func(t *testing.T) {
+		queries = 0
+		manager := newManager(server.URL)
+		state := &v1alpha1.OperationState{}
+		phase, message := manager.analyzeSync(newPolicy("10m", "0.05"), state)
+		assert.Equal(t, synccommon.OperationRunning, phase)
+		assert.Contains(t, message, "analyzing metrics until")
+		require.NotNil(t, state.Analysis)
+		assert.Equal(t, []v1alpha1.SyncAnalysisResult{{Name: "errors", Value: "0.02"}}, state.Analysis.Results)
+		assert.Equal(t, 1, queries)
+
+		// the metrics are not queried again before the interval elapsed
+		phase, _ = manager.analyzeSync(newPolicy("10m", "0.05"), state)
+		assert.Equal(t, synccommon.OperationRunning, phase)
+		assert.Equal(t, 1, queries)
+	})
+
+	t.Run("Succeeded", func(t *testing.T) {
+		manager := newManager(server.URL)
+		state := &v1alpha1.OperationState{Analysis: &v1alpha1.SyncAnalysisStatus{
+			Phase:     synccommon.OperationRunning,
+			StartedAt: metav1.NewTime(time.Now().Add(-11 * time.Minute)),
+			CheckedAt: &metav1.Time{Time: time.Now().Add(-2 * time.Minute)},
+		}}
+		phase, message := manager.analyzeSync(newPolicy("10m", "0.05"), state)
+		assert.Equal(t, synccommon.OperationSucceeded, phase)
+		assert.Equal(t, "all metrics are within their range", message)
+		assert.Equal(t, synccommon.OperationSucceeded, state.Analysis.Phase)
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		manager := newManager(server.URL)
+		state := &v1alpha1.OperationState{}
+		phase, message := manager.analyzeSync(newPolicy("10m", "0.01"), state)
+		assert.Equal(t, synccommon.OperationFailed, phase)
+		assert.Equal(t, "metric errors is 0.02, above the maximum of 0.01", message)
+
+		// the result of a completed analysis is kept
+		phase, _ = manager.analyzeSync(newPolicy("10m", "0.05"), state)
+		assert.Equal(t, synccommon.OperationFailed, phase)
+	})
+
+	t.Run("NoAddress", func(t *testing.T) {
+		manager := newManager("")
+		state := &v1alpha1.OperationState{}
+		phase, message := manager.analyzeSync(newPolicy("10m", "0.05"), state)
+		assert.Equal(t, synccommon.OperationFailed, phase)
+		assert.Equal(t, "no analysis address is configured in the argocd-cm ConfigMap", message)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"reflect"
 	"runtime/debug"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -60,6 +61,7 @@ import (
 	applog "github.com/argoproj/argo-cd/v3/util/app/log"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	argodiff "github.com/argoproj/argo-cd/v3/util/argo/diff"
+	"github.com/argoproj/argo-cd/v3/util/argo/drift"
 	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
 	"github.com/argoproj/argo-cd/v3/util/env"
 	"github.com/argoproj/argo-cd/v3/util/stats"
@@ -81,6 +83,13 @@ const (
 	orphanedIndex = "orphaned"
 )
 
+var (
+	// driftAnalysisEnabled enables tracking the fields which keep differing after successful syncs
+	driftAnalysisEnabled = env.ParseBoolFromEnv("ARGOCD_CONTROLLER_DRIFT_ANALYSIS_ENABLED", false)
+	// driftAnalysisFlushInterval is the interval at which the tracked fields are persisted in the cache
+	driftAnalysisFlushInterval = env.ParseDurationFromEnv("ARGOCD_CONTROLLER_DRIFT_ANALYSIS_FLUSH_INTERVAL", time.Minute, time.Second, 24*time.Hour)
+)
+
 type CompareWith int
 
 const (
@@ -141,6 +150,7 @@ type ApplicationController struct {
 	projByNameCache               sync.Map
 	applicationNamespaces         []string
 	ignoreNormalizerOpts          normalizers.IgnoreNormalizerOpts
+	driftAnalyzer                 *drift.Analyzer
 
 	// dynamicClusterDistributionEnabled if disabled deploymentInformer is never initialized
 	dynamicClusterDistributionEnabled bool
@@ -220,6 +230,9 @@ func NewApplicationController(
 		ignoreNormalizerOpts:              ignoreNormalizerOpts,
 		metricsClusterLabels:              metricsClusterLabels,
 	}
+	if driftAnalysisEnabled {
+		ctrl.driftAnalyzer = drift.NewAnalyzer()
+	}
 	if hydratorEnabled {
 		ctrl.hydrator = hydrator.NewHydrator(&ctrl, appResyncPeriod, commitClientset, repoClientset, db)
 	}
@@ -511,6 +524,10 @@ func (ctrl *ApplicationController) setAppManagedResources(destCluster *appv1.Clu
 	if err != nil {
 		return nil, fmt.Errorf("error getting managed resources: %w", err)
 	}
+	if ctrl.driftAnalyzer != nil && isSyncedToComparedRevision(a, comparisonResult.syncStatus) {
+		ctrl.driftAnalyzer.Observe(a.QualifiedName(), managedResources)
+		ts.AddCheckpoint("observe_drift_ms")
+	}
 	tree, err := ctrl.getResourceTree(destCluster, a, managedResources)
 	ts.AddCheckpoint("get_resource_tree_ms")
 	if err != nil {
@@ -877,12 +894,32 @@ func (ctrl *ApplicationController) hideSecretData(destCluster *appv1.Cluster, ap
 		item.PredictedLiveState = string(resDiff.PredictedLive)
 		item.NormalizedLiveState = string(resDiff.NormalizedLive)
 		item.Modified = resDiff.Modified
+		if item.Modified && comparisonResult.diffConfig != nil {
+			fieldOwners, err := argodiff.FieldOwners(live, &resDiff, comparisonResult.diffConfig.GVKParser())
+			if err != nil {
+				log.WithFields(applog.GetAppLogFields(app)).Warnf("Failed to get field owners of %s: %v", kube.GetResourceKey(live), err)
+			}
+			item.FieldOwners = fieldOwners
+		}
 
 		items[i] = &item
 	}
 	return items, nil
 }
 
+// isSyncedToComparedRevision returns whether the last sync of the app succeeded with the revisions the app is compared
+// to, in which case the remaining differences are drift rather than changes which are not synced yet
+func isSyncedToComparedRevision(app *appv1.Application, syncStatus *appv1.SyncStatus) bool {
+	op := app.Status.OperationState
+	if op == nil || op.Phase != synccommon.OperationSucceeded || op.SyncResult == nil || syncStatus == nil {
+		return false
+	}
+	if app.Spec.HasMultipleSources() {
+		return len(syncStatus.Revisions) > 0 && slices.Equal(op.SyncResult.Revisions, syncStatus.Revisions)
+	}
+	return syncStatus.Revision != "" && op.SyncResult.Revision == syncStatus.Revision
+}
+
 // Run starts the Application CRD controller.
 func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int, operationProcessors int) {
 	defer runtime.HandleCrash()
@@ -951,6 +988,15 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 		}
 	}, time.Second, ctx.Done())
 
+	if ctrl.driftAnalyzer != nil {
+		go wait.Until(func() {
+			// the records are persisted per shard, so that the shards do not overwrite each other's records
+			if err := ctrl.driftAnalyzer.Flush(ctrl.cache, max(ctrl.clusterSharding.GetShard(), 0)); err != nil {
+				log.Warnf("Failed to persist drift analysis: %v", err)
+			}
+		}, driftAnalysisFlushInterval, ctx.Done())
+	}
+
 	if ctrl.hydrator != nil {
 		go wait.Until(func() {
 			for ctrl.processAppHydrateQueueItem() {
@@ -1207,6 +1253,7 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 	if err != nil {
 		logCtx.WithError(err).Warn("Unable to get destination cluster")
 		app.UnSetCascadedDeletion()
+		app.UnSetPreDeleteFinalizer()
 		app.UnSetPostDeleteFinalizerAll()
 		if err := ctrl.updateFinalizers(app); err != nil {
 			return err
@@ -1225,6 +1272,23 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 		return fmt.Errorf("cannot apply impersonation: %w", err)
 	}
 
+	if app.HasPreDeleteFinalizer() {
+		objsMap, err := ctrl.getPermittedAppLiveObjects(destCluster, app, proj, projectClusters)
+		if err != nil {
+			return err
+		}
+
+		done, err := ctrl.executePreDeleteHooks(app, proj, objsMap, config, logCtx)
+		if err != nil {
+			return err
+		}
+		if !done {
+			return nil
+		}
+		app.UnSetPreDeleteFinalizer()
+		return ctrl.updateFinalizers(app)
+	}
+
 	if app.CascadedDeletion() {
 		deletionApproved := app.IsDeletionConfirmed(app.DeletionTimestamp.Time)
 
@@ -1443,6 +1507,7 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 			state.Message = fmt.Sprintf("Retrying operation%s. Attempt #%d", extraMsg, state.RetryCount)
 			state.FinishedAt = nil
 			state.SyncResult = nil
+			state.Analysis = nil
 			ctrl.setOperationState(app, state)
 			logCtx.Infof("Retrying operation%s. Attempt #%d", extraMsg, state.RetryCount)
 		default:
@@ -1471,17 +1536,32 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	ts.AddCheckpoint("sync_app_state_ms")
 
 	switch state.Phase {
-	case synccommon.OperationRunning:
+	case synccommon.OperationRunning, synccommon.OperationWaitingForApproval:
+		if state.Analysis != nil && !state.Analysis.Phase.Completed() && app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.Analysis != nil {
+			// The metrics are analyzed again once the interval elapsed
+			if interval, err := app.Spec.SyncPolicy.Analysis.GetInterval(); err == nil {
+				ctrl.appOperationQueue.AddAfter(ctrl.toAppKey(app.QualifiedName()), interval)
+			}
+		}
 		// It's possible for an app to be terminated while we were operating on it. We do not want
 		// to clobber the Terminated state with Running. Get the latest app state to check for this.
 		freshApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(context.Background(), app.Name, metav1.GetOptions{})
-		if err == nil {
-			if freshApp.Status.OperationState != nil && freshApp.Status.OperationState.Phase == synccommon.OperationTerminating {
+		if err == nil && freshApp.Status.OperationState != nil {
+			freshState := freshApp.Status.OperationState
+			switch {
+			case freshState.Phase == synccommon.OperationTerminating:
 				state.Phase = synccommon.OperationTerminating
 				state.Message = "operation is terminating"
 				// after this, we will get requeued to the workqueue, but next time the
 				// SyncAppState will operate in a Terminating phase, allowing the worker to perform
 				// cleanup (e.g. delete jobs, workflows, etc...)
+			case state.PendingApproval != nil && freshState.IsSyncWaveApproved(state.PendingApproval.Phase, int(state.PendingApproval.Wave)):
+				// The wave was approved while we were operating on it. Keep the approval, the wave is
+				// synced the next time the operation is processed.
+				state.Phase = synccommon.OperationRunning
+				state.Message = freshState.Message
+				state.PendingApproval = nil
+				state.Approvals = freshState.Approvals
 			}
 		}
 	case synccommon.OperationFailed, synccommon.OperationError:
@@ -1510,6 +1590,9 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 
 	ctrl.setOperationState(app, state)
 	ts.AddCheckpoint("final_set_operation_state")
+	if state.Phase.Completed() && len(app.Status.OperationQueue) > 0 {
+		ctrl.startQueuedOperation(app)
+	}
 	if state.Phase.Completed() && (app.Operation.Sync != nil && !app.Operation.Sync.DryRun) {
 		// if we just completed an operation, force a refresh so that UI will report up-to-date
 		// sync/health information
@@ -1523,6 +1606,42 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	ts.AddCheckpoint("request_app_refresh_ms")
 }
 
+// requestDependentAppsRefresh requests a refresh of the applications which depend on the application, so that they are
+// synced as soon as their dependencies are met.
+func (ctrl *ApplicationController) requestDependentAppsRefresh(app *appv1.Application) {
+	apps, err := ctrl.appLister.List(labels.Everything())
+	if err != nil {
+		log.WithFields(applog.GetAppLogFields(app)).WithError(err).Warn("Failed to list dependent applications")
+		return
+	}
+	for _, dependent := range apps {
+		for _, dep := range dependent.Spec.DependsOn {
+			if dep.Name == app.Name && dep.GetNamespace(dependent.Namespace) == app.Namespace {
+				ctrl.requestAppRefresh(dependent.QualifiedName(), CompareWithRecent.Pointer(), nil)
+				break
+			}
+		}
+	}
+}
+
+// startQueuedOperation starts the first operation of the operation queue of the application once the operation in
+// progress completed.
+func (ctrl *ApplicationController) startQueuedOperation(app *appv1.Application) {
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+	updated, op, err := argo.StartQueuedAppOperation(ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace), app.Name)
+	if err != nil {
+		logCtx.WithError(err).Error("Failed to start queued operation")
+		return
+	}
+	if op == nil {
+		return
+	}
+	ctrl.writeBackToInformer(updated)
+	message := fmt.Sprintf("started queued operation %s", op.ID)
+	logCtx.Info(message)
+	ctrl.logAppEvent(context.TODO(), updated, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: corev1.EventTypeNormal}, message)
+}
+
 func (ctrl *ApplicationController) setOperationState(app *appv1.Application, state *appv1.OperationState) {
 	logCtx := log.WithFields(applog.GetAppLogFields(app))
 	if state.Phase == "" {
@@ -1532,6 +1651,11 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 	if state.Phase.Completed() {
 		now := metav1.Now()
 		state.FinishedAt = &now
+		// Only operations which completed while the rollback policy was enabled are rolled back automatically
+		state.Rollback = nil
+		if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.Rollback != nil {
+			state.Rollback = &appv1.OperationRollbackStatus{}
+		}
 	}
 	patch := map[string]any{
 		"status": map[string]any{
@@ -1559,6 +1683,13 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 			return
 		}
 	}
+	if app.Status.OperationState != nil && app.Status.OperationState.Rollback != nil && state.Rollback == nil {
+		patchJSON, err = jsonpatch.MergeMergePatches(patchJSON, []byte(`{"status": {"operationState": {"rollback": null}}}`))
+		if err != nil {
+			logCtx.WithError(err).Error("error merging operation state patch")
+			return
+		}
+	}
 
 	kube.RetryUntilSucceed(context.Background(), updateOperationStateTimeout, "Update application operation state", logutils.NewLogrusLogger(logutils.NewWithCurrentConfig()), func() error {
 		_, err := ctrl.PatchAppWithWriteBack(context.Background(), app.Name, app.Namespace, types.MergePatchType, patchJSON, metav1.PatchOptions{})
@@ -1663,6 +1794,11 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		return processNext
 	}
 	origApp = origApp.DeepCopy()
+	if origApp.Operation == nil && len(origApp.Status.OperationQueue) > 0 {
+		// Queued operations are started when the operation in progress completes. Start them here as well, in case
+		// that did not happen, e.g. because the controller restarted or starting the operation failed.
+		ctrl.startQueuedOperation(origApp)
+	}
 	needRefresh, refreshType, comparisonLevel := ctrl.needRefreshAppStatus(origApp, ctrl.statusRefreshTimeout, ctrl.statusHardRefreshTimeout)
 
 	if !needRefresh {
@@ -1802,8 +1938,39 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		app.Status.Summary = tree.GetSummary(app)
 	}
 
+	setRollbackHealthyAt(app, compareResult.healthStatus)
 	canSync, _ := project.Spec.SyncWindows.Matches(app).CanSync(false)
+	var rollbackCond *appv1.ApplicationCondition
 	if canSync {
+		rollbackCond, setOpDuration = ctrl.autoRollback(app, compareResult.healthStatus)
+	}
+	rolledBack := rollbackCond != nil
+	if !rolledBack {
+		rollbackCond = getRollbackCondition(app)
+	}
+	if rollbackCond != nil {
+		app.Status.SetConditions(
+			[]appv1.ApplicationCondition{*rollbackCond},
+			map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionRollbackWarning: true},
+		)
+	} else {
+		app.Status.SetConditions(
+			[]appv1.ApplicationCondition{},
+			map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionRollbackWarning: true},
+		)
+	}
+	ts.AddCheckpoint("auto_rollback_ms")
+
+	var dependencyConditions []appv1.ApplicationCondition
+	if unmetDependencies := argo.GetUnmetDependencies(app, ctrl.appLister); len(unmetDependencies) > 0 {
+		dependencyConditions = append(dependencyConditions, appv1.ApplicationCondition{
+			Type:    appv1.ApplicationConditionUnmetDependencyWarning,
+			Message: "Waiting for dependencies: " + strings.Join(unmetDependencies, "; "),
+		})
+	}
+	app.Status.SetConditions(dependencyConditions, map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionUnmetDependencyWarning: true})
+
+	if canSync && !rolledBack {
 		syncErrCond, opDuration := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources, compareResult.revisionsMayHaveChanges)
 		setOpDuration = opDuration
 		if syncErrCond != nil {
@@ -1852,6 +2019,17 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 			logCtx.WithError(err).Error("Failed to update finalizers")
 		}
 	}
+	if compareResult.hasPreDeleteHooks != app.HasPreDeleteFinalizer() && app.GetDeletionTimestamp() == nil {
+		if compareResult.hasPreDeleteHooks {
+			app.SetPreDeleteFinalizer()
+		} else {
+			app.UnSetPreDeleteFinalizer()
+		}
+
+		if err := ctrl.updateFinalizers(app); err != nil {
+			logCtx.WithError(err).Error("Failed to update finalizers")
+		}
+	}
 	ts.AddCheckpoint("process_finalizers_ms")
 	return processNext
 }
@@ -2118,10 +2296,18 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		logCtx.Infof("Skipping auto-sync: another operation is in progress")
 		return nil, 0
 	}
+	if len(app.Status.OperationQueue) > 0 {
+		logCtx.Infof("Skipping auto-sync: queued operations are pending")
+		return nil, 0
+	}
 	if app.DeletionTimestamp != nil && !app.DeletionTimestamp.IsZero() {
 		logCtx.Infof("Skipping auto-sync: deletion in progress")
 		return nil, 0
 	}
+	if unmetDependencies := argo.GetUnmetDependencies(app, ctrl.appLister); len(unmetDependencies) > 0 {
+		logCtx.Infof("Skipping auto-sync: dependencies are not met: %s", strings.Join(unmetDependencies, "; "))
+		return nil, 0
+	}
 
 	// Only perform auto-sync if we detect OutOfSync status. This is to prevent us from attempting
 	// a sync when application is already in a Synced or Unknown state
@@ -2151,6 +2337,13 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		desiredRevisions = syncStatus.Revisions
 	}
 
+	// Do not sync again to the revisions which were just rolled back, since the sync would most likely fail again
+	if opState := app.Status.OperationState; opState != nil && opState.Operation.Sync != nil && opState.Operation.Sync.AutoRollback != nil &&
+		reflect.DeepEqual(opState.Operation.Sync.AutoRollback.Revisions, desiredRevisions) {
+		logCtx.Infof("Skipping auto-sync: sync to %s was rolled back", desiredRevisions)
+		return nil, 0
+	}
+
 	op := appv1.Operation{
 		Sync: &appv1.SyncOperation{
 			Source:      source,
@@ -2253,6 +2446,148 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 	return nil, setOpTime
 }
 
+// autoRollback rolls back the most recent sync to the last successful sync in the application history, if the sync
+// failed or if the application did not become Healthy within the health timeout of the rollback policy. It returns the
+// condition recording why the sync was rolled back if a rollback was initiated.
+func (ctrl *ApplicationController) autoRollback(app *appv1.Application, healthStatus health.HealthStatusCode) (*appv1.ApplicationCondition, time.Duration) {
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+	if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Rollback == nil {
+		return nil, 0
+	}
+	if app.Operation != nil || (app.DeletionTimestamp != nil && !app.DeletionTimestamp.IsZero()) {
+		return nil, 0
+	}
+	opState := app.Status.OperationState
+	if opState == nil || opState.Operation.Sync == nil || !opState.Phase.Completed() || opState.FinishedAt == nil || opState.SyncResult == nil {
+		return nil, 0
+	}
+	// the rollback policy was not enabled when the operation completed
+	if opState.Rollback == nil {
+		return nil, 0
+	}
+	// only full syncs are rolled back, and a rollback is never rolled back itself
+	syncOp := opState.Operation.Sync
+	if syncOp.DryRun || len(syncOp.Resources) > 0 || syncOp.AutoRollback != nil {
+		return nil, 0
+	}
+
+	var reason string
+	if opState.Phase.Successful() {
+		timeout, err := app.Spec.SyncPolicy.Rollback.GetHealthTimeout()
+		if err != nil {
+			logCtx.WithError(err).Warn("Skipping auto-rollback: invalid health timeout")
+			return nil, 0
+		}
+		// the sync is not rolled back if the application was Healthy at any point since it completed
+		if timeout == 0 || healthStatus == health.HealthStatusHealthy || wasHealthyAfterOperation(opState) {
+			return nil, 0
+		}
+		deadline := opState.FinishedAt.Add(timeout)
+		if remainingTime := time.Until(deadline); remainingTime > 0 {
+			ctrl.requestAppRefresh(app.QualifiedName(), CompareWithRecent.Pointer(), &remainingTime)
+			return nil, 0
+		}
+		reason = fmt.Sprintf("application did not become Healthy within %s after the sync and is %s", timeout, healthStatus)
+	} else {
+		reason = fmt.Sprintf("sync %s: %s", strings.ToLower(string(opState.Phase)), opState.Message)
+	}
+
+	var target *appv1.RevisionHistory
+	for i := len(app.Status.History) - 1; i >= 0; i-- {
+		// skip the history entry of the sync which is rolled back
+		if deployStartedAt := app.Status.History[i].DeployStartedAt; deployStartedAt != nil && deployStartedAt.Equal(&opState.StartedAt) {
+			continue
+		}
+		target = &app.Status.History[i]
+		break
+	}
+	if target == nil || (target.Source.IsZero() && target.Sources.IsZero()) {
+		logCtx.Warnf("Skipping auto-rollback: no successful sync to roll back to (%s)", reason)
+		return nil, 0
+	}
+
+	rolledBackRevisions := []string{opState.SyncResult.Revision}
+	if app.Spec.HasMultipleSources() {
+		rolledBackRevisions = opState.SyncResult.Revisions
+	}
+	op := appv1.Operation{
+		Sync: &appv1.SyncOperation{
+			Revision:     target.Revision,
+			Revisions:    target.Revisions,
+			Prune:        app.Spec.SyncPolicy.Automated != nil && app.Spec.SyncPolicy.Automated.Prune,
+			SyncOptions:  app.Spec.SyncPolicy.SyncOptions,
+			SyncStrategy: &appv1.SyncStrategy{Apply: &appv1.SyncStrategyApply{}},
+			Source:       &target.Source,
+			Sources:      target.Sources,
+			AutoRollback: &appv1.AutoRollback{
+				Revisions: rolledBackRevisions,
+				ID:        target.ID,
+				Reason:    reason,
+			},
+		},
+		InitiatedBy: appv1.OperationInitiator{Automated: true},
+	}
+	if app.Spec.SyncPolicy.Retry != nil {
+		op.Retry = *app.Spec.SyncPolicy.Retry
+	}
+
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	start := time.Now()
+	updatedApp, err := argo.SetAppOperation(appIf, app.Name, &op)
+	setOpTime := time.Since(start)
+	if err != nil {
+		if stderrors.Is(err, argo.ErrAnotherOperationInProgress) {
+			logCtx.WithError(err).Warnf("Failed to initiate auto-rollback to %d", target.ID)
+			return nil, 0
+		}
+		logCtx.WithError(err).Errorf("Failed to initiate auto-rollback to %d", target.ID)
+		return nil, setOpTime
+	}
+	ctrl.writeBackToInformer(updatedApp)
+
+	message := fmt.Sprintf("Initiated automated rollback of %s to %d: %s", rolledBackRevisions, target.ID, reason)
+	ctrl.logAppEvent(context.TODO(), app, argo.EventInfo{Reason: argo.EventReasonOperationRolledBack, Type: corev1.EventTypeWarning}, message)
+	logCtx.Info(message)
+	return newRollbackCondition(op.Sync.AutoRollback), setOpTime
+}
+
+// setRollbackHealthyAt records the time the application was first Healthy after the most recent operation completed,
+// so that a sync which became Healthy within the health timeout of the rollback policy is not rolled back if it becomes
+// unhealthy later.
+func setRollbackHealthyAt(app *appv1.Application, healthStatus health.HealthStatusCode) {
+	opState := app.Status.OperationState
+	if healthStatus != health.HealthStatusHealthy || app.Operation != nil || opState == nil || opState.Rollback == nil || !opState.Phase.Completed() || opState.FinishedAt == nil {
+		return
+	}
+	if wasHealthyAfterOperation(opState) {
+		return
+	}
+	now := metav1.Now()
+	opState.Rollback.HealthyAt = &now
+}
+
+// wasHealthyAfterOperation returns whether the application was Healthy at any point since the operation completed
+func wasHealthyAfterOperation(opState *appv1.OperationState) bool {
+	return opState.Rollback != nil && opState.Rollback.HealthyAt != nil && opState.FinishedAt != nil && !opState.Rollback.HealthyAt.Before(opState.FinishedAt)
+}
+
+// getRollbackCondition returns the condition recording why the sync was rolled back, if the rollback is the most
+// recent operation
+func getRollbackCondition(app *appv1.Application) *appv1.ApplicationCondition {
+	opState := app.Status.OperationState
+	if opState == nil || opState.Operation.Sync == nil || opState.Operation.Sync.AutoRollback == nil {
+		return nil
+	}
+	return newRollbackCondition(opState.Operation.Sync.AutoRollback)
+}
+
+func newRollbackCondition(rollback *appv1.AutoRollback) *appv1.ApplicationCondition {
+	return &appv1.ApplicationCondition{
+		Type:    appv1.ApplicationConditionRollbackWarning,
+		Message: fmt.Sprintf("Rolled back sync to %s to history entry %d: %s", rollback.Revisions, rollback.ID, rollback.Reason),
+	}
+}
+
 // alreadyAttemptedSync returns whether the most recently synced revision(s) exactly match the given desiredRevisions
 // and for the same application source. If the revision(s) have changed or the Application source configuration has been updated,
 // it will return false, indicating that a new sync should be attempted.
@@ -2498,6 +2833,9 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				}
 
 				ctrl.requestAppRefresh(newApp.QualifiedName(), compareWith, delay)
+				if oldOK && newOK && argo.IsDependencyMet(oldApp) != argo.IsDependencyMet(newApp) {
+					ctrl.requestDependentAppsRefresh(newApp)
+				}
 				if !newOK || (delay != nil && *delay != time.Duration(0)) {
 					ctrl.appOperationQueue.AddRateLimited(key)
 				}
@@ -407,6 +407,44 @@ metadata:
 data:
 `
 
+var fakePreDeleteHook = `
+{
+  "apiVersion": "batch/v1",
+  "kind": "Job",
+  "metadata": {
+    "name": "pre-delete-hook",
+    "namespace": "default",
+    "labels": {
+      "app.kubernetes.io/instance": "my-app"
+    },
+    "annotations": {
+      "argocd.argoproj.io/hook": "PreDelete"
+    }
+  },
+  "spec": {
+    "template": {
+      "metadata": {
+        "name": "pre-delete-hook"
+      },
+      "spec": {
+        "containers": [
+          {
+            "name": "pre-delete-hook",
+            "image": "busybox",
+            "command": [
+              "/bin/sh",
+              "-c",
+              "sleep 5 && echo hello from the pre-delete-hook job"
+            ]
+          }
+        ],
+        "restartPolicy": "Never"
+      }
+    }
+  }
+}
+`
+
 var fakePostDeleteHook = `
 {
   "apiVersion": "batch/v1",
@@ -557,6 +595,15 @@ func newFakeCM() map[string]any {
 	return cm
 }
 
+func newFakePreDeleteHook() map[string]any {
+	var hook map[string]any
+	err := yaml.Unmarshal([]byte(fakePreDeleteHook), &hook)
+	if err != nil {
+		panic(err)
+	}
+	return hook
+}
+
 func newFakePostDeleteHook() map[string]any {
 	var hook map[string]any
 	err := yaml.Unmarshal([]byte(fakePostDeleteHook), &hook)
@@ -871,6 +918,274 @@ func TestAutoSyncIndicateError(t *testing.T) {
 	assert.Nil(t, app.Operation)
 }
 
+func TestAutoRollback(t *testing.T) {
+	newRollbackApp := func(phase synccommon.OperationPhase, finishedAt time.Time) *v1alpha1.Application {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Rollback = &v1alpha1.SyncPolicyRollback{HealthTimeout: "5m"}
+		startedAt := metav1.NewTime(finishedAt.Add(-time.Minute))
+		app.Status.OperationState.Phase = phase
+		app.Status.OperationState.Message = "one or more objects failed to apply"
+		app.Status.OperationState.StartedAt = startedAt
+		app.Status.OperationState.FinishedAt = ptr.To(metav1.NewTime(finishedAt))
+		app.Status.OperationState.Rollback = &v1alpha1.OperationRollbackStatus{}
+		app.Status.History = v1alpha1.RevisionHistories{{
+			ID:              1,
+			Revision:        "cccccccccccccccccccccccccccccccccccccccc",
+			Source:          *app.Spec.Source.DeepCopy(),
+			DeployStartedAt: ptr.To(metav1.NewTime(finishedAt.Add(-time.Hour))),
+		}}
+		if phase.Successful() {
+			app.Status.History = append(app.Status.History, v1alpha1.RevisionHistory{
+				ID:              2,
+				Revision:        "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+				Source:          *app.Spec.Source.DeepCopy(),
+				DeployStartedAt: &startedAt,
+			})
+		}
+		return app
+	}
+	assertRolledBack := func(t *testing.T, ctrl *ApplicationController, reason string) {
+		t.Helper()
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, app.Operation)
+		assert.Equal(t, "cccccccccccccccccccccccccccccccccccccccc", app.Operation.Sync.Revision)
+		assert.True(t, app.Operation.Sync.IsApplyStrategy())
+		assert.True(t, app.Operation.InitiatedBy.Automated)
+		assert.Equal(t, &v1alpha1.AutoRollback{
+			Revisions: []string{"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"},
+			ID:        1,
+			Reason:    reason,
+		}, app.Operation.Sync.AutoRollback)
+	}
+	assertNotRolledBack := func(t *testing.T, ctrl *ApplicationController) {
+		t.Helper()
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	}
+
+	t.Run("FailedSync", func(t *testing.T) {
+		app := newRollbackApp(synccommon.OperationFailed, time.Now())
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoRollback(app, health.HealthStatusDegraded)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionRollbackWarning, cond.Type)
+		assert.Equal(t, "Rolled back sync to [aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa] to history entry 1: sync failed: one or more objects failed to apply", cond.Message)
+		assertRolledBack(t, ctrl, "sync failed: one or more objects failed to apply")
+	})
+
+	t.Run("UnhealthyAfterHealthTimeout", func(t *testing.T) {
+		app := newRollbackApp(synccommon.OperationSucceeded, time.Now().Add(-10*time.Minute))
+		app.Status.Health = v1alpha1.AppHealthStatus{Status: health.HealthStatusDegraded, LastTransitionTime: ptr.To(metav1.NewTime(time.Now().Add(-9 * time.Minute)))}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoRollback(app, health.HealthStatusDegraded)
+		require.NotNil(t, cond)
+		assertRolledBack(t, ctrl, "application did not become Healthy within 5m0s after the sync and is Degraded")
+	})
+
+	t.Run("HealthyAfterHealthTimeout", func(t *testing.T) {
+		app := newRollbackApp(synccommon.OperationSucceeded, time.Now().Add(-10*time.Minute))
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoRollback(app, health.HealthStatusHealthy)
+		assert.Nil(t, cond)
+		assertNotRolledBack(t, ctrl)
+	})
+
+	t.Run("ProgressingAtHealthTimeoutThenDegraded", func(t *testing.T) {
+		app := newRollbackApp(synccommon.OperationSucceeded, time.Now().Add(-10*time.Minute))
+		app.Status.Health = v1alpha1.AppHealthStatus{Status: health.HealthStatusProgressing, LastTransitionTime: ptr.To(metav1.NewTime(time.Now().Add(-9 * time.Minute)))}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		// the reconcile on which the application becomes Degraded
+		cond, _ := ctrl.autoRollback(app, health.HealthStatusDegraded)
+		require.NotNil(t, cond)
+		assertRolledBack(t, ctrl, "application did not become Healthy within 5m0s after the sync and is Degraded")
+	})
+
+	t.Run("DegradedAfterHealthTimeoutLongAfterDeadline", func(t *testing.T) {
+		app := newRollbackApp(synccommon.OperationSucceeded, time.Now().Add(-time.Hour))
+		app.Status.Health = v1alpha1.AppHealthStatus{Status: health.HealthStatusDegraded, LastTransitionTime: ptr.To(metav1.NewTime(time.Now().Add(-time.Minute)))}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoRollback(app, health.HealthStatusDegraded)
+		require.NotNil(t, cond)
+		assertRolledBack(t, ctrl, "application did not become Healthy within 5m0s after the sync and is Degraded")
+	})
+
+	t.Run("HealthyAfterSyncThenDegraded", func(t *testing.T) {
+		app := newRollbackApp(synccommon.OperationSucceeded, time.Now().Add(-10*time.Minute))
+		app.Status.OperationState.Rollback.HealthyAt = ptr.To(metav1.NewTime(time.Now().Add(-8 * time.Minute)))
+		app.Status.Health = v1alpha1.AppHealthStatus{Status: health.HealthStatusDegraded, LastTransitionTime: ptr.To(metav1.NewTime(time.Now().Add(-time.Minute)))}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoRollback(app, health.HealthStatusDegraded)
+		assert.Nil(t, cond)
+		assertNotRolledBack(t, ctrl)
+	})
+
+	t.Run("RollbackEnabledAfterSync", func(t *testing.T) {
+		app := newRollbackApp(synccommon.OperationFailed, time.Now().Add(-30*24*time.Hour))
+		app.Status.OperationState.Rollback = nil
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoRollback(app, health.HealthStatusDegraded)
+		assert.Nil(t, cond)
+		assertNotRolledBack(t, ctrl)
+	})
+
+	t.Run("HealthTimeoutNotElapsed", func(t *testing.T) {
+		app := newRollbackApp(synccommon.OperationSucceeded, time.Now())
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoRollback(app, health.HealthStatusProgressing)
+		assert.Nil(t, cond)
+		assertNotRolledBack(t, ctrl)
+	})
+
+	t.Run("RollbackIsNotRolledBack", func(t *testing.T) {
+		app := newRollbackApp(synccommon.OperationFailed, time.Now())
+		app.Status.OperationState.Operation.Sync.AutoRollback = &v1alpha1.AutoRollback{ID: 1}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoRollback(app, health.HealthStatusDegraded)
+		assert.Nil(t, cond)
+		assertNotRolledBack(t, ctrl)
+	})
+
+	t.Run("NoSuccessfulSync", func(t *testing.T) {
+		app := newRollbackApp(synccommon.OperationFailed, time.Now())
+		app.Status.History = nil
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoRollback(app, health.HealthStatusDegraded)
+		assert.Nil(t, cond)
+		assertNotRolledBack(t, ctrl)
+	})
+
+	t.Run("RollbackDisabled", func(t *testing.T) {
+		app := newRollbackApp(synccommon.OperationFailed, time.Now())
+		app.Spec.SyncPolicy.Rollback = nil
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoRollback(app, health.HealthStatusDegraded)
+		assert.Nil(t, cond)
+		assertNotRolledBack(t, ctrl)
+	})
+}
+
+func TestSetRollbackHealthyAt(t *testing.T) {
+	newApp := func() *v1alpha1.Application {
+		app := newFakeApp()
+		app.Operation = nil
+		app.Status.OperationState.Phase = synccommon.OperationSucceeded
+		app.Status.OperationState.FinishedAt = ptr.To(metav1.NewTime(time.Now().Add(-time.Minute)))
+		app.Status.OperationState.Rollback = &v1alpha1.OperationRollbackStatus{}
+		return app
+	}
+
+	t.Run("Healthy", func(t *testing.T) {
+		app := newApp()
+		setRollbackHealthyAt(app, health.HealthStatusHealthy)
+		require.NotNil(t, app.Status.OperationState.Rollback.HealthyAt)
+		assert.False(t, app.Status.OperationState.Rollback.HealthyAt.Before(app.Status.OperationState.FinishedAt))
+	})
+	t.Run("AlreadyHealthy", func(t *testing.T) {
+		app := newApp()
+		healthyAt := metav1.NewTime(time.Now().Add(-30 * time.Second))
+		app.Status.OperationState.Rollback.HealthyAt = &healthyAt
+		setRollbackHealthyAt(app, health.HealthStatusHealthy)
+		assert.Equal(t, &healthyAt, app.Status.OperationState.Rollback.HealthyAt)
+	})
+	t.Run("HealthyBeforePreviousOperation", func(t *testing.T) {
+		app := newApp()
+		app.Status.OperationState.Rollback.HealthyAt = ptr.To(metav1.NewTime(time.Now().Add(-time.Hour)))
+		setRollbackHealthyAt(app, health.HealthStatusHealthy)
+		assert.False(t, app.Status.OperationState.Rollback.HealthyAt.Before(app.Status.OperationState.FinishedAt))
+	})
+	t.Run("Progressing", func(t *testing.T) {
+		app := newApp()
+		setRollbackHealthyAt(app, health.HealthStatusProgressing)
+		assert.Nil(t, app.Status.OperationState.Rollback.HealthyAt)
+	})
+	t.Run("RollbackDisabled", func(t *testing.T) {
+		app := newApp()
+		app.Status.OperationState.Rollback = nil
+		setRollbackHealthyAt(app, health.HealthStatusHealthy)
+		assert.Nil(t, app.Status.OperationState.Rollback)
+	})
+}
+
+func TestSetOperationStateRecordsRollbackPolicy(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.SyncPolicy.Rollback = &v1alpha1.SyncPolicyRollback{HealthTimeout: "5m"}
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+	state := app.Status.OperationState.DeepCopy()
+	state.Phase = synccommon.OperationSucceeded
+	ctrl.setOperationState(app, state)
+	updated, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, updated.Status.OperationState.Rollback)
+	assert.Nil(t, updated.Status.OperationState.Rollback.HealthyAt)
+
+	// the rollback state of the previous operation is removed if the policy is disabled
+	updated.Spec.SyncPolicy.Rollback = nil
+	state = updated.Status.OperationState.DeepCopy()
+	state.StartedAt = metav1.Now()
+	ctrl.setOperationState(updated, state)
+	updated, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, updated.Status.OperationState.Rollback)
+}
+
+func TestAutoSyncSkipsRolledBackRevision(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState.Operation.Sync.AutoRollback = &v1alpha1.AutoRollback{
+		Revisions: []string{"bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"},
+		ID:        1,
+	}
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+	assert.Nil(t, cond)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, app.Operation)
+}
+
+func TestAutoSyncDependencies(t *testing.T) {
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	resources := []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}
+	newApps := func(depSync v1alpha1.SyncStatusCode, depHealth health.HealthStatusCode) (*v1alpha1.Application, *v1alpha1.Application) {
+		dep := newFakeApp()
+		dep.Name = "crds"
+		dep.Status.Sync.Status = depSync
+		dep.Status.Health.Status = depHealth
+		app := newFakeApp()
+		app.Spec.DependsOn = []v1alpha1.ApplicationDependency{{Name: "crds"}}
+		return app, dep
+	}
+
+	t.Run("Unmet", func(t *testing.T) {
+		app, dep := newApps(v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusProgressing)
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, dep}}, nil)
+		cond, _ := ctrl.autoSync(app, &syncStatus, resources, true)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	})
+
+	t.Run("Met", func(t *testing.T) {
+		app, dep := newApps(v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, dep}}, nil)
+		cond, _ := ctrl.autoSync(app, &syncStatus, resources, true)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, app.Operation)
+	})
+}
+
 // TestAutoSyncParameterOverrides verifies we auto-sync if revision is same but parameter overrides are different
 func TestAutoSyncParameterOverrides(t *testing.T) {
 	t.Run("Single source", func(t *testing.T) {
@@ -1243,6 +1558,140 @@ func TestFinalizeAppDeletion(t *testing.T) {
 		// finalizer is not removed
 		assert.False(t, patched)
 	})
+
+	t.Run("PreDelete_HookIsCreated", func(t *testing.T) {
+		app := newFakeApp()
+		app.SetPreDeleteFinalizer()
+		app.SetCascadedDeletion(v1alpha1.ResourcesFinalizerName)
+		app.Spec.Destination.Namespace = test.FakeArgoCDNamespace
+		appObj := kube.MustToUnstructured(&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-svc", Namespace: test.FakeArgoCDNamespace},
+		})
+		ctrl := newFakeController(t.Context(), &fakeData{
+			manifestResponses: []*apiclient.ManifestResponse{{
+				Manifests: []string{fakePreDeleteHook},
+			}},
+			apps: []runtime.Object{app, &defaultProj},
+			managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+				kube.GetResourceKey(appObj): appObj,
+			},
+		}, nil)
+
+		patched := false
+		fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+		defaultReactor := fakeAppCs.ReactionChain[0]
+		fakeAppCs.ReactionChain = nil
+		fakeAppCs.AddReactor("get", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			return defaultReactor.React(action)
+		})
+		fakeAppCs.AddReactor("patch", "*", func(_ kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			patched = true
+			return true, &v1alpha1.Application{}, nil
+		})
+		err := ctrl.finalizeApplicationDeletion(app, func(_ string) ([]*v1alpha1.Cluster, error) {
+			return []*v1alpha1.Cluster{}, nil
+		})
+		require.NoError(t, err)
+		// finalizer is not deleted
+		assert.False(t, patched)
+		// pre-delete hook is created before any resource is deleted
+		require.Len(t, ctrl.kubectl.(*MockKubectl).CreatedResources, 1)
+		require.Equal(t, "pre-delete-hook", ctrl.kubectl.(*MockKubectl).CreatedResources[0].GetName())
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+	})
+
+	t.Run("PreDelete_HookIsExecuted", func(t *testing.T) {
+		app := newFakeApp()
+		app.SetPreDeleteFinalizer()
+		app.SetCascadedDeletion(v1alpha1.ResourcesFinalizerName)
+		app.Spec.Destination.Namespace = test.FakeArgoCDNamespace
+		liveHook := &unstructured.Unstructured{Object: newFakePreDeleteHook()}
+		conditions := []any{
+			map[string]any{
+				"type":   "Complete",
+				"status": "True",
+			},
+		}
+		require.NoError(t, unstructured.SetNestedField(liveHook.Object, conditions, "status", "conditions"))
+		ctrl := newFakeController(t.Context(), &fakeData{
+			manifestResponses: []*apiclient.ManifestResponse{{
+				Manifests: []string{fakePreDeleteHook},
+			}},
+			apps: []runtime.Object{app, &defaultProj},
+			managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+				kube.GetResourceKey(liveHook): liveHook,
+			},
+		}, nil)
+
+		var patchedFinalizers []string
+		fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+		defaultReactor := fakeAppCs.ReactionChain[0]
+		fakeAppCs.ReactionChain = nil
+		fakeAppCs.AddReactor("get", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			return defaultReactor.React(action)
+		})
+		fakeAppCs.AddReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			var patch struct {
+				Metadata struct {
+					Finalizers []string `json:"finalizers"`
+				} `json:"metadata"`
+			}
+			require.NoError(t, json.Unmarshal(action.(kubetesting.PatchAction).GetPatch(), &patch))
+			patchedFinalizers = patch.Metadata.Finalizers
+			return true, &v1alpha1.Application{}, nil
+		})
+		err := ctrl.finalizeApplicationDeletion(app, func(_ string) ([]*v1alpha1.Cluster, error) {
+			return []*v1alpha1.Cluster{}, nil
+		})
+		require.NoError(t, err)
+		// only the pre-delete finalizer is removed, resources are deleted afterwards
+		assert.Equal(t, []string{v1alpha1.ResourcesFinalizerName}, patchedFinalizers)
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+	})
+
+	t.Run("PreDelete_HookFailed", func(t *testing.T) {
+		app := newFakeApp()
+		app.SetPreDeleteFinalizer()
+		app.SetCascadedDeletion(v1alpha1.ResourcesFinalizerName)
+		app.Spec.Destination.Namespace = test.FakeArgoCDNamespace
+		liveHook := &unstructured.Unstructured{Object: newFakePreDeleteHook()}
+		conditions := []any{
+			map[string]any{
+				"type":    "Failed",
+				"status":  "True",
+				"message": "Job has reached the specified backoff limit",
+			},
+		}
+		require.NoError(t, unstructured.SetNestedField(liveHook.Object, conditions, "status", "conditions"))
+		ctrl := newFakeController(t.Context(), &fakeData{
+			manifestResponses: []*apiclient.ManifestResponse{{
+				Manifests: []string{fakePreDeleteHook},
+			}},
+			apps: []runtime.Object{app, &defaultProj},
+			managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+				kube.GetResourceKey(liveHook): liveHook,
+			},
+		}, nil)
+
+		patched := false
+		fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+		defaultReactor := fakeAppCs.ReactionChain[0]
+		fakeAppCs.ReactionChain = nil
+		fakeAppCs.AddReactor("get", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			return defaultReactor.React(action)
+		})
+		fakeAppCs.AddReactor("patch", "*", func(_ kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			patched = true
+			return true, &v1alpha1.Application{}, nil
+		})
+		err := ctrl.finalizeApplicationDeletion(app, func(_ string) ([]*v1alpha1.Cluster, error) {
+			return []*v1alpha1.Cluster{}, nil
+		})
+		require.ErrorContains(t, err, "pre-delete hooks failed: Job/pre-delete-hook: Job has reached the specified backoff limit")
+		// deletion is blocked
+		assert.False(t, patched)
+		assert.Empty(t, ctrl.kubectl.(*MockKubectl).DeletedResources)
+	})
 }
 
 func TestFinalizeAppDeletionWithImpersonation(t *testing.T) {
@@ -2153,6 +2602,34 @@ func TestProjectErrorToCondition(t *testing.T) {
 	assert.Equal(t, v1alpha1.ApplicationConditionInvalidSpecError, updatedApp.Status.Conditions[0].Type)
 }
 
+func TestProcessAppRefreshQueueItem_StartsQueuedOperation(t *testing.T) {
+	app := newFakeApp()
+	app.Operation = nil
+	app.Status.OperationQueue = []v1alpha1.QueuedOperation{
+		{ID: "first", Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Revision: "bbb"}}},
+	}
+	ctrl := newFakeController(t.Context(), &fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}, nil)
+	key, _ := cache.MetaNamespaceKeyFunc(app)
+	ctrl.appRefreshQueue.AddRateLimited(key)
+
+	ctrl.processAppRefreshQueueItem()
+
+	updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, updatedApp.Operation)
+	assert.Equal(t, "bbb", updatedApp.Operation.Sync.Revision)
+	assert.Empty(t, updatedApp.Status.OperationQueue)
+}
+
 func TestFinalizeProjectDeletion_HasApplications(t *testing.T) {
 	app := newFakeApp()
 	proj := &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: test.FakeArgoCDNamespace}}
@@ -2777,6 +3254,40 @@ func TestAppStatusIsReplaced(t *testing.T) {
 	require.Nil(t, val)
 }
 
+func TestIsSyncedToComparedRevision(t *testing.T) {
+	app := newFakeApp()
+	revision := app.Status.OperationState.SyncResult.Revision
+
+	t.Run("synced to compared revision", func(t *testing.T) {
+		assert.True(t, isSyncedToComparedRevision(app, &v1alpha1.SyncStatus{Revision: revision}))
+	})
+
+	t.Run("synced to other revision", func(t *testing.T) {
+		assert.False(t, isSyncedToComparedRevision(app, &v1alpha1.SyncStatus{Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"}))
+	})
+
+	t.Run("failed sync", func(t *testing.T) {
+		app := app.DeepCopy()
+		app.Status.OperationState.Phase = synccommon.OperationFailed
+		assert.False(t, isSyncedToComparedRevision(app, &v1alpha1.SyncStatus{Revision: revision}))
+	})
+
+	t.Run("no operation state", func(t *testing.T) {
+		app := app.DeepCopy()
+		app.Status.OperationState = nil
+		assert.False(t, isSyncedToComparedRevision(app, &v1alpha1.SyncStatus{Revision: revision}))
+	})
+
+	t.Run("multi-source", func(t *testing.T) {
+		app := app.DeepCopy()
+		app.Spec.Sources = v1alpha1.ApplicationSources{*app.Spec.Source, *app.Spec.Source}
+		app.Spec.Source = nil
+		app.Status.OperationState.SyncResult.Revisions = []string{"a", "b"}
+		assert.True(t, isSyncedToComparedRevision(app, &v1alpha1.SyncStatus{Revisions: []string{"a", "b"}}))
+		assert.False(t, isSyncedToComparedRevision(app, &v1alpha1.SyncStatus{Revisions: []string{"a", "c"}}))
+	})
+}
+
 func TestAlreadyAttemptSync(t *testing.T) {
 	app := newFakeApp()
 	defaultRevision := app.Status.OperationState.SyncResult.Revision
@@ -75,6 +75,12 @@ const (
 	// EnvClusterCacheEventsProcessingInterval is the env variable to control the interval between processing events when BatchEventsProcessing is enabled
 	EnvClusterCacheEventsProcessingInterval = "ARGOCD_CLUSTER_CACHE_EVENTS_PROCESSING_INTERVAL"
 
+	// EnvClusterCacheSnapshotDir is the env variable that holds the directory the cluster cache snapshots are persisted to
+	EnvClusterCacheSnapshotDir = "ARGOCD_CLUSTER_CACHE_SNAPSHOT_DIR"
+
+	// EnvClusterCacheSnapshotInterval is the env variable to control the interval between cluster cache snapshots
+	EnvClusterCacheSnapshotInterval = "ARGOCD_CLUSTER_CACHE_SNAPSHOT_INTERVAL"
+
 	// AnnotationIgnoreResourceUpdates when set to true on an untracked resource,
 	// argo will apply `ignoreResourceUpdates` configuration on it.
 	AnnotationIgnoreResourceUpdates = "argocd.argoproj.io/ignore-resource-updates"
@@ -115,6 +121,12 @@ var (
 
 	// clusterCacheEventsProcessingInterval specifies the interval between processing events when BatchEventsProcessing is enabled
 	clusterCacheEventsProcessingInterval = 100 * time.Millisecond
+
+	// clusterCacheSnapshotDir specifies the directory the cluster cache snapshots are persisted to. Snapshots are disabled if empty
+	clusterCacheSnapshotDir = ""
+
+	// clusterCacheSnapshotInterval specifies the interval between cluster cache snapshots
+	clusterCacheSnapshotInterval = 5 * time.Minute
 )
 
 func init() {
@@ -128,6 +140,8 @@ func init() {
 	clusterCacheRetryUseBackoff = env.ParseBoolFromEnv(EnvClusterCacheRetryUseBackoff, false)
 	clusterCacheBatchEventsProcessing = env.ParseBoolFromEnv(EnvClusterCacheBatchEventsProcessing, true)
 	clusterCacheEventsProcessingInterval = env.ParseDurationFromEnv(EnvClusterCacheEventsProcessingInterval, clusterCacheEventsProcessingInterval, 0, math.MaxInt64)
+	clusterCacheSnapshotDir = env.StringFromEnv(EnvClusterCacheSnapshotDir, clusterCacheSnapshotDir)
+	clusterCacheSnapshotInterval = env.ParseDurationFromEnv(EnvClusterCacheSnapshotInterval, clusterCacheSnapshotInterval, time.Second, math.MaxInt64)
 }
 
 type LiveStateCache interface {
@@ -194,6 +208,10 @@ func NewLiveStateCache(
 	clusterSharding sharding.ClusterShardingCache,
 	resourceTracking argo.ResourceTracking,
 ) LiveStateCache {
+	var snapshotStore *clusterSnapshotStore
+	if clusterCacheSnapshotDir != "" {
+		snapshotStore = newClusterSnapshotStore(clusterCacheSnapshotDir)
+	}
 	return &liveStateCache{
 		appInformer:      appInformer,
 		db:               db,
@@ -203,6 +221,7 @@ func NewLiveStateCache(
 		metricsServer:    metricsServer,
 		clusterSharding:  clusterSharding,
 		resourceTracking: resourceTracking,
+		snapshotStore:    snapshotStore,
 	}
 }
 
@@ -227,6 +246,8 @@ type liveStateCache struct {
 	clusterSharding      sharding.ClusterShardingCache
 	resourceTracking     argo.ResourceTracking
 	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts
+	// snapshotStore persists the snapshots of the cluster caches, or is nil if snapshots are disabled
+	snapshotStore *clusterSnapshotStore
 
 	clusters      map[string]clustercache.ClusterCache
 	cacheSettings cacheSettings
@@ -573,6 +594,14 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 		clustercache.SetEventProcessingInterval(clusterCacheEventsProcessingInterval),
 	}
 
+	if c.snapshotStore != nil {
+		if snapshot, err := c.loadSnapshot(cluster.Server, cacheSettings, resourceCustomLabels); err != nil {
+			log.WithField("server", cluster.Server).Warnf("Failed to load cluster cache snapshot: %v", err)
+		} else if snapshot != nil {
+			clusterCacheOpts = append(clusterCacheOpts, clustercache.SetInitialSnapshot(snapshot))
+		}
+	}
+
 	clusterCache = clustercache.NewClusterCache(clusterCacheConfig, clusterCacheOpts...)
 
 	_ = clusterCache.OnResourceUpdated(func(newRes *clustercache.Resource, oldRes *clustercache.Resource, namespaceResources map[kube.ResourceKey]*clustercache.Resource) {
@@ -786,12 +815,18 @@ func (c *liveStateCache) Init() error {
 // Run watches for resource changes annotated with application label on all registered clusters and schedule corresponding app refresh.
 func (c *liveStateCache) Run(ctx context.Context) error {
 	go c.watchSettings(ctx)
+	if c.snapshotStore != nil {
+		go c.runSnapshots(ctx, clusterCacheSnapshotInterval)
+	}
 
 	kube.RetryUntilSucceed(ctx, clustercache.ClusterRetryTimeout, "watch clusters", logutils.NewLogrusLogger(logutils.NewWithCurrentConfig()), func() error {
 		return c.db.WatchClusters(ctx, c.handleAddEvent, c.handleModEvent, c.handleDeleteEvent)
 	})
 
 	<-ctx.Done()
+	if c.snapshotStore != nil {
+		c.saveSnapshots()
+	}
 	c.invalidate(c.cacheSettings)
 	return nil
 }
@@ -0,0 +1,233 @@
+package cache
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// clusterSnapshotStore persists the snapshots of the cluster caches to local disk, which allows restoring the caches
+// without listing all the resources of the clusters when the controller restarts.
+type clusterSnapshotStore struct {
+	dir string
+}
+
+// clusterSnapshotFile is the content of the snapshot file of a cluster
+type clusterSnapshotFile struct {
+	// Key identifies the controller version and the settings the resource information was populated with
+	Key              string
+	ServerVersion    string
+	Namespaces       []string
+	ClusterResources bool
+	Watches          []clustercache.WatchSnapshot
+	Resources        []resourceSnapshot
+}
+
+// resourceSnapshot is the snapshot of a cached resource with typed resource information
+type resourceSnapshot struct {
+	clustercache.ResourceSnapshot
+	Info         *ResourceInfo
+	ManifestHash string
+}
+
+func newClusterSnapshotStore(dir string) *clusterSnapshotStore {
+	return &clusterSnapshotStore{dir: dir}
+}
+
+// path returns the path of the snapshot file of the given cluster
+func (s *clusterSnapshotStore) path(server string) string {
+	hash := sha256.Sum256([]byte(server))
+	return filepath.Join(s.dir, hex.EncodeToString(hash[:])+".json.gz")
+}
+
+// load returns the snapshot of the given cluster, or nil if there is no snapshot which was taken with the given key
+func (s *clusterSnapshotStore) load(server string, key string) (*clustercache.ClusterSnapshot, error) {
+	f, err := os.Open(s.path(server))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error opening snapshot file: %w", err)
+	}
+	defer f.Close()
+
+	reader, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("error reading snapshot file: %w", err)
+	}
+	defer reader.Close()
+
+	var file clusterSnapshotFile
+	if err := json.NewDecoder(reader).Decode(&file); err != nil {
+		return nil, fmt.Errorf("error decoding snapshot file: %w", err)
+	}
+	if file.Key != key {
+		return nil, nil
+	}
+
+	snapshot := &clustercache.ClusterSnapshot{
+		ServerVersion:    file.ServerVersion,
+		Namespaces:       file.Namespaces,
+		ClusterResources: file.ClusterResources,
+		Watches:          file.Watches,
+		Resources:        make([]clustercache.ResourceSnapshot, len(file.Resources)),
+	}
+	for i, res := range file.Resources {
+		snapshot.Resources[i] = res.ResourceSnapshot
+		if res.Info != nil {
+			res.Info.manifestHash = res.ManifestHash
+			snapshot.Resources[i].Info = res.Info
+		}
+	}
+	return snapshot, nil
+}
+
+// save writes the snapshot of the given cluster. The snapshot file is replaced atomically so that a partially
+// written snapshot is never loaded.
+func (s *clusterSnapshotStore) save(server string, key string, snapshot *clustercache.ClusterSnapshot) error {
+	file := clusterSnapshotFile{
+		Key:              key,
+		ServerVersion:    snapshot.ServerVersion,
+		Namespaces:       snapshot.Namespaces,
+		ClusterResources: snapshot.ClusterResources,
+		Watches:          snapshot.Watches,
+		Resources:        make([]resourceSnapshot, len(snapshot.Resources)),
+	}
+	for i, res := range snapshot.Resources {
+		info, _ := res.Info.(*ResourceInfo)
+		res.Info = nil
+		file.Resources[i] = resourceSnapshot{ResourceSnapshot: res, Info: info}
+		if info != nil {
+			file.Resources[i].ManifestHash = info.manifestHash
+		}
+	}
+
+	tmp, err := os.CreateTemp(s.dir, "snapshot-*.tmp")
+	if err != nil {
+		return fmt.Errorf("error creating snapshot file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	writer := gzip.NewWriter(tmp)
+	if err := json.NewEncoder(writer).Encode(file); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("error encoding snapshot file: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("error writing snapshot file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("error writing snapshot file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path(server)); err != nil {
+		return fmt.Errorf("error replacing snapshot file: %w", err)
+	}
+	return nil
+}
+
+// getSnapshotKey returns the key of the cluster cache snapshots, which changes whenever the controller version or the
+// settings the resource information is populated with change
+func getSnapshotKey(cacheSettings cacheSettings, resourceCustomLabels []string) (string, error) {
+	data, err := json.Marshal(struct {
+		Version                      string
+		AppInstanceLabelKey          string
+		TrackingMethod               appv1.TrackingMethod
+		InstallationID               string
+		ResourceHealthOverride       any
+		ResourceOverrides            map[string]appv1.ResourceOverride
+		IgnoreResourceUpdatesEnabled bool
+		ResourceCustomLabels         []string
+	}{
+		Version:                      common.GetVersion().Version,
+		AppInstanceLabelKey:          cacheSettings.appInstanceLabelKey,
+		TrackingMethod:               cacheSettings.trackingMethod,
+		InstallationID:               cacheSettings.installationID,
+		ResourceHealthOverride:       cacheSettings.clusterSettings.ResourceHealthOverride,
+		ResourceOverrides:            cacheSettings.resourceOverrides,
+		IgnoreResourceUpdatesEnabled: cacheSettings.ignoreResourceUpdatesEnabled,
+		ResourceCustomLabels:         resourceCustomLabels,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error marshaling snapshot key: %w", err)
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// loadSnapshot returns the snapshot of the given cluster, or nil if there is no snapshot which matches the current
+// settings
+func (c *liveStateCache) loadSnapshot(server string, cacheSettings cacheSettings, resourceCustomLabels []string) (*clustercache.ClusterSnapshot, error) {
+	key, err := getSnapshotKey(cacheSettings, resourceCustomLabels)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := c.snapshotStore.load(server, key)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot != nil {
+		log.WithField("server", server).Infof("Loaded cluster cache snapshot of %d resources", len(snapshot.Resources))
+	}
+	return snapshot, nil
+}
+
+// runSnapshots periodically saves the snapshots of the synced cluster caches until the context is done
+func (c *liveStateCache) runSnapshots(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.saveSnapshots()
+		}
+	}
+}
+
+// saveSnapshots saves the snapshots of all synced cluster caches
+func (c *liveStateCache) saveSnapshots() {
+	c.lock.RLock()
+	cacheSettings := c.cacheSettings
+	clusters := make(map[string]clustercache.ClusterCache, len(c.clusters))
+	for server, cluster := range c.clusters {
+		clusters[server] = cluster
+	}
+	c.lock.RUnlock()
+
+	resourceCustomLabels, err := c.settingsMgr.GetResourceCustomLabels()
+	if err != nil {
+		log.Warnf("Failed to get custom labels, skipping cluster cache snapshots: %v", err)
+		return
+	}
+	key, err := getSnapshotKey(cacheSettings, resourceCustomLabels)
+	if err != nil {
+		log.Warnf("Failed to get cluster cache snapshot key: %v", err)
+		return
+	}
+	for server, cluster := range clusters {
+		snapshot := cluster.GetSnapshot()
+		if snapshot == nil {
+			continue
+		}
+		start := time.Now()
+		if err := c.snapshotStore.save(server, key, snapshot); err != nil {
+			log.WithField("server", server).Warnf("Failed to save cluster cache snapshot: %v", err)
+			continue
+		}
+		log.WithField("server", server).Debugf("Saved cluster cache snapshot of %d resources in %v", len(snapshot.Resources), time.Since(start))
+	}
+}
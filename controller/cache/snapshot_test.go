@@ -0,0 +1,71 @@
+package cache
+
+import (
+	"testing"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestClusterSnapshotStore(t *testing.T) {
+	store := newClusterSnapshotStore(t.TempDir())
+	manifest := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "guestbook", "namespace": "default"},
+		"spec":       map[string]any{"replicas": int64(2)},
+	}}
+	snapshot := &clustercache.ClusterSnapshot{
+		ServerVersion: "1.33",
+		Watches: []clustercache.WatchSnapshot{{
+			GroupKind:       schema.GroupKind{Group: "apps", Kind: "Deployment"},
+			ResourceVersion: "123",
+		}},
+		Resources: []clustercache.ResourceSnapshot{{
+			ResourceVersion: "100",
+			Ref:             corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "guestbook", UID: "1"},
+			Info: &ResourceInfo{
+				AppName:      "guestbook",
+				Health:       &health.HealthStatus{Status: health.HealthStatusHealthy},
+				manifestHash: "abc",
+			},
+			Resource: manifest,
+		}, {
+			ResourceVersion: "101",
+			Ref:             corev1.ObjectReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Namespace: "default", Name: "guestbook-1", UID: "2"},
+			OwnerRefs:       []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: "guestbook", UID: "1"}},
+		}},
+	}
+
+	t.Run("Missing", func(t *testing.T) {
+		loaded, err := store.load("https://kubernetes.default.svc", "key")
+		require.NoError(t, err)
+		assert.Nil(t, loaded)
+	})
+
+	require.NoError(t, store.save("https://kubernetes.default.svc", "key", snapshot))
+
+	t.Run("Loaded", func(t *testing.T) {
+		loaded, err := store.load("https://kubernetes.default.svc", "key")
+		require.NoError(t, err)
+		assert.Equal(t, snapshot, loaded)
+	})
+
+	t.Run("KeyChanged", func(t *testing.T) {
+		loaded, err := store.load("https://kubernetes.default.svc", "other-key")
+		require.NoError(t, err)
+		assert.Nil(t, loaded)
+	})
+
+	t.Run("OtherCluster", func(t *testing.T) {
+		loaded, err := store.load("https://other-cluster", "key")
+		require.NoError(t, err)
+		assert.Nil(t, loaded)
+	})
+}
@@ -2,15 +2,21 @@ package controller
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/sync/hook"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	log "github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-cd/v3/util/lua"
 
@@ -18,6 +24,11 @@ import (
 )
 
 var (
+	preDeleteHook  = "PreDelete"
+	preDeleteHooks = map[string]string{
+		"argocd.argoproj.io/hook": preDeleteHook,
+		"helm.sh/hook":            "pre-delete",
+	}
 	postDeleteHook  = "PostDelete"
 	postDeleteHooks = map[string]string{
 		"argocd.argoproj.io/hook": postDeleteHook,
@@ -25,15 +36,83 @@ var (
 	}
 )
 
+// syncPolicyHookTypes are the types of the hooks which can be declared in the sync policy of an application
+var syncPolicyHookTypes = map[common.HookType]bool{
+	common.HookTypePreSync:  true,
+	common.HookTypePostSync: true,
+	common.HookTypeSyncFail: true,
+}
+
+// renderSyncPolicyHooks renders the hooks declared in the sync policy of the application into Jobs, which are then
+// handled like the hooks of the source of the application
+func renderSyncPolicyHooks(app *v1alpha1.Application) ([]*unstructured.Unstructured, error) {
+	if app.Spec.SyncPolicy == nil {
+		return nil, nil
+	}
+	var hooks []*unstructured.Unstructured
+	for _, h := range app.Spec.SyncPolicy.Hooks {
+		switch {
+		case h.Name == "":
+			return nil, errors.New("sync hook name is required")
+		case !syncPolicyHookTypes[h.Type]:
+			return nil, fmt.Errorf("sync hook %q has unsupported type %q: must be one of PreSync, PostSync or SyncFail", h.Name, h.Type)
+		case h.Image == "":
+			return nil, fmt.Errorf("sync hook %q image is required", h.Name)
+		}
+		var env []corev1.EnvVar
+		for _, e := range h.Env {
+			env = append(env, corev1.EnvVar{Name: e.Name, Value: e.Value})
+		}
+		job := &batchv1.Job{
+			TypeMeta: metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        h.Name,
+				Namespace:   app.Spec.Destination.Namespace,
+				Annotations: map[string]string{common.AnnotationKeyHook: string(h.Type)},
+			},
+			Spec: batchv1.JobSpec{
+				BackoffLimit: ptr.To(int32(0)),
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						RestartPolicy:      corev1.RestartPolicyNever,
+						ServiceAccountName: h.ServiceAccount,
+						Containers: []corev1.Container{{
+							Name:    "hook",
+							Image:   h.Image,
+							Command: h.Command,
+							Args:    h.Args,
+							Env:     env,
+						}},
+					},
+				},
+			},
+		}
+		obj, err := kube.ToUnstructured(job)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render sync hook %q: %w", h.Name, err)
+		}
+		hooks = append(hooks, obj)
+	}
+	return hooks, nil
+}
+
 func isHook(obj *unstructured.Unstructured) bool {
-	return hook.IsHook(obj) || isPostDeleteHook(obj)
+	return hook.IsHook(obj) || isPreDeleteHook(obj) || isPostDeleteHook(obj)
+}
+
+func isPreDeleteHook(obj *unstructured.Unstructured) bool {
+	return hasHookAnnotation(obj, preDeleteHooks)
 }
 
 func isPostDeleteHook(obj *unstructured.Unstructured) bool {
+	return hasHookAnnotation(obj, postDeleteHooks)
+}
+
+func hasHookAnnotation(obj *unstructured.Unstructured, hookAnnotations map[string]string) bool {
 	if obj == nil || obj.GetAnnotations() == nil {
 		return false
 	}
-	for k, v := range postDeleteHooks {
+	for k, v := range hookAnnotations {
 		if val, ok := obj.GetAnnotations()[k]; ok && val == v {
 			return true
 		}
@@ -41,10 +120,31 @@ func isPostDeleteHook(obj *unstructured.Unstructured) bool {
 	return false
 }
 
+// executePreDeleteHooks creates the pre-delete hooks of the application and waits for them to complete. A failed
+// pre-delete hook returns an error, which blocks the deletion of the application.
+func (ctrl *ApplicationController) executePreDeleteHooks(app *v1alpha1.Application, proj *v1alpha1.AppProject, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, error) {
+	done, failed, err := ctrl.executeDeleteHooks(app, proj, liveObjs, config, logCtx, "pre-delete", isPreDeleteHook)
+	if err != nil {
+		return false, err
+	}
+	if len(failed) > 0 {
+		return false, fmt.Errorf("pre-delete hooks failed: %s", strings.Join(failed, "; "))
+	}
+	return done, nil
+}
+
 func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Application, proj *v1alpha1.AppProject, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, error) {
+	done, _, err := ctrl.executeDeleteHooks(app, proj, liveObjs, config, logCtx, "post-delete", isPostDeleteHook)
+	return done, err
+}
+
+// executeDeleteHooks creates the hooks of the application selected by isDeleteHook which are not running yet, and
+// waits for the running ones to complete. It returns whether all hooks are completed, and the messages of the completed
+// hooks which are degraded.
+func (ctrl *ApplicationController) executeDeleteHooks(app *v1alpha1.Application, proj *v1alpha1.AppProject, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry, hookName string, isDeleteHook func(obj *unstructured.Unstructured) bool) (bool, []string, error) {
 	appLabelKey, err := ctrl.settingsMgr.GetAppInstanceLabelKey()
 	if err != nil {
-		return false, err
+		return false, nil, err
 	}
 	var revisions []string
 	for _, src := range app.Spec.GetSources() {
@@ -53,11 +153,11 @@ func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Applicat
 
 	targets, _, _, err := ctrl.appStateManager.GetRepoObjs(context.Background(), app, app.Spec.GetSources(), appLabelKey, revisions, false, false, false, proj, true)
 	if err != nil {
-		return false, err
+		return false, nil, err
 	}
 	runningHooks := map[kube.ResourceKey]*unstructured.Unstructured{}
 	for key, obj := range liveObjs {
-		if isPostDeleteHook(obj) {
+		if isDeleteHook(obj) {
 			runningHooks[key] = obj
 		}
 	}
@@ -67,7 +167,7 @@ func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Applicat
 		if obj.GetNamespace() == "" {
 			obj.SetNamespace(app.Spec.Destination.Namespace)
 		}
-		if !isPostDeleteHook(obj) {
+		if !isDeleteHook(obj) {
 			continue
 		}
 		if runningHook := runningHooks[kube.GetResourceKey(obj)]; runningHook == nil {
@@ -78,25 +178,26 @@ func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Applicat
 	for _, obj := range expectedHook {
 		_, err = ctrl.kubectl.CreateResource(context.Background(), config, obj.GroupVersionKind(), obj.GetName(), obj.GetNamespace(), obj, metav1.CreateOptions{})
 		if err != nil {
-			return false, err
+			return false, nil, err
 		}
 		createdCnt++
 	}
 	if createdCnt > 0 {
-		logCtx.Infof("Created %d post-delete hooks", createdCnt)
-		return false, nil
+		logCtx.Infof("Created %d %s hooks", createdCnt, hookName)
+		return false, nil, nil
 	}
 	resourceOverrides, err := ctrl.settingsMgr.GetResourceOverrides()
 	if err != nil {
-		return false, err
+		return false, nil, err
 	}
 	healthOverrides := lua.ResourceHealthOverrides(resourceOverrides)
 
 	progressingHooksCnt := 0
+	var failed []string
 	for _, obj := range runningHooks {
 		hookHealth, err := health.GetResourceHealth(obj, healthOverrides)
 		if err != nil {
-			return false, err
+			return false, nil, err
 		}
 		if hookHealth == nil {
 			logCtx.WithFields(log.Fields{
@@ -110,16 +211,19 @@ func (ctrl *ApplicationController) executePostDeleteHooks(app *v1alpha1.Applicat
 				Status: health.HealthStatusHealthy,
 			}
 		}
-		if hookHealth.Status == health.HealthStatusProgressing {
+		switch hookHealth.Status {
+		case health.HealthStatusProgressing:
 			progressingHooksCnt++
+		case health.HealthStatusDegraded:
+			failed = append(failed, fmt.Sprintf("%s/%s: %s", obj.GetKind(), obj.GetName(), hookHealth.Message))
 		}
 	}
 	if progressingHooksCnt > 0 {
-		logCtx.Infof("Waiting for %d post-delete hooks to complete", progressingHooksCnt)
-		return false, nil
+		logCtx.Infof("Waiting for %d %s hooks to complete", progressingHooksCnt, hookName)
+		return false, nil, nil
 	}
 
-	return true, nil
+	return true, failed, nil
 }
 
 func (ctrl *ApplicationController) cleanupPostDeleteHooks(liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, error) {
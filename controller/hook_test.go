@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"testing"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/sync/hook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestRenderSyncPolicyHooks(t *testing.T) {
+	t.Run("NoSyncPolicy", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy = nil
+		hooks, err := renderSyncPolicyHooks(app)
+		require.NoError(t, err)
+		assert.Empty(t, hooks)
+	})
+
+	t.Run("Job", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{Hooks: []v1alpha1.SyncPolicyHook{{
+			Name:           "smoke-test",
+			Type:           synccommon.HookTypePostSync,
+			Image:          "curlimages/curl:8.8.0",
+			Command:        []string{"curl"},
+			Args:           []string{"-f", "http://guestbook-ui/"},
+			Env:            v1alpha1.Env{{Name: "RETRIES", Value: "3"}},
+			ServiceAccount: "smoke-test",
+		}}}
+		hooks, err := renderSyncPolicyHooks(app)
+		require.NoError(t, err)
+		require.Len(t, hooks, 1)
+		assert.True(t, hook.IsHook(hooks[0]))
+		assert.Equal(t, []synccommon.HookType{synccommon.HookTypePostSync}, hook.Types(hooks[0]))
+
+		var job batchv1.Job
+		require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(hooks[0].Object, &job))
+		assert.Equal(t, "smoke-test", job.Name)
+		assert.Equal(t, app.Spec.Destination.Namespace, job.Namespace)
+		assert.Equal(t, int32(0), *job.Spec.BackoffLimit)
+		podSpec := job.Spec.Template.Spec
+		assert.Equal(t, corev1.RestartPolicyNever, podSpec.RestartPolicy)
+		assert.Equal(t, "smoke-test", podSpec.ServiceAccountName)
+		require.Len(t, podSpec.Containers, 1)
+		assert.Equal(t, "curlimages/curl:8.8.0", podSpec.Containers[0].Image)
+		assert.Equal(t, []string{"curl"}, podSpec.Containers[0].Command)
+		assert.Equal(t, []string{"-f", "http://guestbook-ui/"}, podSpec.Containers[0].Args)
+		assert.Equal(t, []corev1.EnvVar{{Name: "RETRIES", Value: "3"}}, podSpec.Containers[0].Env)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		for _, h := range []v1alpha1.SyncPolicyHook{
+			{Type: synccommon.HookTypePreSync, Image: "busybox"},
+			{Name: "notify", Type: synccommon.HookTypeSync, Image: "busybox"},
+			{Name: "notify", Type: synccommon.HookTypePreSync},
+		} {
+			app := newFakeApp()
+			app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{Hooks: []v1alpha1.SyncPolicyHook{h}}
+			_, err := renderSyncPolicyHooks(app)
+			assert.Error(t, err)
+		}
+	})
+}
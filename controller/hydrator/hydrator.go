@@ -5,8 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
-	"path/filepath"
 	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -21,6 +21,7 @@ import (
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	apppathutil "github.com/argoproj/argo-cd/v3/util/app/path"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/hydrator"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
@@ -48,9 +49,15 @@ type Dependencies interface {
 	// GetProcessableApps returns a list of applications that are processable by the controller.
 	GetProcessableApps() (*appv1.ApplicationList, error)
 
-	// GetRepoObjs returns the repository objects for the given application, source, and revision. It calls the repo-
-	// server and gets the manifests (objects).
-	GetRepoObjs(ctx context.Context, app *appv1.Application, source appv1.ApplicationSource, revision string, project *appv1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error)
+	// GetRepoObjs returns the repository objects for the given application, sources, and revision. It calls the repo-
+	// server and gets the manifests (objects). The revision applies to the first source, the remaining sources use
+	// their own target revisions. One manifest response is returned per source.
+	GetRepoObjs(ctx context.Context, app *appv1.Application, sources []appv1.ApplicationSource, revision string, project *appv1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error)
+
+	// GetUpdateRevisionForPathsRequest returns the request comparing the synced revision of the first source with the
+	// given revision. It identifies the manifests the same way as the manifest requests of GetRepoObjs, so that the
+	// repo-server moves their cache entry to the new revision if the paths did not change. The paths are not set.
+	GetUpdateRevisionForPathsRequest(ctx context.Context, app *appv1.Application, sources []appv1.ApplicationSource, revision, syncedRevision string, project *appv1.AppProject) (*apiclient.UpdateRevisionForPathsRequest, error)
 
 	// GetWriteCredentials returns the repository credentials for the given repository URL and project. These are to be
 	// sent to the commit server to write the hydrated manifests.
@@ -135,6 +142,7 @@ func getHydrationQueueKey(app *appv1.Application) types.HydrationQueueKey {
 	key := types.HydrationQueueKey{
 		SourceRepoURL:        git.NormalizeGitURLAllowInvalid(app.Spec.SourceHydrator.DrySource.RepoURL),
 		SourceTargetRevision: app.Spec.SourceHydrator.DrySource.TargetRevision,
+		DestinationRepoURL:   git.NormalizeGitURLAllowInvalid(app.Spec.GetHydrateToSource().RepoURL),
 		DestinationBranch:    app.Spec.GetHydrateToSource().TargetRevision,
 	}
 	return key
@@ -197,7 +205,7 @@ func (h *Hydrator) ProcessHydrationQueueItem(hydrationKey types.HydrationQueueKe
 	}
 
 	// Hydrate all the apps
-	drySHA, hydratedSHA, appErrors, err := h.hydrate(logCtx, apps, projects)
+	drySHA, hydratedSHA, pullRequest, additionalDrySHAs, appErrors, err := h.hydrate(logCtx, apps, projects)
 	if err != nil {
 		// If there is a single error, it affects each applications
 		for i := range apps {
@@ -231,21 +239,29 @@ func (h *Hydrator) ProcessHydrationQueueItem(hydrationKey types.HydrationQueueKe
 	finishedAt := metav1.Now()
 	for _, app := range apps {
 		origApp := app.DeepCopy()
+		// Apps which were not rendered again have the additional dry SHAs of their last successful hydration.
+		appAdditionalDrySHAs, ok := additionalDrySHAs[app.QualifiedName()]
+		if !ok && app.Status.SourceHydrator.LastSuccessfulOperation != nil {
+			appAdditionalDrySHAs = app.Status.SourceHydrator.LastSuccessfulOperation.AdditionalDrySHAs
+		}
 		operation := &appv1.HydrateOperation{
-			StartedAt:      app.Status.SourceHydrator.CurrentOperation.StartedAt,
-			FinishedAt:     &finishedAt,
-			Phase:          appv1.HydrateOperationPhaseHydrated,
-			Message:        "",
-			DrySHA:         drySHA,
-			HydratedSHA:    hydratedSHA,
-			SourceHydrator: app.Status.SourceHydrator.CurrentOperation.SourceHydrator,
+			StartedAt:         app.Status.SourceHydrator.CurrentOperation.StartedAt,
+			FinishedAt:        &finishedAt,
+			Phase:             appv1.HydrateOperationPhaseHydrated,
+			Message:           "",
+			DrySHA:            drySHA,
+			HydratedSHA:       hydratedSHA,
+			SourceHydrator:    app.Status.SourceHydrator.CurrentOperation.SourceHydrator,
+			AdditionalDrySHAs: appAdditionalDrySHAs,
 		}
 		app.Status.SourceHydrator.CurrentOperation = operation
 		app.Status.SourceHydrator.LastSuccessfulOperation = &appv1.SuccessfulHydrateOperation{
-			DrySHA:         drySHA,
-			HydratedSHA:    hydratedSHA,
-			SourceHydrator: app.Status.SourceHydrator.CurrentOperation.SourceHydrator,
+			DrySHA:            drySHA,
+			HydratedSHA:       hydratedSHA,
+			SourceHydrator:    app.Status.SourceHydrator.CurrentOperation.SourceHydrator,
+			AdditionalDrySHAs: appAdditionalDrySHAs,
 		}
+		app.Status.SourceHydrator.PullRequest = pullRequest
 		h.dependencies.PersistAppHydratorStatus(origApp, &app.Status.SourceHydrator)
 
 		// Request a refresh since we pushed a new commit.
@@ -320,7 +336,7 @@ func (h *Hydrator) validateApplications(apps []*appv1.Application) (map[string]*
 		// which can break other applications or shared configuration.
 		// Every hydrated app must write into a subdirectory instead.
 		destPath := app.Spec.SourceHydrator.SyncSource.Path
-		if IsRootPath(destPath) {
+		if hydrator.IsRootPath(destPath) {
 			errors[app.QualifiedName()] = fmt.Errorf("app is configured to hydrate to the repository root (branch %q, path %q) which is not allowed", app.Spec.GetHydrateToSource().TargetRevision, destPath)
 			continue
 		}
@@ -343,14 +359,17 @@ func (h *Hydrator) validateApplications(apps []*appv1.Application) (map[string]*
 	return projects, errors
 }
 
-func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, projects map[string]*appv1.AppProject) (string, string, map[string]error, error) {
+// hydrate renders the manifests of the given apps and commits them. It returns the dry SHA, the hydrated SHA, the status
+// of the pull request, and the additional dry SHAs of the apps which were rendered, keyed by their qualified name.
+func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, projects map[string]*appv1.AppProject) (string, string, *appv1.HydratorPullRequestStatus, map[string][]string, map[string]error, error) {
 	errors := make(map[string]error)
 	if len(apps) == 0 {
-		return "", "", nil, nil
+		return "", "", nil, nil, nil, nil
 	}
 
 	// These values are the same for all apps being hydrated together, so just get them from the first app.
 	repoURL := apps[0].Spec.GetHydrateToSource().RepoURL
+	dryRepoURL := apps[0].Spec.SourceHydrator.DrySource.RepoURL
 	targetBranch := apps[0].Spec.GetHydrateToSource().TargetRevision
 	// FIXME: As a convenience, the commit server will create the syncBranch if it does not exist. If the
 	// targetBranch does not exist, it will create it based on the syncBranch. On the next line, we take
@@ -358,29 +377,51 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 	// app has a different syncBranch, we should send the commit server an empty string and allow it to
 	// create the targetBranch as an orphan since we can't reliable determine a reasonable base.
 	syncBranch := apps[0].Spec.SourceHydrator.SyncSource.TargetBranch
+	// The pull request configuration is taken from the first app as well.
+	var pullRequest *appv1.HydrateToPullRequest
+	if apps[0].Spec.SourceHydrator.HydrateTo != nil {
+		pullRequest = apps[0].Spec.SourceHydrator.HydrateTo.PullRequest
+	}
 
 	// Get a static SHA revision from the first app so that all apps are hydrated from the same revision.
 	targetRevision, pathDetails, err := h.getManifests(context.Background(), apps[0], "", projects[apps[0].Spec.Project])
 	if err != nil {
 		errors[apps[0].QualifiedName()] = fmt.Errorf("failed to get manifests: %w", err)
-		return "", "", errors, nil
+		return "", "", nil, nil, errors, nil
 	}
 	paths := []*commitclient.PathDetails{pathDetails}
+	additionalDrySHAs := map[string][]string{apps[0].QualifiedName(): pathDetails.AdditionalDrySHAs}
 	logCtx = logCtx.WithFields(log.Fields{"drySha": targetRevision})
 	// De-dupe, if the drySha was already hydrated log a debug and return using the data from the last successful hydration run.
-	// We only inspect one app. If apps have been added/removed, that will be handled on the next DRY commit.
-	if apps[0].Status.SourceHydrator.LastSuccessfulOperation != nil && targetRevision == apps[0].Status.SourceHydrator.LastSuccessfulOperation.DrySHA {
+	// We only inspect one app for the drySha. If apps have been added/removed, that will be handled on the next DRY commit.
+	// Additional dry sources may have changed without the drySha changing, so their revisions are compared for every app.
+	lastOperation := apps[0].Status.SourceHydrator.LastSuccessfulOperation
+	if lastOperation != nil && targetRevision == lastOperation.DrySHA && !h.additionalDrySourcesChanged(context.Background(), logCtx, apps, pathDetails.AdditionalDrySHAs) {
 		logCtx.Debug("Skipping hydration since the DRY commit was already hydrated")
-		return targetRevision, apps[0].Status.SourceHydrator.LastSuccessfulOperation.HydratedSHA, nil, nil
+		return targetRevision, lastOperation.HydratedSHA, apps[0].Status.SourceHydrator.PullRequest, nil, nil, nil
 	}
 
 	eg, ctx := errgroup.WithContext(context.Background())
 	var mu sync.Mutex
+	carriedForward := 0
 
 	for _, app := range apps[1:] {
 		app := app
 		eg.Go(func() error {
-			_, pathDetails, err = h.getManifests(ctx, app, targetRevision, projects[app.Spec.Project])
+			// Apps whose dry inputs did not change since their last hydration are not rendered again. Their hydrated
+			// manifests are left untouched on the target branch.
+			changed, err := h.dryInputsChanged(ctx, app, repoURL, targetRevision, projects[app.Spec.Project])
+			if err != nil {
+				logCtx.WithFields(applog.GetAppLogFields(app)).WithError(err).Warn("failed to compare dry revisions, hydrating app")
+				changed = true
+			}
+			if !changed {
+				mu.Lock()
+				defer mu.Unlock()
+				carriedForward++
+				return nil
+			}
+			_, pathDetails, err := h.getManifests(ctx, app, targetRevision, projects[app.Spec.Project])
 			mu.Lock()
 			defer mu.Unlock()
 			if err != nil {
@@ -388,11 +429,15 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 				return errors[app.QualifiedName()]
 			}
 			paths = append(paths, pathDetails)
+			additionalDrySHAs[app.QualifiedName()] = pathDetails.AdditionalDrySHAs
 			return nil
 		})
 	}
 	if err := eg.Wait(); err != nil {
-		return targetRevision, "", errors, nil
+		return targetRevision, "", nil, nil, errors, nil
+	}
+	if carriedForward > 0 {
+		logCtx.WithField("carriedForward", carriedForward).Debug("Carrying forward hydrated manifests of apps without dry changes")
 	}
 
 	// If all the apps are under the same project, use that project. Otherwise, use an empty string to indicate that we
@@ -406,14 +451,14 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 	}
 
 	// Get the commit metadata for the target revision.
-	revisionMetadata, err := h.getRevisionMetadata(context.Background(), repoURL, project, targetRevision)
+	revisionMetadata, err := h.getRevisionMetadata(context.Background(), dryRepoURL, project, targetRevision)
 	if err != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to get revision metadata for %q: %w", targetRevision, err)
+		return targetRevision, "", nil, nil, errors, fmt.Errorf("failed to get revision metadata for %q: %w", targetRevision, err)
 	}
 
 	repo, err := h.dependencies.GetWriteCredentials(context.Background(), repoURL, project)
 	if err != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to get hydrator credentials: %w", err)
+		return targetRevision, "", nil, nil, errors, fmt.Errorf("failed to get hydrator credentials: %w", err)
 	}
 	if repo == nil {
 		// Try without credentials.
@@ -425,11 +470,11 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 	// get the commit message template
 	commitMessageTemplate, err := h.dependencies.GetHydratorCommitMessageTemplate()
 	if err != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to get hydrated commit message template: %w", err)
+		return targetRevision, "", nil, nil, errors, fmt.Errorf("failed to get hydrated commit message template: %w", err)
 	}
-	commitMessage, errMsg := getTemplatedCommitMessage(repoURL, targetRevision, commitMessageTemplate, revisionMetadata)
+	commitMessage, errMsg := getTemplatedCommitMessage(dryRepoURL, targetRevision, commitMessageTemplate, revisionMetadata)
 	if errMsg != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to get hydrator commit templated message: %w", errMsg)
+		return targetRevision, "", nil, nil, errors, fmt.Errorf("failed to get hydrator commit templated message: %w", errMsg)
 	}
 
 	manifestsRequest := commitclient.CommitHydratedManifestsRequest{
@@ -437,21 +482,166 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 		SyncBranch:        syncBranch,
 		TargetBranch:      targetBranch,
 		DrySha:            targetRevision,
+		DryRepoURL:        dryRepoURL,
 		CommitMessage:     commitMessage,
 		Paths:             paths,
 		DryCommitMetadata: revisionMetadata,
+		PullRequest:       pullRequest,
 	}
 
 	closer, commitService, err := h.commitClientset.NewCommitServerClient()
 	if err != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to create commit service: %w", err)
+		return targetRevision, "", nil, nil, errors, fmt.Errorf("failed to create commit service: %w", err)
 	}
 	defer utilio.Close(closer)
 	resp, err := commitService.CommitHydratedManifests(context.Background(), &manifestsRequest)
 	if err != nil {
-		return targetRevision, "", errors, fmt.Errorf("failed to commit hydrated manifests: %w", err)
+		return targetRevision, "", nil, nil, errors, fmt.Errorf("failed to commit hydrated manifests: %w", err)
 	}
-	return targetRevision, resp.HydratedSha, errors, nil
+	return targetRevision, resp.HydratedSha, resp.PullRequest, additionalDrySHAs, errors, nil
+}
+
+// additionalDrySourcesChanged returns whether the additional dry sources of any of the given apps resolve to other
+// revisions than at their last successful hydration. The additional dry SHAs of the first app are the ones it was
+// just rendered with. Apps whose revisions can't be resolved are considered changed.
+func (h *Hydrator) additionalDrySourcesChanged(ctx context.Context, logCtx *log.Entry, apps []*appv1.Application, firstAdditionalDrySHAs []string) bool {
+	for i, app := range apps {
+		if len(app.Spec.SourceHydrator.AdditionalDrySources) == 0 {
+			continue
+		}
+		lastOperation := app.Status.SourceHydrator.LastSuccessfulOperation
+		if lastOperation == nil {
+			return true
+		}
+		if i == 0 {
+			if !slices.Equal(firstAdditionalDrySHAs, lastOperation.AdditionalDrySHAs) {
+				return true
+			}
+			continue
+		}
+		changed, err := h.additionalDrySHAsChanged(ctx, app)
+		if err != nil {
+			logCtx.WithFields(applog.GetAppLogFields(app)).WithError(err).Warn("failed to resolve additional dry sources, hydrating apps")
+			return true
+		}
+		if changed {
+			return true
+		}
+	}
+	return false
+}
+
+// additionalDrySHAsChanged returns whether the additional dry sources of the given application resolve to other
+// revisions than at its last successful hydration.
+func (h *Hydrator) additionalDrySHAsChanged(ctx context.Context, app *appv1.Application) (bool, error) {
+	additionalDrySources := app.Spec.SourceHydrator.AdditionalDrySources
+	if len(additionalDrySources) == 0 {
+		return false, nil
+	}
+	lastOperation := app.Status.SourceHydrator.LastSuccessfulOperation
+	if lastOperation == nil || len(lastOperation.AdditionalDrySHAs) != len(additionalDrySources) {
+		return true, nil
+	}
+	additionalDrySHAs, err := h.resolveAdditionalDrySHAs(ctx, app)
+	if err != nil {
+		return true, err
+	}
+	return !slices.Equal(additionalDrySHAs, lastOperation.AdditionalDrySHAs), nil
+}
+
+// resolveAdditionalDrySHAs returns the resolved revisions of the additional dry sources of the given application, in
+// the order of the additional dry sources.
+func (h *Hydrator) resolveAdditionalDrySHAs(ctx context.Context, app *appv1.Application) ([]string, error) {
+	closer, repoService, err := h.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create repo service: %w", err)
+	}
+	defer utilio.Close(closer)
+
+	additionalDrySources := app.Spec.SourceHydrator.AdditionalDrySources
+	additionalDrySHAs := make([]string, len(additionalDrySources))
+	for i, additionalDrySource := range additionalDrySources {
+		source := additionalDrySource.ToApplicationSource()
+		repo, err := h.repoGetter.GetRepository(ctx, source.RepoURL, app.Spec.Project)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get repository %q: %w", source.RepoURL, err)
+		}
+		// The repo server resolves the revision of the source at the given index of the application's sources, so
+		// the source is passed as the only source of an application.
+		resp, err := repoService.ResolveRevision(ctx, &apiclient.ResolveRevisionRequest{
+			Repo:              repo,
+			App:               &appv1.Application{Spec: appv1.ApplicationSpec{Source: &source}},
+			AmbiguousRevision: source.TargetRevision,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve revision %q of %q: %w", source.TargetRevision, source.RepoURL, err)
+		}
+		additionalDrySHAs[i] = resp.Revision
+	}
+	return additionalDrySHAs, nil
+}
+
+// dryInputsChanged returns whether the dry inputs of the given application changed between the dry SHA of its last
+// successful hydration and the given dry SHA. The paths are taken from the manifest-generate-paths annotation and
+// compared by the repo server, the same way it does for the refresh of regular applications.
+//
+// Apps are always considered changed when the comparison is not possible or not safe: when the annotation is not set,
+// when the app was never hydrated or its hydrator spec changed since, when hydration was explicitly requested, when the
+// additional dry sources of the app resolve to other revisions, or when the hydrated manifests are not pushed to a git
+// repository (in which case every publication must contain all the manifests).
+func (h *Hydrator) dryInputsChanged(ctx context.Context, app *appv1.Application, hydratedRepoURL, drySHA string, project *appv1.AppProject) (bool, error) {
+	lastOperation := app.Status.SourceHydrator.LastSuccessfulOperation
+	switch {
+	case !isGitRepoURL(hydratedRepoURL):
+		return true, nil
+	case lastOperation == nil || lastOperation.DrySHA == "":
+		return true, nil
+	case lastOperation.DrySHA == drySHA:
+		return true, nil
+	case !app.Spec.SourceHydrator.DeepEquals(lastOperation.SourceHydrator):
+		return true, nil
+	case app.IsHydrateRequested():
+		return true, nil
+	}
+
+	refreshPaths := apppathutil.GetSourceHydratorRefreshPaths(app)
+	if len(refreshPaths) == 0 {
+		return true, nil
+	}
+
+	// The paths are only compared in the repository of the primary dry source.
+	changed, err := h.additionalDrySHAsChanged(ctx, app)
+	if err != nil || changed {
+		return true, err
+	}
+
+	request, err := h.dependencies.GetUpdateRevisionForPathsRequest(ctx, app, app.Spec.SourceHydrator.GetDrySources(), drySHA, lastOperation.DrySHA, project)
+	if err != nil {
+		return true, fmt.Errorf("failed to build the dry revisions comparison: %w", err)
+	}
+	if request.Repo.Depth > 0 {
+		// Shallow clones may not contain the last hydrated dry SHA.
+		return true, nil
+	}
+	request.Paths = refreshPaths
+
+	closer, repoService, err := h.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return true, fmt.Errorf("failed to create repo service: %w", err)
+	}
+	defer utilio.Close(closer)
+
+	resp, err := repoService.UpdateRevisionForPaths(ctx, request)
+	if err != nil {
+		return true, fmt.Errorf("failed to compare dry revisions %q and %q: %w", lastOperation.DrySHA, drySHA, err)
+	}
+	return resp.Changes, nil
+}
+
+// isGitRepoURL returns whether the hydrated manifests for the given repo URL are pushed to git by the commit server, as
+// opposed to being published as an OCI artifact or to an S3 bucket.
+func isGitRepoURL(repoURL string) bool {
+	return !strings.HasPrefix(repoURL, "oci://") && !strings.HasPrefix(repoURL, "s3://")
 }
 
 // getManifests gets the manifests for the given application and target revision. It returns the resolved revision
@@ -459,20 +649,28 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application, project
 //
 // If the given target revision is empty, it uses the target revision from the app dry source spec.
 func (h *Hydrator) getManifests(ctx context.Context, app *appv1.Application, targetRevision string, project *appv1.AppProject) (revision string, pathDetails *commitclient.PathDetails, err error) {
-	drySource := appv1.ApplicationSource{
-		RepoURL:        app.Spec.SourceHydrator.DrySource.RepoURL,
-		Path:           app.Spec.SourceHydrator.DrySource.Path,
-		TargetRevision: app.Spec.SourceHydrator.DrySource.TargetRevision,
-	}
+	drySources := app.Spec.SourceHydrator.GetDrySources()
 	if targetRevision == "" {
 		targetRevision = app.Spec.SourceHydrator.DrySource.TargetRevision
 	}
 
 	// TODO: enable signature verification
-	objs, resp, err := h.dependencies.GetRepoObjs(ctx, app, drySource, targetRevision, project)
+	objs, resps, err := h.dependencies.GetRepoObjs(ctx, app, drySources, targetRevision, project)
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to get repo objects for app %q: %w", app.QualifiedName(), err)
 	}
+	if len(resps) != len(drySources) {
+		return "", nil, fmt.Errorf("expected %d manifest responses for app %q, got %d", len(drySources), app.QualifiedName(), len(resps))
+	}
+
+	var commands []string
+	var additionalDrySHAs []string
+	for i, resp := range resps {
+		commands = append(commands, resp.Commands...)
+		if i > 0 {
+			additionalDrySHAs = append(additionalDrySHAs, resp.Revision)
+		}
+	}
 
 	// Set up a ManifestsRequest
 	manifestDetails := make([]*commitclient.HydratedManifestDetails, len(objs))
@@ -484,10 +682,14 @@ func (h *Hydrator) getManifests(ctx context.Context, app *appv1.Application, tar
 		manifestDetails[i] = &commitclient.HydratedManifestDetails{ManifestJSON: string(objJSON)}
 	}
 
-	return resp.Revision, &commitclient.PathDetails{
-		Path:      app.Spec.SourceHydrator.SyncSource.Path,
-		Manifests: manifestDetails,
-		Commands:  resp.Commands,
+	// The revision of the primary dry source is the dry SHA.
+	return resps[0].Revision, &commitclient.PathDetails{
+		Path:              app.Spec.SourceHydrator.SyncSource.Path,
+		Manifests:         manifestDetails,
+		Commands:          commands,
+		Layout:            string(app.Spec.SourceHydrator.SyncSource.Layout),
+		DisableReadme:     app.Spec.SourceHydrator.SyncSource.DisableReadme,
+		AdditionalDrySHAs: additionalDrySHAs,
 	}, nil
 }
 
@@ -561,9 +763,3 @@ func genericHydrationError(validationErrors map[string]error) error {
 	}
 	return fmt.Errorf("cannot hydrate because application %s %s", keys[0], remainder)
 }
-
-// IsRootPath returns whether the path references a root path
-func IsRootPath(path string) bool {
-	clean := filepath.Clean(path)
-	return clean == "" || clean == "." || clean == string(filepath.Separator)
-}
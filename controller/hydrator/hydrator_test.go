@@ -3,7 +3,7 @@ package hydrator
 import (
 	"context"
 	"errors"
-	"path/filepath"
+	"fmt"
 	"testing"
 	"time"
 
@@ -167,6 +167,7 @@ func Test_getAppsForHydrationKey_RepoURLNormalization(t *testing.T) {
 	hydrationKey := types.HydrationQueueKey{
 		SourceRepoURL:        "https://example.com/repo",
 		SourceTargetRevision: "main",
+		DestinationRepoURL:   "https://example.com/repo",
 		DestinationBranch:    "main",
 	}
 
@@ -291,28 +292,6 @@ func Test_validateApplications_RootPathSkipped(t *testing.T) {
 	assert.Nil(t, proj)
 }
 
-func TestIsRootPath(t *testing.T) {
-	tests := []struct {
-		name     string
-		path     string
-		expected bool
-	}{
-		{"empty string", "", true},
-		{"dot path", ".", true},
-		{"slash", string(filepath.Separator), true},
-		{"nested path", "app", false},
-		{"nested path with slash", "app/", false},
-		{"deep path", "app/config", false},
-		{"current dir with trailing slash", "./", true},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := IsRootPath(tt.path)
-			require.Equal(t, tt.expected, result)
-		})
-	}
-}
-
 func newTestProject() *v1alpha1.AppProject {
 	return &v1alpha1.AppProject{
 		ObjectMeta: metav1.ObjectMeta{Name: "test-project", Namespace: "default"},
@@ -586,9 +565,9 @@ func TestProcessHydrationQueueItem_HydrateFails_CommonError(t *testing.T) {
 	d.EXPECT().GetProcessableAppProj(mock.Anything).Return(newTestProject(), nil)
 	h := &Hydrator{dependencies: d, repoGetter: r}
 
-	d.EXPECT().GetRepoObjs(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, &repoclient.ManifestResponse{
+	d.EXPECT().GetRepoObjs(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, []*repoclient.ManifestResponse{{
 		Revision: "abc123",
-	}, nil)
+	}}, nil)
 	r.EXPECT().GetRepository(mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("repo error"))
 
 	// Expect setAppHydratorError to be called
@@ -638,9 +617,9 @@ func TestProcessHydrationQueueItem_SuccessfulHydration(t *testing.T) {
 		persistedStatus = newStatus
 	}).Return().Once()
 	d.EXPECT().RequestAppRefresh(app.Name, app.Namespace).Return(nil).Once()
-	d.EXPECT().GetRepoObjs(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, &repoclient.ManifestResponse{
+	d.EXPECT().GetRepoObjs(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, []*repoclient.ManifestResponse{{
 		Revision: "abc123",
-	}, nil).Once()
+	}}, nil).Once()
 	r.EXPECT().GetRepository(mock.Anything, "https://example.com/repo", "test-project").Return(nil, nil).Once()
 	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(nil, nil).Once()
 	d.EXPECT().GetWriteCredentials(mock.Anything, "https://example.com/repo", "test-project").Return(nil, nil).Once()
@@ -795,8 +774,8 @@ func TestHydrator_hydrate_Success(t *testing.T) {
 	readRepo := &v1alpha1.Repository{Repo: "https://example.com/repo"}
 	writeRepo := &v1alpha1.Repository{Repo: "https://example.com/repo"}
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app1, app1.Spec.SourceHydrator.GetDrySource(), "main", proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
-	d.EXPECT().GetRepoObjs(mock.Anything, app2, app2.Spec.SourceHydrator.GetDrySource(), "sha123", proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app1, []v1alpha1.ApplicationSource{app1.Spec.SourceHydrator.GetDrySource()}, "main", proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app2, []v1alpha1.ApplicationSource{app2.Spec.SourceHydrator.GetDrySource()}, "sha123", proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
 	r.EXPECT().GetRepository(mock.Anything, readRepo.Repo, proj.Name).Return(readRepo, nil)
 	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{Message: "metadata"}, nil).Run(func(_ context.Context, in *repoclient.RepoServerRevisionMetadataRequest, _ ...grpc.CallOption) {
 		assert.Equal(t, readRepo, in.Repo)
@@ -817,7 +796,7 @@ func TestHydrator_hydrate_Success(t *testing.T) {
 	})
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, apps, projects)
+	sha, hydratedSha, _, _, errs, err := h.hydrate(logCtx, apps, projects)
 
 	require.NoError(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -846,7 +825,7 @@ func TestHydrator_hydrate_GetManifestsError(t *testing.T) {
 	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, nil, errors.New("manifests error"))
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
+	sha, hydratedSha, _, _, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
 
 	require.NoError(t, err)
 	assert.Empty(t, sha)
@@ -873,12 +852,12 @@ func TestHydrator_hydrate_RevisionMetadataError(t *testing.T) {
 	proj := newTestProject()
 	projects := map[string]*v1alpha1.AppProject{app.Spec.Project: proj}
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
 	r.EXPECT().GetRepository(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
 	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(nil, errors.New("metadata error"))
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
+	sha, hydratedSha, _, _, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
 
 	require.Error(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -905,13 +884,13 @@ func TestHydrator_hydrate_GetWriteCredentialsError(t *testing.T) {
 	proj := newTestProject()
 	projects := map[string]*v1alpha1.AppProject{app.Spec.Project: proj}
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
 	r.EXPECT().GetRepository(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
 	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{}, nil)
 	d.EXPECT().GetWriteCredentials(mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("creds error"))
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
+	sha, hydratedSha, _, _, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
 
 	require.Error(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -938,14 +917,14 @@ func TestHydrator_hydrate_CommitMessageTemplateError(t *testing.T) {
 	proj := newTestProject()
 	projects := map[string]*v1alpha1.AppProject{app.Spec.Project: proj}
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
 	r.EXPECT().GetRepository(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
 	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{}, nil)
 	d.EXPECT().GetWriteCredentials(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
 	d.EXPECT().GetHydratorCommitMessageTemplate().Return("", errors.New("template error"))
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
+	sha, hydratedSha, _, _, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
 
 	require.Error(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -972,14 +951,14 @@ func TestHydrator_hydrate_TemplatedCommitMessageError(t *testing.T) {
 	proj := newTestProject()
 	projects := map[string]*v1alpha1.AppProject{app.Spec.Project: proj}
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
 	r.EXPECT().GetRepository(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
 	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{}, nil)
 	d.EXPECT().GetWriteCredentials(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
 	d.EXPECT().GetHydratorCommitMessageTemplate().Return("{{ notAFunction }} template", nil)
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
+	sha, hydratedSha, _, _, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
 
 	require.Error(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -1006,7 +985,7 @@ func TestHydrator_hydrate_CommitHydratedManifestsError(t *testing.T) {
 	proj := newTestProject()
 	projects := map[string]*v1alpha1.AppProject{app.Spec.Project: proj}
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
 	r.EXPECT().GetRepository(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
 	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{}, nil)
 	d.EXPECT().GetWriteCredentials(mock.Anything, mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://example.com/repo"}, nil)
@@ -1014,7 +993,7 @@ func TestHydrator_hydrate_CommitHydratedManifestsError(t *testing.T) {
 	cc.EXPECT().CommitHydratedManifests(mock.Anything, mock.Anything).Return(nil, errors.New("commit error"))
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
+	sha, hydratedSha, _, _, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{app}, projects)
 
 	require.Error(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -1029,7 +1008,7 @@ func TestHydrator_hydrate_EmptyApps(t *testing.T) {
 	logCtx := log.NewEntry(log.StandardLogger())
 	h := &Hydrator{dependencies: d}
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{}, nil)
+	sha, hydratedSha, _, _, errs, err := h.hydrate(logCtx, []*v1alpha1.Application{}, nil)
 
 	require.NoError(t, err)
 	assert.Empty(t, sha)
@@ -1050,10 +1029,10 @@ func TestHydrator_getManifests_Success(t *testing.T) {
 		},
 	})
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app, app.Spec.SourceHydrator.GetDrySource(), "sha123", proj).Return([]*unstructured.Unstructured{cm}, &repoclient.ManifestResponse{
+	d.EXPECT().GetRepoObjs(mock.Anything, app, []v1alpha1.ApplicationSource{app.Spec.SourceHydrator.GetDrySource()}, "sha123", proj).Return([]*unstructured.Unstructured{cm}, []*repoclient.ManifestResponse{{
 		Revision: "sha123",
 		Commands: []string{"cmd1", "cmd2"},
-	}, nil)
+	}}, nil)
 
 	rev, pathDetails, err := h.getManifests(t.Context(), app, "sha123", proj)
 	require.NoError(t, err)
@@ -1064,6 +1043,64 @@ func TestHydrator_getManifests_Success(t *testing.T) {
 	assert.JSONEq(t, `{"metadata":{"name":"test"}}`, pathDetails.Manifests[0].ManifestJSON)
 }
 
+func TestHydrator_getManifests_SourceOptions(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	h := &Hydrator{dependencies: d}
+	app := newTestApp("test-app")
+	app.Spec.SourceHydrator.DrySource.Helm = &v1alpha1.ApplicationSourceHelm{
+		ValueFiles: []string{"values-dev.yaml"},
+		Parameters: []v1alpha1.HelmParameter{{Name: "image.tag", Value: "v2"}},
+	}
+	proj := newTestProject()
+
+	expectedSource := v1alpha1.ApplicationSource{
+		RepoURL:        app.Spec.SourceHydrator.DrySource.RepoURL,
+		Path:           app.Spec.SourceHydrator.DrySource.Path,
+		TargetRevision: app.Spec.SourceHydrator.DrySource.TargetRevision,
+		Helm: &v1alpha1.ApplicationSourceHelm{
+			ValueFiles: []string{"values-dev.yaml"},
+			Parameters: []v1alpha1.HelmParameter{{Name: "image.tag", Value: "v2"}},
+		},
+	}
+	d.EXPECT().GetRepoObjs(mock.Anything, app, []v1alpha1.ApplicationSource{expectedSource}, "sha123", proj).Return([]*unstructured.Unstructured{}, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
+
+	rev, _, err := h.getManifests(t.Context(), app, "sha123", proj)
+	require.NoError(t, err)
+	assert.Equal(t, "sha123", rev)
+}
+
+func TestHydrator_getManifests_AdditionalDrySources(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	h := &Hydrator{dependencies: d}
+	app := newTestApp("test-app")
+	app.Spec.SourceHydrator.DrySource.Helm = &v1alpha1.ApplicationSourceHelm{
+		ValueFiles: []string{"$values/dev/values.yaml"},
+	}
+	app.Spec.SourceHydrator.AdditionalDrySources = []v1alpha1.DrySource{{
+		RepoURL:        "https://example.com/values",
+		TargetRevision: "main",
+		Ref:            "values",
+	}}
+	proj := newTestProject()
+
+	expectedSources := []v1alpha1.ApplicationSource{
+		app.Spec.SourceHydrator.GetDrySource(),
+		{RepoURL: "https://example.com/values", TargetRevision: "main", Ref: "values"},
+	}
+	d.EXPECT().GetRepoObjs(mock.Anything, app, expectedSources, "sha123", proj).Return([]*unstructured.Unstructured{}, []*repoclient.ManifestResponse{
+		{Revision: "sha123", Commands: []string{"helm template"}},
+		{Revision: "valuessha"},
+	}, nil)
+
+	rev, pathDetails, err := h.getManifests(t.Context(), app, "sha123", proj)
+	require.NoError(t, err)
+	assert.Equal(t, "sha123", rev)
+	assert.Equal(t, []string{"helm template"}, pathDetails.Commands)
+	assert.Equal(t, []string{"valuessha"}, pathDetails.AdditionalDrySHAs)
+}
+
 func TestHydrator_getManifests_EmptyTargetRevision(t *testing.T) {
 	t.Parallel()
 	d := mocks.NewDependencies(t)
@@ -1071,7 +1108,7 @@ func TestHydrator_getManifests_EmptyTargetRevision(t *testing.T) {
 	app := newTestApp("test-app")
 	proj := newTestProject()
 
-	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, "main", proj).Return([]*unstructured.Unstructured{}, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, "main", proj).Return([]*unstructured.Unstructured{}, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
 
 	rev, pathDetails, err := h.getManifests(t.Context(), app, "", proj)
 	require.NoError(t, err)
@@ -1117,13 +1154,267 @@ func TestHydrator_hydrate_DeDupe_Success(t *testing.T) {
 
 	// Asserting .Once() confirms that we only make one call to repo-server to get the last hydrated DRY
 	// sha, and then we quit early.
-	d.On("GetRepoObjs", mock.Anything, app1, app1.Spec.SourceHydrator.GetDrySource(), "main", proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil).Once()
+	d.On("GetRepoObjs", mock.Anything, app1, []v1alpha1.ApplicationSource{app1.Spec.SourceHydrator.GetDrySource()}, "main", proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil).Once()
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(logCtx, apps, projects)
+	sha, hydratedSha, _, _, errs, err := h.hydrate(logCtx, apps, projects)
 
 	require.NoError(t, err)
 	assert.Equal(t, "sha123", sha)
 	assert.Equal(t, "hydrated123", hydratedSha)
 	assert.Empty(t, errs)
 }
+
+func TestHydrator_hydrate_DeDupe_AdditionalDrySources(t *testing.T) {
+	t.Parallel()
+
+	newApps := func() []*v1alpha1.Application {
+		app1 := newTestApp("app1")
+		app2 := newTestApp("app2")
+		app2.Spec.SourceHydrator.SyncSource.Path = "app2"
+		for i, app := range []*v1alpha1.Application{app1, app2} {
+			app.Spec.SourceHydrator.AdditionalDrySources = []v1alpha1.DrySource{{RepoURL: "https://example.com/values", TargetRevision: "main", Ref: "values"}}
+			app.Status.SourceHydrator.LastSuccessfulOperation = &v1alpha1.SuccessfulHydrateOperation{
+				DrySHA:            "sha123",
+				HydratedSHA:       "hydrated123",
+				SourceHydrator:    *app.Spec.SourceHydrator,
+				AdditionalDrySHAs: []string{fmt.Sprintf("values%d", i+1)},
+			}
+		}
+		return []*v1alpha1.Application{app1, app2}
+	}
+
+	t.Run("unchanged", func(t *testing.T) {
+		t.Parallel()
+		d := mocks.NewDependencies(t)
+		r := mocks.NewRepoGetter(t)
+		rc := reposervermocks.NewRepoServerServiceClient(t)
+		h := &Hydrator{dependencies: d, repoGetter: r, repoClientset: &reposervermocks.Clientset{RepoServerServiceClient: rc}}
+		apps := newApps()
+		proj := newTestProject()
+		projects := map[string]*v1alpha1.AppProject{apps[0].Spec.Project: proj}
+		valuesRepo := &v1alpha1.Repository{Repo: "https://example.com/values"}
+
+		d.EXPECT().GetRepoObjs(mock.Anything, apps[0], []v1alpha1.ApplicationSource(apps[0].Spec.SourceHydrator.GetDrySources()), "main", proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}, {Revision: "values1"}}, nil).Once()
+		r.EXPECT().GetRepository(mock.Anything, valuesRepo.Repo, proj.Name).Return(valuesRepo, nil).Once()
+		rc.EXPECT().ResolveRevision(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, in *repoclient.ResolveRevisionRequest, _ ...grpc.CallOption) (*repoclient.ResolveRevisionResponse, error) {
+			assert.Equal(t, valuesRepo, in.Repo)
+			assert.Equal(t, "main", in.AmbiguousRevision)
+			assert.Equal(t, valuesRepo.Repo, in.App.Spec.GetSourcePtrByIndex(int(in.SourceIndex)).RepoURL)
+			return &repoclient.ResolveRevisionResponse{Revision: "values2"}, nil
+		}).Once()
+		logCtx := log.NewEntry(log.StandardLogger())
+
+		sha, hydratedSha, _, additionalDrySHAs, errs, err := h.hydrate(logCtx, apps, projects)
+
+		require.NoError(t, err)
+		assert.Equal(t, "sha123", sha)
+		assert.Equal(t, "hydrated123", hydratedSha)
+		assert.Nil(t, additionalDrySHAs)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("changed", func(t *testing.T) {
+		t.Parallel()
+		r := mocks.NewRepoGetter(t)
+		rc := reposervermocks.NewRepoServerServiceClient(t)
+		h := &Hydrator{repoGetter: r, repoClientset: &reposervermocks.Clientset{RepoServerServiceClient: rc}}
+		apps := newApps()
+		valuesRepo := &v1alpha1.Repository{Repo: "https://example.com/values"}
+
+		r.EXPECT().GetRepository(mock.Anything, valuesRepo.Repo, "test-project").Return(valuesRepo, nil).Once()
+		rc.EXPECT().ResolveRevision(mock.Anything, mock.Anything).Return(&repoclient.ResolveRevisionResponse{Revision: "values3"}, nil).Once()
+		logCtx := log.NewEntry(log.StandardLogger())
+
+		assert.True(t, h.additionalDrySourcesChanged(t.Context(), logCtx, apps, []string{"values1"}))
+		// The first app was rendered with other revisions, so the other apps aren't resolved.
+		assert.True(t, h.additionalDrySourcesChanged(t.Context(), logCtx, apps, []string{"values3"}))
+	})
+}
+
+func TestHydrator_hydrate_CarriesForwardUnchangedApps(t *testing.T) {
+	t.Parallel()
+
+	d := mocks.NewDependencies(t)
+	r := mocks.NewRepoGetter(t)
+	cc := commitservermocks.NewCommitServiceClient(t)
+	rc := reposervermocks.NewRepoServerServiceClient(t)
+	h := &Hydrator{
+		dependencies:    d,
+		repoGetter:      r,
+		repoClientset:   &reposervermocks.Clientset{RepoServerServiceClient: rc},
+		commitClientset: &commitservermocks.Clientset{CommitServiceClient: cc},
+	}
+
+	app1 := newTestApp("app1")
+	app2 := newTestApp("app2")
+	app2.Spec.SourceHydrator.SyncSource.Path = "app2"
+	app3 := newTestApp("app3")
+	app3.Spec.SourceHydrator.SyncSource.Path = "app3"
+	for _, app := range []*v1alpha1.Application{app2, app3} {
+		app.Annotations = map[string]string{v1alpha1.AnnotationKeyManifestGeneratePaths: "."}
+		app.Status.SourceHydrator.LastSuccessfulOperation = &v1alpha1.SuccessfulHydrateOperation{
+			DrySHA:         "sha100",
+			HydratedSHA:    "hydrated100",
+			SourceHydrator: *app.Spec.SourceHydrator,
+		}
+	}
+	app3.Spec.SourceHydrator.DrySource.Path = "base/app3"
+	app3.Status.SourceHydrator.LastSuccessfulOperation.SourceHydrator.DrySource.Path = "base/app3"
+	apps := []*v1alpha1.Application{app1, app2, app3}
+	proj := newTestProject()
+	projects := map[string]*v1alpha1.AppProject{app1.Spec.Project: proj}
+	repo := &v1alpha1.Repository{Repo: "https://example.com/repo"}
+
+	d.EXPECT().GetRepoObjs(mock.Anything, app1, []v1alpha1.ApplicationSource{app1.Spec.SourceHydrator.GetDrySource()}, "main", proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
+	d.EXPECT().GetRepoObjs(mock.Anything, app3, []v1alpha1.ApplicationSource{app3.Spec.SourceHydrator.GetDrySource()}, "sha123", proj).Return(nil, []*repoclient.ManifestResponse{{Revision: "sha123"}}, nil)
+	r.EXPECT().GetRepository(mock.Anything, repo.Repo, proj.Name).Return(repo, nil)
+	d.EXPECT().GetUpdateRevisionForPathsRequest(mock.Anything, mock.Anything, mock.Anything, "sha123", "sha100", proj).RunAndReturn(func(_ context.Context, app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, revision, syncedRevision string, _ *v1alpha1.AppProject) (*repoclient.UpdateRevisionForPathsRequest, error) {
+		assert.Equal(t, []v1alpha1.ApplicationSource(app.Spec.SourceHydrator.GetDrySources()), sources)
+		return &repoclient.UpdateRevisionForPathsRequest{Repo: repo, AppName: app.Name, Revision: revision, SyncedRevision: syncedRevision}, nil
+	}).Times(2)
+	rc.EXPECT().UpdateRevisionForPaths(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, in *repoclient.UpdateRevisionForPathsRequest, _ ...grpc.CallOption) (*repoclient.UpdateRevisionForPathsResponse, error) {
+		assert.Equal(t, "sha123", in.Revision)
+		assert.Equal(t, "sha100", in.SyncedRevision)
+		assert.NotEmpty(t, in.Paths)
+		// Only the inputs of app3 changed.
+		return &repoclient.UpdateRevisionForPathsResponse{Revision: in.Revision, Changes: in.AppName == app3.Name}, nil
+	}).Times(2)
+	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{Message: "metadata"}, nil)
+	d.EXPECT().GetWriteCredentials(mock.Anything, repo.Repo, proj.Name).Return(repo, nil)
+	d.EXPECT().GetHydratorCommitMessageTemplate().Return("commit message", nil)
+	cc.EXPECT().CommitHydratedManifests(mock.Anything, mock.Anything).Return(&commitclient.CommitHydratedManifestsResponse{HydratedSha: "hydrated123"}, nil).Run(func(_ context.Context, in *commitclient.CommitHydratedManifestsRequest, _ ...grpc.CallOption) {
+		require.Len(t, in.Paths, 2)
+		assert.Equal(t, app1.Spec.SourceHydrator.SyncSource.Path, in.Paths[0].Path)
+		assert.Equal(t, app3.Spec.SourceHydrator.SyncSource.Path, in.Paths[1].Path)
+	})
+	logCtx := log.NewEntry(log.StandardLogger())
+
+	sha, hydratedSha, _, _, errs, err := h.hydrate(logCtx, apps, projects)
+
+	require.NoError(t, err)
+	assert.Equal(t, "sha123", sha)
+	assert.Equal(t, "hydrated123", hydratedSha)
+	assert.Empty(t, errs)
+}
+
+func TestHydrator_dryInputsChanged(t *testing.T) {
+	t.Parallel()
+
+	newHydratedApp := func() *v1alpha1.Application {
+		app := newTestApp("app")
+		app.Annotations = map[string]string{v1alpha1.AnnotationKeyManifestGeneratePaths: "."}
+		app.Status.SourceHydrator.LastSuccessfulOperation = &v1alpha1.SuccessfulHydrateOperation{
+			DrySHA:         "sha100",
+			SourceHydrator: *app.Spec.SourceHydrator,
+		}
+		return app
+	}
+
+	testCases := []struct {
+		name      string
+		repoURL   string
+		modifyApp func(app *v1alpha1.Application)
+	}{
+		{
+			name:    "hydrated to OCI",
+			repoURL: "oci://example.com/hydrated",
+		},
+		{
+			name: "never hydrated",
+			modifyApp: func(app *v1alpha1.Application) {
+				app.Status.SourceHydrator.LastSuccessfulOperation = nil
+			},
+		},
+		{
+			name: "no manifest-generate-paths annotation",
+			modifyApp: func(app *v1alpha1.Application) {
+				app.Annotations = nil
+			},
+		},
+		{
+			name: "spec.sourceHydrator changed",
+			modifyApp: func(app *v1alpha1.Application) {
+				app.Spec.SourceHydrator.DrySource.Path = "base/other"
+			},
+		},
+		{
+			name: "hydrate requested",
+			modifyApp: func(app *v1alpha1.Application) {
+				app.Annotations[v1alpha1.AnnotationKeyHydrate] = "normal"
+			},
+		},
+		{
+			name: "additional dry sources without resolved revisions",
+			modifyApp: func(app *v1alpha1.Application) {
+				app.Spec.SourceHydrator.AdditionalDrySources = []v1alpha1.DrySource{{RepoURL: "https://example.com/other", Path: "values"}}
+				app.Status.SourceHydrator.LastSuccessfulOperation.SourceHydrator = *app.Spec.SourceHydrator
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			// The repo getter and repo server must not be called.
+			h := &Hydrator{repoGetter: mocks.NewRepoGetter(t)}
+			app := newHydratedApp()
+			if tc.modifyApp != nil {
+				tc.modifyApp(app)
+			}
+			repoURL := tc.repoURL
+			if repoURL == "" {
+				repoURL = "https://example.com/repo"
+			}
+
+			changed, err := h.dryInputsChanged(t.Context(), app, repoURL, "sha123", newTestProject())
+
+			require.NoError(t, err)
+			assert.True(t, changed)
+		})
+	}
+}
+
+func TestHydrator_dryInputsChanged_AdditionalDrySources(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name            string
+		resolvedValues  string
+		expectedChanged bool
+	}{
+		{name: "additional dry sources changed", resolvedValues: "values2", expectedChanged: true},
+		{name: "additional dry sources unchanged", resolvedValues: "values1", expectedChanged: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			d := mocks.NewDependencies(t)
+			r := mocks.NewRepoGetter(t)
+			rc := reposervermocks.NewRepoServerServiceClient(t)
+			h := &Hydrator{dependencies: d, repoGetter: r, repoClientset: &reposervermocks.Clientset{RepoServerServiceClient: rc}}
+			app := newTestApp("app")
+			app.Annotations = map[string]string{v1alpha1.AnnotationKeyManifestGeneratePaths: "."}
+			app.Spec.SourceHydrator.AdditionalDrySources = []v1alpha1.DrySource{{RepoURL: "https://example.com/values", TargetRevision: "main", Ref: "values"}}
+			app.Status.SourceHydrator.LastSuccessfulOperation = &v1alpha1.SuccessfulHydrateOperation{
+				DrySHA:            "sha100",
+				SourceHydrator:    *app.Spec.SourceHydrator,
+				AdditionalDrySHAs: []string{"values1"},
+			}
+			valuesRepo := &v1alpha1.Repository{Repo: "https://example.com/values"}
+
+			r.EXPECT().GetRepository(mock.Anything, valuesRepo.Repo, "test-project").Return(valuesRepo, nil).Once()
+			rc.EXPECT().ResolveRevision(mock.Anything, mock.Anything).Return(&repoclient.ResolveRevisionResponse{Revision: tc.resolvedValues}, nil).Once()
+			if !tc.expectedChanged {
+				// The paths of the primary dry source are only compared if the additional dry sources are unchanged.
+				repo := &v1alpha1.Repository{Repo: "https://example.com/repo"}
+				d.EXPECT().GetUpdateRevisionForPathsRequest(mock.Anything, app, mock.Anything, "sha123", "sha100", mock.Anything).Return(&repoclient.UpdateRevisionForPathsRequest{Repo: repo}, nil).Once()
+				rc.EXPECT().UpdateRevisionForPaths(mock.Anything, mock.Anything).Return(&repoclient.UpdateRevisionForPathsResponse{Changes: false}, nil).Once()
+			}
+
+			changed, err := h.dryInputsChanged(t.Context(), app, "https://example.com/repo", "sha123", newTestProject())
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedChanged, changed)
+		})
+	}
+}
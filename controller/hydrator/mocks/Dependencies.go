@@ -252,35 +252,35 @@ func (_c *Dependencies_GetProcessableApps_Call) RunAndReturn(run func() (*v1alph
 }
 
 // GetRepoObjs provides a mock function for the type Dependencies
-func (_mock *Dependencies) GetRepoObjs(ctx context.Context, app *v1alpha1.Application, source v1alpha1.ApplicationSource, revision string, project *v1alpha1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error) {
-	ret := _mock.Called(ctx, app, source, revision, project)
+func (_mock *Dependencies) GetRepoObjs(ctx context.Context, app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, revision string, project *v1alpha1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error) {
+	ret := _mock.Called(ctx, app, sources, revision, project)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetRepoObjs")
 	}
 
 	var r0 []*unstructured.Unstructured
-	var r1 *apiclient.ManifestResponse
+	var r1 []*apiclient.ManifestResponse
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1alpha1.Application, v1alpha1.ApplicationSource, string, *v1alpha1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error)); ok {
-		return returnFunc(ctx, app, source, revision, project)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1alpha1.Application, []v1alpha1.ApplicationSource, string, *v1alpha1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error)); ok {
+		return returnFunc(ctx, app, sources, revision, project)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1alpha1.Application, v1alpha1.ApplicationSource, string, *v1alpha1.AppProject) []*unstructured.Unstructured); ok {
-		r0 = returnFunc(ctx, app, source, revision, project)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1alpha1.Application, []v1alpha1.ApplicationSource, string, *v1alpha1.AppProject) []*unstructured.Unstructured); ok {
+		r0 = returnFunc(ctx, app, sources, revision, project)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*unstructured.Unstructured)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1alpha1.Application, v1alpha1.ApplicationSource, string, *v1alpha1.AppProject) *apiclient.ManifestResponse); ok {
-		r1 = returnFunc(ctx, app, source, revision, project)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1alpha1.Application, []v1alpha1.ApplicationSource, string, *v1alpha1.AppProject) []*apiclient.ManifestResponse); ok {
+		r1 = returnFunc(ctx, app, sources, revision, project)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*apiclient.ManifestResponse)
+			r1 = ret.Get(1).([]*apiclient.ManifestResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, *v1alpha1.Application, v1alpha1.ApplicationSource, string, *v1alpha1.AppProject) error); ok {
-		r2 = returnFunc(ctx, app, source, revision, project)
+	if returnFunc, ok := ret.Get(2).(func(context.Context, *v1alpha1.Application, []v1alpha1.ApplicationSource, string, *v1alpha1.AppProject) error); ok {
+		r2 = returnFunc(ctx, app, sources, revision, project)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -295,14 +295,14 @@ type Dependencies_GetRepoObjs_Call struct {
 // GetRepoObjs is a helper method to define mock.On call
 //   - ctx context.Context
 //   - app *v1alpha1.Application
-//   - source v1alpha1.ApplicationSource
+//   - sources []v1alpha1.ApplicationSource
 //   - revision string
 //   - project *v1alpha1.AppProject
-func (_e *Dependencies_Expecter) GetRepoObjs(ctx interface{}, app interface{}, source interface{}, revision interface{}, project interface{}) *Dependencies_GetRepoObjs_Call {
-	return &Dependencies_GetRepoObjs_Call{Call: _e.mock.On("GetRepoObjs", ctx, app, source, revision, project)}
+func (_e *Dependencies_Expecter) GetRepoObjs(ctx interface{}, app interface{}, sources interface{}, revision interface{}, project interface{}) *Dependencies_GetRepoObjs_Call {
+	return &Dependencies_GetRepoObjs_Call{Call: _e.mock.On("GetRepoObjs", ctx, app, sources, revision, project)}
 }
 
-func (_c *Dependencies_GetRepoObjs_Call) Run(run func(ctx context.Context, app *v1alpha1.Application, source v1alpha1.ApplicationSource, revision string, project *v1alpha1.AppProject)) *Dependencies_GetRepoObjs_Call {
+func (_c *Dependencies_GetRepoObjs_Call) Run(run func(ctx context.Context, app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, revision string, project *v1alpha1.AppProject)) *Dependencies_GetRepoObjs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -312,9 +312,9 @@ func (_c *Dependencies_GetRepoObjs_Call) Run(run func(ctx context.Context, app *
 		if args[1] != nil {
 			arg1 = args[1].(*v1alpha1.Application)
 		}
-		var arg2 v1alpha1.ApplicationSource
+		var arg2 []v1alpha1.ApplicationSource
 		if args[2] != nil {
-			arg2 = args[2].(v1alpha1.ApplicationSource)
+			arg2 = args[2].([]v1alpha1.ApplicationSource)
 		}
 		var arg3 string
 		if args[3] != nil {
@@ -335,12 +335,104 @@ func (_c *Dependencies_GetRepoObjs_Call) Run(run func(ctx context.Context, app *
 	return _c
 }
 
-func (_c *Dependencies_GetRepoObjs_Call) Return(unstructureds []*unstructured.Unstructured, manifestResponse *apiclient.ManifestResponse, err error) *Dependencies_GetRepoObjs_Call {
-	_c.Call.Return(unstructureds, manifestResponse, err)
+func (_c *Dependencies_GetRepoObjs_Call) Return(unstructureds []*unstructured.Unstructured, manifestResponses []*apiclient.ManifestResponse, err error) *Dependencies_GetRepoObjs_Call {
+	_c.Call.Return(unstructureds, manifestResponses, err)
 	return _c
 }
 
-func (_c *Dependencies_GetRepoObjs_Call) RunAndReturn(run func(ctx context.Context, app *v1alpha1.Application, source v1alpha1.ApplicationSource, revision string, project *v1alpha1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error)) *Dependencies_GetRepoObjs_Call {
+func (_c *Dependencies_GetRepoObjs_Call) RunAndReturn(run func(ctx context.Context, app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, revision string, project *v1alpha1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error)) *Dependencies_GetRepoObjs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUpdateRevisionForPathsRequest provides a mock function for the type Dependencies
+func (_mock *Dependencies) GetUpdateRevisionForPathsRequest(ctx context.Context, app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, revision string, syncedRevision string, project *v1alpha1.AppProject) (*apiclient.UpdateRevisionForPathsRequest, error) {
+	ret := _mock.Called(ctx, app, sources, revision, syncedRevision, project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUpdateRevisionForPathsRequest")
+	}
+
+	var r0 *apiclient.UpdateRevisionForPathsRequest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1alpha1.Application, []v1alpha1.ApplicationSource, string, string, *v1alpha1.AppProject) (*apiclient.UpdateRevisionForPathsRequest, error)); ok {
+		return returnFunc(ctx, app, sources, revision, syncedRevision, project)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1alpha1.Application, []v1alpha1.ApplicationSource, string, string, *v1alpha1.AppProject) *apiclient.UpdateRevisionForPathsRequest); ok {
+		r0 = returnFunc(ctx, app, sources, revision, syncedRevision, project)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiclient.UpdateRevisionForPathsRequest)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1alpha1.Application, []v1alpha1.ApplicationSource, string, string, *v1alpha1.AppProject) error); ok {
+		r1 = returnFunc(ctx, app, sources, revision, syncedRevision, project)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Dependencies_GetUpdateRevisionForPathsRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUpdateRevisionForPathsRequest'
+type Dependencies_GetUpdateRevisionForPathsRequest_Call struct {
+	*mock.Call
+}
+
+// GetUpdateRevisionForPathsRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - app *v1alpha1.Application
+//   - sources []v1alpha1.ApplicationSource
+//   - revision string
+//   - syncedRevision string
+//   - project *v1alpha1.AppProject
+func (_e *Dependencies_Expecter) GetUpdateRevisionForPathsRequest(ctx interface{}, app interface{}, sources interface{}, revision interface{}, syncedRevision interface{}, project interface{}) *Dependencies_GetUpdateRevisionForPathsRequest_Call {
+	return &Dependencies_GetUpdateRevisionForPathsRequest_Call{Call: _e.mock.On("GetUpdateRevisionForPathsRequest", ctx, app, sources, revision, syncedRevision, project)}
+}
+
+func (_c *Dependencies_GetUpdateRevisionForPathsRequest_Call) Run(run func(ctx context.Context, app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, revision string, syncedRevision string, project *v1alpha1.AppProject)) *Dependencies_GetUpdateRevisionForPathsRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v1alpha1.Application
+		if args[1] != nil {
+			arg1 = args[1].(*v1alpha1.Application)
+		}
+		var arg2 []v1alpha1.ApplicationSource
+		if args[2] != nil {
+			arg2 = args[2].([]v1alpha1.ApplicationSource)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 *v1alpha1.AppProject
+		if args[5] != nil {
+			arg5 = args[5].(*v1alpha1.AppProject)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *Dependencies_GetUpdateRevisionForPathsRequest_Call) Return(updateRevisionForPathsRequest *apiclient.UpdateRevisionForPathsRequest, err error) *Dependencies_GetUpdateRevisionForPathsRequest_Call {
+	_c.Call.Return(updateRevisionForPathsRequest, err)
+	return _c
+}
+
+func (_c *Dependencies_GetUpdateRevisionForPathsRequest_Call) RunAndReturn(run func(ctx context.Context, app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, revision string, syncedRevision string, project *v1alpha1.AppProject) (*apiclient.UpdateRevisionForPathsRequest, error)) *Dependencies_GetUpdateRevisionForPathsRequest_Call {
 	_c.Call.Return(run)
 	return _c
 }
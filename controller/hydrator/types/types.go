@@ -7,5 +7,7 @@ type HydrationQueueKey struct {
 	// operation because two apps have different URL formats.
 	SourceRepoURL        string
 	SourceTargetRevision string
-	DestinationBranch    string
+	// DestinationRepoURL must be normalized like SourceRepoURL. It is the repository hydrated manifests are pushed to.
+	DestinationRepoURL string
+	DestinationBranch  string
 }
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/argoproj/argo-cd/v3/controller/hydrator/types"
@@ -31,9 +32,13 @@ func (ctrl *ApplicationController) GetProcessableApps() (*appv1.ApplicationList,
 	return ctrl.getAppList(metav1.ListOptions{})
 }
 
-func (ctrl *ApplicationController) GetRepoObjs(ctx context.Context, origApp *appv1.Application, drySource appv1.ApplicationSource, revision string, project *appv1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error) {
-	drySources := []appv1.ApplicationSource{drySource}
-	dryRevisions := []string{revision}
+func (ctrl *ApplicationController) GetRepoObjs(ctx context.Context, origApp *appv1.Application, drySources []appv1.ApplicationSource, revision string, project *appv1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error) {
+	// Only the primary dry source is pinned to the given revision. Empty revisions fall back to each source's target
+	// revision.
+	dryRevisions := make([]string, len(drySources))
+	if len(dryRevisions) > 0 {
+		dryRevisions[0] = revision
+	}
 
 	appLabelKey, err := ctrl.settingsMgr.GetAppInstanceLabelKey()
 	if err != nil {
@@ -64,11 +69,57 @@ func (ctrl *ApplicationController) GetRepoObjs(ctx context.Context, origApp *app
 		}
 	}
 
-	if len(resp) != 1 {
-		return nil, nil, fmt.Errorf("expected one manifest response, got %d", len(resp))
+	if len(resp) != len(drySources) {
+		return nil, nil, fmt.Errorf("expected %d manifest responses, got %d", len(drySources), len(resp))
+	}
+
+	return objs, resp, nil
+}
+
+func (ctrl *ApplicationController) GetUpdateRevisionForPathsRequest(ctx context.Context, app *appv1.Application, sources []appv1.ApplicationSource, revision, syncedRevision string, project *appv1.AppProject) (*apiclient.UpdateRevisionForPathsRequest, error) {
+	if len(sources) == 0 {
+		return nil, errors.New("no sources to compare")
+	}
+	// The fields match the manifest requests GetRepoObjs sends for the hydrator: the runtime state of the destination
+	// cluster is not sent, the revision cache is not used, and only the first source is pinned to a revision.
+	revisions := make([]string, len(sources))
+	revisions[0] = revision
+
+	appLabelKey, err := ctrl.settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get app instance label key: %w", err)
+	}
+	trackingMethod, err := ctrl.settingsMgr.GetTrackingMethod()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tracking method: %w", err)
+	}
+	installationID, err := ctrl.settingsMgr.GetInstallationID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get installation ID: %w", err)
+	}
+	repo, err := ctrl.db.GetRepository(ctx, sources[0].RepoURL, project.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repo %q: %w", sources[0].RepoURL, err)
+	}
+	refSources, err := argoutil.GetRefSources(ctx, sources, app.Spec.Project, ctrl.db.GetRepository, revisions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ref sources: %w", err)
 	}
 
-	return objs, resp[0], nil
+	return &apiclient.UpdateRevisionForPathsRequest{
+		Repo:               repo,
+		Revision:           revision,
+		SyncedRevision:     syncedRevision,
+		NoRevisionCache:    true,
+		AppLabelKey:        appLabelKey,
+		AppName:            app.InstanceName(ctrl.namespace),
+		ApplicationSource:  &sources[0],
+		ApiVersions:        argoutil.APIResourcesToStrings(nil, true),
+		TrackingMethod:     trackingMethod,
+		RefSources:         refSources,
+		HasMultipleSources: app.Spec.HasMultipleSources() || (app.Spec.SourceHydrator != nil && len(sources) > 1),
+		InstallationID:     installationID,
+	}, nil
 }
 
 func (ctrl *ApplicationController) GetWriteCredentials(ctx context.Context, repoURL string, project string) (*appv1.Repository, error) {
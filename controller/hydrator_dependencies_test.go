@@ -13,7 +13,10 @@ import (
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	mockrepoclient "github.com/argoproj/argo-cd/v3/reposerver/apiclient/mocks"
+	reposervercache "github.com/argoproj/argo-cd/v3/reposerver/cache"
 	"github.com/argoproj/argo-cd/v3/test"
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
@@ -47,7 +50,7 @@ func TestGetRepoObjs(t *testing.T) {
 	source := app.Spec.GetSource()
 	source.RepoURL = "oci://example.com/argo/argo-cd"
 
-	objs, resp, err := ctrl.GetRepoObjs(t.Context(), app, source, "abc123", &v1alpha1.AppProject{
+	objs, resp, err := ctrl.GetRepoObjs(t.Context(), app, []v1alpha1.ApplicationSource{source}, "abc123", &v1alpha1.AppProject{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "default",
 			Namespace: test.FakeArgoCDNamespace,
@@ -63,8 +66,8 @@ func TestGetRepoObjs(t *testing.T) {
 		},
 	})
 	require.NoError(t, err)
-	assert.NotNil(t, resp)
-	assert.Equal(t, "abc123", resp.Revision)
+	require.Len(t, resp, 1)
+	assert.Equal(t, "abc123", resp[0].Revision)
 	assert.Len(t, objs, 1)
 
 	annotations := objs[0].GetAnnotations()
@@ -79,6 +82,68 @@ func TestGetRepoObjs(t *testing.T) {
 	assert.Equal(t, "ConfigMap", objs[0].GetKind())
 }
 
+func TestGetUpdateRevisionForPathsRequest(t *testing.T) {
+	cm := test.NewConfigMap()
+	cmBytes, _ := json.Marshal(cm)
+
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{string(cmBytes)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+	}
+
+	ctrl := newFakeControllerWithResync(t.Context(), &data, time.Minute, nil, errors.New("this should not be called"))
+	app := newFakeApp()
+	source := app.Spec.GetSource()
+	source.RepoURL = "oci://example.com/argo/argo-cd"
+	sources := []v1alpha1.ApplicationSource{source}
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "default",
+			Namespace: test.FakeArgoCDNamespace,
+		},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}
+
+	_, _, err := ctrl.GetRepoObjs(t.Context(), app, sources, "abc123", proj)
+	require.NoError(t, err)
+	mockRepoClient := ctrl.appStateManager.(*appStateManager).repoClientset.(*mockrepoclient.Clientset).RepoServerServiceClient.(*mockrepoclient.RepoServerServiceClient)
+	var manifestRequest *apiclient.ManifestRequest
+	for _, call := range mockRepoClient.Calls {
+		if call.Method == "GenerateManifest" {
+			manifestRequest = call.Arguments.Get(1).(*apiclient.ManifestRequest)
+		}
+	}
+	require.NotNil(t, manifestRequest)
+
+	request, err := ctrl.GetUpdateRevisionForPathsRequest(t.Context(), app, sources, "def456", "abc123", proj)
+	require.NoError(t, err)
+	assert.Equal(t, "def456", request.Revision)
+	assert.Equal(t, "abc123", request.SyncedRevision)
+	assert.Equal(t, manifestRequest.AppLabelKey, request.AppLabelKey)
+	assert.Equal(t, manifestRequest.TrackingMethod, request.TrackingMethod)
+	assert.Equal(t, manifestRequest.InstallationID, request.InstallationID)
+
+	// The repo server moves the cached manifests of the synced revision to the new revision if the paths are
+	// unchanged. The keys only match if the request carries the same fields as the manifest request.
+	repoCache := reposervercache.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Hour, time.Hour, time.Hour)
+	err = repoCache.SetManifests("abc123", manifestRequest.ApplicationSource, manifestRequest.RefSources, manifestRequest, manifestRequest.Namespace, manifestRequest.TrackingMethod, manifestRequest.AppLabelKey, manifestRequest.AppName, &reposervercache.CachedManifestResponse{ManifestResponse: data.manifestResponse}, nil, manifestRequest.InstallationID)
+	require.NoError(t, err)
+	err = repoCache.SetNewRevisionManifests("def456", "abc123", request.ApplicationSource, request.RefSources, request, request.Namespace, request.TrackingMethod, request.AppLabelKey, request.AppName, nil, request.InstallationID)
+	require.NoError(t, err)
+	manifestRequest.Revision = "def456"
+	cached := &reposervercache.CachedManifestResponse{}
+	err = repoCache.GetManifests("def456", manifestRequest.ApplicationSource, manifestRequest.RefSources, manifestRequest, manifestRequest.Namespace, manifestRequest.TrackingMethod, manifestRequest.AppLabelKey, manifestRequest.AppName, cached, nil, manifestRequest.InstallationID)
+	require.NoError(t, err)
+	assert.Equal(t, data.manifestResponse.Manifests, cached.ManifestResponse.Manifests)
+}
+
 func TestGetHydratorCommitMessageTemplate_WhenTemplateisNotDefined_FallbackToDefault(t *testing.T) {
 	cm := test.NewConfigMap()
 	cmBytes, _ := json.Marshal(cm)
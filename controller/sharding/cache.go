@@ -21,6 +21,7 @@ type ClusterShardingCache interface {
 	GetDistribution() map[string]int
 	GetAppDistribution() map[string]int
 	UpdateShard(shard int) bool
+	GetShard() int
 }
 
 type ClusterSharding struct {
@@ -266,6 +267,13 @@ func (sharding *ClusterSharding) GetAppDistribution() map[string]int {
 	return appDistribution
 }
 
+// GetShard returns the shard of ClusterSharding.
+func (sharding *ClusterSharding) GetShard() int {
+	sharding.lock.RLock()
+	defer sharding.lock.RUnlock()
+	return sharding.Shard
+}
+
 // UpdateShard will update the shard of ClusterSharding when the shard has changed.
 func (sharding *ClusterSharding) UpdateShard(shard int) bool {
 	if shard != sharding.Shard {
@@ -94,6 +94,7 @@ type comparisonResult struct {
 	// timings maps phases of comparison to the duration it took to complete (for statistical purposes)
 	timings            map[string]time.Duration
 	diffResultList     *diff.DiffResultList
+	hasPreDeleteHooks  bool
 	hasPostDeleteHooks bool
 	// revisionsMayHaveChanges indicates if there are any possibilities that the revisions contain changes
 	revisionsMayHaveChanges bool
@@ -232,6 +233,10 @@ func (m *appStateManager) GetRepoObjs(ctx context.Context, app *v1alpha1.Applica
 
 	keyManifestGenerateAnnotationVal, keyManifestGenerateAnnotationExists := app.Annotations[v1alpha1.AnnotationKeyManifestGeneratePaths]
 
+	// Apps using the source hydrator may render more than one dry source. Those need to be treated as multi-source so
+	// that ref-only sources are skipped and $ref value files are resolved.
+	hasMultipleSources := app.Spec.HasMultipleSources() || (app.Spec.SourceHydrator != nil && len(sources) > 1)
+
 	for i, source := range sources {
 		if len(revisions) < len(sources) || revisions[i] == "" {
 			revisions[i] = source.TargetRevision
@@ -281,7 +286,7 @@ func (m *appStateManager) GetRepoObjs(ctx context.Context, app *v1alpha1.Applica
 				ApiVersions:        apiVersions,
 				TrackingMethod:     trackingMethod,
 				RefSources:         refSources,
-				HasMultipleSources: app.Spec.HasMultipleSources(),
+				HasMultipleSources: hasMultipleSources,
 				InstallationID:     installationID,
 			})
 			if err != nil {
@@ -331,7 +336,7 @@ func (m *appStateManager) GetRepoObjs(ctx context.Context, app *v1alpha1.Applica
 			TrackingMethod:                  trackingMethod,
 			EnabledSourceTypes:              enabledSourceTypes,
 			HelmOptions:                     helmOptions,
-			HasMultipleSources:              app.Spec.HasMultipleSources(),
+			HasMultipleSources:              hasMultipleSources,
 			RefSources:                      refSources,
 			ProjectName:                     proj.Name,
 			ProjectSourceRepos:              proj.Spec.SourceRepos,
@@ -647,6 +652,23 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		// empty out manifestInfoMap
 		manifestInfos = make([]*apiclient.ManifestResponse, 0)
 	}
+
+	syncPolicyHooks, err := renderSyncPolicyHooks(app)
+	if err != nil {
+		msg := "Failed to render sync policy hooks: " + err.Error()
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
+		failedToLoadObjs = true
+	}
+	for _, hookObj := range syncPolicyHooks {
+		err = m.resourceTracking.SetAppInstance(hookObj, appLabelKey, app.InstanceName(m.namespace), app.Spec.Destination.Namespace, v1alpha1.TrackingMethod(trackingMethod), installationID)
+		if err != nil {
+			msg := "Failed to set the tracking of sync policy hooks: " + err.Error()
+			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
+			failedToLoadObjs = true
+			break
+		}
+		targetObjs = append(targetObjs, hookObj)
+	}
 	ts.AddCheckpoint("git_ms")
 
 	var infoProvider kubeutil.ResourceInfoProvider
@@ -721,10 +743,32 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		}
 	}
 
-	for _, liveObj := range liveObjByKey {
+	// Resources released by the application to another application are no longer part of the application once the
+	// other application took them over, and resources handed off to the application by another application are not
+	// shared, since the sync of the application moves their tracking to the application.
+	handedOffKeys := make(map[kubeutil.ResourceKey]bool)
+	for i := len(targetObjs) - 1; i >= 0; i-- {
+		key := kubeutil.GetResourceKey(targetObjs[i])
+		liveObj := liveObjByKey[key]
+		if liveObj == nil {
+			continue
+		}
+		trackedBy := m.resourceTracking.GetAppName(liveObj, appLabelKey, v1alpha1.TrackingMethod(trackingMethod), installationID)
+		if trackedBy == "" || trackedBy == app.InstanceName(m.namespace) {
+			continue
+		}
+		if argo.IsResourceReleased(targetObjs[i], trackedBy) {
+			targetObjs = append(targetObjs[:i], targetObjs[i+1:]...)
+			delete(liveObjByKey, key)
+		} else if argo.IsResourceHandedOff(targetObjs[i], liveObj, trackedBy, app.InstanceName(m.namespace)) {
+			handedOffKeys[key] = true
+		}
+	}
+
+	for key, liveObj := range liveObjByKey {
 		if liveObj != nil {
 			appInstanceName := m.resourceTracking.GetAppName(liveObj, appLabelKey, v1alpha1.TrackingMethod(trackingMethod), installationID)
-			if appInstanceName != "" && appInstanceName != app.InstanceName(m.namespace) {
+			if appInstanceName != "" && appInstanceName != app.InstanceName(m.namespace) && !handedOffKeys[key] {
 				fqInstanceName := strings.ReplaceAll(appInstanceName, "_", "/")
 				conditions = append(conditions, v1alpha1.ApplicationCondition{
 					Type:               v1alpha1.ApplicationConditionSharedResourceWarning,
@@ -765,8 +809,12 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 			}
 		}
 	}
+	hasPreDeleteHooks := false
 	hasPostDeleteHooks := false
 	for _, obj := range targetObjs {
+		if isPreDeleteHook(obj) {
+			hasPreDeleteHooks = true
+		}
 		if isPostDeleteHook(obj) {
 			hasPostDeleteHooks = true
 		}
@@ -988,6 +1036,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		reconciliationResult:    reconciliation,
 		diffConfig:              diffConfig,
 		diffResultList:          diffResults,
+		hasPreDeleteHooks:       hasPreDeleteHooks,
 		hasPostDeleteHooks:      hasPostDeleteHooks,
 		revisionsMayHaveChanges: revisionsMayHaveChanges,
 	}
@@ -641,6 +641,100 @@ func TestCompareAppStateManagedNamespaceMetadataWithLiveNsDoesNotGetPruned(t *te
 	assert.Len(t, compRes.managedResources, 1)
 }
 
+// TestCompareAppStateSyncPolicyHooks tests that the hooks of the sync policy are added to the hooks of the sync
+func TestCompareAppStateSyncPolicyHooks(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{Hooks: []v1alpha1.SyncPolicyHook{{
+		Name:  "smoke-test",
+		Type:  synccommon.HookTypePostSync,
+		Image: "busybox",
+	}}}
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(t.Context(), &data, nil)
+	compRes, err := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, []v1alpha1.ApplicationSource{app.Spec.GetSource()}, false, false, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+	assert.Empty(t, app.Status.Conditions)
+	require.Len(t, compRes.reconciliationResult.Hooks, 1)
+	hookObj := compRes.reconciliationResult.Hooks[0]
+	assert.Equal(t, "Job", hookObj.GetKind())
+	assert.Equal(t, "smoke-test", hookObj.GetName())
+	assert.Equal(t, "my-app:batch/Job:"+test.FakeDestNamespace+"/smoke-test", hookObj.GetAnnotations()[common.AnnotationKeyAppInstance])
+}
+
+// TestCompareAppStateResourceHandoff tests that resources handed off between applications are not shared resources
+func TestCompareAppStateResourceHandoff(t *testing.T) {
+	newConfigMap := func(annotations map[string]string) *unstructured.Unstructured {
+		return kube.MustToUnstructured(&corev1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{Name: "configmap1", Namespace: test.FakeDestNamespace, Annotations: annotations},
+		})
+	}
+	trackedByGuestbook := func(annotations map[string]string) *unstructured.Unstructured {
+		annotations[common.AnnotationKeyAppInstance] = "guestbook:/ConfigMap:" + test.FakeDestNamespace + "/configmap1"
+		return newConfigMap(annotations)
+	}
+	compare := func(t *testing.T, target, live *unstructured.Unstructured) (*comparisonResult, *v1alpha1.Application) {
+		t.Helper()
+		app := newFakeApp()
+		targetBytes, err := json.Marshal(target)
+		require.NoError(t, err)
+		data := fakeData{
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{string(targetBytes)},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{kube.GetResourceKey(live): live},
+		}
+		ctrl := newFakeController(t.Context(), &data, nil)
+		compRes, err := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, []v1alpha1.ApplicationSource{app.Spec.GetSource()}, false, false, nil, false)
+		require.NoError(t, err)
+		return compRes, app
+	}
+
+	t.Run("Shared", func(t *testing.T) {
+		_, app := compare(t, newConfigMap(nil), trackedByGuestbook(map[string]string{}))
+		require.Len(t, app.Status.Conditions, 1)
+		assert.Equal(t, v1alpha1.ApplicationConditionSharedResourceWarning, app.Status.Conditions[0].Type)
+	})
+
+	t.Run("Claimed", func(t *testing.T) {
+		compRes, app := compare(t,
+			newConfigMap(map[string]string{common.AnnotationKeyClaimFrom: "guestbook"}),
+			trackedByGuestbook(map[string]string{common.AnnotationKeyReleaseTo: "my-app"}))
+		assert.Empty(t, app.Status.Conditions)
+		assert.Equal(t, v1alpha1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
+		assert.Len(t, compRes.managedResources, 1)
+	})
+
+	t.Run("ClaimedWithoutRelease", func(t *testing.T) {
+		_, app := compare(t,
+			newConfigMap(map[string]string{common.AnnotationKeyClaimFrom: "guestbook"}),
+			trackedByGuestbook(map[string]string{}))
+		require.Len(t, app.Status.Conditions, 1)
+		assert.Equal(t, v1alpha1.ApplicationConditionSharedResourceWarning, app.Status.Conditions[0].Type)
+	})
+
+	t.Run("Released", func(t *testing.T) {
+		compRes, app := compare(t,
+			newConfigMap(map[string]string{common.AnnotationKeyReleaseTo: "guestbook"}),
+			trackedByGuestbook(map[string]string{}))
+		assert.Empty(t, app.Status.Conditions)
+		assert.Equal(t, v1alpha1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+		assert.Empty(t, compRes.managedResources)
+	})
+}
+
 var defaultProj = v1alpha1.AppProject{
 	ObjectMeta: metav1.ObjectMeta{
 		Name:      "default",
@@ -5,9 +5,11 @@ import (
 	stderrors "errors"
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 
@@ -26,6 +28,7 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/kubectl/pkg/util/openapi"
+	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-cd/v3/controller/metrics"
 	"github.com/argoproj/argo-cd/v3/controller/syncid"
@@ -47,6 +50,10 @@ const (
 	// serviceAccountDisallowedCharSet contains the characters that are not allowed to be present
 	// in a DefaultServiceAccount configured for a DestinationServiceAccount
 	serviceAccountDisallowedCharSet = "!*[]{}\\/"
+
+	// maxSyncWaveReadinessMessageLength is the maximum length, in characters, of the message of a readiness script
+	// which is reported in the operation state
+	maxSyncWaveReadinessMessageLength = 1024
 )
 
 func (m *appStateManager) getOpenAPISchema(server *v1alpha1.Cluster) (openapi.Resources, error) {
@@ -220,6 +227,10 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 	initialResourcesRes := make([]common.ResourceSyncResult, len(state.SyncResult.Resources))
 	for i, res := range state.SyncResult.Resources {
 		key := kube.ResourceKey{Group: res.Group, Kind: res.Kind, Namespace: res.Namespace, Name: res.Name}
+		var syncedAt metav1.Time
+		if res.SyncedAt != nil {
+			syncedAt = *res.SyncedAt
+		}
 		initialResourcesRes[i] = common.ResourceSyncResult{
 			ResourceKey: key,
 			Message:     res.Message,
@@ -229,6 +240,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 			SyncPhase:   res.SyncPhase,
 			Version:     res.Version,
 			Images:      res.Images,
+			SyncedAt:    syncedAt,
 			Order:       i + 1,
 		}
 	}
@@ -329,13 +341,14 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		sync.WithInitialState(state.Phase, state.Message, initialResourcesRes, state.StartedAt),
 		sync.WithResourcesFilter(func(key kube.ResourceKey, target *unstructured.Unstructured, live *unstructured.Unstructured) bool {
 			return (len(syncOp.Resources) == 0 ||
-				isPostDeleteHook(target) ||
+				isPreDeleteHook(target) || isPostDeleteHook(target) ||
 				argo.ContainsSyncResource(key.Name, key.Namespace, schema.GroupVersionKind{Kind: key.Kind, Group: key.Group}, syncOp.Resources)) &&
 				m.isSelfReferencedObj(live, target, app.GetName(), v1alpha1.TrackingMethod(trackingMethod), installationID)
 		}),
 		sync.WithManifestValidation(!syncOp.SyncOptions.HasOption(common.SyncOptionsDisableValidation)),
 		sync.WithSyncWaveHook(delayBetweenSyncWaves),
 		sync.WithPruneLast(syncOp.SyncOptions.HasOption(common.SyncOptionPruneLast)),
+		sync.WithContinueOnError(syncOp.SyncOptions.HasOption(common.SyncOptionContinueOnError)),
 		sync.WithResourceModificationChecker(syncOp.SyncOptions.HasOption("ApplyOutOfSyncOnly=true"), compareResult.diffResultList),
 		sync.WithPrunePropagationPolicy(&prunePropagationPolicy),
 		sync.WithReplace(syncOp.SyncOptions.HasOption(common.SyncOptionReplace)),
@@ -346,6 +359,14 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 			clientSideApplyManager,
 		),
 		sync.WithPruneConfirmed(app.IsDeletionConfirmed(state.StartedAt.Time)),
+		sync.WithSyncWaveApproval(func(phase common.SyncPhase, wave int) bool {
+			if state.IsSyncWaveApproved(phase, wave) {
+				return true
+			}
+			state.PendingApproval = &v1alpha1.SyncWaveApproval{Phase: phase, Wave: int64(wave)}
+			return false
+		}),
+		sync.WithSyncWaveReadiness(syncWaveReadiness(m.settingsMgr.GetSensitiveAnnotations())),
 		sync.WithSkipDryRunOnMissingResource(syncOp.SyncOptions.HasOption(common.SyncOptionSkipDryRunOnMissingResource)),
 	}
 
@@ -353,6 +374,19 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		opts = append(opts, sync.WithNamespaceModifier(syncNamespace(app.Spec.SyncPolicy)))
 	}
 
+	if syncOp.SyncWaves != nil {
+		opts = append(opts, sync.WithSyncWaveRange(syncWaveBound(syncOp.SyncWaves.From), syncWaveBound(syncOp.SyncWaves.To)))
+	}
+	if len(syncOp.SyncPhases) > 0 {
+		opts = append(opts, sync.WithSyncPhases(syncOp.SyncPhases))
+	}
+	if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.Analysis != nil && len(syncOp.Resources) == 0 {
+		analysisPolicy := app.Spec.SyncPolicy.Analysis
+		opts = append(opts, sync.WithSyncAnalysis(func() (common.OperationPhase, string) {
+			return m.analyzeSync(analysisPolicy, state)
+		}))
+	}
+
 	syncCtx, cleanup, err := sync.NewSyncContext(
 		compareResult.syncStatus.Revision,
 		reconciliationResult,
@@ -381,6 +415,9 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 	var resState []common.ResourceSyncResult
 	state.Phase, state.Message, resState = syncCtx.GetState()
 	state.SyncResult.Resources = nil
+	if state.Phase != common.OperationWaitingForApproval {
+		state.PendingApproval = nil
+	}
 
 	if app.Spec.SyncPolicy != nil {
 		state.SyncResult.ManagedNamespaceMetadata = app.Spec.SyncPolicy.ManagedNamespaceMetadata
@@ -404,6 +441,10 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 			res.Message = augmentedMsg
 		}
 
+		var syncedAt *metav1.Time
+		if !res.SyncedAt.IsZero() {
+			syncedAt = &res.SyncedAt
+		}
 		state.SyncResult.Resources = append(state.SyncResult.Resources, &v1alpha1.ResourceResult{
 			HookType:  res.HookType,
 			Group:     res.ResourceKey.Group,
@@ -416,9 +457,14 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 			Status:    res.Status,
 			Message:   res.Message,
 			Images:    res.Images,
+			SyncedAt:  syncedAt,
 		})
 	}
 
+	if syncOp.DryRun && state.Phase.Completed() {
+		state.SyncResult.Plan = m.getSyncPlan(destCluster, compareResult, resState)
+	}
+
 	logEntry.WithField("duration", time.Since(start)).Info("sync/terminate complete")
 
 	if !syncOp.DryRun && len(syncOp.Resources) == 0 && state.Phase.Successful() {
@@ -533,6 +579,14 @@ func hasSharedResourceCondition(app *v1alpha1.Application) (bool, string) {
 	return false, ""
 }
 
+// syncWaveBound converts a bound of the sync wave range of a sync operation to a bound of the sync context.
+func syncWaveBound(bound *int64) *int {
+	if bound == nil {
+		return nil
+	}
+	return ptr.To(int(*bound))
+}
+
 // delayBetweenSyncWaves is a gitops-engine SyncWaveHook which introduces an artificial delay
 // between each sync wave. We introduce an artificial delay in order give other controllers a
 // _chance_ to react to the spec change that we just applied. This is important because without
@@ -555,6 +609,44 @@ func delayBetweenSyncWaves(_ common.SyncPhase, _ int, finalWave bool) error {
 	return nil
 }
 
+// syncWaveReadiness returns a gitops-engine SyncWaveReadiness which runs the Lua readiness scripts the resources of a
+// sync wave are annotated with. The wave is ready once all of its scripts return true. The scripts do not see the data
+// of Secrets, nor the values of sensitive annotations, and their messages are truncated since they are reported in the
+// operation state.
+func syncWaveReadiness(sensitiveAnnotations map[string]bool) common.SyncWaveReadiness {
+	return func(_ common.SyncPhase, _ int, liveObjs []*unstructured.Unstructured) (bool, string, error) {
+		var scripts []string
+		objs := make([]*unstructured.Unstructured, 0, len(liveObjs))
+		for _, obj := range liveObjs {
+			if script := obj.GetAnnotations()[cdcommon.AnnotationSyncWaveReadiness]; script != "" && !slices.Contains(scripts, script) {
+				scripts = append(scripts, script)
+			}
+			if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
+				_, redacted, err := gitopsDiff.HideSecretData(nil, obj, sensitiveAnnotations)
+				if err != nil {
+					return false, "", fmt.Errorf("failed to hide data of secret %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+				}
+				obj = redacted
+			}
+			objs = append(objs, obj)
+		}
+		vm := lua.VM{}
+		for _, script := range scripts {
+			ready, message, err := vm.ExecuteReadinessLua(objs, script)
+			if err != nil {
+				return false, "", fmt.Errorf("failed to run readiness script: %w", err)
+			}
+			if !ready {
+				if utf8.RuneCountInString(message) > maxSyncWaveReadinessMessageLength {
+					message = string([]rune(message)[:maxSyncWaveReadinessMessageLength-3]) + "..."
+				}
+				return false, message, nil
+			}
+		}
+		return true, "", nil
+	}
+}
+
 func syncWindowPreventsSync(app *v1alpha1.Application, proj *v1alpha1.AppProject) (bool, error) {
 	window := proj.Spec.SyncWindows.Matches(app)
 	isManual := false
@@ -0,0 +1,195 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	gitopsDiff "github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/pmezard/go-difflib/difflib"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	argodiff "github.com/argoproj/argo-cd/v3/util/argo/diff"
+)
+
+const (
+	// maxSyncPlanDiffSize is the maximum size of the diff of a single resource of a sync plan
+	maxSyncPlanDiffSize = 16 * 1024
+	// maxSyncPlanSize is the maximum total size of the diffs of a sync plan. The plan is stored in the status of the
+	// application, so it counts towards the size limit of the Application object.
+	maxSyncPlanSize = 256 * 1024
+)
+
+// getSyncPlan returns the plan of a dry-run sync from the results of the sync. The diffs of the plan are computed
+// with a server-side dry-run apply regardless of the diff strategy of the application, and fall back to the diff
+// configuration of the comparison if no server-side dry-run can be performed.
+func (m *appStateManager) getSyncPlan(destCluster *v1alpha1.Cluster, compareResult *comparisonResult, resState []common.ResourceSyncResult) []v1alpha1.SyncPlanResource {
+	diffConfig := compareResult.diffConfig
+	applier, cleanup, err := m.getServerSideDiffDryRunApplier(destCluster)
+	if err != nil {
+		log.Warnf("Failed to get the server-side dry-run applier of the sync plan, using the comparison diff: %v", err)
+	} else {
+		defer cleanup()
+		diffConfig, err = newSyncPlanDiffConfig(compareResult.diffConfig, gitopsDiff.NewK8sServerSideDryRunner(applier))
+		if err != nil {
+			log.Warnf("Failed to build the diff configuration of the sync plan, using the comparison diff: %v", err)
+			diffConfig = compareResult.diffConfig
+		}
+	}
+	secretDiffConfig, err := newSyncPlanSecretDiffConfig(compareResult.diffConfig)
+	if err != nil {
+		log.Warnf("Failed to build the diff configuration of the Secrets of the sync plan, omitting their diffs: %v", err)
+		secretDiffConfig = nil
+	}
+	return buildSyncPlan(resState, compareResult.managedResources, diffConfig, secretDiffConfig, m.settingsMgr.GetSensitiveAnnotations())
+}
+
+// newSyncPlanDiffConfig returns a copy of the given diff configuration which computes server-side diffs without cache
+func newSyncPlanDiffConfig(dc argodiff.DiffConfig, dryRunner gitopsDiff.ServerSideDryRunner) (argodiff.DiffConfig, error) {
+	return argodiff.NewDiffConfigBuilder().
+		WithDiffSettings(dc.Ignores(), dc.Overrides(), dc.IgnoreAggregatedRoles(), dc.IgnoreNormalizerOpts()).
+		WithTracking(dc.AppLabelKey(), dc.TrackingMethod()).
+		WithNoCache().
+		WithGVKParser(dc.GVKParser()).
+		WithManager(dc.Manager()).
+		WithIgnoreMutationWebhook(dc.IgnoreMutationWebhook()).
+		WithServerSideDiff(true).
+		WithServerSideDryRunner(dryRunner).
+		Build()
+}
+
+// newSyncPlanSecretDiffConfig returns a copy of the given diff configuration which computes client-side diffs without
+// cache. The diffs of Secrets are computed from their masked data, which cannot be applied in a server-side dry-run.
+func newSyncPlanSecretDiffConfig(dc argodiff.DiffConfig) (argodiff.DiffConfig, error) {
+	return argodiff.NewDiffConfigBuilder().
+		WithDiffSettings(dc.Ignores(), dc.Overrides(), dc.IgnoreAggregatedRoles(), dc.IgnoreNormalizerOpts()).
+		WithTracking(dc.AppLabelKey(), dc.TrackingMethod()).
+		WithNoCache().
+		WithGVKParser(dc.GVKParser()).
+		Build()
+}
+
+// buildSyncPlan builds the plan of a dry-run sync from the results of the sync, in the order of the results. The data
+// of Secrets is hidden in the diffs, which are computed with secretDiffConfig, and the diffs are truncated to keep the
+// size of the plan bounded.
+func buildSyncPlan(resState []common.ResourceSyncResult, managedResources []managedResource, diffConfig argodiff.DiffConfig, secretDiffConfig argodiff.DiffConfig, sensitiveAnnotations map[string]bool) []v1alpha1.SyncPlanResource {
+	resourcesByKey := make(map[kube.ResourceKey]managedResource, len(managedResources))
+	for _, res := range managedResources {
+		resourcesByKey[kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)] = res
+	}
+
+	plan := make([]v1alpha1.SyncPlanResource, 0, len(resState))
+	planSize := 0
+	for _, res := range resState {
+		planned := v1alpha1.SyncPlanResource{
+			Group:     res.ResourceKey.Group,
+			Version:   res.Version,
+			Kind:      res.ResourceKey.Kind,
+			Namespace: res.ResourceKey.Namespace,
+			Name:      res.ResourceKey.Name,
+			Action:    res.Action,
+			SyncPhase: res.SyncPhase,
+			SyncWave:  int64(res.SyncWave),
+			HookType:  res.HookType,
+		}
+		if managed, ok := resourcesByKey[res.ResourceKey]; ok {
+			resDiff, err := getSyncPlanResourceDiff(managed, res.Action, diffConfig, secretDiffConfig, sensitiveAnnotations)
+			if err != nil {
+				log.Warnf("Failed to compute the sync plan diff of %s: %v", res.ResourceKey.String(), err)
+			}
+			resDiff = truncateSyncPlanDiff(resDiff, maxSyncPlanDiffSize)
+			if planSize+len(resDiff) > maxSyncPlanSize {
+				resDiff = fmt.Sprintf("diff omitted: the diffs of the sync plan exceed %d bytes\n", maxSyncPlanSize)
+			}
+			planSize += len(resDiff)
+			planned.Diff = resDiff
+		}
+		plan = append(plan, planned)
+	}
+	return plan
+}
+
+// getSyncPlanResourceDiff returns the diff of a managed resource for the sync plan. The data of Secrets is hidden, and
+// their diff is computed with secretDiffConfig.
+func getSyncPlanResourceDiff(res managedResource, action common.SyncAction, diffConfig argodiff.DiffConfig, secretDiffConfig argodiff.DiffConfig, sensitiveAnnotations map[string]bool) (string, error) {
+	live, target := res.Live, res.Target
+	if res.Kind == kube.SecretKind && res.Group == "" {
+		var err error
+		target, live, err = gitopsDiff.HideSecretData(target, live, sensitiveAnnotations)
+		if err != nil {
+			return "", fmt.Errorf("error hiding secret data: %w", err)
+		}
+		diffConfig = secretDiffConfig
+	}
+	if diffConfig == nil {
+		return "", nil
+	}
+	return getSyncPlanDiff(live, target, action, diffConfig)
+}
+
+// truncateSyncPlanDiff truncates the diff to at most maxSize bytes, at a line boundary or, if the first line is longer
+// than maxSize, at a rune boundary
+func truncateSyncPlanDiff(diff string, maxSize int) string {
+	if len(diff) <= maxSize {
+		return diff
+	}
+	truncated := diff[:maxSize]
+	if i := strings.LastIndexByte(truncated, '\n'); i >= 0 {
+		truncated = truncated[:i+1]
+	} else {
+		i = maxSize
+		for i > 0 && !utf8.RuneStart(diff[i]) {
+			i--
+		}
+		truncated = diff[:i]
+	}
+	return truncated + fmt.Sprintf("... diff truncated, %d more bytes\n", len(diff)-len(truncated))
+}
+
+// getSyncPlanDiff returns the unified diff between the live state of a resource and the state predicted by the action
+// of the sync
+func getSyncPlanDiff(live, target *unstructured.Unstructured, action common.SyncAction, diffConfig argodiff.DiffConfig) (string, error) {
+	var liveYAML, predictedYAML []byte
+	var err error
+	obj := target
+	if action == common.SyncActionPrune || target == nil {
+		if live == nil {
+			return "", nil
+		}
+		obj = live
+		liveYAML, err = yaml.Marshal(live)
+		if err != nil {
+			return "", err
+		}
+	} else {
+		res, err := argodiff.StateDiff(live, target, diffConfig)
+		if err != nil {
+			return "", err
+		}
+		if !res.Modified {
+			return "", nil
+		}
+		if live != nil {
+			if liveYAML, err = yaml.JSONToYAML(res.NormalizedLive); err != nil {
+				return "", err
+			}
+		}
+		if predictedYAML, err = yaml.JSONToYAML(res.PredictedLive); err != nil {
+			return "", err
+		}
+	}
+
+	name := obj.GetKind() + "/" + obj.GetName()
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(liveYAML)),
+		B:        difflib.SplitLines(string(predictedYAML)),
+		FromFile: "live/" + name,
+		ToFile:   "predicted/" + name,
+		Context:  3,
+	})
+}
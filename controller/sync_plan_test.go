@@ -0,0 +1,152 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	. "github.com/argoproj/gitops-engine/pkg/utils/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	argodiff "github.com/argoproj/argo-cd/v3/util/argo/diff"
+	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
+)
+
+func TestBuildSyncPlan(t *testing.T) {
+	diffConfig, err := argodiff.NewDiffConfigBuilder().
+		WithDiffSettings(nil, nil, false, normalizers.IgnoreNormalizerOpts{}).
+		WithNoCache().
+		Build()
+	require.NoError(t, err)
+
+	svc := NewService()
+	livePod := NewPod()
+	targetPod := NewPod()
+	targetPod.SetLabels(map[string]string{"team": "payments"})
+	extraPod := NewPod()
+	extraPod.SetName("extra-pod")
+	managedResources := []managedResource{
+		{Target: svc, Kind: "Service", Namespace: svc.GetNamespace(), Name: svc.GetName()},
+		{Target: targetPod, Live: livePod, Kind: "Pod", Namespace: livePod.GetNamespace(), Name: livePod.GetName()},
+		{Live: extraPod, Kind: "Pod", Namespace: extraPod.GetNamespace(), Name: extraPod.GetName()},
+	}
+	newResult := func(obj *unstructured.Unstructured, action synccommon.SyncAction, wave int) synccommon.ResourceSyncResult {
+		return synccommon.ResourceSyncResult{
+			ResourceKey: kube.GetResourceKey(obj),
+			Version:     "v1",
+			SyncPhase:   synccommon.SyncPhaseSync,
+			SyncWave:    wave,
+			Action:      action,
+		}
+	}
+
+	plan := buildSyncPlan([]synccommon.ResourceSyncResult{
+		newResult(svc, synccommon.SyncActionCreate, 0),
+		newResult(targetPod, synccommon.SyncActionPatch, 1),
+		newResult(extraPod, synccommon.SyncActionPrune, 2),
+	}, managedResources, diffConfig, diffConfig, nil)
+
+	require.Len(t, plan, 3)
+	assert.Equal(t, v1alpha1.SyncPlanResource{
+		Version:   "v1",
+		Kind:      "Service",
+		Namespace: svc.GetNamespace(),
+		Name:      svc.GetName(),
+		Action:    synccommon.SyncActionCreate,
+		SyncPhase: synccommon.SyncPhaseSync,
+		Diff:      plan[0].Diff,
+	}, plan[0])
+	assert.Contains(t, plan[0].Diff, "+++ predicted/Service/my-service")
+	assert.Contains(t, plan[0].Diff, "+kind: Service")
+
+	assert.Equal(t, synccommon.SyncActionPatch, plan[1].Action)
+	assert.Equal(t, int64(1), plan[1].SyncWave)
+	assert.Contains(t, plan[1].Diff, "+    team: payments")
+	assert.NotContains(t, plan[1].Diff, "-kind: Pod")
+
+	assert.Equal(t, synccommon.SyncActionPrune, plan[2].Action)
+	assert.Contains(t, plan[2].Diff, "--- live/Pod/extra-pod")
+	assert.Contains(t, plan[2].Diff, "-kind: Pod")
+}
+
+func TestBuildSyncPlan_HidesSecretData(t *testing.T) {
+	diffConfig, err := argodiff.NewDiffConfigBuilder().
+		WithDiffSettings(nil, nil, false, normalizers.IgnoreNormalizerOpts{}).
+		WithNoCache().
+		Build()
+	require.NoError(t, err)
+
+	newSecret := func(name string, data map[string]any) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata":   map[string]any{"name": name, "namespace": "default"},
+			"data":       data,
+		}}
+	}
+	liveSecret := newSecret("db", map[string]any{"password": "b2xkLXBhc3N3b3Jk"})
+	targetSecret := newSecret("db", map[string]any{"password": "bmV3LXBhc3N3b3Jk"})
+	prunedSecret := newSecret("stale", map[string]any{"token": "c2VjcmV0LXRva2Vu"})
+	managedResources := []managedResource{
+		{Target: targetSecret, Live: liveSecret, Kind: kube.SecretKind, Namespace: "default", Name: "db"},
+		{Live: prunedSecret, Kind: kube.SecretKind, Namespace: "default", Name: "stale"},
+	}
+
+	plan := buildSyncPlan([]synccommon.ResourceSyncResult{
+		{ResourceKey: kube.GetResourceKey(targetSecret), Version: "v1", Action: synccommon.SyncActionPatch},
+		{ResourceKey: kube.GetResourceKey(prunedSecret), Version: "v1", Action: synccommon.SyncActionPrune},
+	}, managedResources, diffConfig, diffConfig, nil)
+
+	require.Len(t, plan, 2)
+	assert.Contains(t, plan[0].Diff, "password: +")
+	assert.NotContains(t, plan[0].Diff, "b2xkLXBhc3N3b3Jk")
+	assert.NotContains(t, plan[0].Diff, "bmV3LXBhc3N3b3Jk")
+	assert.Contains(t, plan[1].Diff, "token: +")
+	assert.NotContains(t, plan[1].Diff, "c2VjcmV0LXRva2Vu")
+}
+
+func TestTruncateSyncPlanDiff(t *testing.T) {
+	assert.Equal(t, "a\nb\n", truncateSyncPlanDiff("a\nb\n", 4))
+	assert.Equal(t, "aa\n... diff truncated, 6 more bytes\n", truncateSyncPlanDiff("aa\nbb\ncc\n", 5))
+	assert.Equal(t, "ab... diff truncated, 4 more bytes\n", truncateSyncPlanDiff("abcdef", 2))
+	assert.Equal(t, "a... diff truncated, 5 more bytes\n", truncateSyncPlanDiff("aé€", 2))
+	assert.Equal(t, "aé... diff truncated, 3 more bytes\n", truncateSyncPlanDiff("aé€", 5))
+}
+
+func TestBuildSyncPlan_LimitsSize(t *testing.T) {
+	diffConfig, err := argodiff.NewDiffConfigBuilder().
+		WithDiffSettings(nil, nil, false, normalizers.IgnoreNormalizerOpts{}).
+		WithNoCache().
+		Build()
+	require.NoError(t, err)
+
+	var managedResources []managedResource
+	var resState []synccommon.ResourceSyncResult
+	for i := 0; i < 40; i++ {
+		cm := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]any{"name": fmt.Sprintf("cm-%d", i), "namespace": "default"},
+			"data":       map[string]any{"large": strings.Repeat("x\n", 10*1024)},
+		}}
+		managedResources = append(managedResources, managedResource{Target: cm, Kind: "ConfigMap", Namespace: "default", Name: cm.GetName()})
+		resState = append(resState, synccommon.ResourceSyncResult{ResourceKey: kube.GetResourceKey(cm), Version: "v1", Action: synccommon.SyncActionCreate})
+	}
+
+	plan := buildSyncPlan(resState, managedResources, diffConfig, diffConfig, nil)
+
+	require.Len(t, plan, 40)
+	size := 0
+	for _, res := range plan {
+		assert.LessOrEqual(t, len(res.Diff), maxSyncPlanDiffSize+64)
+		size += len(res.Diff)
+	}
+	assert.LessOrEqual(t, size, maxSyncPlanSize)
+	assert.Contains(t, plan[0].Diff, "... diff truncated")
+	assert.Equal(t, "diff omitted: the diffs of the sync plan exceed 262144 bytes\n", plan[39].Diff)
+}
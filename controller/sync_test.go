@@ -1653,3 +1653,71 @@ func dig(obj any, path ...any) any {
 
 	return i
 }
+
+func TestSyncWaveReadiness(t *testing.T) {
+	newObj := func(name, script string) *unstructured.Unstructured {
+		obj := test.NewDeployment()
+		obj.SetName(name)
+		if script != "" {
+			obj.SetAnnotations(map[string]string{common.AnnotationSyncWaveReadiness: script})
+		}
+		return obj
+	}
+
+	t.Run("NoScripts", func(t *testing.T) {
+		ready, _, err := syncWaveReadiness(nil)(synccommon.SyncPhaseSync, 0, []*unstructured.Unstructured{newObj("deploy-1", "")})
+		require.NoError(t, err)
+		assert.True(t, ready)
+	})
+
+	t.Run("AllScriptsReady", func(t *testing.T) {
+		script := `return #objs == 2`
+		ready, _, err := syncWaveReadiness(nil)(synccommon.SyncPhaseSync, 0, []*unstructured.Unstructured{newObj("deploy-1", script), newObj("deploy-2", script)})
+		require.NoError(t, err)
+		assert.True(t, ready)
+	})
+
+	t.Run("ScriptNotReady", func(t *testing.T) {
+		ready, message, err := syncWaveReadiness(nil)(synccommon.SyncPhaseSync, 0, []*unstructured.Unstructured{
+			newObj("deploy-1", `return true`),
+			newObj("deploy-2", `return {ready = false, message = "waiting for " .. objs[2].metadata.name}`),
+		})
+		require.NoError(t, err)
+		assert.False(t, ready)
+		assert.Equal(t, "waiting for deploy-2", message)
+	})
+
+	t.Run("ScriptError", func(t *testing.T) {
+		_, _, err := syncWaveReadiness(nil)(synccommon.SyncPhaseSync, 0, []*unstructured.Unstructured{newObj("deploy-1", `return 1`)})
+		require.ErrorContains(t, err, "failed to run readiness script")
+	})
+
+	t.Run("SecretDataHidden", func(t *testing.T) {
+		secret := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata":   map[string]any{"name": "secret-1", "annotations": map[string]any{"sensitive": "value"}},
+			"data":       map[string]any{"password": "c2VjcmV0"},
+			"stringData": map[string]any{"token": "secret"},
+		}}
+		ready, message, err := syncWaveReadiness(map[string]bool{"sensitive": true})(synccommon.SyncPhaseSync, 0, []*unstructured.Unstructured{
+			newObj("deploy-1", `return {ready = false, message = objs[2].data.password .. " " .. objs[2].data.token .. " " .. objs[2].metadata.annotations.sensitive}`),
+			secret,
+		})
+		require.NoError(t, err)
+		assert.False(t, ready)
+		assert.NotContains(t, message, "c2VjcmV0")
+		assert.NotContains(t, message, "secret")
+		assert.NotContains(t, message, "value")
+		assert.Equal(t, "c2VjcmV0", secret.Object["data"].(map[string]any)["password"])
+	})
+
+	t.Run("MessageTruncated", func(t *testing.T) {
+		_, message, err := syncWaveReadiness(nil)(synccommon.SyncPhaseSync, 0, []*unstructured.Unstructured{
+			newObj("deploy-1", `local m = "" for i = 1, 2000 do m = m .. "a" end return {ready = false, message = m}`),
+		})
+		require.NoError(t, err)
+		assert.Len(t, message, maxSyncWaveReadinessMessageLength)
+		assert.Equal(t, "...", message[len(message)-3:])
+	})
+}
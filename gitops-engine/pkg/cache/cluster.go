@@ -73,6 +73,9 @@ type apiMeta struct {
 	// watchCancel stops the watch of all resources for this API. This gets called when the cache is invalidated or when
 	// the watched API ceases to exist (e.g. a CRD gets deleted).
 	watchCancel context.CancelFunc
+	// resourceVersions holds the resource version of the most recently processed list or watch event of each watched
+	// namespace. The watches of a restored snapshot resume from these resource versions.
+	resourceVersions map[string]string
 }
 
 type eventMeta struct {
@@ -146,6 +149,9 @@ type ClusterCache interface {
 	OnEvent(handler OnEventHandler) Unsubscribe
 	// OnProcessEventsHandler register event handler that is executed every time when events were processed
 	OnProcessEventsHandler(handler OnProcessEventsHandler) Unsubscribe
+	// GetSnapshot returns a snapshot of the cached resources which can be used to restore the cache, or nil if the
+	// cache is not synced
+	GetSnapshot() *ClusterSnapshot
 }
 
 type WeightedSemaphore interface {
@@ -245,6 +251,9 @@ type clusterCache struct {
 	gvkParser                   *managedfields.GvkParser
 
 	respectRBAC int
+
+	// initialSnapshot is the snapshot the cache is restored from on the next synchronization
+	initialSnapshot *ClusterSnapshot
 }
 
 type clusterCacheSync struct {
@@ -415,7 +424,7 @@ func (c *clusterCache) replaceResourceCache(gk schema.GroupKind, resources []*Re
 }
 
 func (c *clusterCache) newResource(un *unstructured.Unstructured) *Resource {
-	ownerRefs, isInferredParentOf := c.resolveResourceReferences(un)
+	ownerRefs, volumeClaimTemplates := c.resolveResourceReferences(un)
 
 	cacheManifest := false
 	var info any
@@ -428,12 +437,15 @@ func (c *clusterCache) newResource(un *unstructured.Unstructured) *Resource {
 		creationTimestamp = &ct
 	}
 	resource := &Resource{
-		ResourceVersion:    un.GetResourceVersion(),
-		Ref:                kube.GetObjectRef(un),
-		OwnerRefs:          ownerRefs,
-		Info:               info,
-		CreationTimestamp:  creationTimestamp,
-		isInferredParentOf: isInferredParentOf,
+		ResourceVersion:   un.GetResourceVersion(),
+		Ref:               kube.GetObjectRef(un),
+		OwnerRefs:         ownerRefs,
+		Info:              info,
+		CreationTimestamp: creationTimestamp,
+	}
+	if volumeClaimTemplates != nil {
+		resource.volumeClaimTemplates = volumeClaimTemplates
+		resource.isInferredParentOf = isStatefulSetChild(un.GetName(), volumeClaimTemplates)
 	}
 	if cacheManifest {
 		resource.Resource = un
@@ -538,7 +550,7 @@ func (c *clusterCache) startMissingWatches() error {
 		namespacedResources[api.GroupKind] = api.Meta.Namespaced
 		if _, ok := c.apisMeta[api.GroupKind]; !ok {
 			ctx, cancel := context.WithCancel(context.Background())
-			c.apisMeta[api.GroupKind] = &apiMeta{namespaced: api.Meta.Namespaced, watchCancel: cancel}
+			c.apisMeta[api.GroupKind] = &apiMeta{namespaced: api.Meta.Namespaced, watchCancel: cancel, resourceVersions: make(map[string]string)}
 
 			err := c.processApi(client, api, func(resClient dynamic.ResourceInterface, ns string) error {
 				resourceVersion, err := c.loadInitialState(ctx, api, resClient, ns, false) // don't lock here, we are already in a lock before startMissingWatches is called inside watchEvents
@@ -647,10 +659,12 @@ func (c *clusterCache) loadInitialState(ctx context.Context, api kube.APIResourc
 	if lock {
 		return resourceVersion, runSynced(&c.lock, func() error {
 			c.replaceResourceCache(api.GroupKind, items, ns)
+			c.setWatchResourceVersion(api.GroupKind, ns, resourceVersion)
 			return nil
 		})
 	}
 	c.replaceResourceCache(api.GroupKind, items, ns)
+	c.setWatchResourceVersion(api.GroupKind, ns, resourceVersion)
 	return resourceVersion, nil
 }
 
@@ -715,6 +729,12 @@ func (c *clusterCache) watchEvents(ctx context.Context, api kube.APIResourceInfo
 					return fmt.Errorf("watch %s on %s has closed", api.GroupKind, c.config.Host)
 				}
 
+				// the retry watcher stops on errors it cannot recover from, such as the expired resource version of a
+				// restored snapshot, so the API state is re-synchronized by listing all resources
+				if event.Type == watch.Error {
+					return fmt.Errorf("watch %s on %s failed: %w", api.GroupKind, c.config.Host, apierrors.FromObject(event.Object))
+				}
+
 				obj, ok := event.Object.(*unstructured.Unstructured)
 				if !ok {
 					return fmt.Errorf("failed to convert to *unstructured.Unstructured: %v", event.Object)
@@ -809,6 +829,22 @@ func (c *clusterCache) processApi(client dynamic.Interface, api kube.APIResource
 	return nil
 }
 
+// watchNamespace returns the namespace of the watch which receives the events of resources in the given namespace
+func (c *clusterCache) watchNamespace(namespace string) string {
+	if len(c.namespaces) == 0 {
+		return ""
+	}
+	return namespace
+}
+
+// setWatchResourceVersion records the resource version the watch of the given API and namespace has processed.
+// The cluster cache lock must be held by the caller.
+func (c *clusterCache) setWatchResourceVersion(gk schema.GroupKind, ns string, resourceVersion string) {
+	if info, ok := c.apisMeta[gk]; ok && resourceVersion != "" {
+		info.resourceVersions[ns] = resourceVersion
+	}
+}
+
 // isRestrictedResource checks if the kube api call is unauthorized or forbidden
 func (c *clusterCache) isRestrictedResource(err error) bool {
 	return c.respectRBAC != RespectRbacDisabled && (apierrors.IsForbidden(err) || apierrors.IsUnauthorized(err))
@@ -908,6 +944,8 @@ func (c *clusterCache) sync() error {
 
 	c.openAPISchema = openAPISchema
 
+	restoredWatches := c.restoreWatches()
+
 	apis, err := c.kubectl.GetAPIResources(c.config, true, c.settings.ResourcesFilter)
 	if err != nil {
 		return fmt.Errorf("failed to get api resources: %w", err)
@@ -932,12 +970,24 @@ func (c *clusterCache) sync() error {
 
 		lock.Lock()
 		ctx, cancel := context.WithCancel(context.Background())
-		info := &apiMeta{namespaced: api.Meta.Namespaced, watchCancel: cancel}
+		info := &apiMeta{namespaced: api.Meta.Namespaced, watchCancel: cancel, resourceVersions: make(map[string]string)}
 		c.apisMeta[api.GroupKind] = info
 		c.namespacedResources[api.GroupKind] = api.Meta.Namespaced
 		lock.Unlock()
 
 		return c.processApi(client, api, func(resClient dynamic.ResourceInterface, ns string) error {
+			if restored, ok := restoredWatches[api.GroupKind][ns]; ok {
+				lock.Lock()
+				for _, res := range restored.resources {
+					c.setNode(res)
+				}
+				info.resourceVersions[ns] = restored.resourceVersion
+				lock.Unlock()
+
+				go c.watchEvents(ctx, api, resClient, ns, restored.resourceVersion)
+				return nil
+			}
+
 			resourceVersion, err := c.listResources(ctx, resClient, func(listPager *pager.ListPager) error {
 				return listPager.EachListItem(context.Background(), metav1.ListOptions{}, func(obj runtime.Object) error {
 					if un, ok := obj.(*unstructured.Unstructured); !ok {
@@ -972,6 +1022,11 @@ func (c *clusterCache) sync() error {
 				}
 				return fmt.Errorf("failed to load initial state of resource %s: %w", api.GroupKind.String(), err)
 			}
+			if resourceVersion != "" {
+				lock.Lock()
+				info.resourceVersions[ns] = resourceVersion
+				lock.Unlock()
+			}
 
 			go c.watchEvents(ctx, api, resClient, ns, resourceVersion)
 
@@ -1329,6 +1384,7 @@ func (c *clusterCache) processEventsBatch(eventMetas []eventMeta) {
 }
 
 func (c *clusterCache) processEvent(key kube.ResourceKey, evMeta eventMeta) {
+	c.setWatchResourceVersion(key.GroupKind(), c.watchNamespace(key.Namespace), evMeta.un.GetResourceVersion())
 	existingNode, exists := c.resources[key]
 	if evMeta.event == watch.Deleted {
 		if exists {
@@ -451,6 +451,52 @@ func (_c *ClusterCache_GetServerVersion_Call) RunAndReturn(run func() string) *C
 	return _c
 }
 
+// GetSnapshot provides a mock function for the type ClusterCache
+func (_mock *ClusterCache) GetSnapshot() *cache.ClusterSnapshot {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSnapshot")
+	}
+
+	var r0 *cache.ClusterSnapshot
+	if returnFunc, ok := ret.Get(0).(func() *cache.ClusterSnapshot); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cache.ClusterSnapshot)
+		}
+	}
+	return r0
+}
+
+// ClusterCache_GetSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSnapshot'
+type ClusterCache_GetSnapshot_Call struct {
+	*mock.Call
+}
+
+// GetSnapshot is a helper method to define mock.On call
+func (_e *ClusterCache_Expecter) GetSnapshot() *ClusterCache_GetSnapshot_Call {
+	return &ClusterCache_GetSnapshot_Call{Call: _e.mock.On("GetSnapshot")}
+}
+
+func (_c *ClusterCache_GetSnapshot_Call) Run(run func()) *ClusterCache_GetSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ClusterCache_GetSnapshot_Call) Return(clusterSnapshot *cache.ClusterSnapshot) *ClusterCache_GetSnapshot_Call {
+	_c.Call.Return(clusterSnapshot)
+	return _c
+}
+
+func (_c *ClusterCache_GetSnapshot_Call) RunAndReturn(run func() *cache.ClusterSnapshot) *ClusterCache_GetSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Invalidate provides a mock function for the type ClusterCache
 func (_mock *ClusterCache) Invalidate(opts ...cache.UpdateSettingsFunc) {
 	// cache.UpdateSettingsFunc
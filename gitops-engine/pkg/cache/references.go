@@ -18,8 +18,8 @@ func mightHaveInferredOwner(r *Resource) bool {
 	return r.Ref.GroupVersionKind().Group == "" && r.Ref.Kind == kube.PersistentVolumeClaimKind
 }
 
-func (c *clusterCache) resolveResourceReferences(un *unstructured.Unstructured) ([]metav1.OwnerReference, func(kube.ResourceKey) bool) {
-	var isInferredParentOf func(_ kube.ResourceKey) bool
+func (c *clusterCache) resolveResourceReferences(un *unstructured.Unstructured) ([]metav1.OwnerReference, []string) {
+	var volumeClaimTemplates []string
 	ownerRefs := un.GetOwnerReferences()
 	gvk := un.GroupVersionKind()
 
@@ -49,17 +49,18 @@ func (c *clusterCache) resolveResourceReferences(un *unstructured.Unstructured)
 		}
 
 	case (gvk.Group == "apps" || gvk.Group == "extensions") && gvk.Kind == kube.StatefulSetKind:
-		if refs, err := isStatefulSetChild(un); err != nil {
+		if templates, err := getVolumeClaimTemplates(un); err != nil {
 			c.log.Error(err, fmt.Sprintf("Failed to extract StatefulSet %s/%s PVC references", un.GetNamespace(), un.GetName()))
 		} else {
-			isInferredParentOf = refs
+			volumeClaimTemplates = templates
 		}
 	}
 
-	return ownerRefs, isInferredParentOf
+	return ownerRefs, volumeClaimTemplates
 }
 
-func isStatefulSetChild(un *unstructured.Unstructured) (func(kube.ResourceKey) bool, error) {
+// getVolumeClaimTemplates returns the names of the volume claim templates of the given StatefulSet
+func getVolumeClaimTemplates(un *unstructured.Unstructured) ([]string, error) {
 	sts := appsv1.StatefulSet{}
 	data, err := json.Marshal(un)
 	if err != nil {
@@ -70,17 +71,26 @@ func isStatefulSetChild(un *unstructured.Unstructured) (func(kube.ResourceKey) b
 		return nil, fmt.Errorf("failed to unmarshal statefulset: %w", err)
 	}
 
-	templates := sts.Spec.VolumeClaimTemplates
+	templates := make([]string, len(sts.Spec.VolumeClaimTemplates))
+	for i, templ := range sts.Spec.VolumeClaimTemplates {
+		templates[i] = templ.Name
+	}
+	return templates, nil
+}
+
+// isStatefulSetChild returns a function which answers if a PVC was created from the volume claim templates of the
+// StatefulSet with the given name
+func isStatefulSetChild(name string, templates []string) func(kube.ResourceKey) bool {
 	return func(key kube.ResourceKey) bool {
 		if key.Kind == kube.PersistentVolumeClaimKind && key.GroupKind().Group == "" {
 			for _, templ := range templates {
-				if match, _ := regexp.MatchString(fmt.Sprintf(`%s-%s-\d+$`, templ.Name, un.GetName()), key.Name); match {
+				if match, _ := regexp.MatchString(fmt.Sprintf(`%s-%s-\d+$`, templ, name), key.Name); match {
 					return true
 				}
 			}
 		}
 		return false
-	}, nil
+	}
 }
 
 func isServiceAccountTokenSecret(un *unstructured.Unstructured) (bool, metav1.OwnerReference) {
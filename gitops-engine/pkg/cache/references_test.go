@@ -96,10 +96,10 @@ func Test_isStatefulSetChild(t *testing.T) {
 	// Execute test cases
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := isStatefulSetChild(tt.args.un)
-			assert.Equal(t, tt.wantErr, err != nil, "isStatefulSetChild() error = %v, wantErr %v", err, tt.wantErr)
+			templates, err := getVolumeClaimTemplates(tt.args.un)
+			assert.Equal(t, tt.wantErr, err != nil, "getVolumeClaimTemplates() error = %v, wantErr %v", err, tt.wantErr)
 			if err == nil {
-				assert.True(t, tt.checkFunc(got), "Check function failed for %v", tt.name)
+				assert.True(t, tt.checkFunc(isStatefulSetChild(tt.args.un.GetName(), templates)), "Check function failed for %v", tt.name)
 			}
 		})
 	}
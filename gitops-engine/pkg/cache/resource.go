@@ -29,6 +29,8 @@ type Resource struct {
 
 	// answers if resource is inferred parent of provided resource
 	isInferredParentOf func(key kube.ResourceKey) bool
+	// names of the volume claim templates of a StatefulSet, which the inferred parent references are resolved from
+	volumeClaimTemplates []string
 }
 
 func (r *Resource) ResourceKey() kube.ResourceKey {
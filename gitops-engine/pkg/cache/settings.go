@@ -183,3 +183,11 @@ func SetEventProcessingInterval(interval time.Duration) UpdateSettingsFunc {
 		cache.eventProcessingInterval = interval
 	}
 }
+
+// SetInitialSnapshot allows to set the snapshot the cache is restored from on the next synchronization instead of
+// listing all resources. The watches of the restored APIs resume from the resource versions stored in the snapshot.
+func SetInitialSnapshot(snapshot *ClusterSnapshot) UpdateSettingsFunc {
+	return func(cache *clusterCache) {
+		cache.initialSnapshot = snapshot
+	}
+}
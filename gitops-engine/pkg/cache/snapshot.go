@@ -0,0 +1,149 @@
+package cache
+
+import (
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+)
+
+// ClusterSnapshot holds the cached resources of a cluster and the resource versions the watches of the cluster have
+// processed. A snapshot allows restoring the cache without listing all resources of the cluster again.
+type ClusterSnapshot struct {
+	// ServerVersion holds the Kubernetes version of the cluster
+	ServerVersion string
+	// Namespaces holds the namespaces the cache was restricted to
+	Namespaces []string
+	// ClusterResources holds whether the cache included cluster level resources when restricted to namespaces
+	ClusterResources bool
+	// Watches holds the resource versions of the watches
+	Watches []WatchSnapshot
+	// Resources holds the cached resources
+	Resources []ResourceSnapshot
+}
+
+// WatchSnapshot holds the resource version a watch of the given API and namespace has processed
+type WatchSnapshot struct {
+	GroupKind       schema.GroupKind
+	Namespace       string
+	ResourceVersion string
+}
+
+// ResourceSnapshot holds the cached information about a Kubernetes resource
+type ResourceSnapshot struct {
+	ResourceVersion   string
+	Ref               corev1.ObjectReference
+	OwnerRefs         []metav1.OwnerReference
+	CreationTimestamp *metav1.Time
+	Info              any
+	Resource          *unstructured.Unstructured
+	// VolumeClaimTemplates holds the names of the volume claim templates of a StatefulSet
+	VolumeClaimTemplates []string
+}
+
+// isExcludedFromSnapshot returns whether the resources of the given kind are excluded from snapshots. Secrets are
+// excluded so that their data is never written to a snapshot; they are listed again when the cache is restored.
+func isExcludedFromSnapshot(gk schema.GroupKind) bool {
+	return gk.Group == "" && gk.Kind == kube.SecretKind
+}
+
+// restoredWatch holds the resources and the resource version a watch resumes from
+type restoredWatch struct {
+	resourceVersion string
+	resources       []*Resource
+}
+
+// GetSnapshot returns a snapshot of the cached resources which can be used to restore the cache, or nil if the cache
+// is not synced. Secrets are not included in the snapshot.
+func (c *clusterCache) GetSnapshot() *ClusterSnapshot {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	c.syncStatus.lock.Lock()
+	synced := c.syncStatus.syncTime != nil && c.syncStatus.syncError == nil
+	c.syncStatus.lock.Unlock()
+	if !synced || c.apisMeta == nil {
+		return nil
+	}
+
+	snapshot := &ClusterSnapshot{
+		ServerVersion:    c.serverVersion,
+		Namespaces:       slices.Clone(c.namespaces),
+		ClusterResources: c.clusterResources,
+	}
+	for gk, info := range c.apisMeta {
+		if isExcludedFromSnapshot(gk) {
+			continue
+		}
+		for ns, resourceVersion := range info.resourceVersions {
+			snapshot.Watches = append(snapshot.Watches, WatchSnapshot{GroupKind: gk, Namespace: ns, ResourceVersion: resourceVersion})
+		}
+	}
+	snapshot.Resources = make([]ResourceSnapshot, 0, len(c.resources))
+	for key, res := range c.resources {
+		if _, ok := c.apisMeta[key.GroupKind()]; !ok || isExcludedFromSnapshot(key.GroupKind()) {
+			continue
+		}
+		snapshot.Resources = append(snapshot.Resources, ResourceSnapshot{
+			ResourceVersion:      res.ResourceVersion,
+			Ref:                  res.Ref,
+			OwnerRefs:            slices.Clone(res.OwnerRefs),
+			CreationTimestamp:    res.CreationTimestamp,
+			Info:                 res.Info,
+			Resource:             res.Resource,
+			VolumeClaimTemplates: res.volumeClaimTemplates,
+		})
+	}
+	return snapshot
+}
+
+// restoreWatches consumes the initial snapshot of the cache and returns the watches it restores by API and namespace.
+// The snapshot is discarded if it was taken from a different server version or with different namespace settings.
+func (c *clusterCache) restoreWatches() map[schema.GroupKind]map[string]*restoredWatch {
+	snapshot := c.initialSnapshot
+	c.initialSnapshot = nil
+	if snapshot == nil {
+		return nil
+	}
+	if snapshot.ServerVersion != c.serverVersion || !slices.Equal(snapshot.Namespaces, c.namespaces) || snapshot.ClusterResources != c.clusterResources {
+		c.log.Info("Discarding cluster cache snapshot which does not match the cluster settings")
+		return nil
+	}
+
+	watches := make(map[schema.GroupKind]map[string]*restoredWatch)
+	for _, w := range snapshot.Watches {
+		if _, ok := watches[w.GroupKind]; !ok {
+			watches[w.GroupKind] = make(map[string]*restoredWatch)
+		}
+		watches[w.GroupKind][w.Namespace] = &restoredWatch{resourceVersion: w.ResourceVersion}
+	}
+	for i := range snapshot.Resources {
+		res := snapshot.Resources[i].toResource()
+		key := res.ResourceKey()
+		if w, ok := watches[key.GroupKind()][c.watchNamespace(key.Namespace)]; ok {
+			w.resources = append(w.resources, res)
+		}
+	}
+	c.log.Info("Restoring cluster cache from snapshot", "watches", len(snapshot.Watches), "resources", len(snapshot.Resources))
+	return watches
+}
+
+func (r ResourceSnapshot) toResource() *Resource {
+	res := &Resource{
+		ResourceVersion:   r.ResourceVersion,
+		Ref:               r.Ref,
+		OwnerRefs:         slices.Clone(r.OwnerRefs),
+		CreationTimestamp: r.CreationTimestamp,
+		Info:              r.Info,
+		Resource:          r.Resource,
+	}
+	if r.VolumeClaimTemplates != nil {
+		res.volumeClaimTemplates = r.VolumeClaimTemplates
+		res.isInferredParentOf = isStatefulSetChild(r.Ref.Name, r.VolumeClaimTemplates)
+	}
+	return res
+}
@@ -0,0 +1,149 @@
+package cache
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic/fake"
+	testcore "k8s.io/client-go/testing"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
+)
+
+func newSnapshotCluster(t *testing.T, snapshot *ClusterSnapshot, objs ...runtime.Object) *clusterCache {
+	t.Helper()
+	cluster := newClusterWithOptions(t, []UpdateSettingsFunc{
+		SetInitialSnapshot(snapshot),
+		SetPopulateResourceInfoHandler(func(un *unstructured.Unstructured, _ bool) (info any, cacheManifest bool) {
+			return un.GetName(), false
+		}),
+	}, objs...)
+	t.Cleanup(func() {
+		cluster.Invalidate()
+	})
+	return cluster
+}
+
+func TestGetSnapshot(t *testing.T) {
+	cluster := newSnapshotCluster(t, nil, testPod1())
+	assert.Nil(t, cluster.GetSnapshot())
+
+	require.NoError(t, cluster.EnsureSynced())
+	snapshot := cluster.GetSnapshot()
+	require.NotNil(t, snapshot)
+
+	podGroupKind := testPod1().GroupVersionKind().GroupKind()
+	assert.Contains(t, snapshot.Watches, WatchSnapshot{GroupKind: podGroupKind, ResourceVersion: "123"})
+	require.Len(t, snapshot.Resources, 1)
+	assert.Equal(t, "helm-guestbook-pod-1", snapshot.Resources[0].Info)
+	assert.Equal(t, "123", snapshot.Resources[0].ResourceVersion)
+
+	cluster.Invalidate()
+	assert.Nil(t, cluster.GetSnapshot())
+}
+
+func TestGetSnapshot_ExcludesSecrets(t *testing.T) {
+	cluster := newSnapshotCluster(t, nil, testPod1())
+	require.NoError(t, cluster.EnsureSynced())
+
+	secretGroupKind := schema.GroupKind{Kind: kube.SecretKind}
+	secret := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       kube.SecretKind,
+		"metadata":   map[string]any{"name": "creds", "namespace": "default", "resourceVersion": "1"},
+		"data":       map[string]any{"password": "c2VjcmV0"},
+	}}
+	cluster.lock.Lock()
+	cluster.apisMeta[secretGroupKind] = &apiMeta{namespaced: true, watchCancel: func() {}, resourceVersions: map[string]string{"": "1"}}
+	res := cluster.newResource(secret)
+	res.Resource = secret
+	cluster.setNode(res)
+	cluster.lock.Unlock()
+
+	snapshot := cluster.GetSnapshot()
+	require.NotNil(t, snapshot)
+	for _, w := range snapshot.Watches {
+		assert.NotEqual(t, secretGroupKind, w.GroupKind)
+	}
+	require.Len(t, snapshot.Resources, 1)
+	assert.Equal(t, "Pod", snapshot.Resources[0].Ref.Kind)
+}
+
+func TestRestoreSnapshot(t *testing.T) {
+	sts := &appsv1.StatefulSet{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: kube.StatefulSetKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: appsv1.StatefulSetSpec{
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{{ObjectMeta: metav1.ObjectMeta{Name: "data"}}},
+		},
+	}
+	cluster := newSnapshotCluster(t, nil, testPod1(), sts)
+	require.NoError(t, cluster.EnsureSynced())
+	snapshot := cluster.GetSnapshot()
+	require.NotNil(t, snapshot)
+
+	t.Run("RestoredWithoutList", func(t *testing.T) {
+		restored := newSnapshotCluster(t, snapshot)
+		require.NoError(t, restored.EnsureSynced())
+
+		restored.lock.RLock()
+		defer restored.lock.RUnlock()
+		pod, ok := restored.resources[getResourceKey(t, testPod1())]
+		require.True(t, ok)
+		assert.Equal(t, "helm-guestbook-pod-1", pod.Info)
+		stsRes, ok := restored.resources[getResourceKey(t, sts)]
+		require.True(t, ok)
+		require.NotNil(t, stsRes.isInferredParentOf)
+		assert.True(t, stsRes.isInferredParentOf(kube.NewResourceKey("", kube.PersistentVolumeClaimKind, "default", "data-web-0")))
+
+		client := restored.kubectl.(*kubetest.MockKubectlCmd).DynamicClient.(*fake.FakeDynamicClient)
+		for _, action := range client.Actions() {
+			assert.NotEqual(t, "list", action.GetVerb())
+		}
+	})
+
+	t.Run("DiscardedOnNamespaceChange", func(t *testing.T) {
+		restored := newSnapshotCluster(t, snapshot)
+		restored.namespaces = []string{"default"}
+		require.NoError(t, restored.EnsureSynced())
+
+		restored.lock.RLock()
+		defer restored.lock.RUnlock()
+		assert.Empty(t, restored.resources)
+	})
+
+	t.Run("RelistOnExpiredResourceVersion", func(t *testing.T) {
+		restored := newSnapshotCluster(t, snapshot, testPod2())
+		client := restored.kubectl.(*kubetest.MockKubectlCmd).DynamicClient.(*fake.FakeDynamicClient)
+		var expired atomic.Bool
+		client.PrependWatchReactor("pods", func(_ testcore.Action) (bool, watch.Interface, error) {
+			if !expired.CompareAndSwap(false, true) {
+				return false, nil, nil
+			}
+			w := watch.NewFakeWithChanSize(1, false)
+			w.Error(&metav1.Status{Status: metav1.StatusFailure, Code: http.StatusGone, Reason: metav1.StatusReasonExpired})
+			return true, w, nil
+		})
+		require.NoError(t, restored.EnsureSynced())
+
+		assert.Eventually(t, func() bool {
+			restored.lock.RLock()
+			defer restored.lock.RUnlock()
+			_, hasStale := restored.resources[getResourceKey(t, testPod1())]
+			_, hasFresh := restored.resources[getResourceKey(t, testPod2())]
+			return !hasStale && hasFresh
+		}, 5*time.Second, 50*time.Millisecond)
+	})
+}
@@ -1167,6 +1167,109 @@ func TestServerSideDiff(t *testing.T) {
 }
 
 // testIgnoreDifferencesNormalizer implements a simple normalizer that removes specified fields
+func TestFieldOwners(t *testing.T) {
+	live := StrToUnstructured(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+  namespace: default
+  labels:
+    app: guestbook
+    team: payments
+  managedFields:
+  - manager: argocd-controller
+    operation: Apply
+    apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:metadata:
+        f:labels:
+          f:app: {}
+      f:spec:
+        f:template:
+          f:spec:
+            f:containers:
+              k:{"name":"guestbook"}:
+                .: {}
+                f:image: {}
+                f:name: {}
+  - manager: kube-controller-manager
+    operation: Update
+    subresource: scale
+    apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:spec:
+        f:replicas: {}
+  - manager: kubectl-edit
+    operation: Update
+    apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:metadata:
+        f:labels:
+          f:team: {}
+spec:
+  replicas: 5
+  selector:
+    matchLabels:
+      app: guestbook
+  template:
+    metadata:
+      labels:
+        app: guestbook
+    spec:
+      containers:
+      - name: guestbook
+        image: guestbook:v2
+`)
+	predictedLive := live.DeepCopy()
+	require.NoError(t, unstructured.SetNestedField(predictedLive.Object, int64(2), "spec", "replicas"))
+	unstructured.RemoveNestedField(predictedLive.Object, "metadata", "labels", "team")
+	require.NoError(t, unstructured.SetNestedSlice(predictedLive.Object, []any{
+		map[string]any{"name": "guestbook", "image": "guestbook:v1"},
+	}, "spec", "template", "spec", "containers"))
+	predictedLive.SetManagedFields(nil)
+	normalizedLive := live.DeepCopy()
+	normalizedLive.SetManagedFields(nil)
+	res := &DiffResult{Modified: true}
+	var err error
+	res.NormalizedLive, err = json.Marshal(normalizedLive)
+	require.NoError(t, err)
+	res.PredictedLive, err = json.Marshal(predictedLive)
+	require.NoError(t, err)
+
+	t.Run("OwnersOfDifferingFields", func(t *testing.T) {
+		owners, err := FieldOwners(live, res, buildGVKParser(t))
+		require.NoError(t, err)
+		assert.Equal(t, []FieldOwner{
+			{Path: ".metadata.labels.team", Manager: "kubectl-edit", Operation: "Update"},
+			{Path: ".spec.replicas", Manager: "kube-controller-manager", Operation: "Update", Subresource: "scale"},
+			{Path: `.spec.template.spec.containers[name="guestbook"].image`, Manager: "argocd-controller", Operation: "Apply"},
+		}, owners)
+	})
+
+	t.Run("DeducedSchema", func(t *testing.T) {
+		owners, err := FieldOwners(live, res, nil)
+		require.NoError(t, err)
+		assert.Contains(t, owners, FieldOwner{Path: ".spec.replicas", Manager: "kube-controller-manager", Operation: "Update", Subresource: "scale"})
+		assert.Contains(t, owners, FieldOwner{Path: ".spec.template.spec.containers"})
+	})
+
+	t.Run("NotModified", func(t *testing.T) {
+		owners, err := FieldOwners(live, &DiffResult{NormalizedLive: res.NormalizedLive, PredictedLive: res.NormalizedLive}, nil)
+		require.NoError(t, err)
+		assert.Nil(t, owners)
+	})
+
+	t.Run("Created", func(t *testing.T) {
+		owners, err := FieldOwners(live, &DiffResult{Modified: true, NormalizedLive: []byte("null"), PredictedLive: res.PredictedLive}, nil)
+		require.NoError(t, err)
+		assert.Nil(t, owners)
+	})
+}
+
 type testIgnoreDifferencesNormalizer struct {
 	fieldsToRemove [][]string
 }
@@ -0,0 +1,117 @@
+package diff
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/managedfields"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+	"sigs.k8s.io/structured-merge-diff/v6/typed"
+)
+
+// FieldOwner holds the field manager which owns a field of a live resource
+type FieldOwner struct {
+	// Path is the path of the field, e.g. .spec.replicas
+	Path string
+	// Manager is the name of the field manager which owns the field. It is empty if no manager owns the field.
+	Manager string
+	// Operation is the operation the field manager owns the field with, either Apply or Update
+	Operation string
+	// Subresource is the subresource the field manager updated the field through, e.g. scale
+	Subresource string
+}
+
+// managedFieldsPath is the path of the managed fields which are never reported as differing fields
+var managedFieldsPath = fieldpath.MakePathOrDie("metadata", "managedFields")
+
+// FieldOwners returns the field managers which own the fields of the live resource which differ between the normalized
+// live state and the predicted live state of the given diff result. A field is owned by the managers which own the
+// field itself or, if no manager owns the field, the closest parent field which is owned by a manager. The GVK parser
+// is used to compare the states using the schema of the resource; if the parser is nil or does not know the resource
+// type, the schema is deduced from the states.
+func FieldOwners(live *unstructured.Unstructured, res *DiffResult, gvkParser *managedfields.GvkParser) ([]FieldOwner, error) {
+	if live == nil || res == nil || !res.Modified {
+		return nil, nil
+	}
+	normalizedLive, err := jsonStrToUnstructured(string(res.NormalizedLive))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing normalized live state: %w", err)
+	}
+	predictedLive, err := jsonStrToUnstructured(string(res.PredictedLive))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing predicted live state: %w", err)
+	}
+	// the resource is either created or deleted, so there are no differing fields to attribute
+	if len(normalizedLive.Object) == 0 || len(predictedLive.Object) == 0 {
+		return nil, nil
+	}
+
+	pt := &typed.DeducedParseableType
+	if gvkParser != nil {
+		if t := gvkParser.Type(live.GroupVersionKind()); t != nil {
+			pt = t
+		}
+	}
+	typedLive, err := pt.FromUnstructured(normalizedLive.Object)
+	if err != nil {
+		return nil, fmt.Errorf("error converting normalized live state to typed value: %w", err)
+	}
+	typedPredictedLive, err := pt.FromUnstructured(predictedLive.Object)
+	if err != nil {
+		return nil, fmt.Errorf("error converting predicted live state to typed value: %w", err)
+	}
+	comparison, err := typedLive.Compare(typedPredictedLive)
+	if err != nil {
+		return nil, fmt.Errorf("error comparing predicted live state to live state: %w", err)
+	}
+	changed := comparison.Modified.Union(comparison.Added).Union(comparison.Removed).Leaves()
+
+	type managerFields struct {
+		manager     string
+		operation   string
+		subresource string
+		fields      *fieldpath.Set
+	}
+	managers := make([]managerFields, 0, len(live.GetManagedFields()))
+	for _, entry := range live.GetManagedFields() {
+		if entry.FieldsV1 == nil {
+			continue
+		}
+		fields := &fieldpath.Set{}
+		if err := fields.FromJSON(bytes.NewReader(entry.FieldsV1.Raw)); err != nil {
+			return nil, fmt.Errorf("error building managed fields set of manager %s: %w", entry.Manager, err)
+		}
+		managers = append(managers, managerFields{
+			manager:     entry.Manager,
+			operation:   string(entry.Operation),
+			subresource: entry.Subresource,
+			fields:      fields,
+		})
+	}
+
+	var owners []FieldOwner
+	changed.Iterate(func(path fieldpath.Path) {
+		if len(path) >= len(managedFieldsPath) && path[:len(managedFieldsPath)].Equals(managedFieldsPath) {
+			return
+		}
+		found := false
+		for i := len(path); i > 0 && !found; i-- {
+			for _, m := range managers {
+				if m.fields.Has(path[:i]) {
+					owners = append(owners, FieldOwner{Path: path.String(), Manager: m.manager, Operation: m.operation, Subresource: m.subresource})
+					found = true
+				}
+			}
+		}
+		if !found {
+			owners = append(owners, FieldOwner{Path: path.String()})
+		}
+	})
+	slices.SortFunc(owners, func(a, b FieldOwner) int {
+		return cmp.Or(cmp.Compare(a.Path, b.Path), cmp.Compare(a.Manager, b.Manager), cmp.Compare(a.Subresource, b.Subresource))
+	})
+	return owners, nil
+}
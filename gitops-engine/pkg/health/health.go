@@ -147,6 +147,13 @@ func GetHealthCheckFunc(gvk schema.GroupVersionKind) func(obj *unstructured.Unst
 		if gvk.Kind == kube.HorizontalPodAutoscalerKind {
 			return getHPAHealth
 		}
+	case "gateway.networking.k8s.io":
+		switch gvk.Kind {
+		case "Gateway":
+			return getGatewayHealth
+		case "HTTPRoute", "GRPCRoute":
+			return getRouteHealth
+		}
 	}
 	return nil
 }
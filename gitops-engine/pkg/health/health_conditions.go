@@ -0,0 +1,103 @@
+package health
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	conditionReady       = "Ready"
+	conditionReconciling = "Reconciling"
+	conditionStalled     = "Stalled"
+)
+
+// condition is a status condition which, unlike metav1.Condition, does not fail to decode when the resource reports
+// a lastTransitionTime or an observedGeneration which does not follow the Kubernetes API conventions
+type condition struct {
+	Type               string                 `json:"type"`
+	Status             metav1.ConditionStatus `json:"status"`
+	Reason             string                 `json:"reason,omitempty"`
+	Message            string                 `json:"message,omitempty"`
+	ObservedGeneration observedGeneration     `json:"observedGeneration,omitempty"`
+}
+
+// observedGeneration is a generation which is decoded from either a number or a string. A value which is not a valid
+// generation is decoded as zero, i.e. as if it was not reported.
+type observedGeneration int64
+
+func (g *observedGeneration) UnmarshalJSON(data []byte) error {
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("failed to unmarshal observed generation: %w", err)
+	}
+	*g = 0
+	switch v := value.(type) {
+	case float64:
+		*g = observedGeneration(v)
+	case string:
+		if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+			*g = observedGeneration(i)
+		}
+	}
+	return nil
+}
+
+type conditionsStatus struct {
+	ObservedGeneration observedGeneration `json:"observedGeneration,omitempty"`
+	Conditions         []condition        `json:"conditions,omitempty"`
+}
+
+// GetConditionsHealth returns the health of a resource which reports its state using the Ready condition in
+// status.conditions, following the Kubernetes API conventions. The resource is progressing until its current
+// generation is observed, while the Reconciling condition is true and while the Ready condition is unknown. It is
+// degraded if the Stalled condition is true or the Ready condition is false.
+func GetConditionsHealth(obj *unstructured.Unstructured) (*HealthStatus, error) {
+	var status conditionsStatus
+	if err := statusFromUnstructured(obj, &status); err != nil {
+		return nil, fmt.Errorf("failed to convert unstructured %s status to typed: %w", obj.GetKind(), err)
+	}
+	generation := obj.GetGeneration()
+	if status.ObservedGeneration != 0 && int64(status.ObservedGeneration) < generation {
+		return &HealthStatus{
+			Status:  HealthStatusProgressing,
+			Message: fmt.Sprintf("Waiting for generation %d to be observed", generation),
+		}, nil
+	}
+	if c := findCondition(status.Conditions, conditionStalled); c != nil && c.Status == metav1.ConditionTrue {
+		return &HealthStatus{Status: HealthStatusDegraded, Message: conditionMessage(c)}, nil
+	}
+	if c := findCondition(status.Conditions, conditionReconciling); c != nil && c.Status == metav1.ConditionTrue {
+		return &HealthStatus{Status: HealthStatusProgressing, Message: conditionMessage(c)}, nil
+	}
+
+	ready := findCondition(status.Conditions, conditionReady)
+	if ready == nil {
+		return &HealthStatus{Status: HealthStatusProgressing, Message: "Waiting for Ready condition"}, nil
+	}
+	if ready.ObservedGeneration != 0 && int64(ready.ObservedGeneration) < generation {
+		return &HealthStatus{
+			Status:  HealthStatusProgressing,
+			Message: fmt.Sprintf("Waiting for Ready condition of generation %d", generation),
+		}, nil
+	}
+	switch ready.Status {
+	case metav1.ConditionTrue:
+		return &HealthStatus{Status: HealthStatusHealthy, Message: conditionMessage(ready)}, nil
+	case metav1.ConditionFalse:
+		return &HealthStatus{Status: HealthStatusDegraded, Message: conditionMessage(ready)}, nil
+	default:
+		return &HealthStatus{Status: HealthStatusProgressing, Message: conditionMessage(ready)}, nil
+	}
+}
+
+// conditionMessage returns the message of the condition, or its reason if the condition has no message
+func conditionMessage(c *condition) string {
+	if c.Message != "" {
+		return c.Message
+	}
+	return c.Reason
+}
@@ -0,0 +1,138 @@
+package health
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	gatewayConditionAccepted     = "Accepted"
+	gatewayConditionProgrammed   = "Programmed"
+	gatewayConditionResolvedRefs = "ResolvedRefs"
+)
+
+type gatewayListenerStatus struct {
+	Name       string      `json:"name,omitempty"`
+	Conditions []condition `json:"conditions,omitempty"`
+}
+
+type gatewayStatus struct {
+	Conditions []condition             `json:"conditions,omitempty"`
+	Listeners  []gatewayListenerStatus `json:"listeners,omitempty"`
+}
+
+type routeParentStatus struct {
+	ParentRef struct {
+		Name string `json:"name,omitempty"`
+	} `json:"parentRef"`
+	Conditions []condition `json:"conditions,omitempty"`
+}
+
+type routeStatus struct {
+	Parents []routeParentStatus `json:"parents,omitempty"`
+}
+
+// getGatewayHealth returns the health of a Gateway API Gateway based on the conditions of the Gateway and its listeners
+func getGatewayHealth(obj *unstructured.Unstructured) (*HealthStatus, error) {
+	var status gatewayStatus
+	if err := statusFromUnstructured(obj, &status); err != nil {
+		return nil, fmt.Errorf("failed to convert unstructured Gateway status to typed: %w", err)
+	}
+	if health := getGatewayConditionsHealth(status.Conditions, "", "Gateway is still being programmed"); health != nil {
+		return health, nil
+	}
+	for _, listener := range status.Listeners {
+		if health := getGatewayConditionsHealth(listener.Conditions, "Listener: ", "Listener is still being programmed"); health != nil {
+			return health, nil
+		}
+	}
+	if len(status.Conditions) > 0 || len(status.Listeners) > 0 {
+		return &HealthStatus{Status: HealthStatusHealthy, Message: "Gateway is healthy"}, nil
+	}
+	return &HealthStatus{Status: HealthStatusProgressing, Message: "Waiting for Gateway status"}, nil
+}
+
+// getRouteHealth returns the health of a Gateway API route, e.g. an HTTPRoute or a GRPCRoute, based on the conditions
+// the parents of the route report for the current generation of the route
+func getRouteHealth(obj *unstructured.Unstructured) (*HealthStatus, error) {
+	var status routeStatus
+	if err := statusFromUnstructured(obj, &status); err != nil {
+		return nil, fmt.Errorf("failed to convert unstructured %s status to typed: %w", obj.GetKind(), err)
+	}
+	observed := false
+	for _, parent := range status.Parents {
+		if !isRouteParentGenerationObserved(obj.GetGeneration(), parent) {
+			continue
+		}
+		observed = true
+		prefix := fmt.Sprintf("Parent %s: ", parent.ParentRef.Name)
+		if health := getGatewayConditionsHealth(parent.Conditions, prefix, "Route is still being programmed"); health != nil {
+			return health, nil
+		}
+	}
+	if observed {
+		return &HealthStatus{Status: HealthStatusHealthy, Message: obj.GetKind() + " is healthy"}, nil
+	}
+	return &HealthStatus{Status: HealthStatusProgressing, Message: fmt.Sprintf("Waiting for %s status", obj.GetKind())}, nil
+}
+
+// isRouteParentGenerationObserved returns whether the conditions of a route parent are reported for the given
+// generation of the route
+func isRouteParentGenerationObserved(generation int64, parent routeParentStatus) bool {
+	if len(parent.Conditions) == 0 {
+		return false
+	}
+	if generation == 0 {
+		return true
+	}
+	for _, c := range parent.Conditions {
+		if c.ObservedGeneration != 0 && int64(c.ObservedGeneration) != generation {
+			return false
+		}
+	}
+	return true
+}
+
+// getGatewayConditionsHealth returns the health of the given Gateway API conditions, or nil if the conditions are
+// healthy. The conditions are degraded if references cannot be resolved or the resource is not accepted, and
+// progressing until the resource is programmed.
+func getGatewayConditionsHealth(conditions []condition, prefix string, programmingMessage string) *HealthStatus {
+	for _, conditionType := range []string{gatewayConditionResolvedRefs, gatewayConditionAccepted} {
+		if c := findCondition(conditions, conditionType); c != nil && c.Status == metav1.ConditionFalse {
+			message := c.Message
+			if message == "" {
+				message = "Failed condition: " + conditionType
+			}
+			return &HealthStatus{Status: HealthStatusDegraded, Message: prefix + message}
+		}
+	}
+	if c := findCondition(conditions, gatewayConditionProgrammed); c != nil && c.Status != metav1.ConditionTrue {
+		message := c.Message
+		if message == "" {
+			message = programmingMessage
+		}
+		return &HealthStatus{Status: HealthStatusProgressing, Message: prefix + message}
+	}
+	return nil
+}
+
+func findCondition(conditions []condition, conditionType string) *condition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// statusFromUnstructured converts the status of the given resource to the given typed status
+func statusFromUnstructured(obj *unstructured.Unstructured, status any) error {
+	statusObj, ok, err := unstructured.NestedMap(obj.Object, "status")
+	if err != nil || !ok {
+		return err
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(statusObj, status)
+}
@@ -121,6 +121,177 @@ func TestAPIService(t *testing.T) {
 	assertAppHealth(t, "./testdata/apiservice-v1beta1-false.yaml", HealthStatusProgressing)
 }
 
+func TestGatewayAPI(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected HealthStatus
+	}{
+		{"./testdata/gateway-healthy.yaml", HealthStatus{Status: HealthStatusHealthy, Message: "Gateway is healthy"}},
+		{"./testdata/gateway-degraded-resolved-refs.yaml", HealthStatus{Status: HealthStatusDegraded, Message: "Failed to resolve references"}},
+		{"./testdata/gateway-degraded-accepted.yaml", HealthStatus{Status: HealthStatusDegraded, Message: "Gateway has not been accepted by any controller"}},
+		{"./testdata/gateway-listener-degraded.yaml", HealthStatus{Status: HealthStatusDegraded, Message: "Listener: Listener has not been accepted"}},
+		{"./testdata/gateway-progressing.yaml", HealthStatus{Status: HealthStatusProgressing, Message: "Gateway is still being programmed"}},
+		{"./testdata/httproute-healthy.yaml", HealthStatus{Status: HealthStatusHealthy, Message: "HTTPRoute is healthy"}},
+		{"./testdata/httproute-degraded-resolved-refs.yaml", HealthStatus{Status: HealthStatusDegraded, Message: "Parent example-gateway: BackendRef service-does-not-exist not found"}},
+		{"./testdata/httproute-degraded-accepted.yaml", HealthStatus{Status: HealthStatusDegraded, Message: "Parent example-gateway: Route has not been accepted due to invalid configuration"}},
+		{"./testdata/httproute-progressing.yaml", HealthStatus{Status: HealthStatusProgressing, Message: "Parent example-gateway: Route is still being programmed"}},
+		{"./testdata/httproute-healthy-multiple-generations.yaml", HealthStatus{Status: HealthStatusHealthy, Message: "HTTPRoute is healthy"}},
+		{"./testdata/grpcroute-healthy.yaml", HealthStatus{Status: HealthStatusHealthy, Message: "GRPCRoute is healthy"}},
+		{"./testdata/grpcroute-degraded-resolved-refs.yaml", HealthStatus{Status: HealthStatusDegraded, Message: "Parent example-gateway: BackendRef service-does-not-exist not found"}},
+		{"./testdata/grpcroute-degraded-accepted.yaml", HealthStatus{Status: HealthStatusDegraded, Message: "Parent example-gateway: Route has not been accepted due to invalid configuration"}},
+		{"./testdata/grpcroute-progressing.yaml", HealthStatus{Status: HealthStatusProgressing, Message: "Parent example-gateway: Route is still being programmed"}},
+		{"./testdata/grpcroute-degraded-no-message.yaml", HealthStatus{Status: HealthStatusDegraded, Message: "Parent example-gateway: Failed condition: ResolvedRefs"}},
+		{"./testdata/grpcroute-degraded-no-parent-name.yaml", HealthStatus{Status: HealthStatusDegraded, Message: "Parent : BackendRef service-does-not-exist not found"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, &tt.expected, getHealthStatus(t, tt.path))
+		})
+	}
+
+	t.Run("NoStatus", func(t *testing.T) {
+		for _, kind := range []string{"Gateway", "HTTPRoute", "GRPCRoute"} {
+			obj := &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "gateway.networking.k8s.io/v1",
+				"kind":       kind,
+				"metadata":   map[string]any{"name": "example"},
+			}}
+			health, err := GetResourceHealth(obj, nil)
+			require.NoError(t, err)
+			assert.Equal(t, &HealthStatus{Status: HealthStatusProgressing, Message: "Waiting for " + kind + " status"}, health)
+		}
+	})
+
+	t.Run("RouteGenerationNotObserved", func(t *testing.T) {
+		obj := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "gateway.networking.k8s.io/v1",
+			"kind":       "HTTPRoute",
+			"metadata":   map[string]any{"name": "example", "generation": int64(2)},
+			"status": map[string]any{
+				"parents": []any{map[string]any{
+					"parentRef":  map[string]any{"name": "example-gateway"},
+					"conditions": []any{map[string]any{"type": "Accepted", "status": "True", "observedGeneration": int64(1)}},
+				}},
+			},
+		}}
+		health, err := GetResourceHealth(obj, nil)
+		require.NoError(t, err)
+		assert.Equal(t, &HealthStatus{Status: HealthStatusProgressing, Message: "Waiting for HTTPRoute status"}, health)
+	})
+
+	t.Run("NonConformingConditions", func(t *testing.T) {
+		obj := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "gateway.networking.k8s.io/v1",
+			"kind":       "HTTPRoute",
+			"metadata":   map[string]any{"name": "example", "generation": int64(2)},
+			"status": map[string]any{
+				"parents": []any{map[string]any{
+					"parentRef": map[string]any{"name": "example-gateway"},
+					"conditions": []any{map[string]any{
+						"type":               "Accepted",
+						"status":             "False",
+						"message":            "Route has not been accepted",
+						"lastTransitionTime": "2024-01-01 00:00:00",
+						"observedGeneration": "2",
+					}},
+				}},
+			},
+		}}
+		health, err := GetResourceHealth(obj, nil)
+		require.NoError(t, err)
+		assert.Equal(t, &HealthStatus{Status: HealthStatusDegraded, Message: "Parent example-gateway: Route has not been accepted"}, health)
+	})
+}
+
+func TestGetConditionsHealth(t *testing.T) {
+	newObj := func(generation int64, status map[string]any) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "example.com/v1",
+			"kind":       "Database",
+			"metadata":   map[string]any{"name": "example", "generation": generation},
+		}}
+		if status != nil {
+			obj.Object["status"] = status
+		}
+		return obj
+	}
+	condition := func(conditionType, status, reason, message string) map[string]any {
+		return map[string]any{"type": conditionType, "status": status, "reason": reason, "message": message}
+	}
+
+	tests := []struct {
+		name     string
+		obj      *unstructured.Unstructured
+		expected HealthStatus
+	}{{
+		name:     "NoStatus",
+		obj:      newObj(1, nil),
+		expected: HealthStatus{Status: HealthStatusProgressing, Message: "Waiting for Ready condition"},
+	}, {
+		name:     "Ready",
+		obj:      newObj(1, map[string]any{"conditions": []any{condition("Ready", "True", "Available", "Database is available")}}),
+		expected: HealthStatus{Status: HealthStatusHealthy, Message: "Database is available"},
+	}, {
+		name:     "NotReady",
+		obj:      newObj(1, map[string]any{"conditions": []any{condition("Ready", "False", "ConnectionFailed", "")}}),
+		expected: HealthStatus{Status: HealthStatusDegraded, Message: "ConnectionFailed"},
+	}, {
+		name:     "ReadyUnknown",
+		obj:      newObj(1, map[string]any{"conditions": []any{condition("Ready", "Unknown", "Provisioning", "Provisioning database")}}),
+		expected: HealthStatus{Status: HealthStatusProgressing, Message: "Provisioning database"},
+	}, {
+		name: "Reconciling",
+		obj: newObj(1, map[string]any{"conditions": []any{
+			condition("Ready", "False", "Provisioning", ""),
+			condition("Reconciling", "True", "Progressing", "Creating replicas"),
+		}}),
+		expected: HealthStatus{Status: HealthStatusProgressing, Message: "Creating replicas"},
+	}, {
+		name: "Stalled",
+		obj: newObj(1, map[string]any{"conditions": []any{
+			condition("Ready", "False", "Provisioning", ""),
+			condition("Reconciling", "True", "Progressing", "Creating replicas"),
+			condition("Stalled", "True", "QuotaExceeded", "Storage quota exceeded"),
+		}}),
+		expected: HealthStatus{Status: HealthStatusDegraded, Message: "Storage quota exceeded"},
+	}, {
+		name: "GenerationNotObserved",
+		obj: newObj(2, map[string]any{
+			"observedGeneration": int64(1),
+			"conditions":         []any{condition("Ready", "True", "Available", "")},
+		}),
+		expected: HealthStatus{Status: HealthStatusProgressing, Message: "Waiting for generation 2 to be observed"},
+	}, {
+		name: "ReadyGenerationNotObserved",
+		obj: newObj(2, map[string]any{"conditions": []any{
+			map[string]any{"type": "Ready", "status": "True", "observedGeneration": int64(1)},
+		}}),
+		expected: HealthStatus{Status: HealthStatusProgressing, Message: "Waiting for Ready condition of generation 2"},
+	}, {
+		name: "NonConformingConditions",
+		obj: newObj(2, map[string]any{
+			"observedGeneration": "1",
+			"conditions": []any{
+				map[string]any{"type": "Ready", "status": "True", "lastTransitionTime": "yesterday", "observedGeneration": "invalid"},
+			},
+		}),
+		expected: HealthStatus{Status: HealthStatusProgressing, Message: "Waiting for generation 2 to be observed"},
+	}, {
+		name: "NonConformingReadyCondition",
+		obj: newObj(2, map[string]any{"conditions": []any{
+			map[string]any{"type": "Ready", "status": "True", "reason": "Available", "lastTransitionTime": int64(0), "observedGeneration": "invalid"},
+		}}),
+		expected: HealthStatus{Status: HealthStatusHealthy, Message: "Available"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			health, err := GetConditionsHealth(tt.obj)
+			require.NoError(t, err)
+			assert.Equal(t, &tt.expected, health)
+		})
+	}
+}
+
 func TestGetArgoWorkflowHealth(t *testing.T) {
 	sampleWorkflow := unstructured.Unstructured{
 		Object: map[string]any{
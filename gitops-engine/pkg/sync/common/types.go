@@ -12,6 +12,10 @@ const (
 	AnnotationSyncOptions = "argocd.argoproj.io/sync-options"
 	// AnnotationSyncWave indicates which wave of the sync the resource or hook should be in
 	AnnotationSyncWave = "argocd.argoproj.io/sync-wave"
+	// AnnotationSyncWaveTimeout is the duration after which the sync stops waiting for the wave of the resource or hook
+	AnnotationSyncWaveTimeout = "argocd.argoproj.io/sync-wave-timeout"
+	// AnnotationSyncWaveTimeoutAction indicates what happens when the wave of the resource or hook times out
+	AnnotationSyncWaveTimeoutAction = "argocd.argoproj.io/sync-wave-timeout-action"
 	// AnnotationKeyHook contains the hook type of a resource
 	AnnotationKeyHook = "argocd.argoproj.io/hook"
 	// AnnotationKeyHookDeletePolicy is the policy of deleting a hook
@@ -46,10 +50,14 @@ const (
 	SyncOptionDeleteRequireConfirm = "Delete=confirm"
 	// Sync option that requires confirmation before deleting the resource
 	SyncOptionPruneRequireConfirm = "Prune=confirm"
+	// Sync option that requires approval before syncing the wave of the resource
+	SyncOptionRequireApproval = "Approval=required"
 	// Sync option that enables client-side apply migration
 	SyncOptionClientSideApplyMigration = "ClientSideApplyMigration=true"
 	// Sync option that disables client-side apply migration
 	SyncOptionDisableClientSideApplyMigration = "ClientSideApplyMigration=false"
+	// Sync option that keeps applying the other resources of a wave when some of its resources fail
+	SyncOptionContinueOnError = "ContinueOnError=true"
 
 	// Default field manager for client-side apply migration
 	DefaultClientSideApplyMigrationManager = "kubectl-client-side-apply"
@@ -64,6 +72,31 @@ type SyncPhase string
 // executed, and whether or not that wave was the final one.
 type SyncWaveHook func(phase SyncPhase, wave int, final bool) error
 
+// SyncWaveReadiness is a callback function which will be invoked once the resources of a sync wave
+// are healthy. The sync operation waits for the wave until the callback reports its live objects as ready.
+type SyncWaveReadiness func(phase SyncPhase, wave int, liveObjs []*unstructured.Unstructured) (ready bool, message string, err error)
+
+// SyncAnalysis is a callback function which will be invoked once all the tasks of a sync completed successfully,
+// before the sync is marked as successful. It returns OperationRunning while the analysis is in progress, and
+// OperationFailed or OperationError if the sync should be marked as failed.
+type SyncAnalysis func() (phase OperationPhase, message string)
+
+// SyncWaveTimeoutAction is what happens when a sync wave does not complete within its timeout
+type SyncWaveTimeoutAction string
+
+const (
+	// SyncWaveTimeoutActionFail fails the sync operation without running the SyncFail hooks
+	SyncWaveTimeoutActionFail SyncWaveTimeoutAction = "Fail"
+	// SyncWaveTimeoutActionSyncFail fails the sync operation and runs the SyncFail hooks
+	SyncWaveTimeoutActionSyncFail SyncWaveTimeoutAction = "SyncFail"
+	// SyncWaveTimeoutActionContinue considers the wave completed and continues with the next wave
+	SyncWaveTimeoutActionContinue SyncWaveTimeoutAction = "Continue"
+)
+
+// SyncWaveApprover is a callback function which will be invoked before a sync wave which requires
+// approval is applied. The sync operation waits until the callback returns true for the phase and wave.
+type SyncWaveApprover func(phase SyncPhase, wave int) bool
+
 const (
 	SyncPhasePreSync  = "PreSync"
 	SyncPhaseSync     = "Sync"
@@ -79,6 +112,8 @@ const (
 	OperationFailed      OperationPhase = "Failed"
 	OperationError       OperationPhase = "Error"
 	OperationSucceeded   OperationPhase = "Succeeded"
+	// OperationWaitingForApproval indicates that the operation waits for the approval of the next sync wave
+	OperationWaitingForApproval OperationPhase = "WaitingForApproval"
 )
 
 func (os OperationPhase) Completed() bool {
@@ -144,6 +179,17 @@ func NewHookDeletePolicy(p string) (HookDeletePolicy, bool) {
 			p == string(HookDeletePolicyBeforeHookCreation)
 }
 
+// SyncAction is the action the sync takes to reconcile a resource
+type SyncAction string
+
+const (
+	SyncActionCreate  SyncAction = "Create"
+	SyncActionPatch   SyncAction = "Patch"
+	SyncActionReplace SyncAction = "Replace"
+	SyncActionPrune   SyncAction = "Prune"
+	SyncActionHook    SyncAction = "Hook"
+)
+
 type ResourceSyncResult struct {
 	// holds associated resource key
 	ResourceKey kube.ResourceKey
@@ -166,4 +212,10 @@ type ResourceSyncResult struct {
 	HookPhase OperationPhase
 	// indicates the particular phase of the sync that this is for
 	SyncPhase SyncPhase
+	// the sync wave of the resource
+	SyncWave int
+	// the action taken (or, for a dry-run, that would be taken) to reconcile the resource
+	Action SyncAction
+	// the time the resource or hook was first synced
+	SyncedAt metav1.Time
 }
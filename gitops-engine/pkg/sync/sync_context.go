@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -31,6 +32,7 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/sync/hook"
 	resourceutil "github.com/argoproj/gitops-engine/pkg/sync/resource"
+	"github.com/argoproj/gitops-engine/pkg/sync/syncwaves"
 	kubeutil "github.com/argoproj/gitops-engine/pkg/utils/kube"
 )
 
@@ -101,6 +103,23 @@ func WithResourcesFilter(resourcesFilter func(key kubeutil.ResourceKey, target *
 	}
 }
 
+// WithSyncWaveRange limits the sync operation to the resources and hooks with a sync wave between minWave and maxWave,
+// inclusive. A nil bound leaves the range open on that side. SyncFail hooks are not limited by the range.
+func WithSyncWaveRange(minWave, maxWave *int) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.minSyncWave = minWave
+		ctx.maxSyncWave = maxWave
+	}
+}
+
+// WithSyncPhases limits the sync operation to the resources and hooks of the given phases. An empty list does not limit
+// the sync. SyncFail hooks are not limited by the phases.
+func WithSyncPhases(phases []common.SyncPhase) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.syncPhases = phases
+	}
+}
+
 // WithSkipHooks specifies if hooks should be enabled or not
 func WithSkipHooks(skipHooks bool) SyncOpt {
 	return func(ctx *syncContext) {
@@ -146,6 +165,14 @@ func WithPruneLast(enabled bool) SyncOpt {
 	}
 }
 
+// WithContinueOnError enables or disables applying the other resources of a wave when some of its resources fail. The
+// sync fails once the wave is run, listing every failed resource with its error.
+func WithContinueOnError(enabled bool) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.continueOnError = enabled
+	}
+}
+
 // WithResourceModificationChecker sets resource modification result
 func WithResourceModificationChecker(enabled bool, diffResults *diff.DiffResultList) SyncOpt {
 	return func(ctx *syncContext) {
@@ -175,6 +202,30 @@ func WithLogr(log logr.Logger) SyncOpt {
 	}
 }
 
+// WithSyncWaveApproval sets a callback that is invoked before the application of every wave which contains a resource
+// with the Approval=required sync option. The wave is not applied until the callback approves it.
+func WithSyncWaveApproval(syncWaveApproval common.SyncWaveApprover) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.syncWaveApproval = syncWaveApproval
+	}
+}
+
+// WithSyncWaveReadiness sets a callback that is invoked once the resources of a wave are healthy. The sync does not
+// proceed to the next wave until the callback reports the wave as ready.
+func WithSyncWaveReadiness(syncWaveReadiness common.SyncWaveReadiness) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.syncWaveReadiness = syncWaveReadiness
+	}
+}
+
+// WithSyncAnalysis sets a callback that is invoked once all tasks completed successfully. The sync is not marked as
+// successful until the callback reports the analysis as successful.
+func WithSyncAnalysis(syncAnalysis common.SyncAnalysis) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.syncAnalysis = syncAnalysis
+	}
+}
+
 // WithSyncWaveHook sets a callback that is invoked after application of every wave
 func WithSyncWaveHook(syncWaveHook common.SyncWaveHook) SyncOpt {
 	return func(ctx *syncContext) {
@@ -360,11 +411,15 @@ type syncContext struct {
 	validate                        bool
 	skipHooks                       bool
 	resourcesFilter                 func(key kubeutil.ResourceKey, target *unstructured.Unstructured, live *unstructured.Unstructured) bool
+	minSyncWave                     *int
+	maxSyncWave                     *int
+	syncPhases                      []common.SyncPhase
 	prune                           bool
 	replace                         bool
 	serverSideApply                 bool
 	serverSideApplyManager          string
 	pruneLast                       bool
+	continueOnError                 bool
 	prunePropagationPolicy          *metav1.DeletionPropagation
 	pruneConfirmed                  bool
 	clientSideApplyMigrationManager string
@@ -384,7 +439,10 @@ type syncContext struct {
 	// namespace should be synced
 	syncNamespace func(*unstructured.Unstructured, *unstructured.Unstructured) (bool, error)
 
-	syncWaveHook common.SyncWaveHook
+	syncWaveHook      common.SyncWaveHook
+	syncWaveApproval  common.SyncWaveApprover
+	syncWaveReadiness common.SyncWaveReadiness
+	syncAnalysis      common.SyncAnalysis
 
 	applyOutOfSyncOnly bool
 	// stores whether the resource is modified or not
@@ -453,12 +511,16 @@ func (sc *syncContext) Sync() {
 
 		sc.log.WithValues("tasks", dryRunTasks).Info("Tasks (dry-run)")
 		if sc.runTasks(dryRunTasks, true) == failed {
-			sc.setOperationPhase(common.OperationFailed, "one or more objects failed to apply (dry run)")
-			return
+			if !sc.continueOnError {
+				sc.setOperationPhase(common.OperationFailed, "one or more objects failed to apply (dry run)")
+				return
+			}
+			sc.log.Info("One or more objects failed to apply (dry run), continuing with the other objects")
 		}
 	}
 
 	// update status of any tasks that are running, note that this must exclude pruning tasks
+	waveReadiness := map[string]*waveReadinessResult{}
 	for _, task := range tasks.Filter(func(t *syncTask) bool {
 		// just occasionally, you can be running yet not have a live resource
 		return t.running() && t.liveObj != nil
@@ -476,13 +538,23 @@ func (sc *syncContext) Sync() {
 			healthStatus, err := health.GetResourceHealth(task.liveObj, sc.healthOverride)
 			if err == nil {
 				sc.log.WithValues("task", task, "healthStatus", healthStatus).V(1).Info("attempting to update health of running task")
-				if healthStatus == nil {
-					// some objects (e.g. secret) do not have health, and they automatically success
-					sc.setResourceResult(task, task.syncStatus, common.OperationSucceeded, task.message)
+				if healthStatus == nil || healthStatus.Status == health.HealthStatusHealthy {
+					message := task.message
+					if healthStatus != nil {
+						message = healthStatus.Message
+					}
+					readiness := sc.getWaveReadiness(tasks, task, waveReadiness)
+					switch {
+					case readiness.err != nil:
+						sc.setResourceResult(task, task.syncStatus, common.OperationError, fmt.Sprintf("failed to check sync wave readiness: %v", readiness.err))
+					case !readiness.ready:
+						sc.setResourceResult(task, task.syncStatus, common.OperationRunning, readiness.message)
+					default:
+						// some objects (e.g. secret) do not have health, and they automatically success
+						sc.setResourceResult(task, task.syncStatus, common.OperationSucceeded, message)
+					}
 				} else {
 					switch healthStatus.Status {
-					case health.HealthStatusHealthy:
-						sc.setResourceResult(task, task.syncStatus, common.OperationSucceeded, healthStatus.Message)
 					case health.HealthStatusDegraded:
 						sc.setResourceResult(task, task.syncStatus, common.OperationFailed, healthStatus.Message)
 					}
@@ -496,6 +568,13 @@ func (sc *syncContext) Sync() {
 	// then wait...
 	multiStep := tasks.multiStep()
 	runningTasks := tasks.Filter(func(t *syncTask) bool { return (multiStep || t.isHook()) && t.running() })
+	if timedOut, action, message := sc.terminateTimedOutWaves(runningTasks); timedOut {
+		if action == common.SyncWaveTimeoutActionFail {
+			sc.setOperationPhase(common.OperationFailed, message)
+			return
+		}
+		runningTasks = runningTasks.Filter(func(t *syncTask) bool { return t.running() })
+	}
 	if runningTasks.Len() > 0 {
 		sc.setRunningPhase(runningTasks, false)
 		return
@@ -535,13 +614,8 @@ func (sc *syncContext) Sync() {
 	syncFailTasks, tasks := tasks.Split(func(t *syncTask) bool { return t.phase == common.SyncPhaseSyncFail })
 
 	syncFailedTasks, _ := tasks.Split(func(t *syncTask) bool { return t.syncStatus == common.ResultCodeSyncFailed })
-
-	// if there are any completed but unsuccessful tasks, sync is a failure.
-	if tasks.Any(func(t *syncTask) bool { return t.completed() && !t.successful() }) {
-		sc.deleteHooks(hooksPendingDeletionFailed)
-		sc.setOperationFailed(syncFailTasks, syncFailedTasks, "one or more synchronization tasks completed unsuccessfully")
-		return
-	}
+	failedTasks := tasks.Filter(func(t *syncTask) bool { return t.completed() && !t.successful() })
+	allTasks := tasks
 
 	sc.log.WithValues("tasks", tasks).V(1).Info("Filtering out non-pending tasks")
 	// remove tasks that are completed, we can assume that there are no running tasks
@@ -551,9 +625,20 @@ func (sc *syncContext) Sync() {
 		tasks = sc.filterOutOfSyncTasks(tasks)
 	}
 
+	// if there are any completed but unsuccessful tasks, sync is a failure, unless the other tasks of their wave are
+	// still to be run.
+	if failedTasks.Len() > 0 && !sc.continueAfterFailedTasks(failedTasks, tasks) {
+		sc.deleteHooks(hooksPendingDeletionFailed)
+		sc.setTasksFailed(syncFailTasks, syncFailedTasks, allTasks, "one or more synchronization tasks completed unsuccessfully")
+		return
+	}
+
 	// If no sync tasks were generated (e.g., in case all application manifests have been removed),
 	// the sync operation is successful.
 	if len(tasks) == 0 {
+		if !sc.analyzeSync(syncFailTasks, hooksPendingDeletionFailed) {
+			return
+		}
 		// delete all completed hooks which have appropriate delete policy
 		sc.deleteHooks(hooksPendingDeletionSuccessful)
 		sc.setOperationPhase(common.OperationSucceeded, "successfully synced (no more tasks)")
@@ -573,6 +658,12 @@ func (sc *syncContext) Sync() {
 	sc.log.WithValues("phase", phase, "wave", wave, "tasks", tasks, "syncFailTasks", syncFailTasks).V(1).Info("Filtering tasks in correct phase and wave")
 	tasks = tasks.Filter(func(t *syncTask) bool { return t.phase == phase && t.wave() == wave })
 
+	if sc.syncWaveApproval != nil && !sc.dryRun && tasks.Any(func(t *syncTask) bool { return t.requiresApproval() }) && !sc.syncWaveApproval(phase, wave) {
+		sc.log.WithValues("phase", phase, "wave", wave).Info("Sync wave requires approval")
+		sc.setOperationPhase(common.OperationWaitingForApproval, fmt.Sprintf("waiting for approval of %s wave %d", phase, wave))
+		return
+	}
+
 	sc.setOperationPhase(common.OperationRunning, "one or more tasks are running")
 
 	sc.log.WithValues("tasks", tasks).V(1).Info("Wet-run")
@@ -592,9 +683,17 @@ func (sc *syncContext) Sync() {
 	case failed:
 		syncFailedTasks, _ := tasks.Split(func(t *syncTask) bool { return t.syncStatus == common.ResultCodeSyncFailed })
 		sc.deleteHooks(hooksPendingDeletionFailed)
-		sc.setOperationFailed(syncFailTasks, syncFailedTasks, "one or more objects failed to apply")
+		sc.setTasksFailed(syncFailTasks, syncFailedTasks, allTasks, "one or more objects failed to apply")
 	case successful:
 		if remainingTasks.Len() == 0 {
+			if failedTasks.Len() > 0 {
+				sc.deleteHooks(hooksPendingDeletionFailed)
+				sc.setTasksFailed(syncFailTasks, syncFailedTasks, allTasks, "one or more synchronization tasks completed unsuccessfully")
+				return
+			}
+			if !sc.analyzeSync(syncFailTasks, hooksPendingDeletionFailed) {
+				return
+			}
 			// delete all completed hooks which have appropriate delete policy
 			sc.deleteHooks(hooksPendingDeletionSuccessful)
 			sc.setOperationPhase(common.OperationSucceeded, "successfully synced (all tasks run)")
@@ -608,6 +707,136 @@ func (sc *syncContext) Sync() {
 	}
 }
 
+type waveReadinessResult struct {
+	ready   bool
+	message string
+	err     error
+}
+
+// getWaveReadiness returns whether the wave of the task is ready, invoking the readiness callback at most once per wave.
+// Prune tasks and hooks are not part of the readiness check.
+func (sc *syncContext) getWaveReadiness(tasks syncTasks, task *syncTask, cache map[string]*waveReadinessResult) *waveReadinessResult {
+	if sc.syncWaveReadiness == nil {
+		return &waveReadinessResult{ready: true}
+	}
+	key := fmt.Sprintf("%s:%d", task.phase, task.wave())
+	if res, ok := cache[key]; ok {
+		return res
+	}
+	var liveObjs []*unstructured.Unstructured
+	for _, t := range tasks {
+		if t.phase == task.phase && t.wave() == task.wave() && !t.isHook() && !t.isPrune() && t.liveObj != nil {
+			liveObjs = append(liveObjs, t.liveObj)
+		}
+	}
+	res := &waveReadinessResult{}
+	res.ready, res.message, res.err = sc.syncWaveReadiness(task.phase, task.wave(), liveObjs)
+	if !res.ready && res.message == "" {
+		res.message = fmt.Sprintf("waiting for %s wave %d to be ready", task.phase, task.wave())
+	}
+	cache[key] = res
+	return res
+}
+
+// terminateTimedOutWaves completes the running tasks of every wave which has been running for longer than its timeout.
+// The timeout of a wave is the longest timeout of its resources and hooks, and the wave starts when its first resource
+// or hook is synced. The running hooks of a wave that times out are deleted. The running tasks of a wave that times out
+// with the Continue action succeed, otherwise they fail.
+// It returns the action and message of the first wave that timed out, if any.
+func (sc *syncContext) terminateTimedOutWaves(runningTasks syncTasks) (bool, common.SyncWaveTimeoutAction, string) {
+	type waveKey struct {
+		phase common.SyncPhase
+		wave  int
+	}
+	var keys []waveKey
+	waves := map[waveKey]syncTasks{}
+	for _, task := range runningTasks {
+		key := waveKey{phase: task.phase, wave: task.wave()}
+		if _, ok := waves[key]; !ok {
+			keys = append(keys, key)
+		}
+		waves[key] = append(waves[key], task)
+	}
+
+	timedOut := false
+	var timedOutAction common.SyncWaveTimeoutAction
+	var timedOutMessage string
+	for _, key := range keys {
+		var timeout time.Duration
+		action := common.SyncWaveTimeoutActionSyncFail
+		for _, task := range waves[key] {
+			if t := syncwaves.Timeout(task.obj()); t > timeout {
+				timeout = t
+				action = syncwaves.TimeoutAction(task.obj())
+			}
+		}
+		if timeout == 0 {
+			continue
+		}
+		var startedAt time.Time
+		sc.lock.Lock()
+		for _, task := range waves[key] {
+			if res, ok := sc.syncRes[task.resultKey()]; ok && !res.SyncedAt.IsZero() && (startedAt.IsZero() || res.SyncedAt.Before(&metav1.Time{Time: startedAt})) {
+				startedAt = res.SyncedAt.Time
+			}
+		}
+		sc.lock.Unlock()
+		if startedAt.IsZero() || time.Since(startedAt) < timeout {
+			continue
+		}
+
+		message := fmt.Sprintf("%s wave %d did not complete within %s", key.phase, key.wave, timeout)
+		sc.log.WithValues("phase", key.phase, "wave", key.wave, "timeout", timeout, "action", action).Info("Sync wave timed out")
+		for _, task := range waves[key] {
+			if err := sc.terminateHook(task); err != nil {
+				sc.setResourceResult(task, task.syncStatus, common.OperationFailed, fmt.Sprintf("%s: failed to terminate hook: %v", message, err))
+			} else if action == common.SyncWaveTimeoutActionContinue {
+				sc.setResourceResult(task, task.syncStatus, common.OperationSucceeded, message)
+			} else {
+				sc.setResourceResult(task, task.syncStatus, common.OperationFailed, message)
+			}
+		}
+		if !timedOut {
+			timedOut, timedOutAction, timedOutMessage = true, action, message
+		}
+	}
+	return timedOut, timedOutAction, timedOutMessage
+}
+
+// terminateHook removes the finalizer of a running hook and deletes it, so that a hook of a timed out wave, such as a
+// Job, does not keep running once the wave is completed. It is a no-op for tasks which are not hooks.
+func (sc *syncContext) terminateHook(task *syncTask) error {
+	if !task.isHook() || task.liveObj == nil {
+		return nil
+	}
+	if err := sc.removeHookFinalizer(task); err != nil {
+		return fmt.Errorf("failed to remove hook finalizer: %w", err)
+	}
+	if err := sc.deleteResource(task); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// analyzeSync runs the sync analysis once all tasks completed successfully, and returns whether the sync can be marked
+// as successful. Otherwise, the operation is either running while the analysis is in progress, or failed.
+func (sc *syncContext) analyzeSync(syncFailTasks, hooksPendingDeletionFailed syncTasks) bool {
+	if sc.syncAnalysis == nil || sc.dryRun {
+		return true
+	}
+	phase, message := sc.syncAnalysis()
+	switch phase {
+	case common.OperationSucceeded:
+		return true
+	case common.OperationFailed, common.OperationError:
+		sc.deleteHooks(hooksPendingDeletionFailed)
+		sc.setOperationFailed(syncFailTasks, syncTasks{}, fmt.Sprintf("sync analysis failed: %s", message))
+	default:
+		sc.setOperationPhase(common.OperationRunning, message)
+	}
+	return false
+}
+
 // filter out out-of-sync tasks
 func (sc *syncContext) filterOutOfSyncTasks(tasks syncTasks) syncTasks {
 	return tasks.Filter(func(t *syncTask) bool {
@@ -761,6 +990,36 @@ func (sc *syncContext) setOperationFailed(syncFailTasks, syncFailedTasks syncTas
 	}
 }
 
+// setTasksFailed sets the operation as failed. With continueOnError, the message lists every failed task with its error
+// instead of the errors of syncFailedTasks only.
+func (sc *syncContext) setTasksFailed(syncFailTasks, syncFailedTasks, tasks syncTasks, message string) {
+	if !sc.continueOnError {
+		sc.setOperationFailed(syncFailTasks, syncFailedTasks, message)
+		return
+	}
+	failedTasks := tasks.Filter(func(t *syncTask) bool { return t.completed() && !t.successful() })
+	messages := failedTasks.Map(func(t *syncTask) string {
+		key := t.resourceKey()
+		return fmt.Sprintf("%s: %s", key.String(), t.message)
+	})
+	slices.Sort(messages)
+	sc.setOperationFailed(syncFailTasks, syncTasks{}, fmt.Sprintf("%s: %s", message, strings.Join(messages, "; ")))
+}
+
+// continueAfterFailedTasks returns whether the sync continues with the pending tasks although some tasks failed, which
+// is the case with continueOnError until the pending tasks of the waves of the failed tasks are run.
+func (sc *syncContext) continueAfterFailedTasks(failedTasks, pendingTasks syncTasks) bool {
+	if !sc.continueOnError || pendingTasks.Len() == 0 {
+		return false
+	}
+	// tasks are sorted by phase and wave, so the first ones are the earliest
+	failed, next := failedTasks[0], pendingTasks[0]
+	if d := syncPhaseOrder[next.phase] - syncPhaseOrder[failed.phase]; d != 0 {
+		return d < 0
+	}
+	return next.wave() <= failed.wave()
+}
+
 func (sc *syncContext) started() bool {
 	return len(sc.syncRes) > 0
 }
@@ -843,6 +1102,8 @@ func (sc *syncContext) getSyncTasks() (_ syncTasks, successful bool) {
 		}
 	}
 
+	// the tasks appended by the namespace auto-creation are not limited by the sync wave range and phases
+	autoCreatedTasks := len(tasks)
 	if sc.syncNamespace != nil && sc.namespace != "" {
 		tasks = sc.autoCreateNamespace(tasks)
 	}
@@ -855,6 +1116,62 @@ func (sc *syncContext) getSyncTasks() (_ syncTasks, successful bool) {
 		task.liveObj = sc.liveObj(task.targetObj)
 	}
 
+	// for prune tasks, modify the waves for proper cleanup i.e reverse of sync wave (creation order)
+	pruneTasks := make(map[int][]*syncTask)
+	for _, task := range tasks {
+		if task.isPrune() {
+			pruneTasks[task.wave()] = append(pruneTasks[task.wave()], task)
+		}
+	}
+
+	var uniquePruneWaves []int
+	for k := range pruneTasks {
+		uniquePruneWaves = append(uniquePruneWaves, k)
+	}
+	sort.Ints(uniquePruneWaves)
+
+	// reorder waves for pruning tasks using symmetric swap on prune waves
+	n := len(uniquePruneWaves)
+	for i := 0; i < n/2; i++ {
+		// waves to swap
+		startWave := uniquePruneWaves[i]
+		endWave := uniquePruneWaves[n-1-i]
+
+		for _, task := range pruneTasks[startWave] {
+			task.waveOverride = &endWave
+		}
+
+		for _, task := range pruneTasks[endWave] {
+			task.waveOverride = &startWave
+		}
+	}
+
+	// for pruneLast tasks, modify the wave to sync phase last wave of tasks + 1
+	// to ensure proper cleanup, syncPhaseLastWave should also consider prune tasks to determine last wave
+	syncPhaseLastWave := 0
+	for _, task := range tasks {
+		if task.phase == common.SyncPhaseSync {
+			if task.wave() > syncPhaseLastWave {
+				syncPhaseLastWave = task.wave()
+			}
+		}
+	}
+	syncPhaseLastWave = syncPhaseLastWave + 1
+
+	for _, task := range tasks {
+		if task.isPrune() &&
+			(sc.pruneLast || resourceutil.HasAnnotationOption(task.liveObj, common.AnnotationSyncOptions, common.SyncOptionPruneLast)) {
+			task.waveOverride = &syncPhaseLastWave
+		}
+	}
+
+	if sc.minSyncWave != nil || sc.maxSyncWave != nil || len(sc.syncPhases) > 0 {
+		nsTasks := tasks[autoCreatedTasks:]
+		tasks = tasks.Filter(func(t *syncTask) bool {
+			return slices.Contains(nsTasks, t) || sc.inSyncRange(t)
+		})
+	}
+
 	isRetryable := apierrors.IsUnauthorized
 
 	serverResCache := make(map[schema.GroupVersionKind]*metav1.APIResource)
@@ -908,55 +1225,6 @@ func (sc *syncContext) getSyncTasks() (_ syncTasks, successful bool) {
 		}
 	}
 
-	// for prune tasks, modify the waves for proper cleanup i.e reverse of sync wave (creation order)
-	pruneTasks := make(map[int][]*syncTask)
-	for _, task := range tasks {
-		if task.isPrune() {
-			pruneTasks[task.wave()] = append(pruneTasks[task.wave()], task)
-		}
-	}
-
-	var uniquePruneWaves []int
-	for k := range pruneTasks {
-		uniquePruneWaves = append(uniquePruneWaves, k)
-	}
-	sort.Ints(uniquePruneWaves)
-
-	// reorder waves for pruning tasks using symmetric swap on prune waves
-	n := len(uniquePruneWaves)
-	for i := 0; i < n/2; i++ {
-		// waves to swap
-		startWave := uniquePruneWaves[i]
-		endWave := uniquePruneWaves[n-1-i]
-
-		for _, task := range pruneTasks[startWave] {
-			task.waveOverride = &endWave
-		}
-
-		for _, task := range pruneTasks[endWave] {
-			task.waveOverride = &startWave
-		}
-	}
-
-	// for pruneLast tasks, modify the wave to sync phase last wave of tasks + 1
-	// to ensure proper cleanup, syncPhaseLastWave should also consider prune tasks to determine last wave
-	syncPhaseLastWave := 0
-	for _, task := range tasks {
-		if task.phase == common.SyncPhaseSync {
-			if task.wave() > syncPhaseLastWave {
-				syncPhaseLastWave = task.wave()
-			}
-		}
-	}
-	syncPhaseLastWave = syncPhaseLastWave + 1
-
-	for _, task := range tasks {
-		if task.isPrune() &&
-			(sc.pruneLast || resourceutil.HasAnnotationOption(task.liveObj, common.AnnotationSyncOptions, common.SyncOptionPruneLast)) {
-			task.waveOverride = &syncPhaseLastWave
-		}
-	}
-
 	tasks.Sort()
 
 	// finally enrich tasks with the result
@@ -972,6 +1240,24 @@ func (sc *syncContext) getSyncTasks() (_ syncTasks, successful bool) {
 	return tasks, successful
 }
 
+// inSyncRange returns whether the task is within the sync wave range and phases the sync operation is limited to.
+// The wave of prune tasks is the one they are pruned in, which is the reverse of their sync wave.
+func (sc *syncContext) inSyncRange(task *syncTask) bool {
+	if task.phase == common.SyncPhaseSyncFail {
+		return true
+	}
+	if len(sc.syncPhases) > 0 && !slices.Contains(sc.syncPhases, task.phase) {
+		return false
+	}
+	if sc.minSyncWave != nil && task.wave() < *sc.minSyncWave {
+		return false
+	}
+	if sc.maxSyncWave != nil && task.wave() > *sc.maxSyncWave {
+		return false
+	}
+	return true
+}
+
 func (sc *syncContext) autoCreateNamespace(tasks syncTasks) syncTasks {
 	isNamespaceCreationNeeded := true
 
@@ -1217,6 +1503,22 @@ func (sc *syncContext) applyObject(t *syncTask, dryRun, validate bool) (common.R
 	return common.ResultCodeSynced, message
 }
 
+// getSyncAction returns the action which is taken to reconcile the resource of the task
+func (sc *syncContext) getSyncAction(t *syncTask) common.SyncAction {
+	switch {
+	case t.isHook():
+		return common.SyncActionHook
+	case t.isPrune():
+		return common.SyncActionPrune
+	case t.liveObj == nil:
+		return common.SyncActionCreate
+	case sc.replace || resourceutil.HasAnnotationOption(t.targetObj, common.AnnotationSyncOptions, common.SyncOptionReplace):
+		return common.SyncActionReplace
+	default:
+		return common.SyncActionPatch
+	}
+}
+
 // pruneObject deletes the object if both prune is true and dryRun is false. Otherwise appropriate message
 func (sc *syncContext) pruneObject(liveObj *unstructured.Unstructured, prune, dryRun bool) (common.ResultCode, string) {
 	if !prune {
@@ -1360,6 +1662,12 @@ const (
 	failed
 )
 
+// stopRunningTasks returns whether the remaining tasks are not run given the state of the tasks run so far. With
+// continueOnError, failed tasks do not stop the other tasks.
+func (sc *syncContext) stopRunningTasks(state runState) bool {
+	return state == pending || (state == failed && !sc.continueOnError)
+}
+
 func (sc *syncContext) runTasks(tasks syncTasks, dryRun bool) runState {
 	dryRun = dryRun || sc.dryRun
 
@@ -1397,7 +1705,7 @@ func (sc *syncContext) runTasks(tasks syncTasks, dryRun bool) runState {
 		}
 		state = ss.Wait()
 	}
-	if state != successful {
+	if sc.stopRunningTasks(state) {
 		return state
 	}
 
@@ -1441,7 +1749,7 @@ func (sc *syncContext) runTasks(tasks syncTasks, dryRun bool) runState {
 		state = ss.Wait()
 	}
 
-	if state != successful {
+	if sc.stopRunningTasks(state) {
 		return state
 	}
 
@@ -1478,7 +1786,7 @@ func (sc *syncContext) runTasks(tasks syncTasks, dryRun bool) runState {
 		state = ss.Wait()
 	}
 
-	if state != successful {
+	if sc.stopRunningTasks(state) {
 		return state
 	}
 
@@ -1552,6 +1860,9 @@ func (sc *syncContext) setResourceResult(task *syncTask, syncStatus common.Resul
 		HookType:    task.hookType(),
 		HookPhase:   task.operationState,
 		SyncPhase:   task.phase,
+		SyncWave:    task.wave(),
+		Action:      sc.getSyncAction(task),
+		SyncedAt:    metav1.Now(),
 	}
 
 	logCtx := sc.log.WithValues("namespace", task.namespace(), "kind", task.kind(), "name", task.name(), "phase", task.phase)
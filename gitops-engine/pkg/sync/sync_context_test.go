@@ -28,6 +28,7 @@ import (
 	"k8s.io/client-go/rest"
 	testcore "k8s.io/client-go/testing"
 	"k8s.io/klog/v2/textlogger"
+	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/gitops-engine/pkg/diff"
 	"github.com/argoproj/gitops-engine/pkg/health"
@@ -325,6 +326,49 @@ func TestSyncSuccessfully(t *testing.T) {
 	}
 }
 
+func TestSyncDryRunActions(t *testing.T) {
+	syncCtx := newTestSyncCtx(nil, WithOperationSettings(true, true, false, false))
+	svc := testingutils.NewService()
+	svc.SetNamespace(testingutils.FakeArgoCDNamespace)
+	pod := testingutils.NewPod()
+	pod.SetNamespace(testingutils.FakeArgoCDNamespace)
+	pod.SetAnnotations(map[string]string{synccommon.AnnotationSyncWave: "2"})
+	extraPod := testingutils.NewPod()
+	extraPod.SetName("extra-pod")
+	extraPod.SetNamespace(testingutils.FakeArgoCDNamespace)
+	replacedPod := testingutils.NewPod()
+	replacedPod.SetName("replaced-pod")
+	replacedPod.SetNamespace(testingutils.FakeArgoCDNamespace)
+	replacedPod.SetAnnotations(map[string]string{synccommon.AnnotationSyncOptions: synccommon.SyncOptionReplace})
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{nil, pod, extraPod, replacedPod},
+		Target: []*unstructured.Unstructured{svc, pod, nil, replacedPod},
+	})
+	hook := newHook(synccommon.HookTypePreSync)
+	hook.SetName("hook-pod")
+	syncCtx.hooks = []*unstructured.Unstructured{hook}
+
+	syncCtx.Sync()
+	phase, _, resources := syncCtx.GetState()
+
+	assert.Equal(t, synccommon.OperationSucceeded, phase)
+	actions := map[string]synccommon.SyncAction{}
+	waves := map[string]int{}
+	for _, result := range resources {
+		actions[result.ResourceKey.Name] = result.Action
+		waves[result.ResourceKey.Name] = result.SyncWave
+	}
+	assert.Equal(t, map[string]synccommon.SyncAction{
+		svc.GetName():  synccommon.SyncActionCreate,
+		pod.GetName():  synccommon.SyncActionPatch,
+		"extra-pod":    synccommon.SyncActionPrune,
+		"replaced-pod": synccommon.SyncActionReplace,
+		"hook-pod":     synccommon.SyncActionHook,
+	}, actions)
+	assert.Equal(t, 2, waves[pod.GetName()])
+	assert.Equal(t, 0, waves[svc.GetName()])
+}
+
 func TestSyncDeleteSuccessfully(t *testing.T) {
 	syncCtx := newTestSyncCtx(nil, WithOperationSettings(false, true, false, false))
 	svc := testingutils.NewService()
@@ -611,6 +655,62 @@ func TestSyncPruneFailure(t *testing.T) {
 	assert.Equal(t, "foo", result.Message)
 }
 
+func TestSyncContinueOnError(t *testing.T) {
+	newSyncCtx := func() *syncContext {
+		return newTestSyncCtx(nil, WithOperationSettings(false, true, false, false), WithContinueOnError(true), func(ctx *syncContext) {
+			ctx.resourceOps = &kubetest.MockResourceOps{Commands: map[string]kubetest.KubectlOutput{
+				"my-pod": {Err: errors.New("invalid object")},
+			}}
+			ctx.kubectl = &kubetest.MockKubectlCmd{Commands: map[string]kubetest.KubectlOutput{
+				"old-service": {Err: errors.New("forbidden")},
+			}}
+		})
+	}
+
+	t.Run("SameWave", func(t *testing.T) {
+		syncCtx := newSyncCtx()
+		oldSvc := testingutils.NewService()
+		oldSvc.SetName("old-service")
+		oldSvc.SetNamespace(testingutils.FakeArgoCDNamespace)
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{nil, nil, oldSvc},
+			Target: []*unstructured.Unstructured{testingutils.NewPod(), testingutils.NewService(), nil},
+		})
+
+		syncCtx.Sync()
+		phase, msg, resources := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationFailed, phase)
+		assert.Equal(t, "one or more objects failed to apply: "+
+			"/Pod/fake-argocd-ns/my-pod: invalid object; /Service/fake-argocd-ns/old-service: forbidden", msg)
+		results := map[string]synccommon.ResultCode{}
+		for _, res := range resources {
+			results[res.ResourceKey.Name] = res.Status
+		}
+		assert.Equal(t, map[string]synccommon.ResultCode{
+			"my-pod":      synccommon.ResultCodeSyncFailed,
+			"my-service":  synccommon.ResultCodeSynced,
+			"old-service": synccommon.ResultCodeSyncFailed,
+		}, results)
+	})
+
+	t.Run("LaterWave", func(t *testing.T) {
+		syncCtx := newSyncCtx()
+		svc := testingutils.NewService()
+		svc.SetAnnotations(map[string]string{synccommon.AnnotationSyncWave: "1"})
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{nil, nil},
+			Target: []*unstructured.Unstructured{testingutils.NewPod(), svc},
+		})
+
+		syncCtx.Sync()
+		phase, msg, resources := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationFailed, phase)
+		assert.Equal(t, "one or more synchronization tasks completed unsuccessfully: /Pod/fake-argocd-ns/my-pod: invalid object", msg)
+		require.Len(t, resources, 1)
+		assert.Equal(t, "my-pod", resources[0].ResourceKey.Name)
+	})
+}
+
 type APIServerMock struct {
 	calls       int
 	errorStatus int
@@ -1091,6 +1191,87 @@ func TestSelectiveSyncOnly(t *testing.T) {
 	assert.Equal(t, "pod-1", tasks[0].name())
 }
 
+func TestSyncWaveRangeAndPhases(t *testing.T) {
+	newObjs := func() ([]*unstructured.Unstructured, []*unstructured.Unstructured) {
+		pod1 := testingutils.NewPod()
+		pod1.SetName("pod-1")
+		pod1.SetAnnotations(map[string]string{synccommon.AnnotationSyncWave: "-1"})
+		pod2 := testingutils.NewPod()
+		pod2.SetName("pod-2")
+		pod3 := testingutils.NewPod()
+		pod3.SetName("pod-3")
+		pod3.SetAnnotations(map[string]string{synccommon.AnnotationSyncWave: "2"})
+		preSync := newHook(synccommon.HookTypePreSync)
+		preSync.SetName("pre-sync")
+		syncFail := newHook(synccommon.HookTypeSyncFail)
+		syncFail.SetName("sync-fail")
+		return []*unstructured.Unstructured{pod1, pod2, pod3}, []*unstructured.Unstructured{preSync, syncFail}
+	}
+
+	tests := []struct {
+		name     string
+		opts     []SyncOpt
+		expected []string
+	}{
+		{"no limits", nil, []string{"pre-sync", "pod-1", "pod-2", "pod-3", "sync-fail"}},
+		{"up to a wave", []SyncOpt{WithSyncWaveRange(nil, ptr.To(0))}, []string{"pre-sync", "pod-1", "pod-2", "sync-fail"}},
+		{"from a wave", []SyncOpt{WithSyncWaveRange(ptr.To(1), nil)}, []string{"pod-3", "sync-fail"}},
+		{"single wave", []SyncOpt{WithSyncWaveRange(ptr.To(0), ptr.To(0))}, []string{"pre-sync", "pod-2", "sync-fail"}},
+		{"phases", []SyncOpt{WithSyncPhases([]synccommon.SyncPhase{synccommon.SyncPhasePreSync})}, []string{"pre-sync", "sync-fail"}},
+		{"phases and wave range", []SyncOpt{WithSyncPhases([]synccommon.SyncPhase{synccommon.SyncPhaseSync}), WithSyncWaveRange(ptr.To(0), nil)}, []string{"pod-2", "pod-3", "sync-fail"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			syncCtx := newTestSyncCtx(nil, tt.opts...)
+			resources, hooks := newObjs()
+			syncCtx.resources = groupResources(ReconciliationResult{
+				Live:   []*unstructured.Unstructured{nil, nil, nil},
+				Target: resources,
+			})
+			syncCtx.hooks = hooks
+
+			tasks, successful := syncCtx.getSyncTasks()
+
+			assert.True(t, successful)
+			var names []string
+			for _, task := range tasks {
+				names = append(names, task.name())
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func TestSyncWaveRangeResumesFromNextWave(t *testing.T) {
+	pod1 := testingutils.NewPod()
+	pod1.SetName("pod-1")
+	pod2 := testingutils.NewPod()
+	pod2.SetName("pod-2")
+	pod2.SetAnnotations(map[string]string{synccommon.AnnotationSyncWave: "1"})
+	reconciliationResult := ReconciliationResult{
+		Live:   []*unstructured.Unstructured{nil, nil},
+		Target: []*unstructured.Unstructured{pod1, pod2},
+	}
+
+	// the first sync only applies wave 0 and succeeds
+	syncCtx := newTestSyncCtx(nil, WithSyncWaveRange(nil, ptr.To(0)))
+	syncCtx.resources = groupResources(reconciliationResult)
+	syncCtx.Sync()
+	phase, _, results := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationSucceeded, phase)
+	require.Len(t, results, 1)
+	assert.Equal(t, "pod-1", results[0].ResourceKey.Name)
+
+	// a later sync continues from the next wave
+	syncCtx = newTestSyncCtx(nil, WithSyncWaveRange(ptr.To(1), nil))
+	syncCtx.resources = groupResources(reconciliationResult)
+	syncCtx.Sync()
+	phase, _, results = syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationSucceeded, phase)
+	require.Len(t, results, 1)
+	assert.Equal(t, "pod-2", results[0].ResourceKey.Name)
+}
+
 func TestUnnamedHooksGetUniqueNames(t *testing.T) {
 	t.Run("Truncated revision", func(t *testing.T) {
 		syncCtx := newTestSyncCtx(nil)
@@ -1869,6 +2050,321 @@ func TestSyncWaveHookFail(t *testing.T) {
 	assert.Equal(t, synccommon.OperationRunning, results[0].HookPhase)
 }
 
+func TestSyncWaveApproval(t *testing.T) {
+	syncCtx := newTestSyncCtx(nil, WithOperationSettings(false, false, false, false))
+	pod1 := testingutils.NewPod()
+	pod1.SetName("pod-1")
+	pod2 := testingutils.NewPod()
+	pod2.SetName("pod-2")
+	pod2.SetAnnotations(map[string]string{
+		synccommon.AnnotationSyncWave:    "1",
+		synccommon.AnnotationSyncOptions: synccommon.SyncOptionRequireApproval,
+	})
+
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{nil, nil},
+		Target: []*unstructured.Unstructured{pod1, pod2},
+	})
+
+	var requested []int
+	approved := false
+	syncCtx.syncWaveApproval = func(phase synccommon.SyncPhase, wave int) bool {
+		assert.Equal(t, synccommon.SyncPhaseSync, string(phase))
+		requested = append(requested, wave)
+		return approved
+	}
+
+	// wave 0 does not require approval
+	syncCtx.Sync()
+	phase, _, results := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationRunning, phase)
+	assert.Len(t, results, 1)
+	assert.Empty(t, requested)
+
+	// complete wave 0, the sync waits for the approval of wave 1
+	pod1Res := results[0]
+	pod1Res.HookPhase = synccommon.OperationSucceeded
+	syncCtx.syncRes[resourceResultKey(pod1Res.ResourceKey, synccommon.SyncPhaseSync)] = pod1Res
+	syncCtx.Sync()
+	phase, message, results := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationWaitingForApproval, phase)
+	assert.Equal(t, "waiting for approval of Sync wave 1", message)
+	assert.Len(t, results, 1)
+	assert.Equal(t, []int{1}, requested)
+
+	// once approved, wave 1 is applied
+	approved = true
+	syncCtx.Sync()
+	phase, _, results = syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationSucceeded, phase)
+	assert.Len(t, results, 2)
+	assert.Equal(t, []int{1, 1}, requested)
+}
+
+func TestSyncWaveApprovalSkippedOnDryRun(t *testing.T) {
+	syncCtx := newTestSyncCtx(nil, WithOperationSettings(true, false, false, false))
+	pod := testingutils.NewPod()
+	pod.SetAnnotations(map[string]string{synccommon.AnnotationSyncOptions: synccommon.SyncOptionRequireApproval})
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{nil},
+		Target: []*unstructured.Unstructured{pod},
+	})
+	syncCtx.syncWaveApproval = func(_ synccommon.SyncPhase, _ int) bool {
+		return false
+	}
+
+	syncCtx.Sync()
+	phase, _, _ := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationSucceeded, phase)
+}
+
+func TestSyncWaveReadiness(t *testing.T) {
+	syncCtx := newTestSyncCtx(nil, WithOperationSettings(false, false, false, false))
+	svc := testingutils.NewService()
+	pod := testingutils.NewPod()
+	pod.SetAnnotations(map[string]string{synccommon.AnnotationSyncWave: "1"})
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{nil, nil},
+		Target: []*unstructured.Unstructured{svc, pod},
+	})
+
+	ready := false
+	var checked [][]*unstructured.Unstructured
+	syncCtx.syncWaveReadiness = func(phase synccommon.SyncPhase, wave int, liveObjs []*unstructured.Unstructured) (bool, string, error) {
+		assert.Equal(t, synccommon.SyncPhaseSync, string(phase))
+		assert.Equal(t, 0, wave)
+		checked = append(checked, liveObjs)
+		return ready, "service has no endpoints", nil
+	}
+
+	// wave 0 is applied
+	syncCtx.Sync()
+	phase, _, results := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationRunning, phase)
+	assert.Len(t, results, 1)
+	assert.Empty(t, checked)
+
+	// the service is healthy, but wave 0 is not ready
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{svc, nil},
+		Target: []*unstructured.Unstructured{svc, pod},
+	})
+	syncCtx.Sync()
+	phase, _, results = syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationRunning, phase)
+	assert.Len(t, results, 1)
+	assert.Equal(t, synccommon.OperationRunning, results[0].HookPhase)
+	assert.Equal(t, "service has no endpoints", results[0].Message)
+	assert.Equal(t, [][]*unstructured.Unstructured{{svc}}, checked)
+
+	// once ready, wave 1 is applied
+	ready = true
+	syncCtx.Sync()
+	phase, _, results = syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationSucceeded, phase)
+	assert.Len(t, results, 2)
+	assert.Equal(t, synccommon.OperationSucceeded, results[0].HookPhase)
+	assert.Len(t, checked, 2)
+}
+
+func TestSyncWaveReadinessError(t *testing.T) {
+	syncCtx := newTestSyncCtx(nil, WithOperationSettings(false, false, false, false))
+	svc := testingutils.NewService()
+	pod := testingutils.NewPod()
+	pod.SetAnnotations(map[string]string{synccommon.AnnotationSyncWave: "1"})
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{svc, nil},
+		Target: []*unstructured.Unstructured{svc, pod},
+	})
+	syncCtx.syncWaveReadiness = func(_ synccommon.SyncPhase, _ int, _ []*unstructured.Unstructured) (bool, string, error) {
+		return false, "", errors.New("invalid script")
+	}
+
+	syncCtx.Sync()
+	syncCtx.Sync()
+	phase, _, results := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationFailed, phase)
+	assert.Len(t, results, 1)
+	assert.Equal(t, synccommon.OperationError, results[0].HookPhase)
+	assert.Equal(t, "failed to check sync wave readiness: invalid script", results[0].Message)
+}
+
+func TestSyncAnalysis(t *testing.T) {
+	t.Run("Successful", func(t *testing.T) {
+		syncCtx := newTestSyncCtx(nil)
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{nil},
+			Target: []*unstructured.Unstructured{testingutils.NewPod()},
+		})
+		analysisPhase := synccommon.OperationRunning
+		syncCtx.syncAnalysis = func() (synccommon.OperationPhase, string) {
+			return analysisPhase, "analyzing metrics"
+		}
+
+		syncCtx.Sync()
+		phase, message, results := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationRunning, phase)
+		assert.Equal(t, "analyzing metrics", message)
+		assert.Len(t, results, 1)
+
+		analysisPhase = synccommon.OperationSucceeded
+		syncCtx.Sync()
+		phase, message, _ = syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationSucceeded, phase)
+		assert.Equal(t, "successfully synced (no more tasks)", message)
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		syncCtx := newTestSyncCtx(nil)
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{nil},
+			Target: []*unstructured.Unstructured{testingutils.NewPod()},
+		})
+		syncCtx.syncAnalysis = func() (synccommon.OperationPhase, string) {
+			return synccommon.OperationFailed, "error rate is above 0.05"
+		}
+
+		syncCtx.Sync()
+		phase, message, _ := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationFailed, phase)
+		assert.Equal(t, "sync analysis failed: error rate is above 0.05", message)
+	})
+
+	t.Run("SkippedOnDryRun", func(t *testing.T) {
+		syncCtx := newTestSyncCtx(nil, WithOperationSettings(true, false, false, false))
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{nil},
+			Target: []*unstructured.Unstructured{testingutils.NewPod()},
+		})
+		syncCtx.syncAnalysis = func() (synccommon.OperationPhase, string) {
+			return synccommon.OperationFailed, "error rate is above 0.05"
+		}
+
+		syncCtx.Sync()
+		phase, _, _ := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationSucceeded, phase)
+	})
+}
+
+func TestSyncWaveTimeout(t *testing.T) {
+	tests := []struct {
+		name            string
+		action          string
+		expectedPhase   synccommon.OperationPhase
+		expectedMessage string
+		expectedResults int
+	}{
+		{
+			name:            "Default",
+			expectedPhase:   synccommon.OperationFailed,
+			expectedMessage: "one or more synchronization tasks completed unsuccessfully",
+			expectedResults: 1,
+		},
+		{
+			name:            "Fail",
+			action:          string(synccommon.SyncWaveTimeoutActionFail),
+			expectedPhase:   synccommon.OperationFailed,
+			expectedMessage: "Sync wave 0 did not complete within 1m0s",
+			expectedResults: 1,
+		},
+		{
+			name:            "Continue",
+			action:          string(synccommon.SyncWaveTimeoutActionContinue),
+			expectedPhase:   synccommon.OperationSucceeded,
+			expectedMessage: "successfully synced (all tasks run)",
+			expectedResults: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			syncCtx := newTestSyncCtx(nil, WithOperationSettings(false, false, false, false))
+			pod1 := testingutils.NewPod()
+			pod1.SetName("pod-1")
+			pod1.SetAnnotations(map[string]string{
+				synccommon.AnnotationSyncWaveTimeout:       "1m",
+				synccommon.AnnotationSyncWaveTimeoutAction: tt.action,
+			})
+			pod2 := testingutils.NewPod()
+			pod2.SetName("pod-2")
+			pod2.SetAnnotations(map[string]string{synccommon.AnnotationSyncWave: "1"})
+			syncCtx.resources = groupResources(ReconciliationResult{
+				Live:   []*unstructured.Unstructured{nil, nil},
+				Target: []*unstructured.Unstructured{pod1, pod2},
+			})
+
+			// wave 0 is applied and does not time out straight away
+			syncCtx.Sync()
+			syncCtx.Sync()
+			phase, _, results := syncCtx.GetState()
+			assert.Equal(t, synccommon.OperationRunning, phase)
+			require.Len(t, results, 1)
+
+			// wave 0 has been running for longer than its timeout
+			pod1Res := results[0]
+			pod1Res.SyncedAt = metav1.NewTime(time.Now().Add(-2 * time.Minute))
+			syncCtx.syncRes[resourceResultKey(pod1Res.ResourceKey, synccommon.SyncPhaseSync)] = pod1Res
+			syncCtx.Sync()
+			phase, message, results := syncCtx.GetState()
+			assert.Equal(t, tt.expectedPhase, phase)
+			assert.Equal(t, tt.expectedMessage, message)
+			assert.Len(t, results, tt.expectedResults)
+			assert.Equal(t, "Sync wave 0 did not complete within 1m0s", results[0].Message)
+		})
+	}
+}
+
+func TestSyncWaveTimeout_DeletesRunningHooks(t *testing.T) {
+	syncCtx := newTestSyncCtx(nil, WithHealthOverride(resourceNameHealthOverride(map[string]health.HealthStatusCode{
+		"hook-1": health.HealthStatusProgressing,
+	})))
+	hookPod := newHook(synccommon.HookTypeSync)
+	hookPod.SetName("hook-1")
+	hookPod.SetNamespace(testingutils.FakeArgoCDNamespace)
+	testingutils.Annotate(hookPod, synccommon.AnnotationSyncWaveTimeout, "1m")
+	testingutils.Annotate(hookPod, synccommon.AnnotationSyncWaveTimeoutAction, string(synccommon.SyncWaveTimeoutActionFail))
+	pod := testingutils.NewPod()
+	syncCtx.hooks = []*unstructured.Unstructured{hookPod}
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{nil},
+		Target: []*unstructured.Unstructured{pod},
+	})
+
+	// the hook is created and keeps running
+	syncCtx.Sync()
+	phase, _, results := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationRunning, phase)
+	var hookRes synccommon.ResourceSyncResult
+	for _, res := range results {
+		if res.HookType == synccommon.HookTypeSync {
+			hookRes = res
+		}
+	}
+	require.Equal(t, "hook-1", hookRes.ResourceKey.Name)
+
+	liveHook := hookPod.DeepCopy()
+	liveHook.SetFinalizers([]string{hook.HookFinalizer})
+	fakeDynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme(), liveHook)
+	syncCtx.dynamicIf = fakeDynamicClient
+	deleted := false
+	fakeDynamicClient.PrependReactor("delete", "pods", func(_ testcore.Action) (bool, runtime.Object, error) {
+		deleted = true
+		return false, nil, nil
+	})
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{nil, liveHook},
+		Target: []*unstructured.Unstructured{pod, nil},
+	})
+
+	// the hook has been running for longer than the timeout of its wave
+	hookRes.SyncedAt = metav1.NewTime(time.Now().Add(-2 * time.Minute))
+	syncCtx.syncRes[resourceResultKey(hookRes.ResourceKey, synccommon.SyncPhaseSync)] = hookRes
+	syncCtx.Sync()
+	phase, message, _ := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationFailed, phase)
+	assert.Equal(t, "Sync wave 0 did not complete within 1m0s", message)
+	assert.True(t, deleted)
+}
+
 func TestPruneLast(t *testing.T) {
 	syncCtx := newTestSyncCtx(nil)
 	syncCtx.pruneLast = true
@@ -8,6 +8,7 @@ import (
 
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/sync/hook"
+	resourceutil "github.com/argoproj/gitops-engine/pkg/sync/resource"
 	"github.com/argoproj/gitops-engine/pkg/sync/syncwaves"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 )
@@ -67,6 +68,11 @@ func (t *syncTask) isHook() bool {
 	return hook.IsHook(t.obj())
 }
 
+// requiresApproval returns true if the wave of the task may only be applied once it is approved
+func (t *syncTask) requiresApproval() bool {
+	return resourceutil.HasAnnotationOption(t.obj(), common.AnnotationSyncOptions, common.SyncOptionRequireApproval)
+}
+
 func (t *syncTask) group() string {
 	return t.groupVersionKind().Group
 }
@@ -2,6 +2,7 @@ package syncwaves
 
 import (
 	"strconv"
+	"time"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
@@ -19,3 +20,27 @@ func Wave(obj *unstructured.Unstructured) int {
 	}
 	return helmhook.Weight(obj)
 }
+
+// Timeout returns the duration after which the sync stops waiting for the wave of the object, or zero if the object
+// does not set a timeout.
+func Timeout(obj *unstructured.Unstructured) time.Duration {
+	text, ok := obj.GetAnnotations()[common.AnnotationSyncWaveTimeout]
+	if ok {
+		val, err := time.ParseDuration(text)
+		if err == nil && val > 0 {
+			return val
+		}
+	}
+	return 0
+}
+
+// TimeoutAction returns what happens when the wave of the object times out. The sync fails and runs the SyncFail hooks
+// unless the object sets another action.
+func TimeoutAction(obj *unstructured.Unstructured) common.SyncWaveTimeoutAction {
+	switch action := common.SyncWaveTimeoutAction(obj.GetAnnotations()[common.AnnotationSyncWaveTimeoutAction]); action {
+	case common.SyncWaveTimeoutActionFail, common.SyncWaveTimeoutActionContinue:
+		return action
+	default:
+		return common.SyncWaveTimeoutActionSyncFail
+	}
+}
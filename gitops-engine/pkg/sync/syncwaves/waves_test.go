@@ -2,9 +2,11 @@ package syncwaves
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	testingutils "github.com/argoproj/gitops-engine/pkg/utils/testing"
 )
 
@@ -13,3 +15,16 @@ func TestWave(t *testing.T) {
 	assert.Equal(t, 1, Wave(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/sync-wave", "1")))
 	assert.Equal(t, 1, Wave(testingutils.Annotate(testingutils.NewPod(), "helm.sh/hook-weight", "1")))
 }
+
+func TestTimeout(t *testing.T) {
+	assert.Equal(t, time.Duration(0), Timeout(testingutils.NewPod()))
+	assert.Equal(t, time.Duration(0), Timeout(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/sync-wave-timeout", "foo")))
+	assert.Equal(t, 5*time.Minute, Timeout(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/sync-wave-timeout", "5m")))
+}
+
+func TestTimeoutAction(t *testing.T) {
+	assert.Equal(t, common.SyncWaveTimeoutActionSyncFail, TimeoutAction(testingutils.NewPod()))
+	assert.Equal(t, common.SyncWaveTimeoutActionSyncFail, TimeoutAction(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/sync-wave-timeout-action", "foo")))
+	assert.Equal(t, common.SyncWaveTimeoutActionFail, TimeoutAction(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/sync-wave-timeout-action", "Fail")))
+	assert.Equal(t, common.SyncWaveTimeoutActionContinue, TimeoutAction(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/sync-wave-timeout-action", "Continue")))
+}
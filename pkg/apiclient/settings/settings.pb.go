@@ -19,6 +19,7 @@ import (
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	math "math"
 	math_bits "math/bits"
 )
@@ -762,6 +763,194 @@ func (m *OIDCConfig) GetEnablePKCEAuthentication() bool {
 	return false
 }
 
+// IgnoreDifferencesSuggestionsQuery is a query for suggested ignoreDifferences rules
+type IgnoreDifferencesSuggestionsQuery struct {
+	// the minimum number of reconciliations in which a field must have differed
+	MinObservations int64 `protobuf:"varint,1,opt,name=minObservations,proto3" json:"minObservations,omitempty"`
+	// the minimum number of applications in which a field must have differed
+	MinApplications      int64    `protobuf:"varint,2,opt,name=minApplications,proto3" json:"minApplications,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IgnoreDifferencesSuggestionsQuery) Reset()         { *m = IgnoreDifferencesSuggestionsQuery{} }
+func (m *IgnoreDifferencesSuggestionsQuery) String() string { return proto.CompactTextString(m) }
+func (*IgnoreDifferencesSuggestionsQuery) ProtoMessage()    {}
+func (*IgnoreDifferencesSuggestionsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a480d494da040caa, []int{9}
+}
+func (m *IgnoreDifferencesSuggestionsQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IgnoreDifferencesSuggestionsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IgnoreDifferencesSuggestionsQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IgnoreDifferencesSuggestionsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IgnoreDifferencesSuggestionsQuery.Merge(m, src)
+}
+func (m *IgnoreDifferencesSuggestionsQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *IgnoreDifferencesSuggestionsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_IgnoreDifferencesSuggestionsQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IgnoreDifferencesSuggestionsQuery proto.InternalMessageInfo
+
+func (m *IgnoreDifferencesSuggestionsQuery) GetMinObservations() int64 {
+	if m != nil {
+		return m.MinObservations
+	}
+	return 0
+}
+
+func (m *IgnoreDifferencesSuggestionsQuery) GetMinApplications() int64 {
+	if m != nil {
+		return m.MinApplications
+	}
+	return 0
+}
+
+// IgnoreDifferencesSuggestion is an ignoreDifferences rule for a field which keeps differing after successful syncs
+type IgnoreDifferencesSuggestion struct {
+	Rule *v1alpha1.ResourceIgnoreDifferences `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	// the number of reconciliations in which the field differed
+	Observations int64 `protobuf:"varint,2,opt,name=observations,proto3" json:"observations,omitempty"`
+	// the number of applications in which the field differed
+	Applications int64 `protobuf:"varint,3,opt,name=applications,proto3" json:"applications,omitempty"`
+	// the field managers which owned the field in the live resources
+	Managers             []string `protobuf:"bytes,4,rep,name=managers,proto3" json:"managers,omitempty"`
+	LastObserved         *v1.Time `protobuf:"bytes,5,opt,name=lastObserved,proto3" json:"lastObserved,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IgnoreDifferencesSuggestion) Reset()         { *m = IgnoreDifferencesSuggestion{} }
+func (m *IgnoreDifferencesSuggestion) String() string { return proto.CompactTextString(m) }
+func (*IgnoreDifferencesSuggestion) ProtoMessage()    {}
+func (*IgnoreDifferencesSuggestion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a480d494da040caa, []int{10}
+}
+func (m *IgnoreDifferencesSuggestion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IgnoreDifferencesSuggestion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IgnoreDifferencesSuggestion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IgnoreDifferencesSuggestion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IgnoreDifferencesSuggestion.Merge(m, src)
+}
+func (m *IgnoreDifferencesSuggestion) XXX_Size() int {
+	return m.Size()
+}
+func (m *IgnoreDifferencesSuggestion) XXX_DiscardUnknown() {
+	xxx_messageInfo_IgnoreDifferencesSuggestion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IgnoreDifferencesSuggestion proto.InternalMessageInfo
+
+func (m *IgnoreDifferencesSuggestion) GetRule() *v1alpha1.ResourceIgnoreDifferences {
+	if m != nil {
+		return m.Rule
+	}
+	return nil
+}
+
+func (m *IgnoreDifferencesSuggestion) GetObservations() int64 {
+	if m != nil {
+		return m.Observations
+	}
+	return 0
+}
+
+func (m *IgnoreDifferencesSuggestion) GetApplications() int64 {
+	if m != nil {
+		return m.Applications
+	}
+	return 0
+}
+
+func (m *IgnoreDifferencesSuggestion) GetManagers() []string {
+	if m != nil {
+		return m.Managers
+	}
+	return nil
+}
+
+func (m *IgnoreDifferencesSuggestion) GetLastObserved() *v1.Time {
+	if m != nil {
+		return m.LastObserved
+	}
+	return nil
+}
+
+type IgnoreDifferencesSuggestionsResponse struct {
+	Items                []*IgnoreDifferencesSuggestion `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *IgnoreDifferencesSuggestionsResponse) Reset()         { *m = IgnoreDifferencesSuggestionsResponse{} }
+func (m *IgnoreDifferencesSuggestionsResponse) String() string { return proto.CompactTextString(m) }
+func (*IgnoreDifferencesSuggestionsResponse) ProtoMessage()    {}
+func (*IgnoreDifferencesSuggestionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a480d494da040caa, []int{11}
+}
+func (m *IgnoreDifferencesSuggestionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IgnoreDifferencesSuggestionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IgnoreDifferencesSuggestionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IgnoreDifferencesSuggestionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IgnoreDifferencesSuggestionsResponse.Merge(m, src)
+}
+func (m *IgnoreDifferencesSuggestionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *IgnoreDifferencesSuggestionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IgnoreDifferencesSuggestionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IgnoreDifferencesSuggestionsResponse proto.InternalMessageInfo
+
+func (m *IgnoreDifferencesSuggestionsResponse) GetItems() []*IgnoreDifferencesSuggestion {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SettingsQuery)(nil), "cluster.SettingsQuery")
 	proto.RegisterType((*Settings)(nil), "cluster.Settings")
@@ -775,95 +964,113 @@ func init() {
 	proto.RegisterType((*Connector)(nil), "cluster.Connector")
 	proto.RegisterType((*OIDCConfig)(nil), "cluster.OIDCConfig")
 	proto.RegisterMapType((map[string]*oidc.Claim)(nil), "cluster.OIDCConfig.IdTokenClaimsEntry")
+	proto.RegisterType((*IgnoreDifferencesSuggestionsQuery)(nil), "cluster.IgnoreDifferencesSuggestionsQuery")
+	proto.RegisterType((*IgnoreDifferencesSuggestion)(nil), "cluster.IgnoreDifferencesSuggestion")
+	proto.RegisterType((*IgnoreDifferencesSuggestionsResponse)(nil), "cluster.IgnoreDifferencesSuggestionsResponse")
 }
 
 func init() { proto.RegisterFile("server/settings/settings.proto", fileDescriptor_a480d494da040caa) }
 
 var fileDescriptor_a480d494da040caa = []byte{
-	// 1318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0x1b, 0x37,
-	0x13, 0x86, 0x22, 0xc7, 0x96, 0xc6, 0xb1, 0x65, 0x33, 0x8e, 0xb3, 0xd1, 0x9b, 0xd8, 0x7a, 0x75,
-	0x08, 0xd4, 0xa2, 0x5d, 0xc5, 0x36, 0xfa, 0x81, 0xa0, 0x41, 0x6b, 0x49, 0x41, 0xa2, 0xc6, 0x49,
-	0x5c, 0x26, 0x4e, 0x81, 0x5e, 0x02, 0x7a, 0x77, 0x2a, 0x6d, 0xbd, 0x22, 0x17, 0x24, 0x57, 0x89,
-	0x72, 0xec, 0x0f, 0xe8, 0xa5, 0xfd, 0x21, 0x3d, 0xf7, 0x5e, 0xb4, 0xc7, 0x02, 0xbd, 0x1b, 0x85,
-	0xd0, 0x1f, 0x52, 0x2c, 0xf7, 0xc3, 0xeb, 0x95, 0x9c, 0x16, 0xc8, 0x8d, 0x7c, 0x9e, 0xf9, 0xe2,
-	0x70, 0x86, 0x1c, 0xd8, 0x52, 0x28, 0xc7, 0x28, 0xdb, 0x0a, 0xb5, 0xf6, 0xf8, 0x40, 0x65, 0x0b,
-	0x3b, 0x90, 0x42, 0x0b, 0xb2, 0xe4, 0xf8, 0xa1, 0xd2, 0x28, 0xeb, 0x1b, 0x03, 0x31, 0x10, 0x06,
-	0x6b, 0x47, 0xab, 0x98, 0xae, 0xdf, 0x1c, 0x08, 0x31, 0xf0, 0xb1, 0xcd, 0x02, 0xaf, 0xcd, 0x38,
-	0x17, 0x9a, 0x69, 0x4f, 0xf0, 0x44, 0xb9, 0x7e, 0x30, 0xf0, 0xf4, 0x30, 0x3c, 0xb6, 0x1d, 0x31,
-	0x6a, 0x33, 0x69, 0xd4, 0xbf, 0x33, 0x8b, 0x0f, 0x1d, 0xb7, 0x3d, 0xde, 0x6b, 0x07, 0x27, 0x83,
-	0x48, 0x53, 0xb5, 0x59, 0x10, 0xf8, 0x9e, 0x63, 0x74, 0xdb, 0xe3, 0x1d, 0xe6, 0x07, 0x43, 0xb6,
-	0xd3, 0x1e, 0x20, 0x47, 0xc9, 0x34, 0xba, 0x89, 0xb5, 0x2f, 0xfe, 0xc5, 0x5a, 0xf1, 0x24, 0xc2,
-	0x73, 0x9d, 0xb6, 0xe3, 0x33, 0x6f, 0x94, 0xc4, 0xd3, 0xac, 0xc1, 0xca, 0xb3, 0x84, 0xfd, 0x2a,
-	0x44, 0x39, 0x69, 0xfe, 0xbc, 0x02, 0x95, 0x14, 0x21, 0x37, 0xa0, 0x1c, 0x4a, 0xdf, 0x2a, 0x35,
-	0x4a, 0xad, 0x6a, 0x67, 0x69, 0x7a, 0xba, 0x5d, 0x3e, 0xa2, 0x07, 0x34, 0xc2, 0xc8, 0x1d, 0xa8,
-	0xba, 0xf8, 0xba, 0x2b, 0xf8, 0xb7, 0xde, 0xc0, 0xba, 0xd4, 0x28, 0xb5, 0x96, 0x77, 0x89, 0x9d,
-	0x64, 0xc6, 0xee, 0xa5, 0x0c, 0x3d, 0x13, 0x22, 0x5d, 0x80, 0xc8, 0x7f, 0xa2, 0x52, 0x36, 0x2a,
-	0x57, 0x33, 0x95, 0xa7, 0xfd, 0x5e, 0x37, 0xa6, 0x3a, 0xab, 0xd3, 0xd3, 0x6d, 0x38, 0xdb, 0xd3,
-	0x9c, 0x1a, 0x69, 0xc0, 0x32, 0x0b, 0x82, 0x03, 0x76, 0x8c, 0xfe, 0x23, 0x9c, 0x58, 0x0b, 0x51,
-	0x64, 0x34, 0x0f, 0x91, 0x17, 0xb0, 0x2e, 0x51, 0x89, 0x50, 0x3a, 0xf8, 0x74, 0x8c, 0x52, 0x7a,
-	0x2e, 0x2a, 0xeb, 0x72, 0xa3, 0xdc, 0x5a, 0xde, 0x6d, 0x65, 0xde, 0xd2, 0x13, 0xda, 0xb4, 0x28,
-	0x7a, 0x9f, 0x6b, 0x39, 0xa1, 0xb3, 0x26, 0x88, 0x0d, 0x44, 0x69, 0xa6, 0x43, 0xd5, 0x61, 0xee,
-	0x00, 0xef, 0x73, 0x76, 0xec, 0xa3, 0x6b, 0x2d, 0x36, 0x4a, 0xad, 0x0a, 0x9d, 0xc3, 0x90, 0x87,
-	0x50, 0x8b, 0x2b, 0x61, 0x9f, 0x33, 0x7f, 0xa2, 0x3d, 0x47, 0x59, 0x4b, 0xe6, 0xcc, 0x5b, 0x59,
-	0x14, 0x0f, 0xce, 0xf3, 0xc9, 0x71, 0x8b, 0x6a, 0xe4, 0x0d, 0xac, 0x9d, 0x84, 0x4a, 0x8b, 0x91,
-	0xf7, 0x06, 0x9f, 0x06, 0xa6, 0x9a, 0xac, 0x8a, 0x31, 0xf5, 0xc4, 0x3e, 0x2b, 0x00, 0x3b, 0x2d,
-	0x00, 0xb3, 0x78, 0xe9, 0xb8, 0xf6, 0x78, 0xcf, 0x0e, 0x4e, 0x06, 0x76, 0x54, 0x4e, 0x76, 0xae,
-	0x9c, 0xec, 0xb4, 0x9c, 0xec, 0x47, 0x05, 0xab, 0x74, 0xc6, 0x0f, 0xf9, 0x3f, 0x2c, 0x0c, 0xd1,
-	0x0f, 0xac, 0xaa, 0xf1, 0xb7, 0x92, 0x85, 0xfe, 0x10, 0xfd, 0x80, 0x1a, 0x8a, 0xbc, 0x07, 0x4b,
-	0x81, 0x1f, 0x0e, 0x3c, 0xae, 0x2c, 0x30, 0x69, 0xae, 0x65, 0x52, 0x87, 0x06, 0xa7, 0x29, 0x1f,
-	0xe5, 0x30, 0x54, 0x28, 0x0f, 0x44, 0xb4, 0xeb, 0x79, 0x2a, 0xce, 0xe1, 0x72, 0x9c, 0xc3, 0x59,
-	0x86, 0xfc, 0x50, 0x82, 0xeb, 0x8e, 0xc9, 0xca, 0x63, 0xc6, 0xd9, 0x00, 0x47, 0xc8, 0xf5, 0x61,
-	0xe2, 0xeb, 0x8a, 0xf1, 0xf5, 0xfc, 0xdd, 0x32, 0xd0, 0x9d, 0x6b, 0x9c, 0x5e, 0xe4, 0x94, 0x7c,
-	0x00, 0xeb, 0x59, 0x8a, 0x5e, 0xa0, 0x54, 0xe6, 0x2e, 0x56, 0x1a, 0xe5, 0x56, 0x95, 0xce, 0x12,
-	0xa4, 0x0e, 0x95, 0xd0, 0xeb, 0x2a, 0x75, 0x44, 0x0f, 0xac, 0x55, 0x53, 0xa9, 0xd9, 0x9e, 0xb4,
-	0xa0, 0x16, 0x7a, 0x1d, 0xc6, 0x39, 0xca, 0xae, 0xe0, 0x1a, 0xb9, 0xb6, 0x6a, 0x46, 0xa4, 0x08,
-	0x47, 0x25, 0x9f, 0x42, 0x91, 0xa1, 0xb5, 0xb8, 0xe4, 0x73, 0x50, 0x64, 0x2b, 0x60, 0x4a, 0xbd,
-	0x12, 0xd2, 0x3d, 0x64, 0x5a, 0xa3, 0xe4, 0xd6, 0x7a, 0x6c, 0xab, 0x00, 0x93, 0xdb, 0xb0, 0xaa,
-	0x25, 0x73, 0x4e, 0x3c, 0x3e, 0x78, 0x8c, 0x7a, 0x28, 0x5c, 0x8b, 0x18, 0xc1, 0x02, 0x1a, 0x9d,
-	0x33, 0x75, 0x70, 0x88, 0x72, 0xc4, 0x78, 0x14, 0xdf, 0x55, 0x73, 0x4f, 0xb3, 0x04, 0x79, 0x1f,
-	0xd6, 0x32, 0x50, 0x28, 0x2f, 0x4a, 0xb1, 0xb5, 0x61, 0xec, 0xce, 0xe0, 0x85, 0x36, 0xa2, 0x42,
-	0xe8, 0x23, 0xe9, 0x5b, 0xd7, 0x8c, 0xf4, 0x1c, 0x26, 0x3a, 0x3d, 0xbe, 0x46, 0x27, 0xed, 0xb7,
-	0x4d, 0x13, 0x43, 0x1e, 0x22, 0x77, 0xe0, 0xaa, 0x23, 0xb8, 0x96, 0xc2, 0xf7, 0x51, 0x3e, 0x61,
-	0x23, 0x54, 0x01, 0x73, 0xd0, 0xba, 0x6e, 0x4c, 0xce, 0xa3, 0xc8, 0x67, 0x70, 0x83, 0x05, 0x81,
-	0xea, 0xf3, 0x7d, 0x3e, 0xc9, 0xd0, 0xd4, 0x83, 0x65, 0x3c, 0x5c, 0x2c, 0x40, 0x76, 0x61, 0xc3,
-	0x1b, 0x05, 0x28, 0x95, 0xe0, 0xa6, 0x9a, 0x52, 0xc5, 0x1b, 0x46, 0x71, 0x2e, 0x17, 0xe5, 0xdd,
-	0xe3, 0x4a, 0x33, 0xdf, 0x37, 0x70, 0xbf, 0x67, 0xd5, 0xe3, 0xbc, 0x9f, 0x47, 0xc9, 0x5d, 0x58,
-	0x65, 0xae, 0x6b, 0x32, 0xc5, 0xfc, 0x23, 0xe9, 0x2b, 0xeb, 0x7f, 0x51, 0x71, 0x75, 0xc8, 0xf4,
-	0x74, 0x7b, 0x75, 0xff, 0x8c, 0xa1, 0x07, 0x8a, 0x16, 0x24, 0xa3, 0x2a, 0x18, 0x4e, 0x5c, 0xc9,
-	0xb4, 0x90, 0x69, 0x48, 0x37, 0x4d, 0x48, 0x45, 0x98, 0x7c, 0x0c, 0x9b, 0x6a, 0xc2, 0x9d, 0xaf,
-	0x3d, 0x3d, 0xa4, 0x18, 0xf8, 0xcc, 0xc1, 0x7d, 0xdf, 0x17, 0xaf, 0xd0, 0xb5, 0x6e, 0x19, 0x85,
-	0x0b, 0xd8, 0xfa, 0x4f, 0x25, 0xd8, 0x9c, 0xff, 0x60, 0x92, 0x35, 0x28, 0x9f, 0xe0, 0x24, 0xfe,
-	0x29, 0x68, 0xb4, 0x24, 0x2e, 0x5c, 0x1e, 0x33, 0x3f, 0xc4, 0xe4, 0x73, 0x78, 0xc7, 0xa7, 0xaa,
-	0xe8, 0x96, 0xc6, 0xc6, 0xef, 0x5e, 0xfa, 0xb4, 0xd4, 0x7c, 0x09, 0xd7, 0xe6, 0xbe, 0xa4, 0x64,
-	0x0b, 0x20, 0xad, 0xeb, 0x7e, 0x2f, 0x89, 0x2d, 0x87, 0x44, 0xb7, 0xc2, 0xb8, 0xe0, 0x93, 0xa8,
-	0x69, 0x8f, 0x14, 0x4a, 0x65, 0x62, 0xad, 0xd0, 0x02, 0xda, 0xec, 0xc1, 0xf5, 0xf4, 0xc3, 0x48,
-	0x1e, 0x02, 0x8a, 0x2a, 0x10, 0x5c, 0x61, 0xfe, 0xf1, 0x2b, 0xbd, 0xfd, 0xf1, 0x6b, 0xfe, 0x52,
-	0x82, 0x85, 0xe8, 0xd9, 0x24, 0x16, 0x2c, 0x39, 0x43, 0x66, 0xea, 0x3e, 0x8e, 0x29, 0xdd, 0x46,
-	0x0f, 0x46, 0xb4, 0x7c, 0x8e, 0xaf, 0xb5, 0x09, 0xa5, 0x4a, 0xb3, 0x3d, 0xb9, 0x07, 0x70, 0xec,
-	0x71, 0x26, 0x27, 0xa6, 0x2c, 0xca, 0xc6, 0xd9, 0xad, 0x73, 0xef, 0xb1, 0xdd, 0xc9, 0xf8, 0xf8,
-	0x17, 0xcb, 0x29, 0xd4, 0xef, 0x41, 0xad, 0x40, 0xcf, 0xb9, 0xb3, 0x8d, 0xfc, 0x9d, 0x55, 0xf3,
-	0x39, 0xbe, 0x09, 0x8b, 0xf1, 0x79, 0x08, 0x81, 0x05, 0xce, 0x46, 0x98, 0xa8, 0x99, 0x75, 0xf3,
-	0x73, 0xa8, 0x66, 0x5f, 0x3e, 0xd9, 0x05, 0x70, 0x04, 0xe7, 0xe8, 0x68, 0x21, 0xd3, 0xac, 0x9c,
-	0x8d, 0x06, 0xdd, 0x94, 0xa2, 0x39, 0xa9, 0xe6, 0x1e, 0x54, 0x33, 0x62, 0x9e, 0x87, 0x08, 0xd3,
-	0x93, 0x20, 0x0d, 0xcc, 0xac, 0x9b, 0xbf, 0x96, 0x21, 0x37, 0x26, 0xcc, 0x55, 0xdb, 0x84, 0x45,
-	0x4f, 0xa9, 0x10, 0x65, 0xa2, 0x98, 0xec, 0x48, 0x0b, 0x2a, 0x8e, 0xef, 0x21, 0xd7, 0xfd, 0x9e,
-	0x99, 0x44, 0xaa, 0x9d, 0x2b, 0xd3, 0xd3, 0xed, 0x4a, 0x37, 0xc1, 0x68, 0xc6, 0x92, 0x1d, 0x58,
-	0x76, 0x7c, 0x2f, 0x25, 0xe2, 0x81, 0xa3, 0x53, 0x9b, 0x9e, 0x6e, 0x2f, 0x77, 0x0f, 0xfa, 0x99,
-	0x7c, 0x5e, 0x26, 0x72, 0xaa, 0x1c, 0x11, 0x24, 0x63, 0x47, 0x95, 0x26, 0x3b, 0xf2, 0x12, 0x56,
-	0x3c, 0xf7, 0xb9, 0x38, 0x41, 0xde, 0x35, 0x23, 0x98, 0xb5, 0x68, 0x72, 0x73, 0x7b, 0xce, 0x0c,
-	0x64, 0xf7, 0xf3, 0x82, 0xe6, 0xba, 0x3a, 0xeb, 0xd3, 0xd3, 0xed, 0x95, 0x7e, 0x2f, 0x87, 0xd3,
-	0xf3, 0xf6, 0xc8, 0x5d, 0xb0, 0xd0, 0xb4, 0xf8, 0xe1, 0xa3, 0xee, 0xfd, 0xfd, 0x50, 0x0f, 0x91,
-	0xeb, 0xa4, 0x93, 0xcc, 0xec, 0x51, 0xa1, 0x17, 0xf2, 0xf5, 0x09, 0x90, 0x59, 0x9f, 0x73, 0x4a,
-	0xe4, 0xf1, 0xf9, 0xb6, 0xfe, 0xe4, 0xad, 0x6d, 0x1d, 0xcf, 0x9f, 0x76, 0x36, 0x40, 0x47, 0x83,
-	0x9c, 0x6d, 0xec, 0xe7, 0x6a, 0x6b, 0xf7, 0xb7, 0x12, 0xd4, 0xd2, 0xfe, 0x7a, 0x86, 0x72, 0xec,
-	0x39, 0x48, 0xbe, 0x84, 0xf2, 0x03, 0xd4, 0x64, 0x73, 0x66, 0x62, 0x33, 0x53, 0x6a, 0x7d, 0x7d,
-	0x06, 0x6f, 0x5a, 0xdf, 0xff, 0xf9, 0xf7, 0x8f, 0x97, 0x08, 0x59, 0x33, 0x93, 0xf7, 0x78, 0x27,
-	0x9b, 0x7a, 0xc9, 0x10, 0xe0, 0x01, 0x66, 0x5f, 0xf8, 0x45, 0x26, 0x1b, 0x33, 0x78, 0xa1, 0xd7,
-	0x9b, 0x0d, 0xe3, 0xa1, 0x4e, 0xac, 0xa2, 0x87, 0x76, 0xd2, 0xe2, 0x9d, 0xee, 0xef, 0xd3, 0xad,
-	0xd2, 0x1f, 0xd3, 0xad, 0xd2, 0x5f, 0xd3, 0xad, 0xd2, 0x37, 0x1f, 0xfd, 0xb7, 0x59, 0x3f, 0x2e,
-	0xb5, 0xcc, 0xd8, 0xf1, 0xa2, 0x99, 0xcc, 0xf7, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x1a,
-	0xe6, 0x75, 0x88, 0x0c, 0x00, 0x00,
+	// 1556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0x1b, 0xb9,
+	0x15, 0x87, 0x2c, 0xc7, 0xb6, 0xe8, 0xd8, 0xb2, 0x99, 0xac, 0x33, 0xd1, 0x66, 0x6d, 0xad, 0xb0,
+	0x58, 0xa8, 0x41, 0x33, 0x5a, 0x3b, 0x6d, 0x77, 0x11, 0x74, 0xd1, 0x5a, 0x52, 0x90, 0x55, 0xe3,
+	0x24, 0x2e, 0x63, 0xef, 0x02, 0xbd, 0x04, 0xf4, 0xcc, 0xcb, 0x88, 0xd5, 0x88, 0x1c, 0x90, 0x1c,
+	0x25, 0xda, 0x63, 0x3f, 0x40, 0x2f, 0xed, 0x07, 0xe9, 0xa5, 0x97, 0x1e, 0x7a, 0x2b, 0xd0, 0x63,
+	0x81, 0xde, 0x8d, 0x42, 0xe8, 0xf7, 0x68, 0x41, 0xce, 0x1f, 0x8d, 0x47, 0xb2, 0x1b, 0xa0, 0x7b,
+	0x23, 0x7f, 0xef, 0xdf, 0x8f, 0x8f, 0x8f, 0x6f, 0xde, 0xa0, 0x7d, 0x05, 0x72, 0x02, 0xb2, 0xa3,
+	0x40, 0x6b, 0xc6, 0x03, 0x95, 0x2f, 0xdc, 0x48, 0x0a, 0x2d, 0xf0, 0xba, 0x17, 0xc6, 0x4a, 0x83,
+	0x6c, 0xdc, 0x0d, 0x44, 0x20, 0x2c, 0xd6, 0x31, 0xab, 0x44, 0xdc, 0x78, 0x10, 0x08, 0x11, 0x84,
+	0xd0, 0xa1, 0x11, 0xeb, 0x50, 0xce, 0x85, 0xa6, 0x9a, 0x09, 0x9e, 0x1a, 0x37, 0x4e, 0x02, 0xa6,
+	0x87, 0xf1, 0x85, 0xeb, 0x89, 0x71, 0x87, 0x4a, 0x6b, 0xfe, 0x5b, 0xbb, 0x78, 0xe4, 0xf9, 0x9d,
+	0xc9, 0xe3, 0x4e, 0x34, 0x0a, 0x8c, 0xa5, 0xea, 0xd0, 0x28, 0x0a, 0x99, 0x67, 0x6d, 0x3b, 0x93,
+	0x43, 0x1a, 0x46, 0x43, 0x7a, 0xd8, 0x09, 0x80, 0x83, 0xa4, 0x1a, 0xfc, 0xd4, 0xdb, 0x2f, 0xff,
+	0x87, 0xb7, 0xf2, 0x49, 0x04, 0xf3, 0xbd, 0x8e, 0x17, 0x52, 0x36, 0xce, 0xf8, 0xfc, 0x64, 0xf4,
+	0x95, 0x72, 0x99, 0x30, 0x31, 0xc7, 0xd4, 0x1b, 0x32, 0x0e, 0x72, 0x3a, 0x27, 0x31, 0x06, 0x4d,
+	0x3b, 0x93, 0x85, 0xb8, 0xad, 0x3a, 0xda, 0x7a, 0x9d, 0xfa, 0xfc, 0x75, 0x0c, 0x72, 0xda, 0xfa,
+	0xd3, 0x16, 0xda, 0xc8, 0x10, 0x7c, 0x1f, 0x55, 0x63, 0x19, 0x3a, 0x95, 0x66, 0xa5, 0x5d, 0xeb,
+	0xae, 0xcf, 0x2e, 0x0f, 0xaa, 0xe7, 0xe4, 0x84, 0x18, 0x0c, 0x7f, 0x81, 0x6a, 0x3e, 0xbc, 0xef,
+	0x09, 0xfe, 0x96, 0x05, 0xce, 0x4a, 0xb3, 0xd2, 0xde, 0x3c, 0xc2, 0x6e, 0x9a, 0x4f, 0xb7, 0x9f,
+	0x49, 0xc8, 0x5c, 0x09, 0xf7, 0x10, 0x32, 0xac, 0x53, 0x93, 0xaa, 0x35, 0xb9, 0x93, 0x9b, 0xbc,
+	0x1a, 0xf4, 0x7b, 0x89, 0xa8, 0xbb, 0x3d, 0xbb, 0x3c, 0x40, 0xf3, 0x3d, 0x29, 0x98, 0xe1, 0x26,
+	0xda, 0xa4, 0x51, 0x74, 0x42, 0x2f, 0x20, 0x7c, 0x0e, 0x53, 0x67, 0xd5, 0x30, 0x23, 0x45, 0x08,
+	0x7f, 0x8b, 0x76, 0x25, 0x28, 0x11, 0x4b, 0x0f, 0x5e, 0x4d, 0x40, 0x4a, 0xe6, 0x83, 0x72, 0x6e,
+	0x35, 0xab, 0xed, 0xcd, 0xa3, 0x76, 0x1e, 0x2d, 0x3b, 0xa1, 0x4b, 0xca, 0xaa, 0x4f, 0xb9, 0x96,
+	0x53, 0xb2, 0xe8, 0x02, 0xbb, 0x08, 0x2b, 0x4d, 0x75, 0xac, 0xba, 0xd4, 0x0f, 0xe0, 0x29, 0xa7,
+	0x17, 0x21, 0xf8, 0xce, 0x5a, 0xb3, 0xd2, 0xde, 0x20, 0x4b, 0x24, 0xf8, 0x1b, 0x54, 0x4f, 0xea,
+	0xe7, 0x98, 0xd3, 0x70, 0xaa, 0x99, 0xa7, 0x9c, 0x75, 0x7b, 0xe6, 0xfd, 0x9c, 0xc5, 0xb3, 0xab,
+	0xf2, 0xf4, 0xb8, 0x65, 0x33, 0xfc, 0x3d, 0xda, 0x19, 0xc5, 0x4a, 0x8b, 0x31, 0xfb, 0x1e, 0x5e,
+	0x45, 0xb6, 0x06, 0x9d, 0x0d, 0xeb, 0xea, 0xa5, 0x3b, 0x2f, 0x1b, 0x37, 0x2b, 0x1b, 0xbb, 0x78,
+	0xe3, 0xf9, 0xee, 0xe4, 0xb1, 0x1b, 0x8d, 0x02, 0xd7, 0xdc, 0xbf, 0x5b, 0x28, 0x42, 0x37, 0x2b,
+	0x42, 0xf7, 0x79, 0xc9, 0x2b, 0x59, 0x88, 0x83, 0x3f, 0x45, 0xab, 0x43, 0x08, 0x23, 0xa7, 0x66,
+	0xe3, 0x6d, 0xe5, 0xd4, 0xbf, 0x81, 0x30, 0x22, 0x56, 0x84, 0x7f, 0x84, 0xd6, 0xa3, 0x30, 0x0e,
+	0x18, 0x57, 0x0e, 0xb2, 0x69, 0xae, 0xe7, 0x5a, 0xa7, 0x16, 0x27, 0x99, 0xdc, 0xe4, 0x30, 0x56,
+	0x20, 0x4f, 0x84, 0xd9, 0xf5, 0x99, 0x4a, 0x72, 0xb8, 0x99, 0xe4, 0x70, 0x51, 0x82, 0x7f, 0x5f,
+	0x41, 0xf7, 0x3c, 0x9b, 0x95, 0x17, 0x94, 0xd3, 0x00, 0xc6, 0xc0, 0xf5, 0x69, 0x1a, 0xeb, 0xb6,
+	0x8d, 0x75, 0xf6, 0xff, 0x65, 0xa0, 0xb7, 0xd4, 0x39, 0xb9, 0x2e, 0x28, 0xfe, 0x31, 0xda, 0xcd,
+	0x53, 0xf4, 0x2d, 0x48, 0x65, 0xef, 0x62, 0xab, 0x59, 0x6d, 0xd7, 0xc8, 0xa2, 0x00, 0x37, 0xd0,
+	0x46, 0xcc, 0x7a, 0x4a, 0x9d, 0x93, 0x13, 0x67, 0xdb, 0x56, 0x6a, 0xbe, 0xc7, 0x6d, 0x54, 0x8f,
+	0x59, 0x97, 0x72, 0x0e, 0xb2, 0x27, 0xb8, 0x06, 0xae, 0x9d, 0xba, 0x55, 0x29, 0xc3, 0xa6, 0xe4,
+	0x33, 0xc8, 0x38, 0xda, 0x49, 0x4a, 0xbe, 0x00, 0x19, 0x5f, 0x11, 0x55, 0xea, 0x9d, 0x90, 0xfe,
+	0x29, 0xd5, 0x1a, 0x24, 0x77, 0x76, 0x13, 0x5f, 0x25, 0x18, 0x7f, 0x8e, 0xb6, 0xb5, 0xa4, 0xde,
+	0x88, 0xf1, 0xe0, 0x05, 0xe8, 0xa1, 0xf0, 0x1d, 0x6c, 0x15, 0x4b, 0xa8, 0x39, 0x67, 0x16, 0xe0,
+	0x14, 0xe4, 0x98, 0x72, 0xc3, 0xef, 0x8e, 0xbd, 0xa7, 0x45, 0x01, 0x7e, 0x88, 0x76, 0x72, 0x50,
+	0x28, 0x66, 0x52, 0xec, 0xdc, 0xb5, 0x7e, 0x17, 0xf0, 0xd2, 0x33, 0x22, 0x42, 0xe8, 0x73, 0x19,
+	0x3a, 0x1f, 0x59, 0xed, 0x25, 0x12, 0x73, 0x7a, 0x78, 0x0f, 0x5e, 0xf6, 0xde, 0xf6, 0x2c, 0x87,
+	0x22, 0x84, 0xbf, 0x40, 0x77, 0x3c, 0xc1, 0xb5, 0x14, 0x61, 0x08, 0xf2, 0x25, 0x1d, 0x83, 0x8a,
+	0xa8, 0x07, 0xce, 0x3d, 0xeb, 0x72, 0x99, 0x08, 0xff, 0x1c, 0xdd, 0xa7, 0x51, 0xa4, 0x06, 0xfc,
+	0x98, 0x4f, 0x73, 0x34, 0x8b, 0xe0, 0xd8, 0x08, 0xd7, 0x2b, 0xe0, 0x23, 0x74, 0x97, 0x8d, 0x23,
+	0x90, 0x4a, 0x70, 0x5b, 0x4d, 0x99, 0xe1, 0x7d, 0x6b, 0xb8, 0x54, 0x66, 0xf2, 0xce, 0xb8, 0xd2,
+	0x34, 0x0c, 0x2d, 0x3c, 0xe8, 0x3b, 0x8d, 0x24, 0xef, 0x57, 0x51, 0xfc, 0x04, 0x6d, 0x53, 0xdf,
+	0xb7, 0x99, 0xa2, 0xe1, 0xb9, 0x0c, 0x95, 0xf3, 0xb1, 0x29, 0xae, 0x2e, 0x9e, 0x5d, 0x1e, 0x6c,
+	0x1f, 0xcf, 0x25, 0xe4, 0x44, 0x91, 0x92, 0xa6, 0xa9, 0x82, 0xe1, 0xd4, 0x97, 0x54, 0x0b, 0x99,
+	0x51, 0x7a, 0x60, 0x29, 0x95, 0x61, 0xfc, 0x33, 0xb4, 0xa7, 0xa6, 0xdc, 0xfb, 0x8e, 0xe9, 0x21,
+	0x81, 0x28, 0xa4, 0x1e, 0x1c, 0x87, 0xa1, 0x78, 0x07, 0xbe, 0xf3, 0x89, 0x35, 0xb8, 0x46, 0xda,
+	0xf8, 0x63, 0x05, 0xed, 0x2d, 0x6f, 0x98, 0x78, 0x07, 0x55, 0x47, 0x30, 0x4d, 0xbe, 0x14, 0xc4,
+	0x2c, 0xb1, 0x8f, 0x6e, 0x4d, 0x68, 0x18, 0x83, 0xb3, 0xf2, 0x43, 0xb4, 0xaa, 0x72, 0x58, 0x92,
+	0x38, 0x7f, 0xb2, 0xf2, 0x55, 0xa5, 0xf5, 0x06, 0x7d, 0xb4, 0xb4, 0x93, 0xe2, 0x7d, 0x84, 0xb2,
+	0xba, 0x1e, 0xf4, 0x53, 0x6e, 0x05, 0xc4, 0xdc, 0x0a, 0xe5, 0x82, 0x4f, 0xcd, 0xa3, 0x3d, 0x57,
+	0x20, 0x95, 0xe5, 0xba, 0x41, 0x4a, 0x68, 0xab, 0x8f, 0xee, 0x65, 0x1f, 0x8c, 0xb4, 0x11, 0x10,
+	0x50, 0x91, 0xe0, 0x0a, 0x8a, 0xcd, 0xaf, 0x72, 0x73, 0xf3, 0x6b, 0xfd, 0xa5, 0x82, 0x56, 0x4d,
+	0xdb, 0xc4, 0x0e, 0x5a, 0xf7, 0x86, 0xd4, 0xd6, 0x7d, 0xc2, 0x29, 0xdb, 0x9a, 0x86, 0x61, 0x96,
+	0x67, 0xf0, 0x5e, 0x5b, 0x2a, 0x35, 0x92, 0xef, 0xf1, 0xd7, 0x08, 0x5d, 0x30, 0x4e, 0xe5, 0xd4,
+	0x96, 0x45, 0xd5, 0x06, 0xfb, 0xe4, 0x4a, 0x3f, 0x76, 0xbb, 0xb9, 0x3c, 0xf9, 0x8a, 0x15, 0x0c,
+	0x1a, 0x5f, 0xa3, 0x7a, 0x49, 0xbc, 0xe4, 0xce, 0xee, 0x16, 0xef, 0xac, 0x56, 0xcc, 0xf1, 0x03,
+	0xb4, 0x96, 0x9c, 0x07, 0x63, 0xb4, 0xca, 0xe9, 0x18, 0x52, 0x33, 0xbb, 0x6e, 0xfd, 0x02, 0xd5,
+	0xf2, 0x4f, 0x3e, 0x3e, 0x42, 0xc8, 0x13, 0x9c, 0x83, 0xa7, 0x85, 0xcc, 0xb2, 0x32, 0x1f, 0x0d,
+	0x7a, 0x99, 0x88, 0x14, 0xb4, 0x5a, 0x8f, 0x51, 0x2d, 0x17, 0x2c, 0x8b, 0x60, 0x30, 0x3d, 0x8d,
+	0x32, 0x62, 0x76, 0xdd, 0xfa, 0x5b, 0x15, 0x15, 0xc6, 0x84, 0xa5, 0x66, 0x7b, 0x68, 0x8d, 0x29,
+	0x15, 0x83, 0x4c, 0x0d, 0xd3, 0x1d, 0x6e, 0xa3, 0x0d, 0x2f, 0x64, 0xc0, 0xf5, 0xa0, 0x6f, 0x27,
+	0x91, 0x5a, 0xf7, 0xf6, 0xec, 0xf2, 0x60, 0xa3, 0x97, 0x62, 0x24, 0x97, 0xe2, 0x43, 0xb4, 0xe9,
+	0x85, 0x2c, 0x13, 0x24, 0x03, 0x47, 0xb7, 0x3e, 0xbb, 0x3c, 0xd8, 0xec, 0x9d, 0x0c, 0x72, 0xfd,
+	0xa2, 0x8e, 0x09, 0xaa, 0x3c, 0x11, 0xa5, 0x63, 0x47, 0x8d, 0xa4, 0x3b, 0xfc, 0x06, 0x6d, 0x31,
+	0xff, 0x4c, 0x8c, 0x80, 0xf7, 0xec, 0xe0, 0xe6, 0xac, 0xd9, 0xdc, 0x7c, 0xbe, 0x64, 0x06, 0x72,
+	0x07, 0x45, 0x45, 0x7b, 0x5d, 0xdd, 0xdd, 0xd9, 0xe5, 0xc1, 0xd6, 0xa0, 0x5f, 0xc0, 0xc9, 0x55,
+	0x7f, 0xf8, 0x09, 0x72, 0xc0, 0x3e, 0xf1, 0xd3, 0xe7, 0xbd, 0xa7, 0xc7, 0xb1, 0x1e, 0x02, 0xd7,
+	0xe9, 0x4b, 0xb2, 0xb3, 0xc7, 0x06, 0xb9, 0x56, 0xde, 0x98, 0x22, 0xbc, 0x18, 0x73, 0x49, 0x89,
+	0xbc, 0xb8, 0xfa, 0xac, 0xbf, 0xbc, 0xf1, 0x59, 0x27, 0x53, 0xab, 0x9b, 0x8f, 0xdd, 0x66, 0x90,
+	0x73, 0xad, 0xff, 0x62, 0x6d, 0xbd, 0x43, 0x9f, 0x0e, 0x02, 0x2e, 0x24, 0xf4, 0xd9, 0xdb, 0xb7,
+	0x20, 0x81, 0x7b, 0xa0, 0x5e, 0xc7, 0x41, 0x00, 0xca, 0x30, 0x4b, 0xe6, 0x52, 0xd3, 0xdd, 0xc6,
+	0x8c, 0xbf, 0xba, 0x30, 0x2e, 0x2d, 0x63, 0x65, 0x59, 0x55, 0x49, 0x19, 0x4e, 0x35, 0x8f, 0xe7,
+	0x5d, 0x24, 0x79, 0xd6, 0x55, 0x52, 0x86, 0x5b, 0x7f, 0x5d, 0x41, 0x1f, 0xdf, 0x10, 0x19, 0x8f,
+	0xd0, 0xaa, 0x8c, 0xc3, 0xa4, 0xa2, 0x36, 0x8f, 0xbe, 0xfb, 0x61, 0x3a, 0xd8, 0x42, 0x40, 0x62,
+	0x83, 0xe0, 0x16, 0xba, 0x2d, 0x8a, 0xa7, 0x4b, 0x38, 0x5f, 0xc1, 0x8c, 0x0e, 0x2d, 0x9e, 0xab,
+	0x9a, 0xe8, 0x14, 0x31, 0xd3, 0x43, 0xc6, 0x76, 0x6e, 0x91, 0xca, 0x59, 0xb5, 0xf5, 0x97, 0xef,
+	0xf1, 0x4b, 0x74, 0x3b, 0xa4, 0x4a, 0x27, 0xe9, 0x02, 0xdf, 0xb9, 0x65, 0x0f, 0xf6, 0xd0, 0x4d,
+	0x7e, 0x1d, 0xdc, 0xe2, 0xaf, 0xc3, 0xfc, 0x34, 0xe6, 0xd7, 0xc1, 0x9d, 0x1c, 0xba, 0x67, 0x6c,
+	0x0c, 0xe4, 0x8a, 0x7d, 0xeb, 0x02, 0x7d, 0x76, 0xd3, 0xcd, 0xe5, 0x5d, 0xf2, 0x09, 0xba, 0xc5,
+	0x34, 0x8c, 0xb3, 0x6e, 0xf0, 0x59, 0x5e, 0xf1, 0x37, 0x58, 0x93, 0xc4, 0xe4, 0xe8, 0x3f, 0x2b,
+	0xa8, 0x9e, 0x75, 0xdf, 0xd7, 0x20, 0x27, 0xcc, 0x03, 0xfc, 0x2b, 0x54, 0x7d, 0x06, 0x1a, 0xef,
+	0x2d, 0xcc, 0xf3, 0xb6, 0x56, 0x1a, 0xbb, 0x0b, 0x78, 0xcb, 0xf9, 0xdd, 0x3f, 0xff, 0xfd, 0x87,
+	0x15, 0x8c, 0x77, 0xec, 0xdf, 0xdc, 0xe4, 0x30, 0xff, 0x93, 0xc2, 0x43, 0x84, 0x9e, 0x41, 0x3e,
+	0xe0, 0x5d, 0xe7, 0xb2, 0xb9, 0x80, 0x97, 0xbe, 0x04, 0xad, 0xa6, 0x8d, 0xd0, 0xc0, 0x4e, 0x39,
+	0x42, 0x27, 0x9b, 0x7e, 0xff, 0x5c, 0x41, 0x07, 0xcf, 0x40, 0xdf, 0x94, 0x31, 0xfc, 0xf0, 0x43,
+	0x52, 0x93, 0x72, 0x7a, 0xf4, 0x41, 0xba, 0x39, 0xc1, 0x2f, 0x2d, 0xc1, 0x43, 0xdc, 0x59, 0x20,
+	0xc8, 0xac, 0xf9, 0x23, 0x7f, 0x6e, 0xff, 0x48, 0xcd, 0x1d, 0x74, 0x7b, 0x7f, 0x9f, 0xed, 0x57,
+	0xfe, 0x31, 0xdb, 0xaf, 0xfc, 0x6b, 0xb6, 0x5f, 0xf9, 0xcd, 0x4f, 0x3f, 0xec, 0xbf, 0x37, 0x69,
+	0xa0, 0x79, 0x8c, 0x8b, 0x35, 0xfb, 0xbf, 0xf9, 0xf8, 0xbf, 0x03, 0x00, 0xf4, 0xb1, 0x0f, 0x16,
+	0x94, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -882,6 +1089,8 @@ type SettingsServiceClient interface {
 	Get(ctx context.Context, in *SettingsQuery, opts ...grpc.CallOption) (*Settings, error)
 	// Get returns Argo CD plugins
 	GetPlugins(ctx context.Context, in *SettingsQuery, opts ...grpc.CallOption) (*SettingsPluginsResponse, error)
+	// GetIgnoreDifferencesSuggestions returns ignoreDifferences rules for fields which keep differing after successful syncs
+	GetIgnoreDifferencesSuggestions(ctx context.Context, in *IgnoreDifferencesSuggestionsQuery, opts ...grpc.CallOption) (*IgnoreDifferencesSuggestionsResponse, error)
 }
 
 type settingsServiceClient struct {
@@ -910,12 +1119,23 @@ func (c *settingsServiceClient) GetPlugins(ctx context.Context, in *SettingsQuer
 	return out, nil
 }
 
+func (c *settingsServiceClient) GetIgnoreDifferencesSuggestions(ctx context.Context, in *IgnoreDifferencesSuggestionsQuery, opts ...grpc.CallOption) (*IgnoreDifferencesSuggestionsResponse, error) {
+	out := new(IgnoreDifferencesSuggestionsResponse)
+	err := c.cc.Invoke(ctx, "/cluster.SettingsService/GetIgnoreDifferencesSuggestions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SettingsServiceServer is the server API for SettingsService service.
 type SettingsServiceServer interface {
 	// Get returns Argo CD settings
 	Get(context.Context, *SettingsQuery) (*Settings, error)
 	// Get returns Argo CD plugins
 	GetPlugins(context.Context, *SettingsQuery) (*SettingsPluginsResponse, error)
+	// GetIgnoreDifferencesSuggestions returns ignoreDifferences rules for fields which keep differing after successful syncs
+	GetIgnoreDifferencesSuggestions(context.Context, *IgnoreDifferencesSuggestionsQuery) (*IgnoreDifferencesSuggestionsResponse, error)
 }
 
 // UnimplementedSettingsServiceServer can be embedded to have forward compatible implementations.
@@ -928,6 +1148,9 @@ func (*UnimplementedSettingsServiceServer) Get(ctx context.Context, req *Setting
 func (*UnimplementedSettingsServiceServer) GetPlugins(ctx context.Context, req *SettingsQuery) (*SettingsPluginsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPlugins not implemented")
 }
+func (*UnimplementedSettingsServiceServer) GetIgnoreDifferencesSuggestions(ctx context.Context, req *IgnoreDifferencesSuggestionsQuery) (*IgnoreDifferencesSuggestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIgnoreDifferencesSuggestions not implemented")
+}
 
 func RegisterSettingsServiceServer(s *grpc.Server, srv SettingsServiceServer) {
 	s.RegisterService(&_SettingsService_serviceDesc, srv)
@@ -969,6 +1192,24 @@ func _SettingsService_GetPlugins_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SettingsService_GetIgnoreDifferencesSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IgnoreDifferencesSuggestionsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingsServiceServer).GetIgnoreDifferencesSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster.SettingsService/GetIgnoreDifferencesSuggestions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServiceServer).GetIgnoreDifferencesSuggestions(ctx, req.(*IgnoreDifferencesSuggestionsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _SettingsService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cluster.SettingsService",
 	HandlerType: (*SettingsServiceServer)(nil),
@@ -981,6 +1222,10 @@ var _SettingsService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPlugins",
 			Handler:    _SettingsService_GetPlugins_Handler,
 		},
+		{
+			MethodName: "GetIgnoreDifferencesSuggestions",
+			Handler:    _SettingsService_GetIgnoreDifferencesSuggestions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/settings/settings.proto",
@@ -1718,6 +1963,154 @@ func (m *OIDCConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IgnoreDifferencesSuggestionsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IgnoreDifferencesSuggestionsQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IgnoreDifferencesSuggestionsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MinApplications != 0 {
+		i = encodeVarintSettings(dAtA, i, uint64(m.MinApplications))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinObservations != 0 {
+		i = encodeVarintSettings(dAtA, i, uint64(m.MinObservations))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IgnoreDifferencesSuggestion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IgnoreDifferencesSuggestion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IgnoreDifferencesSuggestion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LastObserved != nil {
+		{
+			size, err := m.LastObserved.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSettings(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Managers) > 0 {
+		for iNdEx := len(m.Managers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Managers[iNdEx])
+			copy(dAtA[i:], m.Managers[iNdEx])
+			i = encodeVarintSettings(dAtA, i, uint64(len(m.Managers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Applications != 0 {
+		i = encodeVarintSettings(dAtA, i, uint64(m.Applications))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Observations != 0 {
+		i = encodeVarintSettings(dAtA, i, uint64(m.Observations))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Rule != nil {
+		{
+			size, err := m.Rule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSettings(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IgnoreDifferencesSuggestionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IgnoreDifferencesSuggestionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IgnoreDifferencesSuggestionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettings(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSettings(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSettings(v)
 	base := offset
@@ -2047,10 +2440,78 @@ func (m *OIDCConfig) Size() (n int) {
 	return n
 }
 
-func sovSettings(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *IgnoreDifferencesSuggestionsQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinObservations != 0 {
+		n += 1 + sovSettings(uint64(m.MinObservations))
+	}
+	if m.MinApplications != 0 {
+		n += 1 + sovSettings(uint64(m.MinApplications))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func sozSettings(x uint64) (n int) {
+
+func (m *IgnoreDifferencesSuggestion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rule != nil {
+		l = m.Rule.Size()
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if m.Observations != 0 {
+		n += 1 + sovSettings(uint64(m.Observations))
+	}
+	if m.Applications != 0 {
+		n += 1 + sovSettings(uint64(m.Applications))
+	}
+	if len(m.Managers) > 0 {
+		for _, s := range m.Managers {
+			l = len(s)
+			n += 1 + l + sovSettings(uint64(l))
+		}
+	}
+	if m.LastObserved != nil {
+		l = m.LastObserved.Size()
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IgnoreDifferencesSuggestionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovSettings(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSettings(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSettings(x uint64) (n int) {
 	return sovSettings(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *SettingsQuery) Unmarshal(dAtA []byte) error {
@@ -4181,6 +4642,373 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *IgnoreDifferencesSuggestionsQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IgnoreDifferencesSuggestionsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IgnoreDifferencesSuggestionsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinObservations", wireType)
+			}
+			m.MinObservations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinObservations |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinApplications", wireType)
+			}
+			m.MinApplications = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinApplications |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IgnoreDifferencesSuggestion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IgnoreDifferencesSuggestion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IgnoreDifferencesSuggestion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rule == nil {
+				m.Rule = &v1alpha1.ResourceIgnoreDifferences{}
+			}
+			if err := m.Rule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Observations", wireType)
+			}
+			m.Observations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Observations |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
+			}
+			m.Applications = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Applications |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Managers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Managers = append(m.Managers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObserved", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastObserved == nil {
+				m.LastObserved = &v1.Time{}
+			}
+			if err := m.LastObserved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IgnoreDifferencesSuggestionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IgnoreDifferencesSuggestionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IgnoreDifferencesSuggestionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &IgnoreDifferencesSuggestion{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSettings(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -263,10 +263,38 @@ func (m *ApplicationCondition) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationCondition proto.InternalMessageInfo
 
+func (m *ApplicationDependency) Reset()      { *m = ApplicationDependency{} }
+func (*ApplicationDependency) ProtoMessage() {}
+func (*ApplicationDependency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{8}
+}
+func (m *ApplicationDependency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationDependency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationDependency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDependency.Merge(m, src)
+}
+func (m *ApplicationDependency) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationDependency) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDependency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDependency proto.InternalMessageInfo
+
 func (m *ApplicationDestination) Reset()      { *m = ApplicationDestination{} }
 func (*ApplicationDestination) ProtoMessage() {}
 func (*ApplicationDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{8}
+	return fileDescriptor_c078c3c476799f44, []int{9}
 }
 func (m *ApplicationDestination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -294,7 +322,7 @@ var xxx_messageInfo_ApplicationDestination proto.InternalMessageInfo
 func (m *ApplicationDestinationServiceAccount) Reset()      { *m = ApplicationDestinationServiceAccount{} }
 func (*ApplicationDestinationServiceAccount) ProtoMessage() {}
 func (*ApplicationDestinationServiceAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{9}
+	return fileDescriptor_c078c3c476799f44, []int{10}
 }
 func (m *ApplicationDestinationServiceAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -322,7 +350,7 @@ var xxx_messageInfo_ApplicationDestinationServiceAccount proto.InternalMessageIn
 func (m *ApplicationList) Reset()      { *m = ApplicationList{} }
 func (*ApplicationList) ProtoMessage() {}
 func (*ApplicationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{10}
+	return fileDescriptor_c078c3c476799f44, []int{11}
 }
 func (m *ApplicationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +378,7 @@ var xxx_messageInfo_ApplicationList proto.InternalMessageInfo
 func (m *ApplicationMatchExpression) Reset()      { *m = ApplicationMatchExpression{} }
 func (*ApplicationMatchExpression) ProtoMessage() {}
 func (*ApplicationMatchExpression) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{11}
+	return fileDescriptor_c078c3c476799f44, []int{12}
 }
 func (m *ApplicationMatchExpression) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,7 +406,7 @@ var xxx_messageInfo_ApplicationMatchExpression proto.InternalMessageInfo
 func (m *ApplicationPreservedFields) Reset()      { *m = ApplicationPreservedFields{} }
 func (*ApplicationPreservedFields) ProtoMessage() {}
 func (*ApplicationPreservedFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{12}
+	return fileDescriptor_c078c3c476799f44, []int{13}
 }
 func (m *ApplicationPreservedFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,7 +434,7 @@ var xxx_messageInfo_ApplicationPreservedFields proto.InternalMessageInfo
 func (m *ApplicationSet) Reset()      { *m = ApplicationSet{} }
 func (*ApplicationSet) ProtoMessage() {}
 func (*ApplicationSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{13}
+	return fileDescriptor_c078c3c476799f44, []int{14}
 }
 func (m *ApplicationSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -434,7 +462,7 @@ var xxx_messageInfo_ApplicationSet proto.InternalMessageInfo
 func (m *ApplicationSetApplicationStatus) Reset()      { *m = ApplicationSetApplicationStatus{} }
 func (*ApplicationSetApplicationStatus) ProtoMessage() {}
 func (*ApplicationSetApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{14}
+	return fileDescriptor_c078c3c476799f44, []int{15}
 }
 func (m *ApplicationSetApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +490,7 @@ var xxx_messageInfo_ApplicationSetApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSetCondition) Reset()      { *m = ApplicationSetCondition{} }
 func (*ApplicationSetCondition) ProtoMessage() {}
 func (*ApplicationSetCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{15}
+	return fileDescriptor_c078c3c476799f44, []int{16}
 }
 func (m *ApplicationSetCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,7 +518,7 @@ var xxx_messageInfo_ApplicationSetCondition proto.InternalMessageInfo
 func (m *ApplicationSetGenerator) Reset()      { *m = ApplicationSetGenerator{} }
 func (*ApplicationSetGenerator) ProtoMessage() {}
 func (*ApplicationSetGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{16}
+	return fileDescriptor_c078c3c476799f44, []int{17}
 }
 func (m *ApplicationSetGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +546,7 @@ var xxx_messageInfo_ApplicationSetGenerator proto.InternalMessageInfo
 func (m *ApplicationSetList) Reset()      { *m = ApplicationSetList{} }
 func (*ApplicationSetList) ProtoMessage() {}
 func (*ApplicationSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{17}
+	return fileDescriptor_c078c3c476799f44, []int{18}
 }
 func (m *ApplicationSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -546,7 +574,7 @@ var xxx_messageInfo_ApplicationSetList proto.InternalMessageInfo
 func (m *ApplicationSetNestedGenerator) Reset()      { *m = ApplicationSetNestedGenerator{} }
 func (*ApplicationSetNestedGenerator) ProtoMessage() {}
 func (*ApplicationSetNestedGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{18}
+	return fileDescriptor_c078c3c476799f44, []int{19}
 }
 func (m *ApplicationSetNestedGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -576,7 +604,7 @@ func (m *ApplicationSetResourceIgnoreDifferences) Reset() {
 }
 func (*ApplicationSetResourceIgnoreDifferences) ProtoMessage() {}
 func (*ApplicationSetResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{19}
+	return fileDescriptor_c078c3c476799f44, []int{20}
 }
 func (m *ApplicationSetResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -604,7 +632,7 @@ var xxx_messageInfo_ApplicationSetResourceIgnoreDifferences proto.InternalMessag
 func (m *ApplicationSetRolloutStep) Reset()      { *m = ApplicationSetRolloutStep{} }
 func (*ApplicationSetRolloutStep) ProtoMessage() {}
 func (*ApplicationSetRolloutStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{20}
+	return fileDescriptor_c078c3c476799f44, []int{21}
 }
 func (m *ApplicationSetRolloutStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -632,7 +660,7 @@ var xxx_messageInfo_ApplicationSetRolloutStep proto.InternalMessageInfo
 func (m *ApplicationSetRolloutStrategy) Reset()      { *m = ApplicationSetRolloutStrategy{} }
 func (*ApplicationSetRolloutStrategy) ProtoMessage() {}
 func (*ApplicationSetRolloutStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{21}
+	return fileDescriptor_c078c3c476799f44, []int{22}
 }
 func (m *ApplicationSetRolloutStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -660,7 +688,7 @@ var xxx_messageInfo_ApplicationSetRolloutStrategy proto.InternalMessageInfo
 func (m *ApplicationSetSpec) Reset()      { *m = ApplicationSetSpec{} }
 func (*ApplicationSetSpec) ProtoMessage() {}
 func (*ApplicationSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{22}
+	return fileDescriptor_c078c3c476799f44, []int{23}
 }
 func (m *ApplicationSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,7 +716,7 @@ var xxx_messageInfo_ApplicationSetSpec proto.InternalMessageInfo
 func (m *ApplicationSetStatus) Reset()      { *m = ApplicationSetStatus{} }
 func (*ApplicationSetStatus) ProtoMessage() {}
 func (*ApplicationSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{23}
+	return fileDescriptor_c078c3c476799f44, []int{24}
 }
 func (m *ApplicationSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -716,7 +744,7 @@ var xxx_messageInfo_ApplicationSetStatus proto.InternalMessageInfo
 func (m *ApplicationSetStrategy) Reset()      { *m = ApplicationSetStrategy{} }
 func (*ApplicationSetStrategy) ProtoMessage() {}
 func (*ApplicationSetStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{24}
+	return fileDescriptor_c078c3c476799f44, []int{25}
 }
 func (m *ApplicationSetStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,7 +772,7 @@ var xxx_messageInfo_ApplicationSetStrategy proto.InternalMessageInfo
 func (m *ApplicationSetSyncPolicy) Reset()      { *m = ApplicationSetSyncPolicy{} }
 func (*ApplicationSetSyncPolicy) ProtoMessage() {}
 func (*ApplicationSetSyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{25}
+	return fileDescriptor_c078c3c476799f44, []int{26}
 }
 func (m *ApplicationSetSyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -772,7 +800,7 @@ var xxx_messageInfo_ApplicationSetSyncPolicy proto.InternalMessageInfo
 func (m *ApplicationSetTemplate) Reset()      { *m = ApplicationSetTemplate{} }
 func (*ApplicationSetTemplate) ProtoMessage() {}
 func (*ApplicationSetTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{26}
+	return fileDescriptor_c078c3c476799f44, []int{27}
 }
 func (m *ApplicationSetTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -800,7 +828,7 @@ var xxx_messageInfo_ApplicationSetTemplate proto.InternalMessageInfo
 func (m *ApplicationSetTemplateMeta) Reset()      { *m = ApplicationSetTemplateMeta{} }
 func (*ApplicationSetTemplateMeta) ProtoMessage() {}
 func (*ApplicationSetTemplateMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{27}
+	return fileDescriptor_c078c3c476799f44, []int{28}
 }
 func (m *ApplicationSetTemplateMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,7 +856,7 @@ var xxx_messageInfo_ApplicationSetTemplateMeta proto.InternalMessageInfo
 func (m *ApplicationSetTerminalGenerator) Reset()      { *m = ApplicationSetTerminalGenerator{} }
 func (*ApplicationSetTerminalGenerator) ProtoMessage() {}
 func (*ApplicationSetTerminalGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{28}
+	return fileDescriptor_c078c3c476799f44, []int{29}
 }
 func (m *ApplicationSetTerminalGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -856,7 +884,7 @@ var xxx_messageInfo_ApplicationSetTerminalGenerator proto.InternalMessageInfo
 func (m *ApplicationSetTree) Reset()      { *m = ApplicationSetTree{} }
 func (*ApplicationSetTree) ProtoMessage() {}
 func (*ApplicationSetTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{29}
+	return fileDescriptor_c078c3c476799f44, []int{30}
 }
 func (m *ApplicationSetTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -884,7 +912,7 @@ var xxx_messageInfo_ApplicationSetTree proto.InternalMessageInfo
 func (m *ApplicationSource) Reset()      { *m = ApplicationSource{} }
 func (*ApplicationSource) ProtoMessage() {}
 func (*ApplicationSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{30}
+	return fileDescriptor_c078c3c476799f44, []int{31}
 }
 func (m *ApplicationSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -912,7 +940,7 @@ var xxx_messageInfo_ApplicationSource proto.InternalMessageInfo
 func (m *ApplicationSourceDirectory) Reset()      { *m = ApplicationSourceDirectory{} }
 func (*ApplicationSourceDirectory) ProtoMessage() {}
 func (*ApplicationSourceDirectory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{31}
+	return fileDescriptor_c078c3c476799f44, []int{32}
 }
 func (m *ApplicationSourceDirectory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -940,7 +968,7 @@ var xxx_messageInfo_ApplicationSourceDirectory proto.InternalMessageInfo
 func (m *ApplicationSourceHelm) Reset()      { *m = ApplicationSourceHelm{} }
 func (*ApplicationSourceHelm) ProtoMessage() {}
 func (*ApplicationSourceHelm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{32}
+	return fileDescriptor_c078c3c476799f44, []int{33}
 }
 func (m *ApplicationSourceHelm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -968,7 +996,7 @@ var xxx_messageInfo_ApplicationSourceHelm proto.InternalMessageInfo
 func (m *ApplicationSourceJsonnet) Reset()      { *m = ApplicationSourceJsonnet{} }
 func (*ApplicationSourceJsonnet) ProtoMessage() {}
 func (*ApplicationSourceJsonnet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{33}
+	return fileDescriptor_c078c3c476799f44, []int{34}
 }
 func (m *ApplicationSourceJsonnet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -996,7 +1024,7 @@ var xxx_messageInfo_ApplicationSourceJsonnet proto.InternalMessageInfo
 func (m *ApplicationSourceKustomize) Reset()      { *m = ApplicationSourceKustomize{} }
 func (*ApplicationSourceKustomize) ProtoMessage() {}
 func (*ApplicationSourceKustomize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{34}
+	return fileDescriptor_c078c3c476799f44, []int{35}
 }
 func (m *ApplicationSourceKustomize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1024,7 +1052,7 @@ var xxx_messageInfo_ApplicationSourceKustomize proto.InternalMessageInfo
 func (m *ApplicationSourcePlugin) Reset()      { *m = ApplicationSourcePlugin{} }
 func (*ApplicationSourcePlugin) ProtoMessage() {}
 func (*ApplicationSourcePlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{35}
+	return fileDescriptor_c078c3c476799f44, []int{36}
 }
 func (m *ApplicationSourcePlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1052,7 +1080,7 @@ var xxx_messageInfo_ApplicationSourcePlugin proto.InternalMessageInfo
 func (m *ApplicationSourcePluginParameter) Reset()      { *m = ApplicationSourcePluginParameter{} }
 func (*ApplicationSourcePluginParameter) ProtoMessage() {}
 func (*ApplicationSourcePluginParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{36}
+	return fileDescriptor_c078c3c476799f44, []int{37}
 }
 func (m *ApplicationSourcePluginParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1080,7 +1108,7 @@ var xxx_messageInfo_ApplicationSourcePluginParameter proto.InternalMessageInfo
 func (m *ApplicationSpec) Reset()      { *m = ApplicationSpec{} }
 func (*ApplicationSpec) ProtoMessage() {}
 func (*ApplicationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{37}
+	return fileDescriptor_c078c3c476799f44, []int{38}
 }
 func (m *ApplicationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1108,7 +1136,7 @@ var xxx_messageInfo_ApplicationSpec proto.InternalMessageInfo
 func (m *ApplicationStatus) Reset()      { *m = ApplicationStatus{} }
 func (*ApplicationStatus) ProtoMessage() {}
 func (*ApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{38}
+	return fileDescriptor_c078c3c476799f44, []int{39}
 }
 func (m *ApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1136,7 +1164,7 @@ var xxx_messageInfo_ApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSummary) Reset()      { *m = ApplicationSummary{} }
 func (*ApplicationSummary) ProtoMessage() {}
 func (*ApplicationSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{39}
+	return fileDescriptor_c078c3c476799f44, []int{40}
 }
 func (m *ApplicationSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1164,7 +1192,7 @@ var xxx_messageInfo_ApplicationSummary proto.InternalMessageInfo
 func (m *ApplicationTree) Reset()      { *m = ApplicationTree{} }
 func (*ApplicationTree) ProtoMessage() {}
 func (*ApplicationTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{40}
+	return fileDescriptor_c078c3c476799f44, []int{41}
 }
 func (m *ApplicationTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1192,7 +1220,7 @@ var xxx_messageInfo_ApplicationTree proto.InternalMessageInfo
 func (m *ApplicationWatchEvent) Reset()      { *m = ApplicationWatchEvent{} }
 func (*ApplicationWatchEvent) ProtoMessage() {}
 func (*ApplicationWatchEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{41}
+	return fileDescriptor_c078c3c476799f44, []int{42}
 }
 func (m *ApplicationWatchEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1217,10 +1245,38 @@ func (m *ApplicationWatchEvent) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationWatchEvent proto.InternalMessageInfo
 
+func (m *AutoRollback) Reset()      { *m = AutoRollback{} }
+func (*AutoRollback) ProtoMessage() {}
+func (*AutoRollback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{43}
+}
+func (m *AutoRollback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoRollback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AutoRollback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoRollback.Merge(m, src)
+}
+func (m *AutoRollback) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoRollback) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoRollback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoRollback proto.InternalMessageInfo
+
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{42}
+	return fileDescriptor_c078c3c476799f44, []int{44}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1248,7 +1304,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *BasicAuthBitbucketServer) Reset()      { *m = BasicAuthBitbucketServer{} }
 func (*BasicAuthBitbucketServer) ProtoMessage() {}
 func (*BasicAuthBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{43}
+	return fileDescriptor_c078c3c476799f44, []int{45}
 }
 func (m *BasicAuthBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1276,7 +1332,7 @@ var xxx_messageInfo_BasicAuthBitbucketServer proto.InternalMessageInfo
 func (m *BearerTokenBitbucket) Reset()      { *m = BearerTokenBitbucket{} }
 func (*BearerTokenBitbucket) ProtoMessage() {}
 func (*BearerTokenBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{44}
+	return fileDescriptor_c078c3c476799f44, []int{46}
 }
 func (m *BearerTokenBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1304,7 +1360,7 @@ var xxx_messageInfo_BearerTokenBitbucket proto.InternalMessageInfo
 func (m *BearerTokenBitbucketCloud) Reset()      { *m = BearerTokenBitbucketCloud{} }
 func (*BearerTokenBitbucketCloud) ProtoMessage() {}
 func (*BearerTokenBitbucketCloud) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{45}
+	return fileDescriptor_c078c3c476799f44, []int{47}
 }
 func (m *BearerTokenBitbucketCloud) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1332,7 +1388,7 @@ var xxx_messageInfo_BearerTokenBitbucketCloud proto.InternalMessageInfo
 func (m *ChartDetails) Reset()      { *m = ChartDetails{} }
 func (*ChartDetails) ProtoMessage() {}
 func (*ChartDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{46}
+	return fileDescriptor_c078c3c476799f44, []int{48}
 }
 func (m *ChartDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1360,7 +1416,7 @@ var xxx_messageInfo_ChartDetails proto.InternalMessageInfo
 func (m *Cluster) Reset()      { *m = Cluster{} }
 func (*Cluster) ProtoMessage() {}
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{47}
+	return fileDescriptor_c078c3c476799f44, []int{49}
 }
 func (m *Cluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1388,7 +1444,7 @@ var xxx_messageInfo_Cluster proto.InternalMessageInfo
 func (m *ClusterCacheInfo) Reset()      { *m = ClusterCacheInfo{} }
 func (*ClusterCacheInfo) ProtoMessage() {}
 func (*ClusterCacheInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{48}
+	return fileDescriptor_c078c3c476799f44, []int{50}
 }
 func (m *ClusterCacheInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1416,7 +1472,7 @@ var xxx_messageInfo_ClusterCacheInfo proto.InternalMessageInfo
 func (m *ClusterConfig) Reset()      { *m = ClusterConfig{} }
 func (*ClusterConfig) ProtoMessage() {}
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{49}
+	return fileDescriptor_c078c3c476799f44, []int{51}
 }
 func (m *ClusterConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1444,7 +1500,7 @@ var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
 func (m *ClusterGenerator) Reset()      { *m = ClusterGenerator{} }
 func (*ClusterGenerator) ProtoMessage() {}
 func (*ClusterGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{50}
+	return fileDescriptor_c078c3c476799f44, []int{52}
 }
 func (m *ClusterGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1472,7 +1528,7 @@ var xxx_messageInfo_ClusterGenerator proto.InternalMessageInfo
 func (m *ClusterInfo) Reset()      { *m = ClusterInfo{} }
 func (*ClusterInfo) ProtoMessage() {}
 func (*ClusterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{51}
+	return fileDescriptor_c078c3c476799f44, []int{53}
 }
 func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1500,7 +1556,7 @@ var xxx_messageInfo_ClusterInfo proto.InternalMessageInfo
 func (m *ClusterList) Reset()      { *m = ClusterList{} }
 func (*ClusterList) ProtoMessage() {}
 func (*ClusterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{52}
+	return fileDescriptor_c078c3c476799f44, []int{54}
 }
 func (m *ClusterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1528,7 +1584,7 @@ var xxx_messageInfo_ClusterList proto.InternalMessageInfo
 func (m *ClusterResourceRestrictionItem) Reset()      { *m = ClusterResourceRestrictionItem{} }
 func (*ClusterResourceRestrictionItem) ProtoMessage() {}
 func (*ClusterResourceRestrictionItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{53}
+	return fileDescriptor_c078c3c476799f44, []int{55}
 }
 func (m *ClusterResourceRestrictionItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1556,7 +1612,7 @@ var xxx_messageInfo_ClusterResourceRestrictionItem proto.InternalMessageInfo
 func (m *Command) Reset()      { *m = Command{} }
 func (*Command) ProtoMessage() {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{54}
+	return fileDescriptor_c078c3c476799f44, []int{56}
 }
 func (m *Command) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1584,7 +1640,7 @@ var xxx_messageInfo_Command proto.InternalMessageInfo
 func (m *CommitMetadata) Reset()      { *m = CommitMetadata{} }
 func (*CommitMetadata) ProtoMessage() {}
 func (*CommitMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{55}
+	return fileDescriptor_c078c3c476799f44, []int{57}
 }
 func (m *CommitMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1612,7 +1668,7 @@ var xxx_messageInfo_CommitMetadata proto.InternalMessageInfo
 func (m *ComparedTo) Reset()      { *m = ComparedTo{} }
 func (*ComparedTo) ProtoMessage() {}
 func (*ComparedTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{56}
+	return fileDescriptor_c078c3c476799f44, []int{58}
 }
 func (m *ComparedTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1640,7 +1696,7 @@ var xxx_messageInfo_ComparedTo proto.InternalMessageInfo
 func (m *ComponentParameter) Reset()      { *m = ComponentParameter{} }
 func (*ComponentParameter) ProtoMessage() {}
 func (*ComponentParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{57}
+	return fileDescriptor_c078c3c476799f44, []int{59}
 }
 func (m *ComponentParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1668,7 +1724,7 @@ var xxx_messageInfo_ComponentParameter proto.InternalMessageInfo
 func (m *ConfigManagementPlugin) Reset()      { *m = ConfigManagementPlugin{} }
 func (*ConfigManagementPlugin) ProtoMessage() {}
 func (*ConfigManagementPlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{58}
+	return fileDescriptor_c078c3c476799f44, []int{60}
 }
 func (m *ConfigManagementPlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1696,7 +1752,7 @@ var xxx_messageInfo_ConfigManagementPlugin proto.InternalMessageInfo
 func (m *ConfigMapKeyRef) Reset()      { *m = ConfigMapKeyRef{} }
 func (*ConfigMapKeyRef) ProtoMessage() {}
 func (*ConfigMapKeyRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{59}
+	return fileDescriptor_c078c3c476799f44, []int{61}
 }
 func (m *ConfigMapKeyRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1724,7 +1780,7 @@ var xxx_messageInfo_ConfigMapKeyRef proto.InternalMessageInfo
 func (m *ConnectionState) Reset()      { *m = ConnectionState{} }
 func (*ConnectionState) ProtoMessage() {}
 func (*ConnectionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{60}
+	return fileDescriptor_c078c3c476799f44, []int{62}
 }
 func (m *ConnectionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1752,7 +1808,7 @@ var xxx_messageInfo_ConnectionState proto.InternalMessageInfo
 func (m *DrySource) Reset()      { *m = DrySource{} }
 func (*DrySource) ProtoMessage() {}
 func (*DrySource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{61}
+	return fileDescriptor_c078c3c476799f44, []int{63}
 }
 func (m *DrySource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1780,7 +1836,7 @@ var xxx_messageInfo_DrySource proto.InternalMessageInfo
 func (m *DuckTypeGenerator) Reset()      { *m = DuckTypeGenerator{} }
 func (*DuckTypeGenerator) ProtoMessage() {}
 func (*DuckTypeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{62}
+	return fileDescriptor_c078c3c476799f44, []int{64}
 }
 func (m *DuckTypeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1808,7 +1864,7 @@ var xxx_messageInfo_DuckTypeGenerator proto.InternalMessageInfo
 func (m *EnvEntry) Reset()      { *m = EnvEntry{} }
 func (*EnvEntry) ProtoMessage() {}
 func (*EnvEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{63}
+	return fileDescriptor_c078c3c476799f44, []int{65}
 }
 func (m *EnvEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1836,7 +1892,7 @@ var xxx_messageInfo_EnvEntry proto.InternalMessageInfo
 func (m *ExecProviderConfig) Reset()      { *m = ExecProviderConfig{} }
 func (*ExecProviderConfig) ProtoMessage() {}
 func (*ExecProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{64}
+	return fileDescriptor_c078c3c476799f44, []int{66}
 }
 func (m *ExecProviderConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1864,7 +1920,7 @@ var xxx_messageInfo_ExecProviderConfig proto.InternalMessageInfo
 func (m *GitDirectoryGeneratorItem) Reset()      { *m = GitDirectoryGeneratorItem{} }
 func (*GitDirectoryGeneratorItem) ProtoMessage() {}
 func (*GitDirectoryGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{65}
+	return fileDescriptor_c078c3c476799f44, []int{67}
 }
 func (m *GitDirectoryGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1892,7 +1948,7 @@ var xxx_messageInfo_GitDirectoryGeneratorItem proto.InternalMessageInfo
 func (m *GitFileGeneratorItem) Reset()      { *m = GitFileGeneratorItem{} }
 func (*GitFileGeneratorItem) ProtoMessage() {}
 func (*GitFileGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{66}
+	return fileDescriptor_c078c3c476799f44, []int{68}
 }
 func (m *GitFileGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1920,7 +1976,7 @@ var xxx_messageInfo_GitFileGeneratorItem proto.InternalMessageInfo
 func (m *GitGenerator) Reset()      { *m = GitGenerator{} }
 func (*GitGenerator) ProtoMessage() {}
 func (*GitGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{67}
+	return fileDescriptor_c078c3c476799f44, []int{69}
 }
 func (m *GitGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1948,7 +2004,7 @@ var xxx_messageInfo_GitGenerator proto.InternalMessageInfo
 func (m *GnuPGPublicKey) Reset()      { *m = GnuPGPublicKey{} }
 func (*GnuPGPublicKey) ProtoMessage() {}
 func (*GnuPGPublicKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{68}
+	return fileDescriptor_c078c3c476799f44, []int{70}
 }
 func (m *GnuPGPublicKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1976,7 +2032,7 @@ var xxx_messageInfo_GnuPGPublicKey proto.InternalMessageInfo
 func (m *GnuPGPublicKeyList) Reset()      { *m = GnuPGPublicKeyList{} }
 func (*GnuPGPublicKeyList) ProtoMessage() {}
 func (*GnuPGPublicKeyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{69}
+	return fileDescriptor_c078c3c476799f44, []int{71}
 }
 func (m *GnuPGPublicKeyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2004,7 +2060,7 @@ var xxx_messageInfo_GnuPGPublicKeyList proto.InternalMessageInfo
 func (m *HealthStatus) Reset()      { *m = HealthStatus{} }
 func (*HealthStatus) ProtoMessage() {}
 func (*HealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{70}
+	return fileDescriptor_c078c3c476799f44, []int{72}
 }
 func (m *HealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2032,7 +2088,7 @@ var xxx_messageInfo_HealthStatus proto.InternalMessageInfo
 func (m *HelmFileParameter) Reset()      { *m = HelmFileParameter{} }
 func (*HelmFileParameter) ProtoMessage() {}
 func (*HelmFileParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{71}
+	return fileDescriptor_c078c3c476799f44, []int{73}
 }
 func (m *HelmFileParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2060,7 +2116,7 @@ var xxx_messageInfo_HelmFileParameter proto.InternalMessageInfo
 func (m *HelmOptions) Reset()      { *m = HelmOptions{} }
 func (*HelmOptions) ProtoMessage() {}
 func (*HelmOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{72}
+	return fileDescriptor_c078c3c476799f44, []int{74}
 }
 func (m *HelmOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2088,7 +2144,7 @@ var xxx_messageInfo_HelmOptions proto.InternalMessageInfo
 func (m *HelmParameter) Reset()      { *m = HelmParameter{} }
 func (*HelmParameter) ProtoMessage() {}
 func (*HelmParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{73}
+	return fileDescriptor_c078c3c476799f44, []int{75}
 }
 func (m *HelmParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2116,7 +2172,7 @@ var xxx_messageInfo_HelmParameter proto.InternalMessageInfo
 func (m *HostInfo) Reset()      { *m = HostInfo{} }
 func (*HostInfo) ProtoMessage() {}
 func (*HostInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{74}
+	return fileDescriptor_c078c3c476799f44, []int{76}
 }
 func (m *HostInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2144,7 +2200,7 @@ var xxx_messageInfo_HostInfo proto.InternalMessageInfo
 func (m *HostResourceInfo) Reset()      { *m = HostResourceInfo{} }
 func (*HostResourceInfo) ProtoMessage() {}
 func (*HostResourceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{75}
+	return fileDescriptor_c078c3c476799f44, []int{77}
 }
 func (m *HostResourceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2172,7 +2228,7 @@ var xxx_messageInfo_HostResourceInfo proto.InternalMessageInfo
 func (m *HydrateOperation) Reset()      { *m = HydrateOperation{} }
 func (*HydrateOperation) ProtoMessage() {}
 func (*HydrateOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{76}
+	return fileDescriptor_c078c3c476799f44, []int{78}
 }
 func (m *HydrateOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2200,7 +2256,7 @@ var xxx_messageInfo_HydrateOperation proto.InternalMessageInfo
 func (m *HydrateTo) Reset()      { *m = HydrateTo{} }
 func (*HydrateTo) ProtoMessage() {}
 func (*HydrateTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{77}
+	return fileDescriptor_c078c3c476799f44, []int{79}
 }
 func (m *HydrateTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2225,10 +2281,66 @@ func (m *HydrateTo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HydrateTo proto.InternalMessageInfo
 
+func (m *HydrateToPullRequest) Reset()      { *m = HydrateToPullRequest{} }
+func (*HydrateToPullRequest) ProtoMessage() {}
+func (*HydrateToPullRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{80}
+}
+func (m *HydrateToPullRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HydrateToPullRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HydrateToPullRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HydrateToPullRequest.Merge(m, src)
+}
+func (m *HydrateToPullRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *HydrateToPullRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HydrateToPullRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HydrateToPullRequest proto.InternalMessageInfo
+
+func (m *HydratorPullRequestStatus) Reset()      { *m = HydratorPullRequestStatus{} }
+func (*HydratorPullRequestStatus) ProtoMessage() {}
+func (*HydratorPullRequestStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{81}
+}
+func (m *HydratorPullRequestStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HydratorPullRequestStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HydratorPullRequestStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HydratorPullRequestStatus.Merge(m, src)
+}
+func (m *HydratorPullRequestStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *HydratorPullRequestStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_HydratorPullRequestStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HydratorPullRequestStatus proto.InternalMessageInfo
+
 func (m *Info) Reset()      { *m = Info{} }
 func (*Info) ProtoMessage() {}
 func (*Info) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{78}
+	return fileDescriptor_c078c3c476799f44, []int{82}
 }
 func (m *Info) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2256,7 +2368,7 @@ var xxx_messageInfo_Info proto.InternalMessageInfo
 func (m *InfoItem) Reset()      { *m = InfoItem{} }
 func (*InfoItem) ProtoMessage() {}
 func (*InfoItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{79}
+	return fileDescriptor_c078c3c476799f44, []int{83}
 }
 func (m *InfoItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2284,7 +2396,7 @@ var xxx_messageInfo_InfoItem proto.InternalMessageInfo
 func (m *JWTToken) Reset()      { *m = JWTToken{} }
 func (*JWTToken) ProtoMessage() {}
 func (*JWTToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{80}
+	return fileDescriptor_c078c3c476799f44, []int{84}
 }
 func (m *JWTToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2312,7 +2424,7 @@ var xxx_messageInfo_JWTToken proto.InternalMessageInfo
 func (m *JWTTokens) Reset()      { *m = JWTTokens{} }
 func (*JWTTokens) ProtoMessage() {}
 func (*JWTTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{81}
+	return fileDescriptor_c078c3c476799f44, []int{85}
 }
 func (m *JWTTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2340,7 +2452,7 @@ var xxx_messageInfo_JWTTokens proto.InternalMessageInfo
 func (m *JsonnetVar) Reset()      { *m = JsonnetVar{} }
 func (*JsonnetVar) ProtoMessage() {}
 func (*JsonnetVar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{82}
+	return fileDescriptor_c078c3c476799f44, []int{86}
 }
 func (m *JsonnetVar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2368,7 +2480,7 @@ var xxx_messageInfo_JsonnetVar proto.InternalMessageInfo
 func (m *KnownTypeField) Reset()      { *m = KnownTypeField{} }
 func (*KnownTypeField) ProtoMessage() {}
 func (*KnownTypeField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{83}
+	return fileDescriptor_c078c3c476799f44, []int{87}
 }
 func (m *KnownTypeField) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2396,7 +2508,7 @@ var xxx_messageInfo_KnownTypeField proto.InternalMessageInfo
 func (m *KustomizeGvk) Reset()      { *m = KustomizeGvk{} }
 func (*KustomizeGvk) ProtoMessage() {}
 func (*KustomizeGvk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{84}
+	return fileDescriptor_c078c3c476799f44, []int{88}
 }
 func (m *KustomizeGvk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2424,7 +2536,7 @@ var xxx_messageInfo_KustomizeGvk proto.InternalMessageInfo
 func (m *KustomizeOptions) Reset()      { *m = KustomizeOptions{} }
 func (*KustomizeOptions) ProtoMessage() {}
 func (*KustomizeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{85}
+	return fileDescriptor_c078c3c476799f44, []int{89}
 }
 func (m *KustomizeOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2452,7 +2564,7 @@ var xxx_messageInfo_KustomizeOptions proto.InternalMessageInfo
 func (m *KustomizePatch) Reset()      { *m = KustomizePatch{} }
 func (*KustomizePatch) ProtoMessage() {}
 func (*KustomizePatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{86}
+	return fileDescriptor_c078c3c476799f44, []int{90}
 }
 func (m *KustomizePatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2480,7 +2592,7 @@ var xxx_messageInfo_KustomizePatch proto.InternalMessageInfo
 func (m *KustomizeReplica) Reset()      { *m = KustomizeReplica{} }
 func (*KustomizeReplica) ProtoMessage() {}
 func (*KustomizeReplica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{87}
+	return fileDescriptor_c078c3c476799f44, []int{91}
 }
 func (m *KustomizeReplica) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2508,7 +2620,7 @@ var xxx_messageInfo_KustomizeReplica proto.InternalMessageInfo
 func (m *KustomizeResId) Reset()      { *m = KustomizeResId{} }
 func (*KustomizeResId) ProtoMessage() {}
 func (*KustomizeResId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{88}
+	return fileDescriptor_c078c3c476799f44, []int{92}
 }
 func (m *KustomizeResId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2536,7 +2648,7 @@ var xxx_messageInfo_KustomizeResId proto.InternalMessageInfo
 func (m *KustomizeSelector) Reset()      { *m = KustomizeSelector{} }
 func (*KustomizeSelector) ProtoMessage() {}
 func (*KustomizeSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{89}
+	return fileDescriptor_c078c3c476799f44, []int{93}
 }
 func (m *KustomizeSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2564,7 +2676,7 @@ var xxx_messageInfo_KustomizeSelector proto.InternalMessageInfo
 func (m *KustomizeVersion) Reset()      { *m = KustomizeVersion{} }
 func (*KustomizeVersion) ProtoMessage() {}
 func (*KustomizeVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{90}
+	return fileDescriptor_c078c3c476799f44, []int{94}
 }
 func (m *KustomizeVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2592,7 +2704,7 @@ var xxx_messageInfo_KustomizeVersion proto.InternalMessageInfo
 func (m *ListGenerator) Reset()      { *m = ListGenerator{} }
 func (*ListGenerator) ProtoMessage() {}
 func (*ListGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{91}
+	return fileDescriptor_c078c3c476799f44, []int{95}
 }
 func (m *ListGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2620,7 +2732,7 @@ var xxx_messageInfo_ListGenerator proto.InternalMessageInfo
 func (m *ManagedNamespaceMetadata) Reset()      { *m = ManagedNamespaceMetadata{} }
 func (*ManagedNamespaceMetadata) ProtoMessage() {}
 func (*ManagedNamespaceMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{92}
+	return fileDescriptor_c078c3c476799f44, []int{96}
 }
 func (m *ManagedNamespaceMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2648,7 +2760,7 @@ var xxx_messageInfo_ManagedNamespaceMetadata proto.InternalMessageInfo
 func (m *MatrixGenerator) Reset()      { *m = MatrixGenerator{} }
 func (*MatrixGenerator) ProtoMessage() {}
 func (*MatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{93}
+	return fileDescriptor_c078c3c476799f44, []int{97}
 }
 func (m *MatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2676,7 +2788,7 @@ var xxx_messageInfo_MatrixGenerator proto.InternalMessageInfo
 func (m *MergeGenerator) Reset()      { *m = MergeGenerator{} }
 func (*MergeGenerator) ProtoMessage() {}
 func (*MergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{94}
+	return fileDescriptor_c078c3c476799f44, []int{98}
 }
 func (m *MergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2704,7 +2816,7 @@ var xxx_messageInfo_MergeGenerator proto.InternalMessageInfo
 func (m *NestedMatrixGenerator) Reset()      { *m = NestedMatrixGenerator{} }
 func (*NestedMatrixGenerator) ProtoMessage() {}
 func (*NestedMatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{95}
+	return fileDescriptor_c078c3c476799f44, []int{99}
 }
 func (m *NestedMatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2732,7 +2844,7 @@ var xxx_messageInfo_NestedMatrixGenerator proto.InternalMessageInfo
 func (m *NestedMergeGenerator) Reset()      { *m = NestedMergeGenerator{} }
 func (*NestedMergeGenerator) ProtoMessage() {}
 func (*NestedMergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{96}
+	return fileDescriptor_c078c3c476799f44, []int{100}
 }
 func (m *NestedMergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2760,7 +2872,7 @@ var xxx_messageInfo_NestedMergeGenerator proto.InternalMessageInfo
 func (m *OCIMetadata) Reset()      { *m = OCIMetadata{} }
 func (*OCIMetadata) ProtoMessage() {}
 func (*OCIMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{97}
+	return fileDescriptor_c078c3c476799f44, []int{101}
 }
 func (m *OCIMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2788,7 +2900,7 @@ var xxx_messageInfo_OCIMetadata proto.InternalMessageInfo
 func (m *Operation) Reset()      { *m = Operation{} }
 func (*Operation) ProtoMessage() {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{98}
+	return fileDescriptor_c078c3c476799f44, []int{102}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2816,7 +2928,7 @@ var xxx_messageInfo_Operation proto.InternalMessageInfo
 func (m *OperationInitiator) Reset()      { *m = OperationInitiator{} }
 func (*OperationInitiator) ProtoMessage() {}
 func (*OperationInitiator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{99}
+	return fileDescriptor_c078c3c476799f44, []int{103}
 }
 func (m *OperationInitiator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2841,10 +2953,38 @@ func (m *OperationInitiator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OperationInitiator proto.InternalMessageInfo
 
+func (m *OperationRollbackStatus) Reset()      { *m = OperationRollbackStatus{} }
+func (*OperationRollbackStatus) ProtoMessage() {}
+func (*OperationRollbackStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{104}
+}
+func (m *OperationRollbackStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperationRollbackStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OperationRollbackStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationRollbackStatus.Merge(m, src)
+}
+func (m *OperationRollbackStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperationRollbackStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationRollbackStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperationRollbackStatus proto.InternalMessageInfo
+
 func (m *OperationState) Reset()      { *m = OperationState{} }
 func (*OperationState) ProtoMessage() {}
 func (*OperationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{100}
+	return fileDescriptor_c078c3c476799f44, []int{105}
 }
 func (m *OperationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2872,7 +3012,7 @@ var xxx_messageInfo_OperationState proto.InternalMessageInfo
 func (m *OptionalArray) Reset()      { *m = OptionalArray{} }
 func (*OptionalArray) ProtoMessage() {}
 func (*OptionalArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{101}
+	return fileDescriptor_c078c3c476799f44, []int{106}
 }
 func (m *OptionalArray) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2900,7 +3040,7 @@ var xxx_messageInfo_OptionalArray proto.InternalMessageInfo
 func (m *OptionalMap) Reset()      { *m = OptionalMap{} }
 func (*OptionalMap) ProtoMessage() {}
 func (*OptionalMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{102}
+	return fileDescriptor_c078c3c476799f44, []int{107}
 }
 func (m *OptionalMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2928,7 +3068,7 @@ var xxx_messageInfo_OptionalMap proto.InternalMessageInfo
 func (m *OrphanedResourceKey) Reset()      { *m = OrphanedResourceKey{} }
 func (*OrphanedResourceKey) ProtoMessage() {}
 func (*OrphanedResourceKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{103}
+	return fileDescriptor_c078c3c476799f44, []int{108}
 }
 func (m *OrphanedResourceKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2956,7 +3096,7 @@ var xxx_messageInfo_OrphanedResourceKey proto.InternalMessageInfo
 func (m *OrphanedResourcesMonitorSettings) Reset()      { *m = OrphanedResourcesMonitorSettings{} }
 func (*OrphanedResourcesMonitorSettings) ProtoMessage() {}
 func (*OrphanedResourcesMonitorSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{104}
+	return fileDescriptor_c078c3c476799f44, []int{109}
 }
 func (m *OrphanedResourcesMonitorSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2984,7 +3124,7 @@ var xxx_messageInfo_OrphanedResourcesMonitorSettings proto.InternalMessageInfo
 func (m *OverrideIgnoreDiff) Reset()      { *m = OverrideIgnoreDiff{} }
 func (*OverrideIgnoreDiff) ProtoMessage() {}
 func (*OverrideIgnoreDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{105}
+	return fileDescriptor_c078c3c476799f44, []int{110}
 }
 func (m *OverrideIgnoreDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3012,7 +3152,7 @@ var xxx_messageInfo_OverrideIgnoreDiff proto.InternalMessageInfo
 func (m *PluginConfigMapRef) Reset()      { *m = PluginConfigMapRef{} }
 func (*PluginConfigMapRef) ProtoMessage() {}
 func (*PluginConfigMapRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{106}
+	return fileDescriptor_c078c3c476799f44, []int{111}
 }
 func (m *PluginConfigMapRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3040,7 +3180,7 @@ var xxx_messageInfo_PluginConfigMapRef proto.InternalMessageInfo
 func (m *PluginGenerator) Reset()      { *m = PluginGenerator{} }
 func (*PluginGenerator) ProtoMessage() {}
 func (*PluginGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{107}
+	return fileDescriptor_c078c3c476799f44, []int{112}
 }
 func (m *PluginGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3068,7 +3208,7 @@ var xxx_messageInfo_PluginGenerator proto.InternalMessageInfo
 func (m *PluginInput) Reset()      { *m = PluginInput{} }
 func (*PluginInput) ProtoMessage() {}
 func (*PluginInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{108}
+	return fileDescriptor_c078c3c476799f44, []int{113}
 }
 func (m *PluginInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3096,7 +3236,7 @@ var xxx_messageInfo_PluginInput proto.InternalMessageInfo
 func (m *ProjectRole) Reset()      { *m = ProjectRole{} }
 func (*ProjectRole) ProtoMessage() {}
 func (*ProjectRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{109}
+	return fileDescriptor_c078c3c476799f44, []int{114}
 }
 func (m *ProjectRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3124,7 +3264,7 @@ var xxx_messageInfo_ProjectRole proto.InternalMessageInfo
 func (m *PullRequestGenerator) Reset()      { *m = PullRequestGenerator{} }
 func (*PullRequestGenerator) ProtoMessage() {}
 func (*PullRequestGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{110}
+	return fileDescriptor_c078c3c476799f44, []int{115}
 }
 func (m *PullRequestGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3152,7 +3292,7 @@ var xxx_messageInfo_PullRequestGenerator proto.InternalMessageInfo
 func (m *PullRequestGeneratorAzureDevOps) Reset()      { *m = PullRequestGeneratorAzureDevOps{} }
 func (*PullRequestGeneratorAzureDevOps) ProtoMessage() {}
 func (*PullRequestGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{111}
+	return fileDescriptor_c078c3c476799f44, []int{116}
 }
 func (m *PullRequestGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3180,7 +3320,7 @@ var xxx_messageInfo_PullRequestGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucket) Reset()      { *m = PullRequestGeneratorBitbucket{} }
 func (*PullRequestGeneratorBitbucket) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{112}
+	return fileDescriptor_c078c3c476799f44, []int{117}
 }
 func (m *PullRequestGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3208,7 +3348,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucket proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucketServer) Reset()      { *m = PullRequestGeneratorBitbucketServer{} }
 func (*PullRequestGeneratorBitbucketServer) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{113}
+	return fileDescriptor_c078c3c476799f44, []int{118}
 }
 func (m *PullRequestGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3236,7 +3376,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucketServer proto.InternalMessageInf
 func (m *PullRequestGeneratorFilter) Reset()      { *m = PullRequestGeneratorFilter{} }
 func (*PullRequestGeneratorFilter) ProtoMessage() {}
 func (*PullRequestGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{114}
+	return fileDescriptor_c078c3c476799f44, []int{119}
 }
 func (m *PullRequestGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3264,7 +3404,7 @@ var xxx_messageInfo_PullRequestGeneratorFilter proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitLab) Reset()      { *m = PullRequestGeneratorGitLab{} }
 func (*PullRequestGeneratorGitLab) ProtoMessage() {}
 func (*PullRequestGeneratorGitLab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{115}
+	return fileDescriptor_c078c3c476799f44, []int{120}
 }
 func (m *PullRequestGeneratorGitLab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3292,7 +3432,7 @@ var xxx_messageInfo_PullRequestGeneratorGitLab proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitea) Reset()      { *m = PullRequestGeneratorGitea{} }
 func (*PullRequestGeneratorGitea) ProtoMessage() {}
 func (*PullRequestGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{116}
+	return fileDescriptor_c078c3c476799f44, []int{121}
 }
 func (m *PullRequestGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3320,7 +3460,7 @@ var xxx_messageInfo_PullRequestGeneratorGitea proto.InternalMessageInfo
 func (m *PullRequestGeneratorGithub) Reset()      { *m = PullRequestGeneratorGithub{} }
 func (*PullRequestGeneratorGithub) ProtoMessage() {}
 func (*PullRequestGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{117}
+	return fileDescriptor_c078c3c476799f44, []int{122}
 }
 func (m *PullRequestGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3345,10 +3485,38 @@ func (m *PullRequestGeneratorGithub) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PullRequestGeneratorGithub proto.InternalMessageInfo
 
+func (m *QueuedOperation) Reset()      { *m = QueuedOperation{} }
+func (*QueuedOperation) ProtoMessage() {}
+func (*QueuedOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{123}
+}
+func (m *QueuedOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QueuedOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedOperation.Merge(m, src)
+}
+func (m *QueuedOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedOperation proto.InternalMessageInfo
+
 func (m *RefTarget) Reset()      { *m = RefTarget{} }
 func (*RefTarget) ProtoMessage() {}
 func (*RefTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{118}
+	return fileDescriptor_c078c3c476799f44, []int{124}
 }
 func (m *RefTarget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3376,7 +3544,7 @@ var xxx_messageInfo_RefTarget proto.InternalMessageInfo
 func (m *RepoCreds) Reset()      { *m = RepoCreds{} }
 func (*RepoCreds) ProtoMessage() {}
 func (*RepoCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{119}
+	return fileDescriptor_c078c3c476799f44, []int{125}
 }
 func (m *RepoCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3404,7 +3572,7 @@ var xxx_messageInfo_RepoCreds proto.InternalMessageInfo
 func (m *RepoCredsList) Reset()      { *m = RepoCredsList{} }
 func (*RepoCredsList) ProtoMessage() {}
 func (*RepoCredsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{120}
+	return fileDescriptor_c078c3c476799f44, []int{126}
 }
 func (m *RepoCredsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3432,7 +3600,7 @@ var xxx_messageInfo_RepoCredsList proto.InternalMessageInfo
 func (m *Repository) Reset()      { *m = Repository{} }
 func (*Repository) ProtoMessage() {}
 func (*Repository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{121}
+	return fileDescriptor_c078c3c476799f44, []int{127}
 }
 func (m *Repository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3460,7 +3628,7 @@ var xxx_messageInfo_Repository proto.InternalMessageInfo
 func (m *RepositoryCertificate) Reset()      { *m = RepositoryCertificate{} }
 func (*RepositoryCertificate) ProtoMessage() {}
 func (*RepositoryCertificate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{122}
+	return fileDescriptor_c078c3c476799f44, []int{128}
 }
 func (m *RepositoryCertificate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3488,7 +3656,7 @@ var xxx_messageInfo_RepositoryCertificate proto.InternalMessageInfo
 func (m *RepositoryCertificateList) Reset()      { *m = RepositoryCertificateList{} }
 func (*RepositoryCertificateList) ProtoMessage() {}
 func (*RepositoryCertificateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{123}
+	return fileDescriptor_c078c3c476799f44, []int{129}
 }
 func (m *RepositoryCertificateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3516,7 +3684,7 @@ var xxx_messageInfo_RepositoryCertificateList proto.InternalMessageInfo
 func (m *RepositoryList) Reset()      { *m = RepositoryList{} }
 func (*RepositoryList) ProtoMessage() {}
 func (*RepositoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{124}
+	return fileDescriptor_c078c3c476799f44, []int{130}
 }
 func (m *RepositoryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3544,7 +3712,7 @@ var xxx_messageInfo_RepositoryList proto.InternalMessageInfo
 func (m *ResourceAction) Reset()      { *m = ResourceAction{} }
 func (*ResourceAction) ProtoMessage() {}
 func (*ResourceAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{125}
+	return fileDescriptor_c078c3c476799f44, []int{131}
 }
 func (m *ResourceAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3572,7 +3740,7 @@ var xxx_messageInfo_ResourceAction proto.InternalMessageInfo
 func (m *ResourceActionDefinition) Reset()      { *m = ResourceActionDefinition{} }
 func (*ResourceActionDefinition) ProtoMessage() {}
 func (*ResourceActionDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{126}
+	return fileDescriptor_c078c3c476799f44, []int{132}
 }
 func (m *ResourceActionDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3600,7 +3768,7 @@ var xxx_messageInfo_ResourceActionDefinition proto.InternalMessageInfo
 func (m *ResourceActionParam) Reset()      { *m = ResourceActionParam{} }
 func (*ResourceActionParam) ProtoMessage() {}
 func (*ResourceActionParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{127}
+	return fileDescriptor_c078c3c476799f44, []int{133}
 }
 func (m *ResourceActionParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3628,7 +3796,7 @@ var xxx_messageInfo_ResourceActionParam proto.InternalMessageInfo
 func (m *ResourceActions) Reset()      { *m = ResourceActions{} }
 func (*ResourceActions) ProtoMessage() {}
 func (*ResourceActions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{128}
+	return fileDescriptor_c078c3c476799f44, []int{134}
 }
 func (m *ResourceActions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3656,7 +3824,7 @@ var xxx_messageInfo_ResourceActions proto.InternalMessageInfo
 func (m *ResourceDiff) Reset()      { *m = ResourceDiff{} }
 func (*ResourceDiff) ProtoMessage() {}
 func (*ResourceDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{129}
+	return fileDescriptor_c078c3c476799f44, []int{135}
 }
 func (m *ResourceDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3681,10 +3849,38 @@ func (m *ResourceDiff) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceDiff proto.InternalMessageInfo
 
+func (m *ResourceFieldOwner) Reset()      { *m = ResourceFieldOwner{} }
+func (*ResourceFieldOwner) ProtoMessage() {}
+func (*ResourceFieldOwner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{136}
+}
+func (m *ResourceFieldOwner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceFieldOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ResourceFieldOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceFieldOwner.Merge(m, src)
+}
+func (m *ResourceFieldOwner) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceFieldOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceFieldOwner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceFieldOwner proto.InternalMessageInfo
+
 func (m *ResourceIgnoreDifferences) Reset()      { *m = ResourceIgnoreDifferences{} }
 func (*ResourceIgnoreDifferences) ProtoMessage() {}
 func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{130}
+	return fileDescriptor_c078c3c476799f44, []int{137}
 }
 func (m *ResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3712,7 +3908,7 @@ var xxx_messageInfo_ResourceIgnoreDifferences proto.InternalMessageInfo
 func (m *ResourceNetworkingInfo) Reset()      { *m = ResourceNetworkingInfo{} }
 func (*ResourceNetworkingInfo) ProtoMessage() {}
 func (*ResourceNetworkingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{131}
+	return fileDescriptor_c078c3c476799f44, []int{138}
 }
 func (m *ResourceNetworkingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3740,7 +3936,7 @@ var xxx_messageInfo_ResourceNetworkingInfo proto.InternalMessageInfo
 func (m *ResourceNode) Reset()      { *m = ResourceNode{} }
 func (*ResourceNode) ProtoMessage() {}
 func (*ResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{132}
+	return fileDescriptor_c078c3c476799f44, []int{139}
 }
 func (m *ResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3768,7 +3964,7 @@ var xxx_messageInfo_ResourceNode proto.InternalMessageInfo
 func (m *ResourceOverride) Reset()      { *m = ResourceOverride{} }
 func (*ResourceOverride) ProtoMessage() {}
 func (*ResourceOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{133}
+	return fileDescriptor_c078c3c476799f44, []int{140}
 }
 func (m *ResourceOverride) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3796,7 +3992,7 @@ var xxx_messageInfo_ResourceOverride proto.InternalMessageInfo
 func (m *ResourceRef) Reset()      { *m = ResourceRef{} }
 func (*ResourceRef) ProtoMessage() {}
 func (*ResourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{134}
+	return fileDescriptor_c078c3c476799f44, []int{141}
 }
 func (m *ResourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3824,7 +4020,7 @@ var xxx_messageInfo_ResourceRef proto.InternalMessageInfo
 func (m *ResourceResult) Reset()      { *m = ResourceResult{} }
 func (*ResourceResult) ProtoMessage() {}
 func (*ResourceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{135}
+	return fileDescriptor_c078c3c476799f44, []int{142}
 }
 func (m *ResourceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3852,7 +4048,7 @@ var xxx_messageInfo_ResourceResult proto.InternalMessageInfo
 func (m *ResourceStatus) Reset()      { *m = ResourceStatus{} }
 func (*ResourceStatus) ProtoMessage() {}
 func (*ResourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{136}
+	return fileDescriptor_c078c3c476799f44, []int{143}
 }
 func (m *ResourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3880,7 +4076,7 @@ var xxx_messageInfo_ResourceStatus proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{137}
+	return fileDescriptor_c078c3c476799f44, []int{144}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3908,7 +4104,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *RevisionHistory) Reset()      { *m = RevisionHistory{} }
 func (*RevisionHistory) ProtoMessage() {}
 func (*RevisionHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{138}
+	return fileDescriptor_c078c3c476799f44, []int{145}
 }
 func (m *RevisionHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3936,7 +4132,7 @@ var xxx_messageInfo_RevisionHistory proto.InternalMessageInfo
 func (m *RevisionMetadata) Reset()      { *m = RevisionMetadata{} }
 func (*RevisionMetadata) ProtoMessage() {}
 func (*RevisionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{139}
+	return fileDescriptor_c078c3c476799f44, []int{146}
 }
 func (m *RevisionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3964,7 +4160,7 @@ var xxx_messageInfo_RevisionMetadata proto.InternalMessageInfo
 func (m *RevisionReference) Reset()      { *m = RevisionReference{} }
 func (*RevisionReference) ProtoMessage() {}
 func (*RevisionReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{140}
+	return fileDescriptor_c078c3c476799f44, []int{147}
 }
 func (m *RevisionReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3992,7 +4188,7 @@ var xxx_messageInfo_RevisionReference proto.InternalMessageInfo
 func (m *SCMProviderGenerator) Reset()      { *m = SCMProviderGenerator{} }
 func (*SCMProviderGenerator) ProtoMessage() {}
 func (*SCMProviderGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{141}
+	return fileDescriptor_c078c3c476799f44, []int{148}
 }
 func (m *SCMProviderGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4020,7 +4216,7 @@ var xxx_messageInfo_SCMProviderGenerator proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAWSCodeCommit) Reset()      { *m = SCMProviderGeneratorAWSCodeCommit{} }
 func (*SCMProviderGeneratorAWSCodeCommit) ProtoMessage() {}
 func (*SCMProviderGeneratorAWSCodeCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{142}
+	return fileDescriptor_c078c3c476799f44, []int{149}
 }
 func (m *SCMProviderGeneratorAWSCodeCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4048,7 +4244,7 @@ var xxx_messageInfo_SCMProviderGeneratorAWSCodeCommit proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAzureDevOps) Reset()      { *m = SCMProviderGeneratorAzureDevOps{} }
 func (*SCMProviderGeneratorAzureDevOps) ProtoMessage() {}
 func (*SCMProviderGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{143}
+	return fileDescriptor_c078c3c476799f44, []int{150}
 }
 func (m *SCMProviderGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4076,7 +4272,7 @@ var xxx_messageInfo_SCMProviderGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucket) Reset()      { *m = SCMProviderGeneratorBitbucket{} }
 func (*SCMProviderGeneratorBitbucket) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{144}
+	return fileDescriptor_c078c3c476799f44, []int{151}
 }
 func (m *SCMProviderGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4104,7 +4300,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucket proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucketServer) Reset()      { *m = SCMProviderGeneratorBitbucketServer{} }
 func (*SCMProviderGeneratorBitbucketServer) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{145}
+	return fileDescriptor_c078c3c476799f44, []int{152}
 }
 func (m *SCMProviderGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4132,7 +4328,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucketServer proto.InternalMessageInf
 func (m *SCMProviderGeneratorFilter) Reset()      { *m = SCMProviderGeneratorFilter{} }
 func (*SCMProviderGeneratorFilter) ProtoMessage() {}
 func (*SCMProviderGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{146}
+	return fileDescriptor_c078c3c476799f44, []int{153}
 }
 func (m *SCMProviderGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4160,7 +4356,7 @@ var xxx_messageInfo_SCMProviderGeneratorFilter proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitea) Reset()      { *m = SCMProviderGeneratorGitea{} }
 func (*SCMProviderGeneratorGitea) ProtoMessage() {}
 func (*SCMProviderGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{147}
+	return fileDescriptor_c078c3c476799f44, []int{154}
 }
 func (m *SCMProviderGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4188,7 +4384,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitea proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGithub) Reset()      { *m = SCMProviderGeneratorGithub{} }
 func (*SCMProviderGeneratorGithub) ProtoMessage() {}
 func (*SCMProviderGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{148}
+	return fileDescriptor_c078c3c476799f44, []int{155}
 }
 func (m *SCMProviderGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4216,7 +4412,7 @@ var xxx_messageInfo_SCMProviderGeneratorGithub proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitlab) Reset()      { *m = SCMProviderGeneratorGitlab{} }
 func (*SCMProviderGeneratorGitlab) ProtoMessage() {}
 func (*SCMProviderGeneratorGitlab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{149}
+	return fileDescriptor_c078c3c476799f44, []int{156}
 }
 func (m *SCMProviderGeneratorGitlab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4244,7 +4440,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitlab proto.InternalMessageInfo
 func (m *SecretRef) Reset()      { *m = SecretRef{} }
 func (*SecretRef) ProtoMessage() {}
 func (*SecretRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{150}
+	return fileDescriptor_c078c3c476799f44, []int{157}
 }
 func (m *SecretRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4272,7 +4468,7 @@ var xxx_messageInfo_SecretRef proto.InternalMessageInfo
 func (m *SignatureKey) Reset()      { *m = SignatureKey{} }
 func (*SignatureKey) ProtoMessage() {}
 func (*SignatureKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{151}
+	return fileDescriptor_c078c3c476799f44, []int{158}
 }
 func (m *SignatureKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4300,7 +4496,7 @@ var xxx_messageInfo_SignatureKey proto.InternalMessageInfo
 func (m *SourceHydrator) Reset()      { *m = SourceHydrator{} }
 func (*SourceHydrator) ProtoMessage() {}
 func (*SourceHydrator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{152}
+	return fileDescriptor_c078c3c476799f44, []int{159}
 }
 func (m *SourceHydrator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4328,7 +4524,7 @@ var xxx_messageInfo_SourceHydrator proto.InternalMessageInfo
 func (m *SourceHydratorStatus) Reset()      { *m = SourceHydratorStatus{} }
 func (*SourceHydratorStatus) ProtoMessage() {}
 func (*SourceHydratorStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{153}
+	return fileDescriptor_c078c3c476799f44, []int{160}
 }
 func (m *SourceHydratorStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4356,7 +4552,7 @@ var xxx_messageInfo_SourceHydratorStatus proto.InternalMessageInfo
 func (m *SuccessfulHydrateOperation) Reset()      { *m = SuccessfulHydrateOperation{} }
 func (*SuccessfulHydrateOperation) ProtoMessage() {}
 func (*SuccessfulHydrateOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{154}
+	return fileDescriptor_c078c3c476799f44, []int{161}
 }
 func (m *SuccessfulHydrateOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4381,10 +4577,94 @@ func (m *SuccessfulHydrateOperation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SuccessfulHydrateOperation proto.InternalMessageInfo
 
+func (m *SyncAnalysisMetric) Reset()      { *m = SyncAnalysisMetric{} }
+func (*SyncAnalysisMetric) ProtoMessage() {}
+func (*SyncAnalysisMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{162}
+}
+func (m *SyncAnalysisMetric) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncAnalysisMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncAnalysisMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncAnalysisMetric.Merge(m, src)
+}
+func (m *SyncAnalysisMetric) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncAnalysisMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncAnalysisMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncAnalysisMetric proto.InternalMessageInfo
+
+func (m *SyncAnalysisResult) Reset()      { *m = SyncAnalysisResult{} }
+func (*SyncAnalysisResult) ProtoMessage() {}
+func (*SyncAnalysisResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{163}
+}
+func (m *SyncAnalysisResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncAnalysisResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncAnalysisResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncAnalysisResult.Merge(m, src)
+}
+func (m *SyncAnalysisResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncAnalysisResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncAnalysisResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncAnalysisResult proto.InternalMessageInfo
+
+func (m *SyncAnalysisStatus) Reset()      { *m = SyncAnalysisStatus{} }
+func (*SyncAnalysisStatus) ProtoMessage() {}
+func (*SyncAnalysisStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{164}
+}
+func (m *SyncAnalysisStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncAnalysisStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncAnalysisStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncAnalysisStatus.Merge(m, src)
+}
+func (m *SyncAnalysisStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncAnalysisStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncAnalysisStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncAnalysisStatus proto.InternalMessageInfo
+
 func (m *SyncOperation) Reset()      { *m = SyncOperation{} }
 func (*SyncOperation) ProtoMessage() {}
 func (*SyncOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{155}
+	return fileDescriptor_c078c3c476799f44, []int{165}
 }
 func (m *SyncOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4412,7 +4692,7 @@ var xxx_messageInfo_SyncOperation proto.InternalMessageInfo
 func (m *SyncOperationResource) Reset()      { *m = SyncOperationResource{} }
 func (*SyncOperationResource) ProtoMessage() {}
 func (*SyncOperationResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{156}
+	return fileDescriptor_c078c3c476799f44, []int{166}
 }
 func (m *SyncOperationResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4440,7 +4720,7 @@ var xxx_messageInfo_SyncOperationResource proto.InternalMessageInfo
 func (m *SyncOperationResult) Reset()      { *m = SyncOperationResult{} }
 func (*SyncOperationResult) ProtoMessage() {}
 func (*SyncOperationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{157}
+	return fileDescriptor_c078c3c476799f44, []int{167}
 }
 func (m *SyncOperationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4465,10 +4745,38 @@ func (m *SyncOperationResult) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncOperationResult proto.InternalMessageInfo
 
+func (m *SyncPlanResource) Reset()      { *m = SyncPlanResource{} }
+func (*SyncPlanResource) ProtoMessage() {}
+func (*SyncPlanResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{168}
+}
+func (m *SyncPlanResource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncPlanResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncPlanResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncPlanResource.Merge(m, src)
+}
+func (m *SyncPlanResource) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncPlanResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncPlanResource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncPlanResource proto.InternalMessageInfo
+
 func (m *SyncPolicy) Reset()      { *m = SyncPolicy{} }
 func (*SyncPolicy) ProtoMessage() {}
 func (*SyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{158}
+	return fileDescriptor_c078c3c476799f44, []int{169}
 }
 func (m *SyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4493,10 +4801,38 @@ func (m *SyncPolicy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncPolicy proto.InternalMessageInfo
 
+func (m *SyncPolicyAnalysis) Reset()      { *m = SyncPolicyAnalysis{} }
+func (*SyncPolicyAnalysis) ProtoMessage() {}
+func (*SyncPolicyAnalysis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{170}
+}
+func (m *SyncPolicyAnalysis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncPolicyAnalysis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncPolicyAnalysis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncPolicyAnalysis.Merge(m, src)
+}
+func (m *SyncPolicyAnalysis) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncPolicyAnalysis) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncPolicyAnalysis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncPolicyAnalysis proto.InternalMessageInfo
+
 func (m *SyncPolicyAutomated) Reset()      { *m = SyncPolicyAutomated{} }
 func (*SyncPolicyAutomated) ProtoMessage() {}
 func (*SyncPolicyAutomated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{159}
+	return fileDescriptor_c078c3c476799f44, []int{171}
 }
 func (m *SyncPolicyAutomated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4521,10 +4857,94 @@ func (m *SyncPolicyAutomated) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncPolicyAutomated proto.InternalMessageInfo
 
+func (m *SyncPolicyHook) Reset()      { *m = SyncPolicyHook{} }
+func (*SyncPolicyHook) ProtoMessage() {}
+func (*SyncPolicyHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{172}
+}
+func (m *SyncPolicyHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncPolicyHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncPolicyHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncPolicyHook.Merge(m, src)
+}
+func (m *SyncPolicyHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncPolicyHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncPolicyHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncPolicyHook proto.InternalMessageInfo
+
+func (m *SyncPolicyOperationQueue) Reset()      { *m = SyncPolicyOperationQueue{} }
+func (*SyncPolicyOperationQueue) ProtoMessage() {}
+func (*SyncPolicyOperationQueue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{173}
+}
+func (m *SyncPolicyOperationQueue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncPolicyOperationQueue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncPolicyOperationQueue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncPolicyOperationQueue.Merge(m, src)
+}
+func (m *SyncPolicyOperationQueue) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncPolicyOperationQueue) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncPolicyOperationQueue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncPolicyOperationQueue proto.InternalMessageInfo
+
+func (m *SyncPolicyRollback) Reset()      { *m = SyncPolicyRollback{} }
+func (*SyncPolicyRollback) ProtoMessage() {}
+func (*SyncPolicyRollback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{174}
+}
+func (m *SyncPolicyRollback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncPolicyRollback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncPolicyRollback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncPolicyRollback.Merge(m, src)
+}
+func (m *SyncPolicyRollback) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncPolicyRollback) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncPolicyRollback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncPolicyRollback proto.InternalMessageInfo
+
 func (m *SyncSource) Reset()      { *m = SyncSource{} }
 func (*SyncSource) ProtoMessage() {}
 func (*SyncSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{160}
+	return fileDescriptor_c078c3c476799f44, []int{175}
 }
 func (m *SyncSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4552,7 +4972,7 @@ var xxx_messageInfo_SyncSource proto.InternalMessageInfo
 func (m *SyncStatus) Reset()      { *m = SyncStatus{} }
 func (*SyncStatus) ProtoMessage() {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{161}
+	return fileDescriptor_c078c3c476799f44, []int{176}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4580,7 +5000,7 @@ var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
 func (m *SyncStrategy) Reset()      { *m = SyncStrategy{} }
 func (*SyncStrategy) ProtoMessage() {}
 func (*SyncStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{162}
+	return fileDescriptor_c078c3c476799f44, []int{177}
 }
 func (m *SyncStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4608,7 +5028,7 @@ var xxx_messageInfo_SyncStrategy proto.InternalMessageInfo
 func (m *SyncStrategyApply) Reset()      { *m = SyncStrategyApply{} }
 func (*SyncStrategyApply) ProtoMessage() {}
 func (*SyncStrategyApply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{163}
+	return fileDescriptor_c078c3c476799f44, []int{178}
 }
 func (m *SyncStrategyApply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4636,7 +5056,7 @@ var xxx_messageInfo_SyncStrategyApply proto.InternalMessageInfo
 func (m *SyncStrategyHook) Reset()      { *m = SyncStrategyHook{} }
 func (*SyncStrategyHook) ProtoMessage() {}
 func (*SyncStrategyHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{164}
+	return fileDescriptor_c078c3c476799f44, []int{179}
 }
 func (m *SyncStrategyHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4661,10 +5081,66 @@ func (m *SyncStrategyHook) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncStrategyHook proto.InternalMessageInfo
 
+func (m *SyncWaveApproval) Reset()      { *m = SyncWaveApproval{} }
+func (*SyncWaveApproval) ProtoMessage() {}
+func (*SyncWaveApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{180}
+}
+func (m *SyncWaveApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWaveApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncWaveApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWaveApproval.Merge(m, src)
+}
+func (m *SyncWaveApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWaveApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWaveApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWaveApproval proto.InternalMessageInfo
+
+func (m *SyncWaveRange) Reset()      { *m = SyncWaveRange{} }
+func (*SyncWaveRange) ProtoMessage() {}
+func (*SyncWaveRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{181}
+}
+func (m *SyncWaveRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWaveRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncWaveRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWaveRange.Merge(m, src)
+}
+func (m *SyncWaveRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWaveRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWaveRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWaveRange proto.InternalMessageInfo
+
 func (m *SyncWindow) Reset()      { *m = SyncWindow{} }
 func (*SyncWindow) ProtoMessage() {}
 func (*SyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{165}
+	return fileDescriptor_c078c3c476799f44, []int{182}
 }
 func (m *SyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4692,7 +5168,7 @@ var xxx_messageInfo_SyncWindow proto.InternalMessageInfo
 func (m *TLSClientConfig) Reset()      { *m = TLSClientConfig{} }
 func (*TLSClientConfig) ProtoMessage() {}
 func (*TLSClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{166}
+	return fileDescriptor_c078c3c476799f44, []int{183}
 }
 func (m *TLSClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4720,7 +5196,7 @@ var xxx_messageInfo_TLSClientConfig proto.InternalMessageInfo
 func (m *TagFilter) Reset()      { *m = TagFilter{} }
 func (*TagFilter) ProtoMessage() {}
 func (*TagFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{167}
+	return fileDescriptor_c078c3c476799f44, []int{184}
 }
 func (m *TagFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4755,6 +5231,7 @@ func init() {
 	proto.RegisterMapType((map[string]JWTTokens)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AppProjectStatus.JwtTokensByRoleEntry")
 	proto.RegisterType((*Application)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Application")
 	proto.RegisterType((*ApplicationCondition)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationCondition")
+	proto.RegisterType((*ApplicationDependency)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationDependency")
 	proto.RegisterType((*ApplicationDestination)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationDestination")
 	proto.RegisterType((*ApplicationDestinationServiceAccount)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationDestinationServiceAccount")
 	proto.RegisterType((*ApplicationList)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationList")
@@ -4793,6 +5270,7 @@ func init() {
 	proto.RegisterType((*ApplicationSummary)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSummary")
 	proto.RegisterType((*ApplicationTree)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationTree")
 	proto.RegisterType((*ApplicationWatchEvent)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationWatchEvent")
+	proto.RegisterType((*AutoRollback)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AutoRollback")
 	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Backoff")
 	proto.RegisterType((*BasicAuthBitbucketServer)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.BasicAuthBitbucketServer")
 	proto.RegisterType((*BearerTokenBitbucket)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.BearerTokenBitbucket")
@@ -4836,6 +5314,8 @@ func init() {
 	proto.RegisterType((*HostResourceInfo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HostResourceInfo")
 	proto.RegisterType((*HydrateOperation)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydrateOperation")
 	proto.RegisterType((*HydrateTo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydrateTo")
+	proto.RegisterType((*HydrateToPullRequest)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydrateToPullRequest")
+	proto.RegisterType((*HydratorPullRequestStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydratorPullRequestStatus")
 	proto.RegisterType((*Info)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Info")
 	proto.RegisterType((*InfoItem)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.InfoItem")
 	proto.RegisterType((*JWTToken)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.JWTToken")
@@ -4861,6 +5341,7 @@ func init() {
 	proto.RegisterType((*OCIMetadata)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OCIMetadata")
 	proto.RegisterType((*Operation)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Operation")
 	proto.RegisterType((*OperationInitiator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OperationInitiator")
+	proto.RegisterType((*OperationRollbackStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OperationRollbackStatus")
 	proto.RegisterType((*OperationState)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OperationState")
 	proto.RegisterType((*OptionalArray)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OptionalArray")
 	proto.RegisterType((*OptionalMap)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OptionalMap")
@@ -4883,6 +5364,7 @@ func init() {
 	proto.RegisterType((*PullRequestGeneratorGitLab)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.PullRequestGeneratorGitLab")
 	proto.RegisterType((*PullRequestGeneratorGitea)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.PullRequestGeneratorGitea")
 	proto.RegisterType((*PullRequestGeneratorGithub)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.PullRequestGeneratorGithub")
+	proto.RegisterType((*QueuedOperation)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.QueuedOperation")
 	proto.RegisterType((*RefTarget)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.RefTarget")
 	proto.RegisterType((*RepoCreds)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.RepoCreds")
 	proto.RegisterType((*RepoCredsList)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.RepoCredsList")
@@ -4895,6 +5377,7 @@ func init() {
 	proto.RegisterType((*ResourceActionParam)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceActionParam")
 	proto.RegisterType((*ResourceActions)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceActions")
 	proto.RegisterType((*ResourceDiff)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceDiff")
+	proto.RegisterType((*ResourceFieldOwner)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceFieldOwner")
 	proto.RegisterType((*ResourceIgnoreDifferences)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceIgnoreDifferences")
 	proto.RegisterType((*ResourceNetworkingInfo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceNetworkingInfo")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceNetworkingInfo.LabelsEntry")
@@ -4923,16 +5406,26 @@ func init() {
 	proto.RegisterType((*SourceHydrator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SourceHydrator")
 	proto.RegisterType((*SourceHydratorStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SourceHydratorStatus")
 	proto.RegisterType((*SuccessfulHydrateOperation)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SuccessfulHydrateOperation")
+	proto.RegisterType((*SyncAnalysisMetric)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncAnalysisMetric")
+	proto.RegisterType((*SyncAnalysisResult)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncAnalysisResult")
+	proto.RegisterType((*SyncAnalysisStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncAnalysisStatus")
 	proto.RegisterType((*SyncOperation)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncOperation")
 	proto.RegisterType((*SyncOperationResource)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncOperationResource")
 	proto.RegisterType((*SyncOperationResult)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncOperationResult")
+	proto.RegisterType((*SyncPlanResource)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncPlanResource")
 	proto.RegisterType((*SyncPolicy)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncPolicy")
+	proto.RegisterType((*SyncPolicyAnalysis)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncPolicyAnalysis")
 	proto.RegisterType((*SyncPolicyAutomated)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncPolicyAutomated")
+	proto.RegisterType((*SyncPolicyHook)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncPolicyHook")
+	proto.RegisterType((*SyncPolicyOperationQueue)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncPolicyOperationQueue")
+	proto.RegisterType((*SyncPolicyRollback)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncPolicyRollback")
 	proto.RegisterType((*SyncSource)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncSource")
 	proto.RegisterType((*SyncStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncStatus")
 	proto.RegisterType((*SyncStrategy)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncStrategy")
 	proto.RegisterType((*SyncStrategyApply)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncStrategyApply")
 	proto.RegisterType((*SyncStrategyHook)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncStrategyHook")
+	proto.RegisterType((*SyncWaveApproval)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncWaveApproval")
+	proto.RegisterType((*SyncWaveRange)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncWaveRange")
 	proto.RegisterType((*SyncWindow)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncWindow")
 	proto.RegisterType((*TLSClientConfig)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.TLSClientConfig")
 	proto.RegisterType((*TagFilter)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.TagFilter")
@@ -4943,785 +5436,863 @@ func init() {
 }
 
 var fileDescriptor_c078c3c476799f44 = []byte{
-	// 12443 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x24, 0xdb,
-	0x55, 0x98, 0x7b, 0x46, 0x23, 0xcd, 0x1c, 0x7d, 0xec, 0xea, 0xee, 0xee, 0x7b, 0xda, 0x7d, 0x1f,
-	0x5a, 0xfa, 0xc1, 0xb3, 0x89, 0x9f, 0x25, 0xbc, 0xf6, 0x33, 0x2f, 0x3c, 0x30, 0xe8, 0x63, 0x3f,
-	0xb4, 0x2b, 0xad, 0xe4, 0x3b, 0xda, 0x5d, 0xfc, 0xf1, 0x6c, 0xb7, 0x7a, 0xae, 0x46, 0xbd, 0xea,
-	0xe9, 0x9e, 0xd7, 0xdd, 0xa3, 0xd5, 0x3c, 0x8c, 0xb1, 0xb1, 0x0d, 0x06, 0x1b, 0xfb, 0x05, 0x52,
-	0xe1, 0x91, 0xc4, 0xc4, 0x04, 0xf2, 0x51, 0x95, 0xa2, 0x20, 0xe1, 0x47, 0xa8, 0x00, 0x45, 0x05,
-	0x28, 0x0a, 0x2a, 0x24, 0x10, 0x8a, 0x00, 0x09, 0xa0, 0xd8, 0x9b, 0xa4, 0xa0, 0x52, 0x15, 0xaa,
-	0x48, 0xf2, 0x23, 0xb5, 0x49, 0x51, 0xa9, 0xfb, 0x7d, 0xbb, 0xa7, 0x47, 0x1a, 0xad, 0x5a, 0xda,
-	0x35, 0xbc, 0x7f, 0x33, 0xf7, 0x9c, 0x3e, 0xe7, 0xf6, 0xed, 0x7b, 0xcf, 0x39, 0xf7, 0xdc, 0x73,
-	0xce, 0x85, 0xe5, 0xa6, 0x97, 0x6c, 0x75, 0x36, 0x66, 0xdc, 0xb0, 0x35, 0xeb, 0x44, 0xcd, 0xb0,
-	0x1d, 0x85, 0x77, 0xd9, 0x8f, 0x77, 0xb8, 0x8d, 0xd9, 0x9d, 0x77, 0xcd, 0xb6, 0xb7, 0x9b, 0xb3,
-	0x4e, 0xdb, 0x8b, 0x67, 0x9d, 0x76, 0xdb, 0xf7, 0x5c, 0x27, 0xf1, 0xc2, 0x60, 0x76, 0xe7, 0x9d,
-	0x8e, 0xdf, 0xde, 0x72, 0xde, 0x39, 0xdb, 0x24, 0x01, 0x89, 0x9c, 0x84, 0x34, 0x66, 0xda, 0x51,
-	0x98, 0x84, 0xe8, 0x5b, 0x35, 0xb5, 0x19, 0x49, 0x8d, 0xfd, 0xf8, 0x88, 0xdb, 0x98, 0xd9, 0x79,
-	0xd7, 0x4c, 0x7b, 0xbb, 0x39, 0x43, 0xa9, 0xcd, 0x18, 0xd4, 0x66, 0x24, 0xb5, 0x0b, 0xef, 0x30,
-	0xfa, 0xd2, 0x0c, 0x9b, 0xe1, 0x2c, 0x23, 0xba, 0xd1, 0xd9, 0x64, 0xff, 0xd8, 0x1f, 0xf6, 0x8b,
-	0x33, 0xbb, 0x60, 0x6f, 0xbf, 0x14, 0xcf, 0x78, 0x21, 0xed, 0xde, 0xac, 0x1b, 0x46, 0x64, 0x76,
-	0xa7, 0xa7, 0x43, 0x17, 0xae, 0x69, 0x1c, 0xb2, 0x9b, 0x90, 0x20, 0xf6, 0xc2, 0x20, 0x7e, 0x07,
-	0xed, 0x02, 0x89, 0x76, 0x48, 0x64, 0xbe, 0x9e, 0x81, 0x90, 0x47, 0xe9, 0xdd, 0x9a, 0x52, 0xcb,
-	0x71, 0xb7, 0xbc, 0x80, 0x44, 0x5d, 0xfd, 0x78, 0x8b, 0x24, 0x4e, 0xde, 0x53, 0xb3, 0xfd, 0x9e,
-	0x8a, 0x3a, 0x41, 0xe2, 0xb5, 0x48, 0xcf, 0x03, 0xef, 0x39, 0xe8, 0x81, 0xd8, 0xdd, 0x22, 0x2d,
-	0xa7, 0xe7, 0xb9, 0x77, 0xf5, 0x7b, 0xae, 0x93, 0x78, 0xfe, 0xac, 0x17, 0x24, 0x71, 0x12, 0x65,
-	0x1f, 0xb2, 0xff, 0xbe, 0x05, 0xe3, 0x73, 0x77, 0xea, 0x73, 0x9d, 0x64, 0x6b, 0x21, 0x0c, 0x36,
-	0xbd, 0x26, 0x7a, 0x11, 0x46, 0x5d, 0xbf, 0x13, 0x27, 0x24, 0xba, 0xe9, 0xb4, 0xc8, 0x94, 0x75,
-	0xd1, 0x7a, 0x5b, 0x6d, 0xfe, 0xcc, 0x6f, 0xec, 0x4d, 0xbf, 0xe5, 0xfe, 0xde, 0xf4, 0xe8, 0x82,
-	0x06, 0x61, 0x13, 0x0f, 0x7d, 0x23, 0x8c, 0x44, 0xa1, 0x4f, 0xe6, 0xf0, 0xcd, 0xa9, 0x12, 0x7b,
-	0xe4, 0x94, 0x78, 0x64, 0x04, 0xf3, 0x66, 0x2c, 0xe1, 0x14, 0xb5, 0x1d, 0x85, 0x9b, 0x9e, 0x4f,
-	0xa6, 0xca, 0x69, 0xd4, 0x35, 0xde, 0x8c, 0x25, 0xdc, 0xfe, 0xb1, 0x12, 0x9c, 0x9a, 0x6b, 0xb7,
-	0xaf, 0x11, 0xc7, 0x4f, 0xb6, 0xea, 0x89, 0x93, 0x74, 0x62, 0xd4, 0x84, 0xe1, 0x98, 0xfd, 0x12,
-	0x7d, 0x5b, 0x15, 0x4f, 0x0f, 0x73, 0xf8, 0x83, 0xbd, 0xe9, 0x6f, 0xcb, 0x9b, 0xd1, 0x4d, 0x2f,
-	0x09, 0xdb, 0xf1, 0x3b, 0x48, 0xd0, 0xf4, 0x02, 0xc2, 0xc6, 0x65, 0x8b, 0x51, 0x9d, 0x31, 0x89,
-	0x2f, 0x84, 0x0d, 0x82, 0x05, 0x79, 0xda, 0xcf, 0x16, 0x89, 0x63, 0xa7, 0x49, 0xb2, 0xaf, 0xb4,
-	0xc2, 0x9b, 0xb1, 0x84, 0xa3, 0x08, 0x90, 0xef, 0xc4, 0xc9, 0x7a, 0xe4, 0x04, 0xb1, 0x47, 0xa7,
-	0xf4, 0xba, 0xd7, 0xe2, 0x6f, 0x37, 0x7a, 0xe9, 0x6f, 0xcc, 0xf0, 0x0f, 0x33, 0x63, 0x7e, 0x18,
-	0xbd, 0x0e, 0xe8, 0xbc, 0x99, 0xd9, 0x79, 0xe7, 0x0c, 0x7d, 0x62, 0xfe, 0x89, 0xfb, 0x7b, 0xd3,
-	0x68, 0xb9, 0x87, 0x12, 0xce, 0xa1, 0x6e, 0xff, 0x7e, 0x09, 0x60, 0xae, 0xdd, 0x5e, 0x8b, 0xc2,
-	0xbb, 0xc4, 0x4d, 0xd0, 0x47, 0xa1, 0x4a, 0x49, 0x35, 0x9c, 0xc4, 0x61, 0x03, 0x33, 0x7a, 0xe9,
-	0x9b, 0x06, 0x63, 0xbc, 0xba, 0x41, 0x9f, 0x5f, 0x21, 0x89, 0x33, 0x8f, 0xc4, 0x0b, 0x82, 0x6e,
-	0xc3, 0x8a, 0x2a, 0x0a, 0x60, 0x28, 0x6e, 0x13, 0x97, 0x0d, 0xc6, 0xe8, 0xa5, 0xe5, 0x99, 0xa3,
-	0xac, 0xf4, 0x19, 0xdd, 0xf3, 0x7a, 0x9b, 0xb8, 0xf3, 0x63, 0x82, 0xf3, 0x10, 0xfd, 0x87, 0x19,
-	0x1f, 0xb4, 0xa3, 0x3e, 0x34, 0x1f, 0xc8, 0x9b, 0x85, 0x71, 0x64, 0x54, 0xe7, 0x27, 0xd2, 0x13,
-	0x47, 0x7e, 0x77, 0xfb, 0x4f, 0x2c, 0x98, 0xd0, 0xc8, 0xcb, 0x5e, 0x9c, 0xa0, 0x0f, 0xf5, 0x0c,
-	0xee, 0xcc, 0x60, 0x83, 0x4b, 0x9f, 0x66, 0x43, 0x7b, 0x5a, 0x30, 0xab, 0xca, 0x16, 0x63, 0x60,
-	0x5b, 0x50, 0xf1, 0x12, 0xd2, 0x8a, 0xa7, 0x4a, 0x17, 0xcb, 0x6f, 0x1b, 0xbd, 0x74, 0xad, 0xa8,
-	0xf7, 0x9c, 0x1f, 0x17, 0x4c, 0x2b, 0x4b, 0x94, 0x3c, 0xe6, 0x5c, 0xec, 0xdf, 0x9a, 0x30, 0xdf,
-	0x8f, 0x0e, 0x38, 0x7a, 0x27, 0x8c, 0xc6, 0x61, 0x27, 0x72, 0x09, 0x26, 0xed, 0x90, 0x2e, 0xac,
-	0x32, 0x9d, 0xee, 0x74, 0xc1, 0xd7, 0x75, 0x33, 0x36, 0x71, 0xd0, 0x17, 0x2c, 0x18, 0x6b, 0x90,
-	0x38, 0xf1, 0x02, 0xc6, 0x5f, 0x76, 0x7e, 0xfd, 0xc8, 0x9d, 0x97, 0x8d, 0x8b, 0x9a, 0xf8, 0xfc,
-	0x59, 0xf1, 0x22, 0x63, 0x46, 0x63, 0x8c, 0x53, 0xfc, 0xa9, 0xe0, 0x6a, 0x90, 0xd8, 0x8d, 0xbc,
-	0x36, 0xfd, 0x2f, 0x44, 0x8b, 0x12, 0x5c, 0x8b, 0x1a, 0x84, 0x4d, 0x3c, 0x14, 0x40, 0x85, 0x0a,
-	0xa6, 0x78, 0x6a, 0x88, 0xf5, 0x7f, 0xe9, 0x68, 0xfd, 0x17, 0x83, 0x4a, 0x65, 0x9e, 0x1e, 0x7d,
-	0xfa, 0x2f, 0xc6, 0x9c, 0x0d, 0xfa, 0x57, 0x16, 0x4c, 0x09, 0xc1, 0x89, 0x09, 0x1f, 0xd0, 0x3b,
-	0x5b, 0x5e, 0x42, 0x7c, 0x2f, 0x4e, 0xa6, 0x2a, 0xac, 0x0f, 0x1f, 0x3a, 0x5a, 0x1f, 0x16, 0xd2,
-	0xd4, 0x31, 0x89, 0x93, 0xc8, 0x73, 0x29, 0x0e, 0x9d, 0x06, 0xf3, 0x17, 0x45, 0xb7, 0xa6, 0x16,
-	0xfa, 0xf4, 0x02, 0xf7, 0xed, 0x1f, 0xfa, 0x11, 0x0b, 0x2e, 0x04, 0x4e, 0x8b, 0xc4, 0x6d, 0x87,
-	0x11, 0x66, 0xe0, 0x79, 0xdf, 0x71, 0xb7, 0x59, 0xf7, 0x87, 0x59, 0xf7, 0x67, 0x07, 0x5b, 0x1a,
-	0x57, 0xa3, 0xb0, 0xd3, 0xbe, 0xe1, 0x05, 0x8d, 0x79, 0x5b, 0xf4, 0xe8, 0xc2, 0xcd, 0xbe, 0xa4,
-	0xf1, 0x3e, 0x6c, 0xd1, 0x4f, 0x5a, 0x30, 0x19, 0x46, 0xed, 0x2d, 0x27, 0x20, 0x0d, 0x09, 0x8d,
-	0xa7, 0x46, 0xd8, 0x3a, 0xfd, 0xf0, 0xd1, 0xc6, 0x72, 0x35, 0x4b, 0x76, 0x25, 0x0c, 0xbc, 0x24,
-	0x8c, 0xea, 0x24, 0x49, 0xbc, 0xa0, 0x19, 0xcf, 0x9f, 0xbb, 0xbf, 0x37, 0x3d, 0xd9, 0x83, 0x85,
-	0x7b, 0xfb, 0x83, 0xbe, 0x0b, 0x46, 0xe3, 0x6e, 0xe0, 0xde, 0xf1, 0x82, 0x46, 0x78, 0x2f, 0x9e,
-	0xaa, 0x16, 0xb1, 0xd6, 0xeb, 0x8a, 0xa0, 0x58, 0xad, 0x9a, 0x01, 0x36, 0xb9, 0xe5, 0x7f, 0x38,
-	0x3d, 0xef, 0x6a, 0x45, 0x7f, 0x38, 0x3d, 0x99, 0xf6, 0x61, 0x8b, 0xbe, 0xdf, 0x82, 0xf1, 0xd8,
-	0x6b, 0x06, 0x4e, 0xd2, 0x89, 0xc8, 0x0d, 0xd2, 0x8d, 0xa7, 0x80, 0x75, 0xe4, 0xfa, 0x11, 0x47,
-	0xc5, 0x20, 0x39, 0x7f, 0x4e, 0xf4, 0x71, 0xdc, 0x6c, 0x8d, 0x71, 0x9a, 0x6f, 0xde, 0xaa, 0xd4,
-	0xd3, 0x7a, 0xf4, 0x11, 0xae, 0x4a, 0xbd, 0x02, 0xfa, 0xf6, 0x0f, 0x7d, 0x07, 0x9c, 0xe6, 0x4d,
-	0xea, 0x33, 0xc4, 0x53, 0x63, 0x4c, 0x84, 0x9f, 0xbd, 0xbf, 0x37, 0x7d, 0xba, 0x9e, 0x81, 0xe1,
-	0x1e, 0x6c, 0xf4, 0x2a, 0x4c, 0xb7, 0x49, 0xd4, 0xf2, 0x92, 0xd5, 0xc0, 0xef, 0x4a, 0xc5, 0xe0,
-	0x86, 0x6d, 0xd2, 0x10, 0xdd, 0x89, 0xa7, 0xc6, 0x2f, 0x5a, 0x6f, 0xab, 0xce, 0xbf, 0x55, 0x74,
-	0x73, 0x7a, 0x6d, 0x7f, 0x74, 0x7c, 0x10, 0x3d, 0xf4, 0xeb, 0x16, 0x5c, 0x30, 0xe4, 0x77, 0x9d,
-	0x44, 0x3b, 0x9e, 0x4b, 0xe6, 0x5c, 0x37, 0xec, 0x04, 0x49, 0x3c, 0x35, 0xc1, 0xc6, 0x7c, 0xe3,
-	0x38, 0xb4, 0x49, 0x9a, 0x95, 0x9e, 0xc4, 0x7d, 0x51, 0x62, 0xbc, 0x4f, 0x4f, 0xed, 0xdf, 0x2c,
-	0xc1, 0xe9, 0xac, 0x6d, 0x81, 0xfe, 0xb1, 0x05, 0xa7, 0xee, 0xde, 0x4b, 0xd6, 0xc3, 0x6d, 0x12,
-	0xc4, 0xf3, 0x5d, 0xaa, 0x01, 0x98, 0x56, 0x1d, 0xbd, 0xe4, 0x16, 0x6b, 0xc5, 0xcc, 0x5c, 0x4f,
-	0x73, 0xb9, 0x1c, 0x24, 0x51, 0x77, 0xfe, 0x49, 0xf1, 0x4e, 0xa7, 0xae, 0xdf, 0x59, 0x37, 0xa1,
-	0x38, 0xdb, 0xa9, 0x0b, 0x9f, 0xb3, 0xe0, 0x6c, 0x1e, 0x09, 0x74, 0x1a, 0xca, 0xdb, 0xa4, 0xcb,
-	0x6d, 0x6c, 0x4c, 0x7f, 0xa2, 0x57, 0xa0, 0xb2, 0xe3, 0xf8, 0x1d, 0x22, 0x0c, 0xc0, 0xab, 0x47,
-	0x7b, 0x11, 0xd5, 0x33, 0xcc, 0xa9, 0x7e, 0x4b, 0xe9, 0x25, 0xcb, 0xfe, 0xed, 0x32, 0x8c, 0x1a,
-	0x1f, 0xed, 0x04, 0x8c, 0xda, 0x30, 0x65, 0xd4, 0xae, 0x14, 0x36, 0xdf, 0xfa, 0x5a, 0xb5, 0xf7,
-	0x32, 0x56, 0xed, 0x6a, 0x71, 0x2c, 0xf7, 0x35, 0x6b, 0x51, 0x02, 0xb5, 0xb0, 0x4d, 0x37, 0x7f,
-	0xd4, 0x3a, 0x1a, 0x2a, 0xe2, 0x13, 0xae, 0x4a, 0x72, 0xf3, 0xe3, 0xf7, 0xf7, 0xa6, 0x6b, 0xea,
-	0x2f, 0xd6, 0x8c, 0xec, 0x3f, 0xb0, 0xe0, 0xac, 0xd1, 0xc7, 0x85, 0x30, 0x68, 0xb0, 0x2d, 0x0c,
-	0xba, 0x08, 0x43, 0x49, 0xb7, 0x2d, 0x37, 0x98, 0x6a, 0xa4, 0xd6, 0xbb, 0x6d, 0x82, 0x19, 0xe4,
-	0x71, 0xdf, 0x7f, 0xfd, 0x88, 0x05, 0x4f, 0xe4, 0x0b, 0x18, 0xf4, 0x3c, 0x0c, 0x73, 0xef, 0x82,
-	0x78, 0x3b, 0xfd, 0x49, 0x58, 0x2b, 0x16, 0x50, 0x34, 0x0b, 0x35, 0xa5, 0x1d, 0xc5, 0x3b, 0x4e,
-	0x0a, 0xd4, 0x9a, 0x56, 0xa9, 0x1a, 0x87, 0x0e, 0x1a, 0xfd, 0x23, 0x8c, 0x5b, 0x35, 0x68, 0x6c,
-	0x3b, 0xce, 0x20, 0xf6, 0xef, 0x59, 0xf0, 0xf5, 0x83, 0x88, 0xbd, 0xe3, 0xeb, 0x63, 0x1d, 0xce,
-	0x35, 0xc8, 0xa6, 0xd3, 0xf1, 0x93, 0x34, 0x47, 0xd1, 0xe9, 0x67, 0xc4, 0xc3, 0xe7, 0x16, 0xf3,
-	0x90, 0x70, 0xfe, 0xb3, 0xf6, 0x7f, 0xb6, 0x98, 0x23, 0x40, 0xbe, 0xd6, 0x09, 0x6c, 0xca, 0x82,
-	0xf4, 0xa6, 0x6c, 0xa9, 0xb0, 0x65, 0xda, 0x67, 0x57, 0xf6, 0x43, 0x16, 0x5c, 0x30, 0xb0, 0x56,
-	0x9c, 0xc4, 0xdd, 0xba, 0xbc, 0xdb, 0x8e, 0x48, 0x1c, 0xd3, 0x29, 0xf5, 0x8c, 0x21, 0x8e, 0xe7,
-	0x47, 0x05, 0x85, 0xf2, 0x0d, 0xd2, 0xe5, 0xb2, 0xf9, 0x05, 0xa8, 0xf2, 0x35, 0x17, 0x46, 0xe2,
-	0x23, 0xa9, 0x77, 0x5b, 0x15, 0xed, 0x58, 0x61, 0x20, 0x1b, 0x86, 0x99, 0xcc, 0xa5, 0x32, 0x88,
-	0x9a, 0x09, 0x40, 0xbf, 0xfb, 0x6d, 0xd6, 0x82, 0x05, 0xc4, 0x8e, 0x53, 0xdd, 0x59, 0x8b, 0x08,
-	0x9b, 0x0f, 0x8d, 0x2b, 0x1e, 0xf1, 0x1b, 0x31, 0xdd, 0x30, 0x3a, 0x41, 0x10, 0x26, 0x62, 0xef,
-	0x67, 0x6c, 0x18, 0xe7, 0x74, 0x33, 0x36, 0x71, 0x28, 0x53, 0xdf, 0xd9, 0x20, 0x3e, 0x1f, 0x51,
-	0xc1, 0x74, 0x99, 0xb5, 0x60, 0x01, 0xb1, 0xef, 0x97, 0xd8, 0xd6, 0x54, 0x49, 0x34, 0x72, 0x12,
-	0x7e, 0x8d, 0x28, 0xa5, 0x02, 0xd6, 0x8a, 0x93, 0xc7, 0xa4, 0xbf, 0x6f, 0xe3, 0xb5, 0x8c, 0x16,
-	0xc0, 0x85, 0x72, 0xdd, 0xdf, 0xbf, 0xf1, 0xa5, 0x32, 0x4c, 0xa7, 0x1f, 0xe8, 0x51, 0x22, 0x74,
-	0x33, 0x6d, 0x30, 0xca, 0x7a, 0x01, 0x0d, 0x7c, 0x6c, 0xe2, 0xf5, 0x91, 0xc3, 0xa5, 0xe3, 0x94,
-	0xc3, 0xa6, 0x9a, 0x28, 0x1f, 0xa0, 0x26, 0x16, 0xd4, 0xa8, 0x0f, 0x31, 0xcc, 0xb7, 0xf7, 0xb8,
-	0x0e, 0xcf, 0xaf, 0x45, 0x61, 0x93, 0xad, 0xb9, 0x1d, 0x42, 0x37, 0x53, 0x39, 0x6e, 0xc1, 0x8b,
-	0x30, 0x14, 0x27, 0xa4, 0x3d, 0x55, 0x49, 0xcb, 0xe0, 0x7a, 0x42, 0xda, 0x98, 0x41, 0xd0, 0xb7,
-	0xc1, 0xa9, 0xc4, 0x89, 0x9a, 0x24, 0x89, 0xc8, 0x8e, 0xc7, 0xdc, 0xc9, 0x6c, 0x67, 0x5c, 0x9b,
-	0x3f, 0x43, 0x4d, 0xb2, 0x75, 0x06, 0xc2, 0x12, 0x84, 0xb3, 0xb8, 0xf6, 0x7f, 0x2f, 0xc1, 0x93,
-	0xe9, 0xef, 0xa3, 0xb5, 0xe6, 0xb7, 0xa7, 0xb4, 0xe6, 0xdb, 0x4d, 0xad, 0xf9, 0x60, 0x6f, 0xfa,
-	0xa9, 0x3e, 0x8f, 0x7d, 0xcd, 0x28, 0x55, 0x74, 0x35, 0xf3, 0x85, 0x66, 0x7b, 0xbe, 0xd0, 0x33,
-	0x7d, 0xde, 0x31, 0x63, 0xed, 0x3c, 0x0f, 0xc3, 0x11, 0x71, 0xe2, 0x30, 0x10, 0xdf, 0x49, 0x2d,
-	0x06, 0xcc, 0x5a, 0xb1, 0x80, 0xda, 0xbf, 0x5b, 0xcb, 0x0e, 0xf6, 0x55, 0xee, 0x22, 0x0f, 0x23,
-	0xe4, 0xc1, 0x10, 0xdb, 0xff, 0x71, 0xb1, 0x73, 0xe3, 0x68, 0x4b, 0x94, 0xaa, 0x18, 0x45, 0x7a,
-	0xbe, 0x4a, 0xbf, 0x1a, 0x6d, 0xc2, 0x8c, 0x05, 0xda, 0x85, 0xaa, 0x2b, 0x77, 0x5a, 0xa5, 0x22,
-	0xbc, 0x9d, 0x62, 0x9f, 0xa5, 0x39, 0x8e, 0x51, 0x5d, 0xa0, 0xb6, 0x67, 0x8a, 0x1b, 0x22, 0x50,
-	0x6e, 0x7a, 0x89, 0xf8, 0xac, 0x47, 0xdc, 0x78, 0x5f, 0xf5, 0x8c, 0x57, 0x1c, 0xa1, 0x0a, 0xea,
-	0xaa, 0x97, 0x60, 0x4a, 0x1f, 0x7d, 0xc6, 0x82, 0xd1, 0xd8, 0x6d, 0xad, 0x45, 0xe1, 0x8e, 0xd7,
-	0x20, 0x91, 0x30, 0x40, 0x8f, 0x28, 0xf6, 0xea, 0x0b, 0x2b, 0x92, 0xa0, 0xe6, 0xcb, 0x1d, 0x21,
-	0x1a, 0x82, 0x4d, 0xbe, 0x74, 0x63, 0xf6, 0xa4, 0x78, 0xf7, 0x45, 0xe2, 0xb2, 0x15, 0x27, 0x37,
-	0xd4, 0x6c, 0xa6, 0x1c, 0xd9, 0x20, 0x5f, 0xec, 0xb8, 0xdb, 0x74, 0xbd, 0xe9, 0x0e, 0x3d, 0x75,
-	0x7f, 0x6f, 0xfa, 0xc9, 0x85, 0x7c, 0x9e, 0xb8, 0x5f, 0x67, 0xd8, 0x80, 0xb5, 0x3b, 0xbe, 0x8f,
-	0xc9, 0xab, 0x1d, 0xc2, 0x7c, 0x6b, 0x05, 0x0c, 0xd8, 0x9a, 0x26, 0x98, 0x19, 0x30, 0x03, 0x82,
-	0x4d, 0xbe, 0xe8, 0x55, 0x18, 0x6e, 0x39, 0x49, 0xe4, 0xed, 0x0a, 0x87, 0xda, 0x11, 0xb7, 0x48,
-	0x2b, 0x8c, 0x96, 0x66, 0xce, 0xac, 0x00, 0xde, 0x88, 0x05, 0x23, 0xd4, 0x82, 0x4a, 0x8b, 0x44,
-	0x4d, 0x32, 0x55, 0x2d, 0xe2, 0xa4, 0x61, 0x85, 0x92, 0xd2, 0x0c, 0x6b, 0xd4, 0xf2, 0x62, 0x6d,
-	0x98, 0x73, 0x41, 0xaf, 0x40, 0x35, 0x26, 0x3e, 0x71, 0xa9, 0xed, 0x54, 0x63, 0x1c, 0xdf, 0x35,
-	0xa0, 0x1d, 0x49, 0x8d, 0x96, 0xba, 0x78, 0x94, 0x2f, 0x30, 0xf9, 0x0f, 0x2b, 0x92, 0x74, 0x00,
-	0xdb, 0x7e, 0xa7, 0xe9, 0x05, 0x53, 0x50, 0xc4, 0x00, 0xae, 0x31, 0x5a, 0x99, 0x01, 0xe4, 0x8d,
-	0x58, 0x30, 0xb2, 0xff, 0x9b, 0x05, 0x28, 0x2d, 0xd4, 0x4e, 0xc0, 0x60, 0x7e, 0x35, 0x6d, 0x30,
-	0x2f, 0x17, 0x69, 0xd1, 0xf4, 0xb1, 0x99, 0x7f, 0xa1, 0x06, 0x19, 0x75, 0x70, 0x93, 0xc4, 0x09,
-	0x69, 0xbc, 0x29, 0xc2, 0xdf, 0x14, 0xe1, 0x6f, 0x8a, 0x70, 0x25, 0xc2, 0x37, 0x32, 0x22, 0xfc,
-	0xbd, 0xc6, 0xaa, 0xd7, 0x21, 0x0f, 0x1f, 0x51, 0x31, 0x11, 0x66, 0x0f, 0x0c, 0x04, 0x2a, 0x09,
-	0xae, 0xd7, 0x57, 0x6f, 0xe6, 0xca, 0xec, 0x8f, 0xa4, 0x65, 0xf6, 0x51, 0x59, 0xfc, 0x75, 0x90,
-	0xd2, 0xbf, 0x6e, 0xc1, 0x5b, 0xd3, 0xd2, 0x4b, 0xce, 0x9c, 0xa5, 0x66, 0x10, 0x46, 0x64, 0xd1,
-	0xdb, 0xdc, 0x24, 0x11, 0x09, 0x5c, 0x12, 0x2b, 0xc7, 0x8f, 0xd5, 0xcf, 0xf1, 0x83, 0xde, 0x0d,
-	0x63, 0x77, 0xe3, 0x30, 0x58, 0x0b, 0xbd, 0x40, 0x88, 0x20, 0xba, 0xe3, 0x38, 0x7d, 0x7f, 0x6f,
-	0x7a, 0x8c, 0x8e, 0xa8, 0x6c, 0xc7, 0x29, 0x2c, 0xb4, 0x00, 0x93, 0x77, 0x5f, 0x5d, 0x73, 0x12,
-	0xc3, 0xd5, 0x20, 0x9d, 0x02, 0xec, 0x64, 0xeb, 0xfa, 0xfb, 0x32, 0x40, 0xdc, 0x8b, 0x6f, 0xff,
-	0xbd, 0x12, 0x9c, 0xcf, 0xbc, 0x48, 0xe8, 0xfb, 0x61, 0x27, 0xa1, 0x7b, 0x22, 0xf4, 0xe3, 0x16,
-	0x9c, 0x6e, 0xa5, 0xbd, 0x19, 0xb1, 0xf0, 0x85, 0x7f, 0x67, 0x61, 0x3a, 0x22, 0xe3, 0x2e, 0x99,
-	0x9f, 0x12, 0x23, 0x74, 0x3a, 0x03, 0x88, 0x71, 0x4f, 0x5f, 0xd0, 0x2b, 0x50, 0x6b, 0x39, 0xbb,
-	0xb7, 0xda, 0x0d, 0x27, 0x91, 0x7b, 0xd5, 0xfe, 0x2e, 0x86, 0x4e, 0xe2, 0xf9, 0x33, 0x3c, 0x98,
-	0x66, 0x66, 0x29, 0x48, 0x56, 0xa3, 0x7a, 0x12, 0x79, 0x41, 0x93, 0x7b, 0x40, 0x57, 0x24, 0x19,
-	0xac, 0x29, 0xda, 0x5f, 0xb2, 0xb2, 0x4a, 0x4a, 0x8d, 0x4e, 0xe4, 0x24, 0xa4, 0xd9, 0x45, 0x1f,
-	0x83, 0x0a, 0xdd, 0x37, 0xca, 0x51, 0xb9, 0x53, 0xa4, 0xe6, 0x34, 0xbe, 0x84, 0x56, 0xa2, 0xf4,
-	0x5f, 0x8c, 0x39, 0x53, 0xfb, 0xc7, 0x6b, 0x59, 0x63, 0x81, 0x85, 0x04, 0x5c, 0x02, 0x68, 0x86,
-	0xeb, 0xa4, 0xd5, 0xf6, 0xe9, 0xb0, 0x58, 0xec, 0xf4, 0x47, 0xf9, 0x51, 0xae, 0x2a, 0x08, 0x36,
-	0xb0, 0xd0, 0x0f, 0x58, 0x00, 0x4d, 0x39, 0xe7, 0xa5, 0x21, 0x70, 0xab, 0xc8, 0xd7, 0xd1, 0x2b,
-	0x4a, 0xf7, 0x45, 0x31, 0xc4, 0x06, 0x73, 0xf4, 0xbd, 0x16, 0x54, 0x13, 0xd9, 0x7d, 0xae, 0x1a,
-	0xd7, 0x8b, 0xec, 0x89, 0x7c, 0x69, 0x6d, 0x13, 0xa9, 0x21, 0x51, 0x7c, 0xd1, 0xf7, 0x59, 0x00,
-	0x71, 0x37, 0x70, 0xd7, 0x42, 0xdf, 0x73, 0xbb, 0x42, 0x63, 0xde, 0x2e, 0xd4, 0xd7, 0xa3, 0xa8,
-	0xcf, 0x4f, 0xd0, 0xd1, 0xd0, 0xff, 0xb1, 0xc1, 0x19, 0x7d, 0x1c, 0xaa, 0xb1, 0x98, 0x6e, 0x42,
-	0x47, 0xae, 0x17, 0xeb, 0x71, 0xe2, 0xb4, 0x85, 0x78, 0x15, 0xff, 0xb0, 0xe2, 0x89, 0x7e, 0xd4,
-	0x82, 0x53, 0xed, 0xb4, 0x0f, 0x51, 0xa8, 0xc3, 0xe2, 0x64, 0x40, 0xc6, 0x47, 0xc9, 0xbd, 0x2d,
-	0x99, 0x46, 0x9c, 0xed, 0x05, 0x95, 0x80, 0x7a, 0x06, 0xaf, 0xb6, 0xb9, 0x3f, 0x73, 0x44, 0x4b,
-	0xc0, 0xab, 0x59, 0x20, 0xee, 0xc5, 0x47, 0x6b, 0x70, 0x96, 0xf6, 0xae, 0xcb, 0xcd, 0x4f, 0xa9,
-	0x5e, 0x62, 0xa6, 0x0c, 0xab, 0xf3, 0x4f, 0x8b, 0x19, 0xc2, 0x0e, 0x42, 0xb2, 0x38, 0x38, 0xf7,
-	0x49, 0xf4, 0xdb, 0x16, 0x3c, 0xed, 0x31, 0x35, 0x60, 0x7a, 0xf3, 0xb5, 0x46, 0x10, 0x47, 0xf6,
-	0xa4, 0x50, 0x59, 0xd1, 0x4f, 0xfd, 0xcc, 0x7f, 0xbd, 0x78, 0x83, 0xa7, 0x97, 0xf6, 0xe9, 0x12,
-	0xde, 0xb7, 0xc3, 0xe8, 0x9b, 0x61, 0x5c, 0xae, 0x8b, 0x35, 0x2a, 0x82, 0x99, 0xa2, 0xad, 0xcd,
-	0x4f, 0xde, 0xdf, 0x9b, 0x1e, 0x5f, 0x37, 0x01, 0x38, 0x8d, 0x67, 0xff, 0xe0, 0x50, 0xea, 0x08,
-	0x49, 0x39, 0x38, 0x99, 0xb8, 0x71, 0xa5, 0xff, 0x47, 0x4a, 0xcf, 0x42, 0xc5, 0x8d, 0xf2, 0x2e,
-	0x69, 0x71, 0xa3, 0x9a, 0x62, 0x6c, 0x30, 0xa7, 0x46, 0xe9, 0xa4, 0x93, 0x75, 0xa3, 0x0a, 0x09,
-	0xf8, 0x4a, 0x91, 0x5d, 0xea, 0x3d, 0xf0, 0x3b, 0x2f, 0xba, 0x36, 0xd9, 0x03, 0xc2, 0xbd, 0x5d,
-	0x42, 0xdf, 0x0d, 0xb5, 0x48, 0xc5, 0xc8, 0x94, 0x8b, 0xd8, 0xaa, 0xc9, 0x69, 0x23, 0xba, 0xa3,
-	0x4e, 0x87, 0x74, 0x34, 0x8c, 0xe6, 0x88, 0xde, 0x0b, 0x13, 0xea, 0xcf, 0x02, 0x3b, 0x16, 0xa2,
-	0x42, 0xb1, 0x3c, 0xff, 0x84, 0x78, 0x6a, 0x02, 0xa7, 0xa0, 0x38, 0x83, 0x6d, 0x7f, 0xb6, 0x94,
-	0x3a, 0x75, 0x33, 0x64, 0xcf, 0x00, 0x27, 0x8a, 0x5f, 0xb0, 0x60, 0x34, 0x0a, 0x7d, 0xdf, 0x0b,
-	0x9a, 0x54, 0x4e, 0x0a, 0x65, 0xff, 0xc1, 0x63, 0xd1, 0xb7, 0x42, 0x20, 0x32, 0xcb, 0x1c, 0x6b,
-	0x9e, 0xd8, 0xec, 0x00, 0x7a, 0x19, 0xc6, 0x1b, 0xc4, 0x27, 0xf4, 0xd9, 0xd5, 0x88, 0xee, 0xa9,
-	0xb8, 0x07, 0x5b, 0xc5, 0xac, 0x2c, 0x9a, 0x40, 0x9c, 0xc6, 0xb5, 0xff, 0xc4, 0x82, 0xa9, 0x7e,
-	0xca, 0x00, 0x11, 0x78, 0x4a, 0x4a, 0x3a, 0x35, 0xa2, 0xab, 0x81, 0xa4, 0x27, 0xf4, 0xf9, 0x73,
-	0x82, 0xcf, 0x53, 0x6b, 0xfd, 0x51, 0xf1, 0x7e, 0x74, 0xd0, 0x07, 0xe0, 0xb4, 0x31, 0x28, 0xb1,
-	0x1a, 0xd5, 0xda, 0xfc, 0x0c, 0xb5, 0xbe, 0xe6, 0x32, 0xb0, 0x07, 0x7b, 0xd3, 0x4f, 0x64, 0xdb,
-	0x84, 0xb6, 0xea, 0xa1, 0x63, 0xff, 0x54, 0xcf, 0xa7, 0x56, 0x86, 0xc6, 0x1b, 0x56, 0x8f, 0x2b,
-	0xe3, 0x3b, 0x8f, 0x43, 0xb9, 0x33, 0xa7, 0x87, 0x0a, 0x10, 0xe9, 0x8f, 0xf3, 0x08, 0x03, 0x0a,
-	0xec, 0xdf, 0x1a, 0x82, 0x7d, 0x7a, 0x36, 0xc0, 0xce, 0xe1, 0xd0, 0x27, 0xbc, 0x9f, 0xb7, 0xd4,
-	0x51, 0x1e, 0x17, 0x20, 0x8d, 0xe3, 0x1a, 0x7b, 0xbe, 0x79, 0x8b, 0x79, 0x50, 0x8b, 0x72, 0xe1,
-	0xa7, 0x0f, 0x0d, 0xd1, 0x97, 0xad, 0xf4, 0x61, 0x24, 0x0f, 0xe4, 0xf4, 0x8e, 0xad, 0x4f, 0xc6,
-	0x09, 0x27, 0xef, 0x98, 0x3e, 0x17, 0xeb, 0x77, 0xf6, 0x39, 0x03, 0xb0, 0xe9, 0x05, 0x8e, 0xef,
-	0xbd, 0x46, 0xb7, 0x66, 0x15, 0x66, 0x5d, 0x30, 0x73, 0xed, 0x8a, 0x6a, 0xc5, 0x06, 0xc6, 0x85,
-	0xbf, 0x09, 0xa3, 0xc6, 0x9b, 0xe7, 0xc4, 0xe2, 0x9c, 0x35, 0x63, 0x71, 0x6a, 0x46, 0x08, 0xcd,
-	0x85, 0xf7, 0xc2, 0xe9, 0x6c, 0x07, 0x0f, 0xf3, 0xbc, 0xfd, 0x7f, 0x46, 0xb2, 0xa7, 0x83, 0xeb,
-	0x24, 0x6a, 0xd1, 0xae, 0xbd, 0xe9, 0x55, 0x7b, 0xd3, 0xab, 0xf6, 0xa6, 0x57, 0xcd, 0x3c, 0x18,
-	0x11, 0x1e, 0xa3, 0x91, 0x13, 0xf2, 0x18, 0xa5, 0x7c, 0x60, 0xd5, 0xc2, 0x7d, 0x60, 0xf6, 0x67,
-	0x7a, 0x8e, 0x0d, 0xd6, 0x23, 0x42, 0x50, 0x08, 0x95, 0x20, 0x6c, 0x10, 0x69, 0x60, 0x5f, 0x2f,
-	0xc6, 0x5a, 0xbc, 0x19, 0x36, 0x8c, 0x10, 0x79, 0xfa, 0x2f, 0xc6, 0x9c, 0x8f, 0xfd, 0xe9, 0x61,
-	0x48, 0xd9, 0xb2, 0xfc, 0xbb, 0x7f, 0x23, 0x8c, 0x44, 0xa4, 0x1d, 0xde, 0xc2, 0xcb, 0x42, 0x97,
-	0xe9, 0x0c, 0x23, 0xde, 0x8c, 0x25, 0x9c, 0xea, 0xbc, 0xb6, 0x93, 0x6c, 0x09, 0x65, 0xa6, 0x74,
-	0xde, 0x9a, 0x93, 0x6c, 0x61, 0x06, 0xa1, 0x66, 0x68, 0x92, 0x3a, 0x87, 0x17, 0xe7, 0xcd, 0xca,
-	0x0c, 0x4d, 0x9f, 0xd2, 0xe3, 0x0c, 0x36, 0x7a, 0x15, 0x86, 0xb6, 0x88, 0xdf, 0x12, 0x9f, 0xbe,
-	0x5e, 0x9c, 0xae, 0x61, 0xef, 0x7a, 0x8d, 0xf8, 0x2d, 0x2e, 0x09, 0xe9, 0x2f, 0xcc, 0x58, 0xd1,
-	0x79, 0x5f, 0xdb, 0xee, 0xc4, 0x49, 0xd8, 0xf2, 0x5e, 0x93, 0x6e, 0xd6, 0xef, 0x2c, 0x98, 0xf1,
-	0x0d, 0x49, 0x9f, 0xfb, 0xb3, 0xd4, 0x5f, 0xac, 0x39, 0xb3, 0x7e, 0x34, 0xbc, 0x88, 0x4d, 0x99,
-	0xae, 0xf0, 0x96, 0x16, 0xdd, 0x8f, 0x45, 0x49, 0x9f, 0xf7, 0x43, 0xfd, 0xc5, 0x9a, 0x33, 0xea,
-	0xaa, 0xf5, 0x37, 0xca, 0xfa, 0x70, 0xab, 0xe0, 0x3e, 0xf0, 0xb5, 0x97, 0xbb, 0x0e, 0x9f, 0x83,
-	0x8a, 0xbb, 0xe5, 0x44, 0xc9, 0xd4, 0x18, 0x9b, 0x34, 0x6a, 0x16, 0x2f, 0xd0, 0x46, 0xcc, 0x61,
-	0xe8, 0x19, 0x28, 0x47, 0x64, 0x93, 0xc5, 0x4d, 0x1b, 0x11, 0x5b, 0x98, 0x6c, 0x62, 0xda, 0xae,
-	0xec, 0xb2, 0x89, 0xbe, 0xa1, 0x7c, 0x3f, 0x51, 0x4a, 0x1b, 0x76, 0xe9, 0x91, 0xe1, 0xeb, 0xc1,
-	0xed, 0x44, 0xb1, 0xf4, 0xce, 0x19, 0xeb, 0x81, 0x35, 0x63, 0x09, 0x47, 0x9f, 0xb4, 0x60, 0xe4,
-	0x6e, 0x1c, 0x06, 0x01, 0x49, 0x84, 0x12, 0xbd, 0x5d, 0xf0, 0x60, 0x5d, 0xe7, 0xd4, 0x75, 0x1f,
-	0x44, 0x03, 0x96, 0x7c, 0x69, 0x77, 0xc9, 0xae, 0xeb, 0x77, 0x1a, 0x3d, 0x61, 0x3a, 0x97, 0x79,
-	0x33, 0x96, 0x70, 0x8a, 0xea, 0x05, 0x1c, 0x75, 0x28, 0x8d, 0xba, 0x14, 0x08, 0x54, 0x01, 0xb7,
-	0x7f, 0xae, 0x0a, 0xe7, 0x72, 0x97, 0x0f, 0x35, 0xb9, 0x98, 0x51, 0x73, 0xc5, 0xf3, 0x89, 0x0c,
-	0x50, 0x63, 0x26, 0xd7, 0x6d, 0xd5, 0x8a, 0x0d, 0x0c, 0xf4, 0x3d, 0x00, 0x6d, 0x27, 0x72, 0x5a,
-	0x44, 0x79, 0xcf, 0x8f, 0x6c, 0xd9, 0xd0, 0x7e, 0xac, 0x49, 0x9a, 0xda, 0x83, 0xa0, 0x9a, 0x62,
-	0x6c, 0xb0, 0x44, 0x2f, 0xc2, 0x68, 0x44, 0x7c, 0xe2, 0xc4, 0x2c, 0x30, 0x3f, 0x9b, 0xbf, 0x84,
-	0x35, 0x08, 0x9b, 0x78, 0xe8, 0x79, 0x15, 0xcb, 0x37, 0x94, 0x0e, 0x74, 0x49, 0xc7, 0xf3, 0xa1,
-	0x2f, 0x5a, 0x30, 0xb1, 0xe9, 0xf9, 0x44, 0x73, 0x17, 0xd9, 0x46, 0xab, 0x47, 0x7f, 0xc9, 0x2b,
-	0x26, 0x5d, 0x2d, 0x43, 0x53, 0xcd, 0x31, 0xce, 0xb0, 0xa7, 0x9f, 0x79, 0x87, 0x44, 0x4c, 0xf8,
-	0x0e, 0xa7, 0x3f, 0xf3, 0x6d, 0xde, 0x8c, 0x25, 0x1c, 0xcd, 0xc1, 0xa9, 0xb6, 0x13, 0xc7, 0x0b,
-	0x11, 0x69, 0x90, 0x20, 0xf1, 0x1c, 0x9f, 0xa7, 0xf7, 0x54, 0x75, 0xa0, 0xfb, 0x5a, 0x1a, 0x8c,
-	0xb3, 0xf8, 0xe8, 0xfd, 0xf0, 0x24, 0x77, 0x4f, 0xad, 0x78, 0x71, 0xec, 0x05, 0x4d, 0x3d, 0x0d,
-	0x84, 0x97, 0x6e, 0x5a, 0x90, 0x7a, 0x72, 0x29, 0x1f, 0x0d, 0xf7, 0x7b, 0x1e, 0xbd, 0x00, 0xd5,
-	0x78, 0xdb, 0x6b, 0x2f, 0x44, 0x8d, 0x98, 0x1d, 0x4d, 0x55, 0xb5, 0x4f, 0xb8, 0x2e, 0xda, 0xb1,
-	0xc2, 0x40, 0x2e, 0x8c, 0xf1, 0x4f, 0xc2, 0x83, 0x11, 0x85, 0x04, 0x7d, 0x47, 0x5f, 0x45, 0x2e,
-	0xd2, 0x7e, 0x67, 0xb0, 0x73, 0xef, 0xb2, 0x3c, 0x28, 0xe3, 0xe7, 0x3a, 0xb7, 0x0d, 0x32, 0x38,
-	0x45, 0x34, 0xbd, 0xa7, 0x1b, 0x1d, 0x60, 0x4f, 0xf7, 0x22, 0x8c, 0x6e, 0x77, 0x36, 0x88, 0x18,
-	0x79, 0x21, 0xd8, 0xd4, 0xec, 0xbb, 0xa1, 0x41, 0xd8, 0xc4, 0x63, 0x71, 0xa0, 0x6d, 0x4f, 0xfc,
-	0x8b, 0xa7, 0xc6, 0x8d, 0x38, 0xd0, 0xb5, 0x25, 0xd9, 0x8c, 0x4d, 0x1c, 0xda, 0x35, 0x3a, 0x16,
-	0xeb, 0x24, 0x66, 0x69, 0x1e, 0x74, 0xb8, 0x54, 0xd7, 0xea, 0x12, 0x80, 0x35, 0x0e, 0x5a, 0x83,
-	0xb3, 0xf4, 0x4f, 0x9d, 0xa5, 0x3d, 0xdf, 0x76, 0x7c, 0xaf, 0xc1, 0x83, 0x12, 0x4f, 0xa5, 0x9d,
-	0xab, 0xf5, 0x1c, 0x1c, 0x9c, 0xfb, 0xa4, 0xfd, 0x63, 0xa5, 0xb4, 0xe7, 0xc4, 0x14, 0x61, 0x28,
-	0xa6, 0x82, 0x2a, 0xb9, 0xed, 0x44, 0xd2, 0xe0, 0x39, 0x62, 0x8e, 0x96, 0xa0, 0x7b, 0xdb, 0x89,
-	0x4c, 0x91, 0xc7, 0x18, 0x60, 0xc9, 0x09, 0xdd, 0x85, 0xa1, 0xc4, 0x77, 0x0a, 0xca, 0x00, 0x35,
-	0x38, 0x6a, 0x2f, 0xd8, 0xf2, 0x5c, 0x8c, 0x19, 0x0f, 0xf4, 0x34, 0xdd, 0xbd, 0x6d, 0xc8, 0x63,
-	0x3e, 0xb1, 0xe1, 0xda, 0x88, 0x31, 0x6b, 0xb5, 0xff, 0xf6, 0x78, 0x8e, 0xd6, 0x51, 0x86, 0x00,
-	0xba, 0x04, 0x40, 0x27, 0xcd, 0x5a, 0x44, 0x36, 0xbd, 0x5d, 0x61, 0x88, 0x29, 0xc9, 0x76, 0x53,
-	0x41, 0xb0, 0x81, 0x25, 0x9f, 0xa9, 0x77, 0x36, 0xe9, 0x33, 0xa5, 0xde, 0x67, 0x38, 0x04, 0x1b,
-	0x58, 0xe8, 0xdd, 0x30, 0xec, 0xb5, 0x9c, 0xa6, 0x0a, 0x51, 0x7e, 0x9a, 0x8a, 0xb4, 0x25, 0xd6,
-	0xf2, 0x60, 0x6f, 0x7a, 0x42, 0x75, 0x88, 0x35, 0x61, 0x81, 0x8b, 0x7e, 0xca, 0x82, 0x31, 0x37,
-	0x6c, 0xb5, 0xc2, 0x80, 0x6f, 0x9f, 0x85, 0x2f, 0xe0, 0xee, 0x71, 0x99, 0x49, 0x33, 0x0b, 0x06,
-	0x33, 0xee, 0x0c, 0x50, 0xa9, 0xaa, 0x26, 0x08, 0xa7, 0x7a, 0x65, 0x4a, 0xbe, 0xca, 0x01, 0x92,
-	0xef, 0xe7, 0x2d, 0x98, 0xe4, 0xcf, 0x1a, 0xbb, 0x7a, 0x91, 0x68, 0x19, 0x1e, 0xf3, 0x6b, 0xf5,
-	0x38, 0x3a, 0x94, 0xa7, 0xb9, 0x07, 0x8e, 0x7b, 0x3b, 0x89, 0xae, 0xc2, 0xe4, 0x66, 0x18, 0xb9,
-	0xc4, 0x1c, 0x08, 0x21, 0xb6, 0x15, 0xa1, 0x2b, 0x59, 0x04, 0xdc, 0xfb, 0x0c, 0xba, 0x0d, 0x4f,
-	0x18, 0x8d, 0xe6, 0x38, 0x70, 0xc9, 0xfd, 0xac, 0xa0, 0xf6, 0xc4, 0x95, 0x5c, 0x2c, 0xdc, 0xe7,
-	0xe9, 0xb4, 0x90, 0xac, 0x0d, 0x20, 0x24, 0x3f, 0x02, 0xe7, 0xdd, 0xde, 0x91, 0xd9, 0x89, 0x3b,
-	0x1b, 0x31, 0x97, 0xe3, 0xd5, 0xf9, 0xaf, 0x13, 0x04, 0xce, 0x2f, 0xf4, 0x43, 0xc4, 0xfd, 0x69,
-	0xa0, 0x8f, 0x41, 0x35, 0x22, 0xec, 0xab, 0xc4, 0x22, 0xeb, 0xf0, 0x88, 0xde, 0x0e, 0x6d, 0xc1,
-	0x73, 0xb2, 0x5a, 0x33, 0x89, 0x86, 0x18, 0x2b, 0x8e, 0xe8, 0x1e, 0x8c, 0xb4, 0x9d, 0xc4, 0xdd,
-	0x12, 0xe9, 0x83, 0x47, 0x3e, 0x18, 0x50, 0xcc, 0xd9, 0x39, 0x8e, 0x51, 0xe6, 0x81, 0x33, 0xc1,
-	0x92, 0x1b, 0xb5, 0xd5, 0xdc, 0xb0, 0xd5, 0x0e, 0x03, 0x12, 0x24, 0x52, 0x89, 0x4c, 0xf0, 0xc3,
-	0x16, 0xd9, 0x8a, 0x0d, 0x8c, 0x1e, 0x5d, 0xae, 0xd1, 0xa6, 0x26, 0xf7, 0xd1, 0xe5, 0x06, 0xb5,
-	0x7e, 0xcf, 0x53, 0x65, 0xc3, 0xdc, 0x8a, 0x77, 0xbc, 0x64, 0x2b, 0xec, 0x24, 0x72, 0x97, 0x2c,
-	0x14, 0x95, 0x52, 0x36, 0xcb, 0x39, 0x38, 0x38, 0xf7, 0xc9, 0xac, 0x66, 0x3d, 0xf5, 0x70, 0x9a,
-	0xf5, 0xf4, 0x00, 0x9a, 0xb5, 0x0e, 0xe7, 0x58, 0x0f, 0x84, 0x95, 0x2c, 0x9d, 0x96, 0xf1, 0x14,
-	0x62, 0x9d, 0x57, 0x99, 0x37, 0xcb, 0x79, 0x48, 0x38, 0xff, 0xd9, 0x0b, 0xdf, 0x0e, 0x93, 0x3d,
-	0x42, 0xee, 0x50, 0x0e, 0xc9, 0x45, 0x78, 0x22, 0x5f, 0x9c, 0x1c, 0xca, 0x2d, 0xf9, 0x73, 0x99,
-	0xa0, 0x78, 0x63, 0x8b, 0x36, 0x80, 0x8b, 0xdb, 0x81, 0x32, 0x09, 0x76, 0x84, 0x76, 0xbd, 0x72,
-	0xb4, 0x59, 0x7d, 0x39, 0xd8, 0xe1, 0xd2, 0x90, 0xf9, 0xf1, 0x2e, 0x07, 0x3b, 0x98, 0xd2, 0x46,
-	0x3f, 0x6c, 0xa5, 0x36, 0x10, 0xdc, 0x31, 0xfe, 0xe1, 0x63, 0xd9, 0x93, 0x0e, 0xbc, 0xa7, 0xb0,
-	0xff, 0x6d, 0x09, 0x2e, 0x1e, 0x44, 0x64, 0x80, 0xe1, 0x7b, 0x0e, 0x86, 0x63, 0x16, 0xe6, 0x22,
-	0xd4, 0xd5, 0x28, 0x5d, 0xc5, 0x3c, 0xf0, 0xe5, 0x23, 0x58, 0x80, 0x90, 0x0f, 0xe5, 0x96, 0xd3,
-	0x16, 0xfe, 0xd2, 0xa5, 0xa3, 0x66, 0x16, 0xd2, 0xff, 0x8e, 0xbf, 0xe2, 0xb4, 0xf9, 0x9c, 0x37,
-	0x1a, 0x30, 0x65, 0x83, 0x12, 0xa8, 0x38, 0x51, 0xe4, 0xc8, 0x98, 0x8a, 0x1b, 0xc5, 0xf0, 0x9b,
-	0xa3, 0x24, 0xf9, 0x91, 0x74, 0xaa, 0x09, 0x73, 0x66, 0xf6, 0x8f, 0x56, 0x53, 0x69, 0x68, 0x2c,
-	0x50, 0x26, 0x86, 0x61, 0xe1, 0x26, 0xb5, 0x8a, 0x4e, 0xe8, 0xe4, 0x79, 0xde, 0xcc, 0x03, 0x21,
-	0xea, 0x70, 0x08, 0x56, 0xe8, 0x73, 0x16, 0xab, 0x76, 0x21, 0x73, 0xfb, 0xc4, 0xae, 0xfe, 0x78,
-	0x8a, 0x6f, 0x98, 0x35, 0x34, 0x64, 0x23, 0x36, 0xb9, 0x8b, 0x8a, 0x3e, 0x6c, 0x37, 0xd3, 0x5b,
-	0xd1, 0x87, 0xed, 0x4e, 0x24, 0x1c, 0xed, 0xe6, 0x04, 0xc4, 0x14, 0x50, 0x04, 0x61, 0x80, 0x10,
-	0x98, 0x2f, 0x5b, 0x30, 0xe9, 0x65, 0x23, 0x1b, 0xc4, 0x1e, 0xf8, 0x4e, 0x31, 0x3e, 0xcd, 0xde,
-	0xc0, 0x09, 0x65, 0xe8, 0xf4, 0x80, 0x70, 0x6f, 0x67, 0x50, 0x03, 0x86, 0xbc, 0x60, 0x33, 0x14,
-	0xe6, 0xdd, 0xfc, 0xd1, 0x3a, 0xb5, 0x14, 0x6c, 0x86, 0x7a, 0x35, 0xd3, 0x7f, 0x98, 0x51, 0x47,
-	0xcb, 0x70, 0x56, 0x26, 0x1b, 0x5d, 0xf3, 0xe2, 0x24, 0x8c, 0xba, 0xcb, 0x5e, 0xcb, 0x4b, 0x98,
-	0x69, 0x56, 0x9e, 0x9f, 0xa2, 0xea, 0x0d, 0xe7, 0xc0, 0x71, 0xee, 0x53, 0xe8, 0x35, 0x18, 0x91,
-	0xd1, 0x04, 0xd5, 0x22, 0xfc, 0x09, 0xbd, 0xf3, 0x5f, 0x4d, 0xa6, 0xba, 0x08, 0x27, 0x90, 0x0c,
-	0xd1, 0x67, 0x2d, 0x98, 0xe0, 0xbf, 0xaf, 0x75, 0x1b, 0x3c, 0xf9, 0xb1, 0x56, 0x44, 0xca, 0x40,
-	0x3d, 0x45, 0x73, 0x1e, 0xdd, 0xdf, 0x9b, 0x9e, 0x48, 0xb7, 0xe1, 0x0c, 0x5f, 0xfb, 0x9f, 0x8c,
-	0x41, 0x6f, 0xfc, 0x45, 0x3a, 0xd8, 0xc2, 0x3a, 0xf1, 0x60, 0x8b, 0xbb, 0x30, 0x14, 0xeb, 0x38,
-	0x87, 0x02, 0x96, 0x99, 0xe0, 0xaa, 0x8f, 0xa1, 0xbb, 0x81, 0x8b, 0x19, 0x0f, 0xd4, 0x81, 0x61,
-	0x5e, 0x50, 0x4b, 0x68, 0x80, 0xa3, 0x9f, 0x7c, 0x9b, 0x85, 0xb9, 0xb4, 0x5b, 0x8b, 0xb7, 0x62,
-	0xc1, 0x0c, 0xed, 0xc2, 0xc8, 0x16, 0x9f, 0x8e, 0x62, 0xaf, 0xb7, 0x72, 0xd4, 0xf1, 0x4d, 0xcd,
-	0x71, 0x3d, 0xf9, 0x44, 0x03, 0x96, 0xec, 0x58, 0x6c, 0x9f, 0x11, 0x7d, 0xc4, 0x05, 0x49, 0x71,
-	0x79, 0x9c, 0x83, 0x87, 0x1e, 0x7d, 0x14, 0xc6, 0x22, 0xe2, 0x86, 0x81, 0xeb, 0xf9, 0xa4, 0x31,
-	0x27, 0x0f, 0xc4, 0x0e, 0x93, 0xa1, 0xc7, 0xbc, 0x49, 0xd8, 0xa0, 0x81, 0x53, 0x14, 0xd9, 0x3a,
-	0x53, 0x29, 0xfd, 0xf4, 0x83, 0x10, 0x71, 0xf0, 0xb1, 0x5c, 0x50, 0x01, 0x01, 0x46, 0x93, 0xaf,
-	0xb3, 0x74, 0x1b, 0xce, 0xf0, 0x45, 0x1f, 0x00, 0x08, 0x37, 0x78, 0x00, 0xdf, 0x5c, 0x22, 0x4e,
-	0x41, 0x0e, 0xf3, 0xaa, 0x13, 0x3c, 0x0d, 0x58, 0x52, 0xc0, 0x06, 0x35, 0x74, 0x03, 0x80, 0xaf,
-	0x9c, 0xf5, 0x6e, 0x5b, 0x6e, 0x08, 0x65, 0x8a, 0x25, 0xd4, 0x15, 0xe4, 0xc1, 0xde, 0x74, 0xaf,
-	0xcf, 0x99, 0x45, 0x19, 0x19, 0x8f, 0xa3, 0xef, 0x82, 0x91, 0xb8, 0xd3, 0x6a, 0x39, 0xea, 0x8c,
-	0xa4, 0xc0, 0xc4, 0x62, 0x4e, 0xd7, 0x10, 0x8c, 0xbc, 0x01, 0x4b, 0x8e, 0xe8, 0x2e, 0x15, 0xf1,
-	0x42, 0x42, 0xf1, 0x55, 0xc4, 0x2d, 0x14, 0xee, 0x09, 0x7c, 0x8f, 0xdc, 0xc5, 0xe0, 0x1c, 0x9c,
-	0x07, 0x7b, 0xd3, 0x4f, 0xa4, 0xdb, 0x97, 0x43, 0x91, 0xea, 0x9b, 0x4b, 0x13, 0x5d, 0x97, 0xb5,
-	0xc3, 0xe8, 0x6b, 0xcb, 0xc2, 0x33, 0x6f, 0xd3, 0xb5, 0xc3, 0x58, 0x73, 0xff, 0x31, 0x33, 0x1f,
-	0x46, 0x2b, 0x70, 0xc6, 0x0d, 0x83, 0x24, 0x0a, 0x7d, 0x9f, 0xd7, 0x15, 0xe4, 0x7b, 0x73, 0x7e,
-	0x86, 0xf2, 0x94, 0xe8, 0xf6, 0x99, 0x85, 0x5e, 0x14, 0x9c, 0xf7, 0x1c, 0xb5, 0xc9, 0xb3, 0xfa,
-	0x61, 0xa2, 0x90, 0xe3, 0xf5, 0x14, 0x4d, 0x21, 0xa1, 0x94, 0xdb, 0xfb, 0x00, 0x4d, 0x11, 0xa4,
-	0x0f, 0x59, 0xc5, 0x17, 0x7b, 0x37, 0x8c, 0x91, 0xdd, 0x84, 0x44, 0x81, 0xe3, 0xdf, 0xc2, 0xcb,
-	0xf2, 0xc0, 0x82, 0x2d, 0xcc, 0xcb, 0x46, 0x3b, 0x4e, 0x61, 0x21, 0x5b, 0x79, 0xc9, 0x8c, 0x9c,
-	0x7a, 0xee, 0x25, 0x93, 0x3e, 0x31, 0xfb, 0x67, 0xcb, 0x29, 0x9b, 0xf5, 0x91, 0x1c, 0xe9, 0xb2,
-	0x4a, 0x4f, 0xb2, 0x24, 0x16, 0x03, 0x88, 0xbd, 0x58, 0x91, 0x9c, 0x55, 0xd4, 0xdc, 0xaa, 0xc9,
-	0x08, 0xa7, 0xf9, 0xa2, 0x6d, 0xa8, 0x6c, 0x85, 0x71, 0x22, 0x77, 0x68, 0x47, 0xdc, 0x0c, 0x5e,
-	0x0b, 0xe3, 0x84, 0x19, 0x5a, 0xea, 0xb5, 0x69, 0x4b, 0x8c, 0x39, 0x0f, 0xba, 0xf7, 0x8f, 0xb7,
-	0x9c, 0xa8, 0x91, 0x0a, 0x75, 0x54, 0xf6, 0x74, 0x5d, 0x83, 0xb0, 0x89, 0x67, 0xff, 0xa9, 0x95,
-	0x3a, 0xd5, 0xba, 0xc3, 0x32, 0x16, 0x76, 0x48, 0x40, 0x45, 0x94, 0x19, 0xe3, 0xf8, 0xcd, 0x99,
-	0xfc, 0xef, 0xb7, 0xf6, 0x2b, 0x01, 0x7a, 0x8f, 0x52, 0x98, 0x61, 0x24, 0x8c, 0x70, 0xc8, 0x4f,
-	0x58, 0xe9, 0x2c, 0xff, 0x52, 0x11, 0x5b, 0x37, 0xb3, 0xd2, 0xc5, 0x81, 0x05, 0x03, 0xec, 0x1f,
-	0xb6, 0x60, 0x64, 0xde, 0x71, 0xb7, 0xc3, 0xcd, 0x4d, 0xf4, 0x02, 0x54, 0x1b, 0x9d, 0xc8, 0x2c,
-	0x38, 0xa0, 0x9c, 0x55, 0x8b, 0xa2, 0x1d, 0x2b, 0x0c, 0x3a, 0xf5, 0x37, 0x1d, 0x57, 0xd6, 0xbb,
-	0x28, 0xf3, 0xa9, 0x7f, 0x85, 0xb5, 0x60, 0x01, 0xa1, 0xc3, 0xdf, 0x72, 0x76, 0xe5, 0xc3, 0xd9,
-	0x23, 0xb5, 0x15, 0x0d, 0xc2, 0x26, 0x9e, 0xfd, 0x6b, 0x16, 0x4c, 0xcd, 0x3b, 0xb1, 0xe7, 0xce,
-	0x75, 0x92, 0xad, 0x79, 0x2f, 0xd9, 0xe8, 0xb8, 0xdb, 0x24, 0xe1, 0x75, 0x51, 0x68, 0x2f, 0x3b,
-	0x31, 0x5d, 0x81, 0x6a, 0xc7, 0xac, 0x7a, 0x79, 0x4b, 0xb4, 0x63, 0x85, 0x81, 0x5e, 0x83, 0xd1,
-	0xb6, 0x13, 0xc7, 0xf7, 0xc2, 0xa8, 0x81, 0xc9, 0x66, 0x31, 0x95, 0x93, 0xea, 0xc4, 0x8d, 0x48,
-	0x82, 0xc9, 0xa6, 0x08, 0x50, 0xd1, 0xf4, 0xb1, 0xc9, 0xcc, 0xfe, 0x01, 0x0b, 0xce, 0xce, 0x13,
-	0x27, 0x22, 0x11, 0x2b, 0xb4, 0xa4, 0x5e, 0x04, 0xbd, 0x0a, 0xd5, 0x84, 0xb6, 0xd0, 0x1e, 0x59,
-	0xc5, 0xf6, 0x88, 0x85, 0x96, 0xac, 0x0b, 0xe2, 0x58, 0xb1, 0xb1, 0xbf, 0x60, 0xc1, 0xf9, 0xbc,
-	0xbe, 0x2c, 0xf8, 0x61, 0xa7, 0xf1, 0x28, 0x3a, 0xf4, 0x77, 0x2d, 0x18, 0x63, 0xc7, 0xf5, 0x8b,
-	0x24, 0x71, 0x3c, 0xbf, 0xa7, 0x7c, 0xa4, 0x35, 0x60, 0xf9, 0xc8, 0x8b, 0x30, 0xb4, 0x15, 0xb6,
-	0x48, 0x36, 0xd4, 0xe4, 0x5a, 0xd8, 0x22, 0x98, 0x41, 0xd0, 0x3b, 0xe9, 0x24, 0xf4, 0x82, 0xc4,
-	0xa1, 0xcb, 0x51, 0x1e, 0x67, 0x9c, 0xe2, 0x13, 0x50, 0x35, 0x63, 0x13, 0xc7, 0xfe, 0xd7, 0x35,
-	0x18, 0x11, 0x71, 0x51, 0x03, 0xd7, 0xe9, 0x91, 0x5e, 0x9c, 0x52, 0x5f, 0x2f, 0x4e, 0x0c, 0xc3,
-	0x2e, 0xab, 0xf1, 0x2b, 0x2c, 0xf4, 0x1b, 0x85, 0x04, 0xd2, 0xf1, 0xb2, 0xc1, 0xba, 0x5b, 0xfc,
-	0x3f, 0x16, 0xac, 0xd0, 0xeb, 0x16, 0x9c, 0x72, 0xc3, 0x20, 0x20, 0xae, 0xb6, 0x1d, 0x87, 0x8a,
-	0xd8, 0x20, 0x2c, 0xa4, 0x89, 0xea, 0x93, 0xe0, 0x0c, 0x00, 0x67, 0xd9, 0xa3, 0x97, 0x61, 0x9c,
-	0x8f, 0xd9, 0xed, 0xd4, 0x19, 0x8c, 0x2e, 0x14, 0x68, 0x02, 0x71, 0x1a, 0x17, 0xcd, 0xf0, 0xb3,
-	0x2c, 0x51, 0x65, 0x6f, 0x58, 0xbb, 0xaa, 0x8d, 0xfa, 0x7a, 0x06, 0x06, 0x8a, 0x00, 0x45, 0x64,
-	0x33, 0x22, 0xf1, 0x96, 0x88, 0x1b, 0x63, 0x76, 0xeb, 0xc8, 0xc3, 0x15, 0xd1, 0xc0, 0x3d, 0x94,
-	0x70, 0x0e, 0x75, 0xb4, 0x2d, 0xdc, 0x08, 0xd5, 0x22, 0xe4, 0xb9, 0xf8, 0xcc, 0x7d, 0xbd, 0x09,
-	0xd3, 0x50, 0x61, 0xaa, 0x8b, 0xd9, 0xcb, 0x65, 0x9e, 0xb8, 0xc9, 0x14, 0x1b, 0xe6, 0xed, 0x68,
-	0x11, 0x4e, 0x67, 0x2a, 0x17, 0xc6, 0xe2, 0xac, 0x44, 0x25, 0xe9, 0x65, 0x6a, 0x1e, 0xc6, 0xb8,
-	0xe7, 0x09, 0xd3, 0xc5, 0x34, 0x7a, 0x80, 0x8b, 0xa9, 0xab, 0xa2, 0x93, 0xf9, 0x29, 0xc6, 0xfb,
-	0x0a, 0x19, 0x80, 0x81, 0x42, 0x91, 0x7f, 0x28, 0x13, 0x8a, 0x3c, 0xce, 0x3a, 0x70, 0xbb, 0x98,
-	0x0e, 0x1c, 0x3e, 0xee, 0xf8, 0x51, 0xc6, 0x11, 0xff, 0x6f, 0x0b, 0xe4, 0x77, 0x5d, 0x70, 0xdc,
-	0x2d, 0x42, 0xa7, 0x4c, 0x4e, 0xf6, 0x87, 0x75, 0x98, 0xec, 0x0f, 0x34, 0x0b, 0x35, 0x3a, 0x4e,
-	0xfc, 0x51, 0xae, 0xf7, 0x95, 0x07, 0x64, 0x6e, 0x6d, 0x49, 0x3c, 0xa5, 0x71, 0x50, 0x08, 0x93,
-	0xbe, 0x13, 0x27, 0xac, 0x07, 0xf5, 0x6e, 0xe0, 0x3e, 0x64, 0x09, 0x1b, 0x96, 0x09, 0xb6, 0x9c,
-	0x25, 0x84, 0x7b, 0x69, 0xdb, 0xff, 0xbe, 0x02, 0xe3, 0x29, 0xc9, 0x78, 0x48, 0x83, 0xe1, 0x05,
-	0xa8, 0x4a, 0x1d, 0x9e, 0x2d, 0xe4, 0xa5, 0x14, 0xbd, 0xc2, 0xa0, 0x4a, 0x6b, 0x43, 0x6b, 0xd5,
-	0xac, 0x81, 0x63, 0x28, 0x5c, 0x6c, 0xe2, 0x31, 0xa1, 0x9c, 0xf8, 0xf1, 0x82, 0xef, 0x91, 0x20,
-	0xe1, 0xdd, 0x2c, 0x46, 0x28, 0xaf, 0x2f, 0xd7, 0x4d, 0xa2, 0x5a, 0x28, 0x67, 0x00, 0x38, 0xcb,
-	0x1e, 0x7d, 0xda, 0x82, 0x71, 0xe7, 0x5e, 0xac, 0x0b, 0xd1, 0x8b, 0xa0, 0xe3, 0x23, 0x2a, 0xa9,
-	0x54, 0x6d, 0x7b, 0xee, 0xd8, 0x4f, 0x35, 0xe1, 0x34, 0x53, 0xf4, 0x86, 0x05, 0x88, 0xec, 0x12,
-	0x57, 0x86, 0x45, 0x8b, 0xbe, 0x0c, 0x17, 0xb1, 0x83, 0xbf, 0xdc, 0x43, 0x97, 0x4b, 0xf5, 0xde,
-	0x76, 0x9c, 0xd3, 0x07, 0x74, 0x1d, 0x50, 0xc3, 0x8b, 0x9d, 0x0d, 0x9f, 0x2c, 0x84, 0x2d, 0x99,
-	0xbd, 0x2c, 0xce, 0xd3, 0x2f, 0x88, 0x71, 0x46, 0x8b, 0x3d, 0x18, 0x38, 0xe7, 0x29, 0x36, 0xcb,
-	0xa2, 0x70, 0xb7, 0x7b, 0x2b, 0xf2, 0x99, 0x96, 0x30, 0x67, 0x99, 0x68, 0xc7, 0x0a, 0xc3, 0xfe,
-	0xb3, 0xb2, 0x5a, 0xca, 0x3a, 0x07, 0xc0, 0x31, 0x62, 0x91, 0xad, 0x87, 0x8f, 0x45, 0xd6, 0x91,
-	0x52, 0xbd, 0x39, 0xf9, 0xa9, 0x14, 0xde, 0xd2, 0x23, 0x4a, 0xe1, 0xfd, 0x5e, 0x2b, 0x55, 0x2c,
-	0x6f, 0xf4, 0xd2, 0x07, 0x8a, 0xcd, 0x3f, 0x98, 0xe1, 0x51, 0x5c, 0x19, 0xbd, 0x92, 0x09, 0xde,
-	0x7b, 0x01, 0xaa, 0x9b, 0xbe, 0xc3, 0xaa, 0xb8, 0xb0, 0x85, 0x6a, 0x44, 0x98, 0x5d, 0x11, 0xed,
-	0x58, 0x61, 0x50, 0xa9, 0x6f, 0x10, 0x3d, 0x94, 0xd4, 0xfe, 0x4f, 0x65, 0x18, 0x35, 0x34, 0x7e,
-	0xae, 0xf9, 0x66, 0x3d, 0x66, 0xe6, 0x5b, 0xe9, 0x10, 0xe6, 0xdb, 0xf7, 0x40, 0xcd, 0x95, 0xda,
-	0xa8, 0x98, 0x6b, 0x05, 0xb2, 0x3a, 0x4e, 0x2b, 0x24, 0xd5, 0x84, 0x35, 0x4f, 0x74, 0x35, 0x95,
-	0x26, 0x9a, 0xf2, 0x0b, 0xe4, 0xe5, 0x71, 0x0a, 0x8d, 0xd6, 0xfb, 0x4c, 0x36, 0x3e, 0xa0, 0x72,
-	0x70, 0x7c, 0x80, 0xfd, 0x07, 0x96, 0xfa, 0xb8, 0x27, 0x50, 0x0f, 0xe8, 0x6e, 0xba, 0x1e, 0xd0,
-	0xe5, 0x42, 0x86, 0xb9, 0x4f, 0x21, 0xa0, 0x1f, 0xb0, 0xe0, 0xd9, 0xfd, 0x0b, 0x6c, 0xa3, 0xe7,
-	0xa0, 0xd2, 0x8c, 0xc2, 0x4e, 0x5b, 0xe8, 0x60, 0x45, 0x87, 0x55, 0x33, 0xc7, 0x1c, 0x46, 0x37,
-	0x51, 0xdb, 0x5e, 0xd0, 0xc8, 0x6e, 0xa2, 0x6e, 0x78, 0x41, 0x03, 0x33, 0xc8, 0x00, 0x15, 0x58,
-	0x6f, 0xc2, 0xc8, 0x42, 0xd8, 0x6a, 0x39, 0x41, 0x03, 0x7d, 0x03, 0x8c, 0xb8, 0xfc, 0xa7, 0xf0,
-	0xe7, 0xb1, 0x83, 0x73, 0x01, 0xc5, 0x12, 0x86, 0x9e, 0x86, 0x21, 0x27, 0x6a, 0x4a, 0x1f, 0x1e,
-	0x0b, 0xc8, 0x9b, 0x8b, 0x9a, 0x31, 0x66, 0xad, 0xf6, 0x5f, 0x58, 0x30, 0x41, 0x1f, 0xf1, 0xd8,
-	0x00, 0xb3, 0xa1, 0x7d, 0x1e, 0x86, 0x9d, 0x4e, 0xb2, 0x15, 0xf6, 0xec, 0x09, 0xe7, 0x58, 0x2b,
-	0x16, 0x50, 0xda, 0x59, 0x55, 0xd4, 0xc2, 0xe8, 0xec, 0x22, 0x5d, 0x57, 0x0c, 0x42, 0xcd, 0xea,
-	0xb8, 0xb3, 0x91, 0x77, 0x72, 0x5b, 0xe7, 0xcd, 0x58, 0xc2, 0x29, 0xb1, 0x8d, 0xb0, 0xd1, 0x15,
-	0x61, 0xc6, 0x8a, 0xd8, 0x7c, 0xd8, 0xe8, 0x62, 0x06, 0x41, 0xcf, 0x40, 0x39, 0xde, 0x72, 0x64,
-	0x8c, 0x80, 0x8c, 0x78, 0xaf, 0x5f, 0x9b, 0xc3, 0xb4, 0x5d, 0x25, 0x70, 0x44, 0x7e, 0x36, 0xde,
-	0x37, 0x9d, 0xc0, 0x11, 0xf9, 0xf6, 0xbf, 0x18, 0x02, 0x16, 0xfb, 0xe3, 0x44, 0xa4, 0xb1, 0x1e,
-	0xb2, 0x9a, 0xc9, 0xc7, 0x7a, 0xc4, 0xae, 0x37, 0xd5, 0x8f, 0xf3, 0x31, 0xbb, 0x71, 0xd4, 0x5a,
-	0x3e, 0xe9, 0xa3, 0xd6, 0xfc, 0xd3, 0xf3, 0xa1, 0xc7, 0xe8, 0xf4, 0xdc, 0xfe, 0xbc, 0x05, 0x48,
-	0x45, 0x72, 0xe9, 0xf0, 0x96, 0x59, 0xa8, 0xa9, 0xd0, 0x31, 0xb1, 0x5e, 0xb4, 0x88, 0x96, 0x00,
-	0xac, 0x71, 0x06, 0xf0, 0xa4, 0x3c, 0x27, 0xf5, 0x67, 0x39, 0x2d, 0x4b, 0x98, 0xd6, 0x15, 0xea,
-	0xd4, 0xfe, 0x95, 0x12, 0x3c, 0xc1, 0x4d, 0xb7, 0x15, 0x27, 0x70, 0x9a, 0xa4, 0x45, 0x7b, 0x35,
-	0x68, 0xc0, 0x92, 0x4b, 0xb7, 0xf0, 0x9e, 0xcc, 0xd6, 0x38, 0xaa, 0xec, 0xe4, 0x72, 0x86, 0x4b,
-	0x96, 0xa5, 0xc0, 0x4b, 0x30, 0x23, 0x8e, 0x62, 0xa8, 0xca, 0xfb, 0xa0, 0x84, 0x2e, 0x2c, 0x88,
-	0x91, 0x52, 0x0b, 0xc2, 0xca, 0x21, 0x58, 0x31, 0xa2, 0xa6, 0x8c, 0x1f, 0xba, 0xdb, 0x74, 0xc9,
-	0x67, 0x4d, 0x99, 0x65, 0xd1, 0x8e, 0x15, 0x86, 0xdd, 0x82, 0x53, 0x72, 0x0c, 0xdb, 0x37, 0x48,
-	0x17, 0x93, 0x4d, 0xaa, 0xff, 0x5d, 0xd9, 0x64, 0x5c, 0x51, 0xa5, 0xf4, 0xff, 0x82, 0x09, 0xc4,
-	0x69, 0x5c, 0x59, 0x46, 0xb9, 0x94, 0x5f, 0x46, 0xd9, 0xfe, 0x15, 0x0b, 0xb2, 0x06, 0x08, 0x73,
-	0xc0, 0x99, 0xf7, 0x4d, 0xf5, 0xab, 0xaf, 0x7e, 0x88, 0xca, 0xaa, 0x1f, 0x82, 0x51, 0x27, 0xa1,
-	0x16, 0x26, 0xf7, 0x06, 0x95, 0x1f, 0xee, 0x14, 0x73, 0x25, 0x6c, 0x78, 0x9b, 0x1e, 0xf3, 0x02,
-	0x99, 0xe4, 0xec, 0x37, 0x2c, 0xa8, 0x2d, 0x46, 0xdd, 0xc3, 0xa7, 0xcd, 0xf5, 0x26, 0xc5, 0x95,
-	0x0e, 0x95, 0x14, 0x27, 0xd3, 0xee, 0xca, 0xfd, 0xd2, 0xee, 0xec, 0xff, 0x39, 0x04, 0x93, 0x3d,
-	0x79, 0xa0, 0xe8, 0x25, 0x18, 0x53, 0x5f, 0x49, 0xba, 0x80, 0x6b, 0x66, 0x20, 0xb5, 0x86, 0xe1,
-	0x14, 0xe6, 0x00, 0x4b, 0x75, 0x09, 0xce, 0x44, 0xe4, 0xd5, 0x0e, 0xe9, 0x90, 0xb9, 0xcd, 0x84,
-	0x44, 0x75, 0xe2, 0x86, 0x41, 0x83, 0x57, 0x5d, 0x2e, 0xcf, 0x3f, 0x79, 0x7f, 0x6f, 0xfa, 0x0c,
-	0xee, 0x05, 0xe3, 0xbc, 0x67, 0x50, 0x1b, 0xc6, 0x7d, 0x73, 0xef, 0x22, 0xb6, 0xcc, 0x0f, 0xb5,
-	0xed, 0x51, 0xb3, 0x35, 0xd5, 0x8c, 0xd3, 0x0c, 0xd2, 0x1b, 0xa0, 0xca, 0x23, 0xda, 0x00, 0x7d,
-	0x4a, 0x6f, 0x80, 0x78, 0x5c, 0xd2, 0x07, 0x0b, 0xce, 0x03, 0x1e, 0x64, 0x07, 0x74, 0x94, 0x3d,
-	0xcd, 0xfb, 0xa0, 0x2a, 0x63, 0x36, 0x07, 0x8a, 0x75, 0x34, 0xe9, 0xf4, 0x91, 0xed, 0x0f, 0x4a,
-	0x90, 0xb3, 0x6d, 0xa7, 0x6b, 0x4d, 0xdb, 0x7b, 0xa9, 0xb5, 0x76, 0x38, 0x9b, 0x0f, 0xed, 0xf2,
-	0x78, 0x55, 0xae, 0xe5, 0xdf, 0x5f, 0xb4, 0xdb, 0x41, 0x87, 0xb0, 0x2a, 0x09, 0xa8, 0xc2, 0x58,
-	0x2f, 0x01, 0xe8, 0x2d, 0x83, 0xb0, 0xf5, 0x54, 0x00, 0x8a, 0xde, 0x59, 0x60, 0x03, 0x0b, 0xbd,
-	0x08, 0xa3, 0x5e, 0x10, 0x27, 0x8e, 0xef, 0x5f, 0xf3, 0x82, 0x44, 0xd8, 0x7f, 0xca, 0x9c, 0x59,
-	0xd2, 0x20, 0x6c, 0xe2, 0x5d, 0x78, 0x8f, 0xf1, 0x5d, 0x0e, 0xf3, 0x3d, 0xb7, 0xe0, 0xfc, 0x55,
-	0x2f, 0x51, 0x89, 0x90, 0x6a, 0x1e, 0x31, 0x33, 0x5f, 0xca, 0x20, 0xab, 0x6f, 0xea, 0xaf, 0x91,
-	0x88, 0x58, 0x4a, 0xe7, 0x4d, 0x66, 0x13, 0x11, 0x6d, 0x17, 0xce, 0x5e, 0xf5, 0x92, 0x2b, 0x9e,
-	0x4f, 0x8e, 0x91, 0xc9, 0x2f, 0x0f, 0xc3, 0x98, 0x59, 0x1f, 0xe0, 0x30, 0x12, 0xfb, 0x0b, 0xd4,
-	0x3e, 0x15, 0x03, 0xe1, 0xa9, 0x43, 0xf5, 0x3b, 0x47, 0x2e, 0x56, 0x90, 0x3f, 0xb8, 0x86, 0x89,
-	0xaa, 0x79, 0x62, 0xb3, 0x03, 0xe8, 0x1e, 0x54, 0x36, 0x59, 0x4e, 0x5d, 0xb9, 0x88, 0x70, 0xa8,
-	0xbc, 0xc1, 0xd7, 0x2b, 0x92, 0x67, 0xe5, 0x71, 0x7e, 0xd4, 0xac, 0x88, 0xd2, 0xa9, 0xdc, 0x46,
-	0xa6, 0x83, 0xd0, 0x57, 0x0a, 0xa3, 0x9f, 0x56, 0xa8, 0x3c, 0x84, 0x56, 0x48, 0xc9, 0xe8, 0xe1,
-	0x47, 0x24, 0xa3, 0x59, 0x7e, 0x64, 0xb2, 0xc5, 0x8c, 0x5e, 0x91, 0x9a, 0x35, 0xc2, 0x06, 0xc1,
-	0xc8, 0x8f, 0x4c, 0x81, 0x71, 0x16, 0x1f, 0x7d, 0x5c, 0x49, 0xf9, 0x6a, 0x11, 0x87, 0x16, 0xe6,
-	0x8c, 0x3e, 0x6e, 0x01, 0xff, 0xf9, 0x12, 0x4c, 0x5c, 0x0d, 0x3a, 0x6b, 0x57, 0xd7, 0x3a, 0x1b,
-	0xbe, 0xe7, 0xde, 0x20, 0x5d, 0x2a, 0xc5, 0xb7, 0x49, 0x77, 0x69, 0x31, 0xbb, 0xdb, 0xbf, 0x41,
-	0x1b, 0x31, 0x87, 0x51, 0xb9, 0xb5, 0xe9, 0x05, 0x4d, 0x12, 0xb5, 0x23, 0x4f, 0x9c, 0x27, 0x18,
-	0x72, 0xeb, 0x8a, 0x06, 0x61, 0x13, 0x8f, 0xd2, 0x0e, 0xef, 0x05, 0xaa, 0x58, 0x93, 0xa2, 0xbd,
-	0x4a, 0x1b, 0x31, 0x87, 0x51, 0xa4, 0x24, 0xea, 0x08, 0x77, 0x9d, 0x81, 0xb4, 0x4e, 0x1b, 0x31,
-	0x87, 0x89, 0xdd, 0x37, 0x8b, 0x36, 0xab, 0xf4, 0xec, 0xbe, 0x59, 0xa0, 0x86, 0x84, 0x53, 0xd4,
-	0x6d, 0xd2, 0x5d, 0x74, 0x12, 0x27, 0xbb, 0x79, 0xbe, 0xc1, 0x9b, 0xb1, 0x84, 0xb3, 0xea, 0xcf,
-	0xe9, 0xe1, 0xf8, 0x9a, 0xab, 0xfe, 0x9c, 0xee, 0x7e, 0x1f, 0xa7, 0xcf, 0xdf, 0x29, 0xc1, 0xd8,
-	0x9b, 0x37, 0xc3, 0xe6, 0xdc, 0x4c, 0x74, 0x07, 0x26, 0x7b, 0xb2, 0xb2, 0x07, 0xb0, 0x7c, 0x0e,
-	0xac, 0x9a, 0x61, 0x63, 0x18, 0xa5, 0x84, 0x65, 0xd5, 0xc3, 0x05, 0x98, 0xe4, 0x8b, 0x97, 0x72,
-	0x62, 0x49, 0xb6, 0x2a, 0xd3, 0x9e, 0x1d, 0x98, 0xdd, 0xce, 0x02, 0x71, 0x2f, 0xbe, 0xfd, 0x43,
-	0x16, 0x8c, 0xa7, 0x12, 0xe5, 0x0b, 0xb2, 0xd1, 0xd8, 0xea, 0x0e, 0x59, 0xa4, 0x34, 0xcb, 0x5c,
-	0x29, 0x33, 0x35, 0xac, 0x57, 0xb7, 0x06, 0x61, 0x13, 0xcf, 0xfe, 0xcd, 0x32, 0x54, 0x65, 0x54,
-	0xd7, 0x00, 0x5d, 0xf9, 0x9c, 0x05, 0xe3, 0xea, 0x90, 0x92, 0x79, 0x95, 0x4b, 0x45, 0xe4, 0xed,
-	0xd1, 0x1e, 0x28, 0xbf, 0x48, 0xb0, 0x19, 0xea, 0x0d, 0x03, 0x36, 0x99, 0xe1, 0x34, 0x6f, 0x74,
-	0x1b, 0x20, 0xee, 0xc6, 0x09, 0x69, 0x19, 0xfe, 0x6d, 0xdb, 0x98, 0x65, 0x33, 0x6e, 0x18, 0x11,
-	0x3a, 0xa7, 0x6e, 0x86, 0x0d, 0x52, 0x57, 0x98, 0xda, 0xc2, 0xd3, 0x6d, 0xd8, 0xa0, 0x84, 0x5e,
-	0x53, 0x47, 0xea, 0x43, 0x45, 0xe8, 0x75, 0x39, 0xbe, 0x83, 0x9c, 0xa9, 0x1f, 0xe1, 0x0c, 0xdb,
-	0xfe, 0x99, 0x12, 0x9c, 0xce, 0x8e, 0x24, 0xfa, 0x20, 0x8c, 0xc9, 0x41, 0x33, 0xdc, 0x07, 0x32,
-	0x94, 0x6e, 0x0c, 0x1b, 0xb0, 0x07, 0x7b, 0xd3, 0xd3, 0xbd, 0x57, 0x8c, 0xcf, 0x98, 0x28, 0x38,
-	0x45, 0x8c, 0x1f, 0x70, 0x8b, 0x48, 0x8c, 0xf9, 0xee, 0x5c, 0xbb, 0x2d, 0x4e, 0xa9, 0x8d, 0x03,
-	0x6e, 0x13, 0x8a, 0x33, 0xd8, 0x68, 0x0d, 0xce, 0x1a, 0x2d, 0x37, 0x89, 0xd7, 0xdc, 0xda, 0x08,
-	0x23, 0xb9, 0x5f, 0x7d, 0x5a, 0x07, 0xee, 0xf6, 0xe2, 0xe0, 0xdc, 0x27, 0xa9, 0x61, 0xe4, 0x3a,
-	0x6d, 0xc7, 0xf5, 0x92, 0xae, 0x38, 0x67, 0x50, 0x62, 0x7c, 0x41, 0xb4, 0x63, 0x85, 0x61, 0xff,
-	0xc3, 0x21, 0x38, 0xcd, 0x23, 0x55, 0x89, 0x0a, 0xc4, 0x46, 0x1f, 0x84, 0x5a, 0x9c, 0x38, 0x11,
-	0x77, 0x56, 0x58, 0x87, 0x16, 0x5d, 0x3a, 0xbb, 0x5f, 0x12, 0xc1, 0x9a, 0x1e, 0xfa, 0x00, 0x2b,
-	0x8d, 0xe6, 0xc5, 0x5b, 0x8c, 0x7a, 0xe9, 0xe1, 0x5c, 0x21, 0x57, 0x14, 0x05, 0x6c, 0x50, 0x43,
-	0xdf, 0x0a, 0x95, 0xf6, 0x96, 0x13, 0x4b, 0x3f, 0xdd, 0xf3, 0x52, 0x4e, 0xac, 0xd1, 0xc6, 0x07,
-	0x7b, 0xd3, 0xe7, 0xb2, 0xaf, 0xca, 0x00, 0x98, 0x3f, 0x64, 0x4a, 0xf9, 0xa1, 0x03, 0xa4, 0xfc,
-	0xf3, 0x30, 0xdc, 0x88, 0xba, 0xf5, 0x6b, 0x73, 0xd9, 0xdb, 0x66, 0x16, 0x59, 0x2b, 0x16, 0x50,
-	0x2a, 0x93, 0xb6, 0x38, 0xcb, 0x06, 0x45, 0x1e, 0x4e, 0x5b, 0x1c, 0xd7, 0x34, 0x08, 0x9b, 0x78,
-	0xe8, 0xf3, 0xbd, 0x71, 0xcc, 0x23, 0xc7, 0x90, 0xe7, 0x32, 0x68, 0x04, 0xf3, 0x65, 0xa8, 0x89,
-	0xae, 0xae, 0x87, 0xe8, 0x25, 0x18, 0xe3, 0x6e, 0xa0, 0xf9, 0xc8, 0x09, 0xdc, 0xad, 0xac, 0xf3,
-	0x66, 0xdd, 0x80, 0xe1, 0x14, 0xa6, 0xbd, 0x02, 0x43, 0x03, 0x0a, 0xd9, 0x81, 0xf6, 0xe4, 0xef,
-	0x83, 0x2a, 0x25, 0x27, 0x37, 0x68, 0x45, 0x90, 0x0c, 0xa1, 0x2a, 0xaf, 0xa9, 0x44, 0x36, 0x94,
-	0x3d, 0x47, 0xc6, 0xab, 0xa8, 0x25, 0xb4, 0x14, 0xc7, 0x1d, 0x36, 0xed, 0x28, 0x10, 0x3d, 0x07,
-	0x65, 0xb2, 0xdb, 0xce, 0x06, 0xa6, 0x5c, 0xde, 0x6d, 0x7b, 0x11, 0x89, 0x29, 0x12, 0xd9, 0x6d,
-	0xa3, 0x0b, 0x50, 0xf2, 0x1a, 0x62, 0x46, 0x82, 0xc0, 0x29, 0x2d, 0x2d, 0xe2, 0x92, 0xd7, 0xb0,
-	0x77, 0xa1, 0xa6, 0xee, 0xc5, 0x44, 0xdb, 0xd2, 0xa4, 0xb2, 0x8a, 0x88, 0x54, 0x96, 0x74, 0xfb,
-	0x18, 0x53, 0x1d, 0x00, 0x5d, 0x36, 0xa2, 0x28, 0x15, 0x7c, 0x11, 0x86, 0xdc, 0x50, 0x14, 0xfc,
-	0xa9, 0x6a, 0x32, 0xcc, 0x96, 0x62, 0x10, 0xfb, 0x0e, 0x4c, 0xdc, 0x08, 0xc2, 0x7b, 0xec, 0x86,
-	0x2a, 0x56, 0x90, 0x99, 0x12, 0xde, 0xa4, 0x3f, 0xb2, 0x96, 0x3b, 0x83, 0x62, 0x0e, 0x53, 0xa5,
-	0x5e, 0x4b, 0xfd, 0x4a, 0xbd, 0xda, 0x9f, 0xb0, 0x60, 0x4c, 0xe5, 0x9f, 0x5f, 0xdd, 0xd9, 0x1e,
-	0xec, 0xfc, 0xcf, 0x28, 0xcc, 0x50, 0x3a, 0xa0, 0x30, 0x83, 0x3c, 0x2a, 0x2c, 0xf7, 0x3b, 0x2a,
-	0xb4, 0xff, 0xd2, 0x82, 0xd3, 0xaa, 0x0b, 0xd2, 0x66, 0x7a, 0x09, 0xc6, 0x36, 0x3a, 0x9e, 0xdf,
-	0x90, 0x95, 0xa6, 0x33, 0xcb, 0x65, 0xde, 0x80, 0xe1, 0x14, 0x26, 0xba, 0x04, 0xb0, 0xe1, 0x05,
-	0x4e, 0xd4, 0x5d, 0xd3, 0x46, 0x9a, 0xd2, 0xdb, 0xf3, 0x0a, 0x82, 0x0d, 0x2c, 0xf4, 0x31, 0xa8,
-	0xee, 0xc8, 0x13, 0xe2, 0x72, 0xa1, 0xf5, 0x04, 0xc4, 0x78, 0xe8, 0x95, 0xa0, 0x8e, 0x9c, 0x15,
-	0x47, 0xfb, 0x8b, 0x65, 0x98, 0x48, 0xd7, 0x00, 0x18, 0xc0, 0x73, 0xf2, 0x1c, 0x54, 0x58, 0x59,
-	0x80, 0xec, 0xc4, 0xe2, 0xa5, 0xa1, 0x39, 0x0c, 0xc5, 0x30, 0xcc, 0x45, 0x49, 0x31, 0x97, 0xa8,
-	0xaa, 0x4e, 0x2a, 0xff, 0x2c, 0x8b, 0x26, 0x17, 0xee, 0x6e, 0xc1, 0x0a, 0x7d, 0xda, 0x82, 0x91,
-	0xb0, 0x6d, 0xd6, 0x18, 0x7d, 0x7f, 0x91, 0xf5, 0x11, 0x44, 0x12, 0xb2, 0xb0, 0x86, 0xd4, 0xc4,
-	0x93, 0x93, 0x41, 0xb2, 0xbe, 0xf0, 0x2d, 0x30, 0x66, 0x62, 0x1e, 0x64, 0x10, 0x55, 0x4d, 0x83,
-	0xe8, 0x73, 0xe6, 0x94, 0x14, 0x15, 0x20, 0x06, 0x58, 0xec, 0xb7, 0xa0, 0xe2, 0xaa, 0x90, 0xbb,
-	0x87, 0xba, 0x1d, 0x41, 0x55, 0x48, 0x63, 0xe1, 0x0c, 0x9c, 0x9a, 0xfd, 0x07, 0x96, 0x31, 0x3f,
-	0x30, 0x89, 0x97, 0x1a, 0x28, 0x82, 0x72, 0x73, 0x67, 0x5b, 0x18, 0x19, 0xd7, 0x0b, 0x1a, 0xde,
-	0xab, 0x3b, 0xdb, 0x7a, 0x85, 0x99, 0xad, 0x98, 0x32, 0x1b, 0xe0, 0x10, 0x21, 0x55, 0x28, 0xa4,
-	0x7c, 0x70, 0xa1, 0x10, 0xfb, 0x8d, 0x12, 0x4c, 0xf6, 0x4c, 0x2a, 0xf4, 0x1a, 0x54, 0x22, 0xfa,
-	0x96, 0xe2, 0xf5, 0x96, 0x0b, 0x2b, 0xed, 0x11, 0x2f, 0x35, 0xb4, 0xf2, 0x4e, 0xb7, 0x63, 0xce,
-	0x12, 0x5d, 0x07, 0xa4, 0x03, 0x43, 0xd5, 0x09, 0x06, 0x7f, 0x65, 0x15, 0x3d, 0x36, 0xd7, 0x83,
-	0x81, 0x73, 0x9e, 0x42, 0x2f, 0x67, 0x0f, 0x42, 0x32, 0x55, 0xab, 0xf7, 0x3b, 0xd3, 0xb0, 0x5f,
-	0x37, 0xa7, 0xe0, 0x6d, 0x2d, 0x4c, 0x8f, 0xba, 0x39, 0xed, 0x91, 0xac, 0xe5, 0x41, 0x25, 0xab,
-	0xfd, 0x8b, 0x25, 0x18, 0x4f, 0x55, 0xa1, 0x45, 0x3e, 0x54, 0x89, 0xcf, 0x4e, 0x6c, 0xa5, 0xf6,
-	0x3d, 0xea, 0x85, 0x36, 0x4a, 0x4e, 0x5e, 0x16, 0x74, 0xb1, 0xe2, 0xf0, 0x78, 0xc4, 0xb9, 0xbd,
-	0x04, 0x63, 0xb2, 0x43, 0xef, 0x77, 0x5a, 0x7e, 0x76, 0xf8, 0x2e, 0x1b, 0x30, 0x9c, 0xc2, 0xb4,
-	0x7f, 0xb5, 0x0c, 0x53, 0xfc, 0x88, 0xbb, 0xa1, 0x16, 0x83, 0x0a, 0x55, 0xf9, 0x41, 0x5d, 0x2b,
-	0xda, 0x2a, 0xe2, 0x4a, 0xf7, 0x7e, 0x8c, 0x06, 0x0a, 0xcf, 0xfe, 0xf1, 0x4c, 0x78, 0x36, 0xdf,
-	0xaa, 0x37, 0x8f, 0xa9, 0x47, 0x5f, 0x5b, 0xf1, 0xda, 0xff, 0xb4, 0x04, 0xa7, 0x32, 0x97, 0xf3,
-	0xa1, 0x2f, 0xa6, 0xef, 0x73, 0xb1, 0x8a, 0x38, 0xfe, 0xdb, 0xf7, 0xbe, 0xb6, 0xc3, 0xdd, 0xea,
-	0xf2, 0x88, 0x96, 0x8a, 0xfd, 0x7b, 0x25, 0x98, 0x48, 0xdf, 0x2a, 0xf8, 0x18, 0x8e, 0xd4, 0xdb,
-	0xa1, 0xc6, 0x2e, 0xce, 0xba, 0x41, 0xba, 0xf2, 0x94, 0x91, 0xdf, 0x51, 0x24, 0x1b, 0xb1, 0x86,
-	0x3f, 0x16, 0x97, 0xe5, 0xd8, 0xff, 0xcc, 0x82, 0x73, 0xfc, 0x2d, 0xb3, 0xf3, 0xf0, 0x6f, 0xe5,
-	0x8d, 0xee, 0x2b, 0xc5, 0x76, 0x30, 0x53, 0xe3, 0xfc, 0xa0, 0xf1, 0x65, 0x17, 0xdb, 0x8b, 0xde,
-	0xa6, 0xa7, 0xc2, 0x63, 0xd8, 0xd9, 0x43, 0x4d, 0x06, 0xfb, 0x3f, 0x94, 0x60, 0x74, 0x75, 0x61,
-	0x49, 0x89, 0xf0, 0x59, 0xa8, 0xb9, 0x11, 0x71, 0xb4, 0xfb, 0xc7, 0x0c, 0xa0, 0x92, 0x00, 0xac,
-	0x71, 0xe8, 0x2e, 0x8a, 0x07, 0x20, 0xc6, 0xd9, 0x5d, 0x14, 0x8f, 0x4f, 0x8c, 0xb1, 0x84, 0xa3,
-	0x17, 0xa0, 0xca, 0xd2, 0x94, 0x6f, 0x45, 0x52, 0xe3, 0xe8, 0xad, 0x35, 0x6b, 0xc7, 0xcb, 0x58,
-	0x61, 0x50, 0xc2, 0x8d, 0xd0, 0x8d, 0x29, 0x72, 0xc6, 0x23, 0xb3, 0x48, 0x9b, 0xf1, 0x32, 0x96,
-	0x70, 0x56, 0x65, 0x92, 0x79, 0x2d, 0x28, 0x72, 0x25, 0xdd, 0x69, 0xee, 0xde, 0xa0, 0xe8, 0x1a,
-	0xe7, 0x30, 0xd5, 0x48, 0x33, 0xa9, 0x82, 0x23, 0x83, 0xa5, 0x0a, 0xda, 0xbf, 0x57, 0x86, 0x9a,
-	0x76, 0xaa, 0x79, 0xa2, 0x36, 0x47, 0x21, 0x35, 0xf4, 0xeb, 0xdd, 0xc0, 0x55, 0xa4, 0x79, 0x34,
-	0x81, 0x51, 0x9a, 0xe3, 0xfb, 0x2d, 0x18, 0xf5, 0x02, 0x2f, 0xf1, 0x1c, 0xe6, 0x1b, 0x2c, 0xe6,
-	0xa2, 0x73, 0xc5, 0x6e, 0x89, 0x53, 0x0e, 0x23, 0xf3, 0xc8, 0x5f, 0x31, 0xc3, 0x26, 0x67, 0xf4,
-	0x51, 0x91, 0x99, 0x56, 0x2e, 0xac, 0xc0, 0x4d, 0x35, 0x93, 0x8e, 0xd6, 0xa6, 0x36, 0x76, 0x12,
-	0x15, 0x54, 0x17, 0x0a, 0x53, 0x52, 0xea, 0x2e, 0x17, 0xb5, 0x8b, 0x61, 0xcd, 0x98, 0x33, 0xb2,
-	0x63, 0x40, 0xbd, 0x63, 0x71, 0xc8, 0xac, 0x9f, 0x59, 0xa8, 0x39, 0x9d, 0x24, 0x6c, 0xd1, 0x61,
-	0x12, 0x01, 0x03, 0x3a, 0xaf, 0x49, 0x02, 0xb0, 0xc6, 0xb1, 0xbf, 0x58, 0x81, 0x4c, 0xa5, 0x0c,
-	0xb4, 0x0b, 0x35, 0x55, 0x2b, 0xa3, 0x98, 0x2c, 0x5a, 0x3d, 0xa3, 0x54, 0x67, 0x54, 0x13, 0xd6,
-	0xcc, 0x50, 0x53, 0xba, 0x59, 0xf9, 0x6a, 0x7f, 0x5f, 0xd6, 0xcd, 0xfa, 0x1d, 0x83, 0x9d, 0xba,
-	0xd1, 0xb9, 0x3a, 0xcb, 0x6b, 0x23, 0xce, 0x1c, 0xe8, 0x91, 0x3d, 0xe8, 0xaa, 0xf7, 0x4f, 0x8a,
-	0x9b, 0xd7, 0x30, 0x89, 0x3b, 0x7e, 0x22, 0x66, 0xc3, 0xfb, 0x0a, 0x5c, 0x65, 0x9c, 0xb0, 0xae,
-	0x38, 0xc5, 0xff, 0x63, 0x83, 0x69, 0xda, 0x6f, 0x3e, 0x7c, 0xac, 0x7e, 0xf3, 0x91, 0x42, 0xfd,
-	0xe6, 0x97, 0x00, 0xd8, 0xdc, 0xe6, 0xd9, 0x09, 0x55, 0xe6, 0xce, 0x54, 0x2a, 0x06, 0x2b, 0x08,
-	0x36, 0xb0, 0xec, 0x6f, 0x82, 0x74, 0xc9, 0x34, 0x34, 0x2d, 0x2b, 0xb4, 0xf1, 0x13, 0x41, 0x96,
-	0x18, 0x9a, 0x2a, 0xa6, 0xf6, 0xf3, 0x16, 0x98, 0x75, 0xdd, 0xd0, 0xab, 0xbc, 0x80, 0x9c, 0x55,
-	0xc4, 0x09, 0x93, 0x41, 0x77, 0x66, 0xc5, 0x69, 0x67, 0xa2, 0x9d, 0x64, 0x15, 0xb9, 0x0b, 0xef,
-	0x81, 0xaa, 0x84, 0x1e, 0xca, 0x58, 0xfe, 0x38, 0x9c, 0x91, 0x45, 0x26, 0xe4, 0x61, 0x90, 0x88,
-	0x3a, 0x38, 0x99, 0x1c, 0x83, 0x5f, 0xb0, 0xe0, 0x62, 0xb6, 0x03, 0xf1, 0x4a, 0x18, 0x78, 0x49,
-	0x18, 0xd5, 0x49, 0x92, 0x78, 0x41, 0x93, 0xd5, 0xf9, 0xbd, 0xe7, 0x44, 0xf2, 0xae, 0x27, 0x26,
-	0x28, 0xef, 0x38, 0x51, 0x80, 0x59, 0x2b, 0xea, 0xc2, 0x30, 0x0f, 0xa1, 0x16, 0xbb, 0xa0, 0x23,
-	0xae, 0x8d, 0x9c, 0xe1, 0xd0, 0xdb, 0x30, 0x1e, 0xbe, 0x8d, 0x05, 0x43, 0xfb, 0x2b, 0x16, 0xa0,
-	0xd5, 0x1d, 0x12, 0x45, 0x5e, 0xc3, 0x08, 0xfa, 0x66, 0x37, 0x98, 0x1a, 0x37, 0x95, 0x9a, 0x25,
-	0x50, 0x32, 0x37, 0x98, 0x1a, 0xff, 0xf2, 0x6f, 0x30, 0x2d, 0x1d, 0xee, 0x06, 0x53, 0xb4, 0x0a,
-	0xe7, 0x5a, 0x7c, 0x1b, 0xc7, 0x6f, 0x05, 0xe4, 0x7b, 0x3a, 0x95, 0xad, 0x7f, 0xfe, 0xfe, 0xde,
-	0xf4, 0xb9, 0x95, 0x3c, 0x04, 0x9c, 0xff, 0x9c, 0xfd, 0x1e, 0x40, 0x3c, 0xd6, 0x7b, 0x21, 0x2f,
-	0x5c, 0xb5, 0xaf, 0x9b, 0xc3, 0xfe, 0x52, 0x05, 0x4e, 0x65, 0x6e, 0x02, 0xa1, 0x5b, 0xe8, 0xde,
-	0xf8, 0xd8, 0x23, 0xeb, 0xef, 0xde, 0xee, 0x0d, 0x14, 0x71, 0x1b, 0x40, 0xc5, 0x0b, 0xda, 0x9d,
-	0xa4, 0x98, 0x62, 0x21, 0xbc, 0x13, 0x4b, 0x94, 0xa0, 0x71, 0x2e, 0x41, 0xff, 0x62, 0xce, 0xa6,
-	0xc8, 0xf8, 0xdd, 0xd4, 0x26, 0x67, 0xe8, 0x11, 0xb9, 0x59, 0x3e, 0xa9, 0xa3, 0x69, 0x2b, 0x45,
-	0xf8, 0x90, 0x33, 0x93, 0xe5, 0xb8, 0x43, 0xad, 0x7e, 0xb6, 0x04, 0xa3, 0xc6, 0x47, 0x43, 0x3f,
-	0x91, 0xae, 0x7a, 0x6a, 0x15, 0xf7, 0x4a, 0x8c, 0xfe, 0x8c, 0xae, 0x6b, 0xca, 0x5f, 0xe9, 0xf9,
-	0xde, 0x82, 0xa7, 0x0f, 0xf6, 0xa6, 0x4f, 0x67, 0x4a, 0x9a, 0xa6, 0x8a, 0xa0, 0x5e, 0xf8, 0x6e,
-	0x38, 0x95, 0x21, 0x93, 0xf3, 0xca, 0xeb, 0xe6, 0x2b, 0x1f, 0xd9, 0xdd, 0x67, 0x0e, 0xd9, 0x4f,
-	0xd3, 0x21, 0x13, 0x35, 0x0a, 0x42, 0x9f, 0x0c, 0xe0, 0xeb, 0xcc, 0xec, 0x2f, 0x4a, 0x03, 0x96,
-	0x22, 0x79, 0x1b, 0x54, 0xdb, 0xa1, 0xef, 0xb9, 0x9e, 0x2a, 0x9a, 0xce, 0x8a, 0x9f, 0xac, 0x89,
-	0x36, 0xac, 0xa0, 0xe8, 0x1e, 0xd4, 0xee, 0xde, 0x4b, 0xf8, 0x31, 0xa3, 0x38, 0xca, 0x28, 0xea,
-	0x74, 0x51, 0x19, 0x2d, 0xea, 0x1c, 0x13, 0x6b, 0x5e, 0xc8, 0x86, 0x61, 0xa6, 0x04, 0x65, 0xbe,
-	0x22, 0x3b, 0x66, 0x61, 0xda, 0x31, 0xc6, 0x02, 0x62, 0xff, 0xbb, 0x51, 0x38, 0x9b, 0x77, 0x1d,
-	0x13, 0xfa, 0x18, 0x0c, 0xf3, 0x3e, 0x16, 0x73, 0xe3, 0x5f, 0x1e, 0x8f, 0xab, 0x8c, 0xa0, 0xe8,
-	0x16, 0xfb, 0x8d, 0x05, 0x4f, 0xc1, 0xdd, 0x77, 0x36, 0xc4, 0x0c, 0x39, 0x1e, 0xee, 0xcb, 0x8e,
-	0xe6, 0xbe, 0xec, 0x70, 0xee, 0xbe, 0xb3, 0x81, 0x76, 0xa1, 0xd2, 0xf4, 0x12, 0xe2, 0x08, 0xe7,
-	0xcc, 0x9d, 0x63, 0x61, 0x4e, 0x1c, 0x6e, 0xa5, 0xb1, 0x9f, 0x98, 0x33, 0x44, 0x5f, 0xb6, 0xe0,
-	0xd4, 0x46, 0xba, 0x06, 0x92, 0x10, 0x9e, 0xce, 0x31, 0x5c, 0xb9, 0x95, 0x66, 0xc4, 0xaf, 0xf0,
-	0xcd, 0x34, 0xe2, 0x6c, 0x77, 0xd0, 0xa7, 0x2c, 0x18, 0xd9, 0xf4, 0x7c, 0xe3, 0x4e, 0x93, 0x63,
-	0xf8, 0x38, 0x57, 0x18, 0x03, 0xbd, 0xe3, 0xe0, 0xff, 0x63, 0x2c, 0x39, 0xf7, 0xd3, 0x54, 0xc3,
-	0x47, 0xd5, 0x54, 0x23, 0x8f, 0x48, 0x53, 0x7d, 0xd6, 0x82, 0x9a, 0x1a, 0x69, 0x51, 0x4b, 0xe6,
-	0x83, 0xc7, 0xf8, 0xc9, 0xb9, 0x47, 0x4a, 0xfd, 0xc5, 0x9a, 0x39, 0x7a, 0xdd, 0x82, 0x51, 0xe7,
-	0xb5, 0x4e, 0x44, 0x1a, 0x64, 0x27, 0x6c, 0xc7, 0xa2, 0xc8, 0xeb, 0x2b, 0xc5, 0x77, 0x66, 0x8e,
-	0x32, 0x59, 0x24, 0x3b, 0xab, 0xed, 0x58, 0xe4, 0x52, 0xeb, 0x06, 0x6c, 0x76, 0x01, 0x7d, 0x9f,
-	0xd6, 0xe3, 0x50, 0x44, 0xa9, 0xef, 0xbc, 0xde, 0x0c, 0x54, 0x1a, 0x80, 0xc0, 0x53, 0x6e, 0x18,
-	0x24, 0x5e, 0xd0, 0x21, 0xab, 0x01, 0x26, 0xed, 0xf0, 0x66, 0x98, 0x5c, 0x09, 0x3b, 0x41, 0xe3,
-	0x72, 0x14, 0x85, 0x11, 0x2b, 0x96, 0x63, 0x5c, 0xf4, 0xba, 0xd0, 0x1f, 0x15, 0xef, 0x47, 0xe7,
-	0x28, 0x36, 0xc3, 0x5e, 0x09, 0xa6, 0x0f, 0x18, 0x6c, 0xf4, 0x12, 0x8c, 0x85, 0x51, 0xd3, 0x09,
-	0xbc, 0xd7, 0xcc, 0xfa, 0x6f, 0xca, 0x20, 0x5d, 0x35, 0x60, 0x38, 0x85, 0x69, 0x16, 0x06, 0x2a,
-	0x1d, 0x50, 0x18, 0xe8, 0x22, 0x0c, 0x45, 0xa4, 0x1d, 0x66, 0xf7, 0x55, 0x2c, 0xe5, 0x90, 0x41,
-	0xd0, 0x33, 0x50, 0x76, 0xda, 0x9e, 0x70, 0x2e, 0xaa, 0xed, 0xe2, 0xdc, 0xda, 0x12, 0xa6, 0xed,
-	0xa9, 0x3a, 0x65, 0x95, 0x13, 0xa9, 0x53, 0x46, 0x35, 0xa6, 0x38, 0x3e, 0x1b, 0xd6, 0x1a, 0x33,
-	0x7d, 0xac, 0x65, 0xbf, 0x51, 0x86, 0x67, 0xf6, 0x5d, 0x5a, 0x3a, 0x64, 0xdd, 0xda, 0x27, 0x64,
-	0x5d, 0x0e, 0x4f, 0xe9, 0xa0, 0xe1, 0x29, 0xf7, 0x19, 0x9e, 0x4f, 0x51, 0x89, 0x21, 0xeb, 0xe6,
-	0x15, 0x73, 0xd9, 0x7d, 0xbf, 0x32, 0x7c, 0x42, 0x58, 0x48, 0x28, 0xd6, 0x7c, 0xe9, 0x76, 0x29,
-	0x55, 0x14, 0xa7, 0x52, 0x84, 0xc6, 0xec, 0x5b, 0xbb, 0x8e, 0x8b, 0x89, 0x7e, 0x95, 0x76, 0xec,
-	0x5f, 0x1a, 0x82, 0xe7, 0x06, 0x50, 0x74, 0xe6, 0x2c, 0xb6, 0x06, 0x9c, 0xc5, 0x5f, 0xe3, 0x9f,
-	0xe9, 0x33, 0xb9, 0x9f, 0x09, 0x17, 0xff, 0x99, 0xf6, 0xff, 0x42, 0xec, 0x04, 0x22, 0x88, 0x89,
-	0xdb, 0x89, 0x78, 0xfa, 0x8e, 0x91, 0x8f, 0xbc, 0x24, 0xda, 0xb1, 0xc2, 0xa0, 0xdb, 0x5f, 0xd7,
-	0xa1, 0xcb, 0x7f, 0xa4, 0xa0, 0x22, 0x28, 0x66, 0x6a, 0x33, 0xb7, 0xbe, 0x16, 0xe6, 0xa8, 0x04,
-	0xe0, 0x6c, 0xec, 0x5f, 0xb3, 0xe0, 0x42, 0x7f, 0x6b, 0x04, 0xbd, 0x13, 0x46, 0x37, 0x58, 0x30,
-	0xe5, 0x0a, 0x0b, 0x99, 0x12, 0x53, 0x87, 0xbd, 0xaf, 0x6e, 0xc6, 0x26, 0x0e, 0x5a, 0x80, 0x49,
-	0x33, 0x0a, 0x73, 0xc5, 0x88, 0xb5, 0x62, 0xfe, 0x92, 0xf5, 0x2c, 0x10, 0xf7, 0xe2, 0xa3, 0x19,
-	0x80, 0xc4, 0x4b, 0x7c, 0xc2, 0x9f, 0xe6, 0x13, 0x8d, 0x39, 0x14, 0xd7, 0x55, 0x2b, 0x36, 0x30,
-	0xec, 0xaf, 0x96, 0xf3, 0x5f, 0x83, 0x5b, 0xb9, 0x87, 0x99, 0xfd, 0x62, 0x6e, 0x97, 0x06, 0x90,
-	0xd0, 0xe5, 0x93, 0x96, 0xd0, 0x43, 0xfd, 0x24, 0x34, 0x5a, 0x84, 0xd3, 0xc6, 0xd5, 0xb1, 0xbc,
-	0x8c, 0x0e, 0x3f, 0x94, 0x52, 0x35, 0xf0, 0xd6, 0x32, 0x70, 0xdc, 0xf3, 0xc4, 0x63, 0x3e, 0x55,
-	0x7f, 0xbd, 0x04, 0xe7, 0xfb, 0x6e, 0x2c, 0x4e, 0x48, 0x03, 0x99, 0x9f, 0x7f, 0xe8, 0x64, 0x3e,
-	0xbf, 0xf9, 0x51, 0x2a, 0x07, 0x7e, 0x94, 0x41, 0xd4, 0xf9, 0xef, 0x97, 0xfa, 0x2e, 0x16, 0xba,
-	0x11, 0xfd, 0x2b, 0x3b, 0x92, 0x2f, 0xc3, 0xb8, 0xd3, 0x6e, 0x73, 0x3c, 0x96, 0x99, 0x91, 0xa9,
-	0xcb, 0x39, 0x67, 0x02, 0x71, 0x1a, 0x77, 0xa0, 0x81, 0xfd, 0x63, 0x0b, 0x6a, 0x98, 0x6c, 0x72,
-	0x09, 0x87, 0xee, 0x8a, 0x21, 0xb2, 0x8a, 0xb8, 0x1c, 0x81, 0x0e, 0x6c, 0xec, 0xb1, 0x1b, 0x03,
-	0xf2, 0x06, 0xfb, 0xa8, 0x95, 0x15, 0xd4, 0x85, 0xb3, 0xe5, 0xfe, 0x17, 0xce, 0xda, 0xbf, 0x5c,
-	0xa3, 0xaf, 0xd7, 0x0e, 0x17, 0x22, 0xd2, 0x88, 0xe9, 0xf7, 0xed, 0x44, 0xbe, 0x98, 0x24, 0xea,
-	0xfb, 0xde, 0xc2, 0xcb, 0x98, 0xb6, 0xa7, 0xce, 0x27, 0x4b, 0x87, 0xaa, 0x4a, 0x58, 0x3e, 0xb0,
-	0x2a, 0xe1, 0xcb, 0x30, 0x1e, 0xc7, 0x5b, 0x6b, 0x91, 0xb7, 0xe3, 0x24, 0xe4, 0x06, 0x91, 0x25,
-	0x83, 0x74, 0x85, 0xae, 0xfa, 0x35, 0x0d, 0xc4, 0x69, 0x5c, 0x74, 0x15, 0x26, 0x75, 0x6d, 0x40,
-	0x12, 0x25, 0x2c, 0xe5, 0x91, 0xcf, 0x04, 0x55, 0x0e, 0x46, 0x57, 0x13, 0x14, 0x08, 0xb8, 0xf7,
-	0x19, 0x2a, 0x73, 0x53, 0x8d, 0xb4, 0x23, 0xc3, 0x69, 0x99, 0x9b, 0xa2, 0x43, 0xfb, 0xd2, 0xf3,
-	0x04, 0x5a, 0x81, 0x33, 0x7c, 0x62, 0xcc, 0xb5, 0xdb, 0xc6, 0x1b, 0x8d, 0xa4, 0x2b, 0xd2, 0x5f,
-	0xed, 0x45, 0xc1, 0x79, 0xcf, 0xa1, 0x17, 0x61, 0x54, 0x35, 0x2f, 0x2d, 0x8a, 0xa3, 0x35, 0xe5,
-	0xda, 0x53, 0x64, 0x96, 0x1a, 0xd8, 0xc4, 0x43, 0xef, 0x87, 0x27, 0xf5, 0x5f, 0x9e, 0x42, 0xcf,
-	0xcf, 0x9b, 0x17, 0x45, 0xd9, 0x55, 0x75, 0xe1, 0xd9, 0xd5, 0x5c, 0xb4, 0x06, 0xee, 0xf7, 0x3c,
-	0xda, 0x80, 0x0b, 0x0a, 0x74, 0x39, 0x48, 0x58, 0x92, 0x6b, 0x4c, 0xe6, 0x9d, 0x98, 0x45, 0x4e,
-	0x00, 0x7b, 0x4f, 0x5b, 0x50, 0xbf, 0x70, 0xd5, 0x4b, 0xae, 0xe5, 0x61, 0xe2, 0x65, 0xbc, 0x0f,
-	0x15, 0x34, 0x0b, 0x35, 0x12, 0x38, 0x1b, 0x3e, 0x59, 0x5d, 0x58, 0x12, 0x3b, 0x52, 0x9d, 0x1d,
-	0x21, 0x01, 0x58, 0xe3, 0xa8, 0xf8, 0xfe, 0xb1, 0x7e, 0xf1, 0xfd, 0x68, 0x0d, 0xce, 0x36, 0xdd,
-	0x36, 0xb5, 0x32, 0x3d, 0x97, 0xcc, 0xb9, 0x2c, 0xa0, 0x98, 0x7e, 0x18, 0x7e, 0x55, 0x80, 0x4a,
-	0x94, 0xba, 0xba, 0xb0, 0xd6, 0x83, 0x83, 0x73, 0x9f, 0x64, 0x81, 0xe7, 0x51, 0xb8, 0xdb, 0x9d,
-	0x3a, 0x93, 0x09, 0x3c, 0xa7, 0x8d, 0x98, 0xc3, 0xd0, 0x75, 0x40, 0x2c, 0x59, 0xf0, 0x5a, 0x92,
-	0xb4, 0x95, 0x59, 0x3b, 0x75, 0x36, 0x5d, 0x84, 0xf1, 0x4a, 0x0f, 0x06, 0xce, 0x79, 0x8a, 0x5a,
-	0x3d, 0x41, 0xc8, 0xa8, 0x4f, 0x3d, 0x99, 0xb6, 0x7a, 0x6e, 0xf2, 0x66, 0x2c, 0xe1, 0xe8, 0x43,
-	0x30, 0xd5, 0x89, 0x09, 0xdb, 0x30, 0xdf, 0x09, 0xa3, 0x6d, 0x3f, 0x74, 0x1a, 0x4b, 0xec, 0x66,
-	0xdb, 0xa4, 0x3b, 0x35, 0xc5, 0x98, 0x5f, 0x14, 0xcf, 0x4e, 0xdd, 0xea, 0x83, 0x87, 0xfb, 0x52,
-	0xc8, 0x56, 0x11, 0x3d, 0x3f, 0x60, 0x15, 0xd1, 0x35, 0x38, 0x2b, 0xf5, 0xda, 0xea, 0xc2, 0x92,
-	0x7a, 0xe9, 0xa9, 0x0b, 0xe9, 0xab, 0xf2, 0x96, 0x72, 0x70, 0x70, 0xee, 0x93, 0xf6, 0x1f, 0x59,
-	0x30, 0xae, 0x24, 0xd8, 0x09, 0x24, 0x2d, 0xfb, 0xe9, 0xa4, 0xe5, 0xab, 0x47, 0xd7, 0x01, 0xac,
-	0xe7, 0x7d, 0x52, 0x6c, 0x7e, 0x71, 0x1c, 0x40, 0xeb, 0x09, 0xa5, 0xa2, 0xad, 0xbe, 0x2a, 0xfa,
-	0xb1, 0x95, 0xd1, 0x79, 0x55, 0x21, 0x2b, 0x8f, 0xb6, 0x2a, 0x64, 0x1d, 0xce, 0xc9, 0x29, 0xc5,
-	0x8f, 0x94, 0xaf, 0x85, 0xb1, 0x12, 0xf9, 0xc6, 0xdd, 0x87, 0x4b, 0x79, 0x48, 0x38, 0xff, 0xd9,
-	0x94, 0x6d, 0x37, 0x72, 0xa0, 0x6d, 0xa7, 0xa4, 0xdc, 0xf2, 0xa6, 0xbc, 0x99, 0x34, 0x23, 0xe5,
-	0x96, 0xaf, 0xd4, 0xb1, 0xc6, 0xc9, 0x57, 0x75, 0xb5, 0x82, 0x54, 0x1d, 0x1c, 0x5a, 0xd5, 0x49,
-	0xa1, 0x3b, 0xda, 0x57, 0xe8, 0xca, 0xa3, 0xab, 0xb1, 0xbe, 0x47, 0x57, 0xef, 0x85, 0x09, 0x2f,
-	0xd8, 0x22, 0x91, 0x97, 0x90, 0x06, 0x5b, 0x0b, 0x4c, 0x20, 0x57, 0xb5, 0xa1, 0xb3, 0x94, 0x82,
-	0xe2, 0x0c, 0x76, 0x5a, 0x53, 0x4c, 0x0c, 0xa0, 0x29, 0xfa, 0xe8, 0xe7, 0x53, 0xc5, 0xe8, 0xe7,
-	0xd3, 0x47, 0xd7, 0xcf, 0x93, 0xc7, 0xaa, 0x9f, 0x51, 0x21, 0xfa, 0x79, 0x20, 0xd5, 0x67, 0x6c,
-	0xd2, 0xcf, 0x1e, 0xb0, 0x49, 0xef, 0xa7, 0x9c, 0xcf, 0x3d, 0xb4, 0x72, 0xce, 0xd7, 0xbb, 0x4f,
-	0xbc, 0xa9, 0x77, 0x8b, 0xd0, 0xbb, 0xf4, 0xfb, 0x37, 0x48, 0x3b, 0xd9, 0x9a, 0x7a, 0x8a, 0x4d,
-	0x56, 0xf5, 0xfd, 0x17, 0x69, 0x23, 0xe6, 0x30, 0xfb, 0xb3, 0x25, 0x38, 0xa7, 0xd5, 0x17, 0x15,
-	0x1a, 0xde, 0x26, 0x15, 0xe0, 0xec, 0x4e, 0x70, 0x7e, 0x2a, 0x6e, 0xe4, 0xd3, 0xeb, 0x8a, 0x02,
-	0x0a, 0x82, 0x0d, 0x2c, 0x96, 0x96, 0x4e, 0x22, 0x76, 0x1b, 0x4d, 0x56, 0xb7, 0x2d, 0x88, 0x76,
-	0xac, 0x30, 0xe8, 0x48, 0xd1, 0xdf, 0xa2, 0x2a, 0x4a, 0xb6, 0xce, 0xf9, 0x82, 0x06, 0x61, 0x13,
-	0x0f, 0xbd, 0x8d, 0x33, 0x61, 0x72, 0x95, 0xea, 0xb7, 0x31, 0xbe, 0xf7, 0x54, 0xa2, 0x54, 0x41,
-	0x65, 0x77, 0x58, 0xd9, 0x84, 0x4a, 0x6f, 0x77, 0x58, 0x80, 0xa9, 0xc2, 0xb0, 0xff, 0x97, 0x05,
-	0xe7, 0x73, 0x87, 0xe2, 0x04, 0x6c, 0x96, 0xdd, 0xb4, 0xcd, 0x52, 0x2f, 0x6a, 0xdf, 0x6a, 0xbc,
-	0x45, 0x1f, 0xfb, 0xe5, 0x3f, 0x5a, 0x30, 0xa1, 0xf1, 0x4f, 0xe0, 0x55, 0xbd, 0xf4, 0xab, 0x16,
-	0xb7, 0x45, 0xaf, 0xf5, 0xbc, 0xdb, 0xaf, 0x96, 0x40, 0xdd, 0x3d, 0x30, 0xe7, 0x26, 0x83, 0xe5,
-	0xa4, 0x75, 0x61, 0x98, 0x85, 0x99, 0xc4, 0xc5, 0x84, 0xd0, 0xa5, 0xf9, 0xb3, 0x90, 0x15, 0x7d,
-	0xea, 0xc7, 0xfe, 0xc6, 0x58, 0x30, 0x64, 0x77, 0x25, 0xf1, 0xb2, 0xee, 0x0d, 0x91, 0x5d, 0xad,
-	0xef, 0x4a, 0x12, 0xed, 0x58, 0x61, 0x50, 0xad, 0xea, 0xb9, 0x61, 0xb0, 0xe0, 0x3b, 0x71, 0x2c,
-	0x0c, 0x3d, 0xa5, 0x55, 0x97, 0x24, 0x00, 0x6b, 0x1c, 0x16, 0x81, 0xe2, 0xc5, 0x6d, 0xdf, 0xe9,
-	0x1a, 0x8e, 0x18, 0xa3, 0xfa, 0x97, 0x02, 0x61, 0x13, 0xcf, 0x6e, 0xc1, 0x54, 0xfa, 0x25, 0x16,
-	0xc9, 0x26, 0x0b, 0xff, 0x1e, 0x68, 0x38, 0x67, 0xa1, 0xe6, 0xb0, 0xa7, 0x96, 0x3b, 0x8e, 0x90,
-	0x09, 0x3a, 0x08, 0x5a, 0x02, 0xb0, 0xc6, 0xb1, 0xbf, 0x19, 0xce, 0xe4, 0x8c, 0xd9, 0x00, 0x51,
-	0x76, 0xbf, 0x58, 0x82, 0x53, 0xe9, 0x27, 0x63, 0x96, 0x20, 0xc9, 0xfb, 0xec, 0xc5, 0x6e, 0xb8,
-	0x43, 0xa2, 0x2e, 0xed, 0x86, 0x95, 0x49, 0x90, 0xec, 0xc1, 0xc0, 0x39, 0x4f, 0xb1, 0x6b, 0x40,
-	0x1a, 0xea, 0xd5, 0xe5, 0xf4, 0xb8, 0x5d, 0xe4, 0xf4, 0xd0, 0x23, 0x6b, 0x46, 0x06, 0x29, 0x96,
-	0xd8, 0xe4, 0x4f, 0x8d, 0x24, 0x96, 0xde, 0x31, 0xdf, 0xf1, 0xfc, 0xc4, 0x0b, 0xc4, 0x2b, 0x8b,
-	0x89, 0xa3, 0x8c, 0xa4, 0x95, 0x5e, 0x14, 0x9c, 0xf7, 0x9c, 0xfd, 0x95, 0x21, 0x50, 0x65, 0x52,
-	0x58, 0xe4, 0x66, 0x41, 0x71, 0xaf, 0x87, 0x4d, 0xb3, 0x55, 0x5f, 0x7a, 0x68, 0xbf, 0x50, 0x2a,
-	0xee, 0x4a, 0x33, 0x7d, 0xee, 0x6a, 0xc0, 0xd6, 0x35, 0x08, 0x9b, 0x78, 0xb4, 0x27, 0xbe, 0xb7,
-	0x43, 0xf8, 0x43, 0xc3, 0xe9, 0x9e, 0x2c, 0x4b, 0x00, 0xd6, 0x38, 0xac, 0xd2, 0xb6, 0xb7, 0xb9,
-	0x29, 0xfc, 0x42, 0xba, 0xd2, 0xb6, 0xb7, 0xb9, 0x89, 0x19, 0x84, 0x5f, 0x14, 0x15, 0x6e, 0x8b,
-	0x8d, 0x81, 0x71, 0x51, 0x54, 0xb8, 0x8d, 0x19, 0x84, 0x7e, 0xa5, 0x20, 0x8c, 0x5a, 0x8e, 0xef,
-	0xbd, 0x46, 0x1a, 0x8a, 0x8b, 0xd8, 0x10, 0xa8, 0xaf, 0x74, 0xb3, 0x17, 0x05, 0xe7, 0x3d, 0x47,
-	0x27, 0x74, 0x3b, 0x22, 0x0d, 0xcf, 0x4d, 0x4c, 0x6a, 0x90, 0x9e, 0xd0, 0x6b, 0x3d, 0x18, 0x38,
-	0xe7, 0x29, 0x34, 0x07, 0xa7, 0x64, 0x99, 0x1b, 0x59, 0x1a, 0x72, 0x34, 0x5d, 0x5f, 0x0e, 0xa7,
-	0xc1, 0x38, 0x8b, 0x4f, 0x25, 0x56, 0x4b, 0x14, 0xac, 0x65, 0xfb, 0x07, 0x43, 0x62, 0xc9, 0x42,
-	0xb6, 0x58, 0x61, 0xd8, 0x9f, 0x2c, 0x53, 0x0d, 0xdb, 0xa7, 0x2e, 0xf4, 0x89, 0xc5, 0x59, 0xa7,
-	0x67, 0xe4, 0xd0, 0x00, 0x33, 0xf2, 0xdd, 0x30, 0x76, 0x37, 0x0e, 0x03, 0x15, 0xc3, 0x5c, 0xe9,
-	0x1b, 0xc3, 0x6c, 0x60, 0xe5, 0xc7, 0x30, 0x0f, 0x17, 0x15, 0xc3, 0x3c, 0xf2, 0x90, 0x31, 0xcc,
-	0xff, 0xa6, 0x02, 0xea, 0x26, 0xd0, 0x9b, 0x24, 0xb9, 0x17, 0x46, 0xdb, 0x5e, 0xd0, 0x64, 0x25,
-	0x5b, 0xbe, 0x6c, 0xc9, 0xaa, 0x2f, 0xcb, 0x66, 0x6e, 0xef, 0x66, 0x41, 0xb7, 0x39, 0xa6, 0x98,
-	0xcd, 0xac, 0x1b, 0x8c, 0x78, 0x2c, 0x4c, 0xa6, 0xba, 0x8c, 0x70, 0xf3, 0xa7, 0x7a, 0x84, 0xbe,
-	0x1b, 0x40, 0x3a, 0xd1, 0x37, 0xa5, 0x04, 0x5e, 0x2a, 0xa6, 0x7f, 0x98, 0x6c, 0x6a, 0xfb, 0x76,
-	0x5d, 0x31, 0xc1, 0x06, 0x43, 0xf4, 0x59, 0x9d, 0xf7, 0xcc, 0x93, 0x9d, 0x3e, 0x7a, 0x2c, 0x63,
-	0x33, 0x48, 0xd6, 0x33, 0x86, 0x11, 0x2f, 0x68, 0xd2, 0x79, 0x22, 0x62, 0x3d, 0xdf, 0x9a, 0x57,
-	0x11, 0x6c, 0x39, 0x74, 0x1a, 0xf3, 0x8e, 0xef, 0x04, 0x2e, 0x89, 0x96, 0x38, 0xba, 0xde, 0x18,
-	0x89, 0x06, 0x2c, 0x09, 0xf5, 0x5c, 0x57, 0x5a, 0x19, 0xe4, 0xba, 0xd2, 0x0b, 0xdf, 0x0e, 0x93,
-	0x3d, 0x1f, 0xf3, 0x50, 0x49, 0xce, 0x47, 0xa8, 0x05, 0xf6, 0x4b, 0xc3, 0x5a, 0x69, 0xdd, 0x0c,
-	0x1b, 0xfc, 0xf6, 0xcb, 0x48, 0x7f, 0x51, 0x61, 0xbf, 0x16, 0x38, 0x45, 0x94, 0x9a, 0x31, 0x1a,
-	0xb1, 0xc9, 0x92, 0xce, 0xd1, 0xb6, 0x13, 0x91, 0xe0, 0xb8, 0xe7, 0xe8, 0x9a, 0x62, 0x82, 0x0d,
-	0x86, 0x68, 0x2b, 0x95, 0x8d, 0x77, 0xe5, 0xe8, 0xd9, 0x78, 0xac, 0x3e, 0x6b, 0xde, 0x25, 0x71,
-	0xaf, 0x5b, 0x30, 0x11, 0xa4, 0x66, 0x6e, 0x31, 0x01, 0xf8, 0xf9, 0xab, 0x82, 0x5f, 0x24, 0x9d,
-	0x6e, 0xc3, 0x19, 0xfe, 0x79, 0x2a, 0xad, 0x72, 0x48, 0x95, 0xa6, 0x6f, 0xdf, 0x1d, 0xee, 0x77,
-	0xfb, 0x2e, 0x0a, 0xd4, 0xb5, 0xe8, 0x23, 0x45, 0xd4, 0x34, 0x49, 0xdd, 0x89, 0x0e, 0x39, 0xf7,
-	0xa1, 0xdf, 0x31, 0x93, 0x75, 0x0f, 0x7f, 0x3d, 0xf6, 0x78, 0xbf, 0xa4, 0x5e, 0xfb, 0xff, 0x0e,
-	0xc1, 0x69, 0x39, 0x22, 0x32, 0x79, 0x87, 0xea, 0x47, 0xce, 0x57, 0xdb, 0xca, 0x4a, 0x3f, 0x5e,
-	0x93, 0x00, 0xac, 0x71, 0xa8, 0x3d, 0xd6, 0x89, 0xc9, 0x6a, 0x9b, 0x04, 0xcb, 0xde, 0x46, 0x2c,
-	0x0e, 0xcc, 0xd5, 0x42, 0xb9, 0xa5, 0x41, 0xd8, 0xc4, 0x63, 0x19, 0xc5, 0xae, 0x59, 0xd6, 0x43,
-	0x67, 0x14, 0x0b, 0x43, 0x55, 0xc2, 0xd1, 0x8f, 0xe5, 0x5e, 0x54, 0x51, 0x4c, 0xca, 0x6b, 0x4f,
-	0xce, 0xd2, 0xe1, 0x6e, 0xa8, 0x40, 0xff, 0xc8, 0x82, 0x73, 0xbc, 0x55, 0x8e, 0xe4, 0xad, 0x76,
-	0xc3, 0x49, 0x48, 0x5c, 0xcc, 0x05, 0x63, 0x39, 0xfd, 0xd3, 0x7e, 0xef, 0x3c, 0xb6, 0x38, 0xbf,
-	0x37, 0xe8, 0x8b, 0x16, 0x9c, 0xda, 0x4e, 0x95, 0xe5, 0x92, 0xaa, 0xe3, 0xa8, 0x35, 0x6b, 0x52,
-	0x44, 0xf5, 0x52, 0x4b, 0xb7, 0xc7, 0x38, 0xcb, 0xdd, 0xfe, 0x0b, 0x0b, 0x4c, 0x31, 0x7a, 0xf2,
-	0xd5, 0xbc, 0x0e, 0x6f, 0x0a, 0x4a, 0xeb, 0xb2, 0xd2, 0xd7, 0xba, 0x7c, 0x06, 0xca, 0x1d, 0xaf,
-	0x21, 0xf6, 0x17, 0xfa, 0x88, 0x7e, 0x69, 0x11, 0xd3, 0x76, 0xfb, 0x4f, 0x2a, 0xda, 0x27, 0x21,
-	0x32, 0x4a, 0xff, 0x4a, 0xbc, 0xf6, 0xa6, 0x2a, 0xd3, 0xcb, 0xdf, 0xfc, 0x66, 0x4f, 0x99, 0xde,
-	0x6f, 0x3d, 0x7c, 0xc2, 0x30, 0x1f, 0xa0, 0x7e, 0x55, 0x7a, 0x47, 0x0e, 0xc8, 0x16, 0xbe, 0x0b,
-	0x55, 0xba, 0x05, 0x63, 0xce, 0xc5, 0x6a, 0xaa, 0x53, 0xd5, 0x6b, 0xa2, 0xfd, 0xc1, 0xde, 0xf4,
-	0xb7, 0x1c, 0xbe, 0x5b, 0xf2, 0x69, 0xac, 0xe8, 0xa3, 0x18, 0x6a, 0xf4, 0x37, 0x4b, 0x6c, 0x16,
-	0x9b, 0xbb, 0x5b, 0x4a, 0x66, 0x4a, 0x40, 0x21, 0x59, 0xd3, 0x9a, 0x0f, 0x0a, 0xa0, 0x46, 0x11,
-	0x39, 0x53, 0xbe, 0x07, 0x5c, 0x53, 0xe9, 0xc5, 0x12, 0xf0, 0x60, 0x6f, 0xfa, 0xe5, 0xc3, 0x33,
-	0x55, 0x8f, 0x63, 0xcd, 0xc2, 0x50, 0x8d, 0xa3, 0x7d, 0x2f, 0xa6, 0xff, 0x7f, 0x43, 0x7a, 0x7e,
-	0x8b, 0x0a, 0xce, 0x7f, 0x25, 0xe6, 0xf7, 0x4b, 0x99, 0xf9, 0x7d, 0xb1, 0x67, 0x7e, 0x4f, 0xd0,
-	0x31, 0xcb, 0xa9, 0x2b, 0x7d, 0xd2, 0xc6, 0xc2, 0xc1, 0x3e, 0x09, 0x66, 0x25, 0xbd, 0xda, 0xf1,
-	0x22, 0x12, 0xaf, 0x45, 0x9d, 0xc0, 0x0b, 0x9a, 0x6c, 0xca, 0x56, 0x4d, 0x2b, 0x29, 0x05, 0xc6,
-	0x59, 0x7c, 0xba, 0xf1, 0xa7, 0xf3, 0xe2, 0x8e, 0xb3, 0xc3, 0x67, 0x9e, 0x51, 0x3d, 0xb3, 0x2e,
-	0xda, 0xb1, 0xc2, 0x40, 0x5b, 0xf0, 0xb4, 0x24, 0xb0, 0x48, 0x7c, 0x42, 0x5f, 0x88, 0x85, 0x1e,
-	0x46, 0x2d, 0x9e, 0x18, 0xc0, 0xa3, 0x47, 0xbe, 0x5e, 0x50, 0x78, 0x1a, 0xef, 0x83, 0x8b, 0xf7,
-	0xa5, 0x64, 0xff, 0x21, 0x0b, 0x36, 0x30, 0xea, 0x3b, 0xd0, 0xd9, 0xe7, 0x7b, 0x2d, 0x4f, 0x16,
-	0xf9, 0x54, 0xb3, 0x6f, 0x99, 0x36, 0x62, 0x0e, 0x43, 0xf7, 0x60, 0x64, 0x83, 0x5f, 0x58, 0x5f,
-	0xcc, 0xe5, 0x4c, 0xe2, 0xf6, 0x7b, 0x56, 0xe0, 0x5b, 0x5e, 0x85, 0xff, 0x40, 0xff, 0xc4, 0x92,
-	0x1b, 0xbf, 0x16, 0x82, 0xdd, 0xf5, 0x2c, 0x1c, 0x77, 0xc6, 0xb5, 0x10, 0xfc, 0x0a, 0x68, 0x09,
-	0xb7, 0x7f, 0xb7, 0x02, 0xa7, 0x64, 0xec, 0xd8, 0x35, 0x2f, 0x66, 0xe1, 0x06, 0xe6, 0x05, 0x09,
-	0xa5, 0x03, 0x2f, 0x48, 0xf8, 0x30, 0x40, 0x83, 0xb4, 0xfd, 0xb0, 0xcb, 0xec, 0xc8, 0xa1, 0x43,
-	0xdb, 0x91, 0x6a, 0xeb, 0xb1, 0xa8, 0xa8, 0x60, 0x83, 0xa2, 0x28, 0x82, 0xca, 0xef, 0x5b, 0xc8,
-	0x14, 0x41, 0x35, 0x6e, 0x7b, 0x1b, 0x3e, 0xd9, 0xdb, 0xde, 0x3c, 0x38, 0xc5, 0xbb, 0xa8, 0x0a,
-	0x2e, 0x3c, 0x44, 0x5d, 0x05, 0x96, 0xb2, 0xb6, 0x98, 0x26, 0x83, 0xb3, 0x74, 0xcd, 0xab, 0xdc,
-	0xaa, 0x27, 0x7d, 0x95, 0xdb, 0xdb, 0xa1, 0x26, 0xbf, 0x73, 0x3c, 0x55, 0xd3, 0xc5, 0x80, 0xe4,
-	0x34, 0x88, 0xb1, 0x86, 0xf7, 0xd4, 0x8e, 0x81, 0x47, 0x55, 0x3b, 0xc6, 0x7e, 0xbd, 0x4c, 0x37,
-	0x20, 0xbc, 0x5f, 0x87, 0xbe, 0x09, 0xf1, 0x9a, 0x71, 0x13, 0xe2, 0xe1, 0xbe, 0x67, 0x35, 0x73,
-	0x63, 0xe2, 0xd3, 0x30, 0x94, 0x38, 0x4d, 0x99, 0x61, 0xcb, 0xa0, 0xeb, 0x4e, 0x33, 0xc6, 0xac,
-	0xf5, 0x30, 0x35, 0xa3, 0x5f, 0x86, 0xf1, 0xd8, 0x6b, 0x06, 0x4e, 0xd2, 0x89, 0x88, 0x71, 0xee,
-	0xa8, 0x23, 0x70, 0x4c, 0x20, 0x4e, 0xe3, 0xa2, 0x4f, 0x59, 0x00, 0x11, 0x51, 0xdb, 0x9b, 0xe1,
-	0x22, 0xe6, 0x90, 0x12, 0x03, 0x92, 0xae, 0x59, 0xf3, 0x43, 0x6d, 0x6b, 0x0c, 0xb6, 0xf6, 0x67,
-	0x2c, 0x98, 0xec, 0x79, 0x0a, 0xb5, 0x61, 0xd8, 0x65, 0xf7, 0x55, 0x16, 0x53, 0xe7, 0x32, 0x7d,
-	0xf7, 0x25, 0xd7, 0x63, 0xbc, 0x0d, 0x0b, 0x3e, 0xf6, 0x2f, 0x8f, 0xc1, 0xd9, 0xfa, 0xc2, 0x8a,
-	0xbc, 0xe5, 0xe8, 0xd8, 0x52, 0x86, 0xf3, 0x78, 0x9c, 0x5c, 0xca, 0x70, 0x1f, 0xee, 0xbe, 0x91,
-	0x32, 0xec, 0x1b, 0x29, 0xc3, 0xe9, 0xfc, 0xcd, 0x72, 0x11, 0xf9, 0x9b, 0x79, 0x3d, 0x18, 0x24,
-	0x7f, 0xf3, 0xd8, 0x72, 0x88, 0xf7, 0xed, 0xd0, 0xa1, 0x72, 0x88, 0x55, 0x82, 0x75, 0x21, 0xe9,
-	0x62, 0x7d, 0x3e, 0x55, 0x6e, 0x82, 0xb5, 0x4a, 0x6e, 0xe5, 0xa9, 0x90, 0x42, 0xe9, 0xbd, 0x52,
-	0x7c, 0x07, 0x06, 0x48, 0x6e, 0x15, 0xd9, 0x98, 0x66, 0x42, 0xf5, 0x48, 0x11, 0x09, 0xd5, 0x79,
-	0xdd, 0x39, 0x30, 0xa1, 0xfa, 0x65, 0x18, 0x77, 0xfd, 0x30, 0x20, 0x6b, 0x51, 0x98, 0x84, 0x6e,
-	0x28, 0x6f, 0x2a, 0xd7, 0x17, 0x3d, 0x9a, 0x40, 0x9c, 0xc6, 0xed, 0x97, 0x8d, 0x5d, 0x3b, 0x6a,
-	0x36, 0x36, 0x3c, 0xa2, 0x6c, 0x6c, 0x23, 0xdf, 0x78, 0xb4, 0x88, 0x7c, 0xe3, 0xbc, 0x2f, 0x32,
-	0x50, 0xbe, 0xf1, 0x1b, 0xfc, 0xa2, 0x7e, 0xba, 0x6f, 0xe1, 0x52, 0x98, 0x9d, 0xe6, 0x8d, 0x5e,
-	0xfa, 0xc8, 0x31, 0x4c, 0xd8, 0x3b, 0x75, 0xcd, 0x46, 0x5d, 0xde, 0xaf, 0x9b, 0x70, 0xba, 0x23,
-	0x47, 0xc9, 0x51, 0xfe, 0x52, 0x09, 0xbe, 0xee, 0xc0, 0x2e, 0xa0, 0x7b, 0x00, 0x89, 0xd3, 0x14,
-	0x13, 0x55, 0x9c, 0x79, 0x1d, 0x31, 0x68, 0x78, 0x5d, 0xd2, 0x13, 0xf9, 0x73, 0x8a, 0x3c, 0x36,
-	0x58, 0xb1, 0x58, 0xe1, 0xd0, 0xef, 0x29, 0x51, 0x8d, 0x43, 0x9f, 0x60, 0x06, 0xa1, 0x86, 0x50,
-	0x44, 0x9a, 0xd4, 0xb8, 0x2f, 0xa7, 0x0d, 0x21, 0xcc, 0x5a, 0xb1, 0x80, 0xa2, 0x17, 0x61, 0xd4,
-	0xf1, 0x7d, 0x9e, 0xcb, 0x47, 0x62, 0x71, 0x03, 0xab, 0x2e, 0x4c, 0xab, 0x41, 0xd8, 0xc4, 0xb3,
-	0xff, 0xbc, 0x04, 0xd3, 0x07, 0xc8, 0x94, 0x9e, 0x1c, 0xee, 0xca, 0xc0, 0x39, 0xdc, 0x22, 0x17,
-	0x69, 0xb8, 0x4f, 0x2e, 0xd2, 0x8b, 0x30, 0x9a, 0x10, 0xa7, 0x25, 0xc2, 0x0c, 0xb3, 0xf5, 0x16,
-	0xd7, 0x35, 0x08, 0x9b, 0x78, 0x54, 0x8a, 0x4d, 0x38, 0xae, 0x4b, 0xe2, 0x58, 0x26, 0x1b, 0x09,
-	0x87, 0x78, 0x61, 0x99, 0x4c, 0xec, 0x9c, 0x61, 0x2e, 0xc5, 0x02, 0x67, 0x58, 0x66, 0x07, 0xbc,
-	0x36, 0xe0, 0x80, 0xff, 0x64, 0x09, 0x9e, 0xd9, 0x57, 0xbb, 0x0d, 0x9c, 0x07, 0xd6, 0x89, 0x49,
-	0x94, 0x9d, 0x38, 0xb7, 0x62, 0x12, 0x61, 0x06, 0xe1, 0xa3, 0xd4, 0x6e, 0xab, 0x10, 0xf1, 0xe2,
-	0x13, 0x27, 0xf9, 0x28, 0xa5, 0x58, 0xe0, 0x0c, 0xcb, 0x87, 0x9d, 0x96, 0xbf, 0x3b, 0x04, 0xcf,
-	0x0d, 0x60, 0x03, 0x14, 0x98, 0x60, 0x9a, 0x4e, 0x9e, 0x2e, 0x3f, 0xa2, 0xe4, 0xe9, 0x87, 0x1b,
-	0xae, 0x37, 0x73, 0xae, 0x07, 0x4a, 0x64, 0xfd, 0xe9, 0x12, 0x5c, 0xe8, 0x6f, 0xb0, 0xa0, 0x6f,
-	0x83, 0x53, 0x91, 0x0a, 0x25, 0x34, 0xf3, 0xae, 0xcf, 0x70, 0x77, 0x58, 0x0a, 0x84, 0xb3, 0xb8,
-	0x68, 0x06, 0xa0, 0xed, 0x24, 0x5b, 0xf1, 0xe5, 0x5d, 0x2f, 0x4e, 0x44, 0xa1, 0xba, 0x09, 0x7e,
-	0x48, 0x2b, 0x5b, 0xb1, 0x81, 0x41, 0xd9, 0xb1, 0x7f, 0x8b, 0xe1, 0xcd, 0x30, 0xe1, 0x0f, 0xf1,
-	0xad, 0xe7, 0x19, 0x79, 0xad, 0xa3, 0x01, 0xc2, 0x59, 0x5c, 0xca, 0x8e, 0x85, 0x01, 0xf0, 0x8e,
-	0x0e, 0xe9, 0x4c, 0xed, 0x65, 0xd5, 0x8a, 0x0d, 0x8c, 0x6c, 0x46, 0x79, 0xe5, 0xe0, 0x8c, 0x72,
-	0xfb, 0x5f, 0x96, 0xe0, 0x7c, 0x5f, 0x83, 0x77, 0x30, 0x31, 0xf5, 0xf8, 0x65, 0x75, 0x3f, 0xe4,
-	0x0a, 0x3b, 0x54, 0x36, 0xb0, 0xfd, 0xc7, 0x7d, 0x66, 0x9a, 0xc8, 0xf4, 0x7d, 0xf8, 0xa2, 0x28,
-	0x8f, 0xdf, 0x78, 0xf6, 0x24, 0xf7, 0x0e, 0x1d, 0x22, 0xb9, 0x37, 0xf3, 0x31, 0x2a, 0x03, 0x6a,
-	0x87, 0xff, 0x3a, 0xd4, 0x77, 0x78, 0xe9, 0x06, 0x79, 0xa0, 0xc3, 0x86, 0x45, 0x38, 0xed, 0x05,
-	0xec, 0xa2, 0xde, 0x7a, 0x67, 0x43, 0xd4, 0x2e, 0xe3, 0x05, 0x7a, 0x55, 0x6a, 0xcd, 0x52, 0x06,
-	0x8e, 0x7b, 0x9e, 0x78, 0x0c, 0x93, 0xad, 0x1f, 0x6e, 0x48, 0x0f, 0x29, 0xb9, 0x57, 0xe1, 0x9c,
-	0x1c, 0x8a, 0x2d, 0x27, 0x22, 0x0d, 0xa1, 0x6c, 0x63, 0x91, 0x4c, 0x75, 0x9e, 0x27, 0x64, 0xe5,
-	0x20, 0xe0, 0xfc, 0xe7, 0xd8, 0xad, 0xaa, 0x61, 0xdb, 0x73, 0xc5, 0x56, 0x50, 0xdf, 0xaa, 0x4a,
-	0x1b, 0x31, 0x87, 0x69, 0x7d, 0x51, 0x3b, 0x19, 0x7d, 0xf1, 0x61, 0xa8, 0xa9, 0xf1, 0xe6, 0xb9,
-	0x10, 0x6a, 0x92, 0xf7, 0xe4, 0x42, 0xa8, 0x19, 0x6e, 0x60, 0xd1, 0xd9, 0x41, 0x37, 0x2a, 0x99,
-	0xd5, 0x4a, 0xf9, 0xd1, 0x76, 0xfb, 0x5d, 0x30, 0xa6, 0x7c, 0x81, 0x83, 0xde, 0x6d, 0x6b, 0xff,
-	0x65, 0x09, 0x32, 0xd7, 0xb8, 0xa1, 0x5d, 0xa8, 0x35, 0xa2, 0x2e, 0x6f, 0x2c, 0xa6, 0x40, 0xf4,
-	0xa2, 0x24, 0xa7, 0xcf, 0xcc, 0x54, 0x13, 0xd6, 0xcc, 0xd0, 0xc7, 0x78, 0x2d, 0x66, 0xc1, 0xba,
-	0x54, 0x44, 0xc2, 0x7d, 0x5d, 0xd1, 0x33, 0x2f, 0xaf, 0x94, 0x6d, 0xd8, 0xe0, 0x87, 0x12, 0xa8,
-	0x6d, 0xc9, 0xeb, 0xea, 0x8a, 0x11, 0x77, 0xea, 0xf6, 0x3b, 0x6e, 0xa2, 0xa9, 0xbf, 0x58, 0x33,
-	0xb2, 0xff, 0xa8, 0x04, 0x67, 0xd3, 0x1f, 0x40, 0x9c, 0x71, 0xfe, 0x8c, 0x05, 0x4f, 0xfa, 0x4e,
-	0x9c, 0xd4, 0x3b, 0x6c, 0xa3, 0xb0, 0xd9, 0xf1, 0x57, 0x33, 0x65, 0xbb, 0x8f, 0xea, 0x6c, 0x51,
-	0x84, 0xb3, 0xd7, 0x1b, 0xce, 0x3f, 0x75, 0x7f, 0x6f, 0xfa, 0xc9, 0xe5, 0x7c, 0xe6, 0xb8, 0x5f,
-	0xaf, 0xd0, 0xeb, 0x16, 0x9c, 0x76, 0x3b, 0x51, 0x44, 0x82, 0x44, 0x77, 0x95, 0x7f, 0xc5, 0x9b,
-	0x85, 0x0c, 0xa4, 0xee, 0xe0, 0x59, 0x2a, 0x50, 0x17, 0x32, 0xbc, 0x70, 0x0f, 0x77, 0xfb, 0x07,
-	0xa9, 0xe6, 0xec, 0xfb, 0x9e, 0x7f, 0xcd, 0xee, 0x63, 0xfc, 0xd3, 0x61, 0x18, 0x4f, 0xd5, 0x26,
-	0x4f, 0x1d, 0xf6, 0x59, 0x07, 0x1e, 0xf6, 0xb1, 0xf4, 0xbf, 0x4e, 0x20, 0xaf, 0xaa, 0x37, 0xd2,
-	0xff, 0x3a, 0x01, 0xc1, 0x1c, 0x26, 0x86, 0x14, 0x77, 0x02, 0x71, 0xfa, 0x68, 0x0e, 0x29, 0xee,
-	0x04, 0x58, 0x40, 0xd1, 0x27, 0x2c, 0x18, 0x63, 0x8b, 0x4f, 0x9c, 0xaa, 0x0a, 0x85, 0x76, 0xbd,
-	0x80, 0xe5, 0x2e, 0xeb, 0xf0, 0xb3, 0x30, 0x53, 0xb3, 0x05, 0xa7, 0x38, 0xa2, 0x4f, 0x5b, 0x50,
-	0x53, 0xf7, 0xe2, 0x8a, 0xb3, 0x91, 0x7a, 0xb1, 0xa5, 0xdf, 0x33, 0x52, 0x4f, 0xd5, 0xe0, 0xc6,
-	0x9a, 0x31, 0x8a, 0xd5, 0x39, 0xe6, 0xc8, 0xf1, 0x9c, 0x63, 0x42, 0xce, 0x19, 0xe6, 0xdb, 0xa1,
-	0xd6, 0x72, 0x02, 0x6f, 0x93, 0xc4, 0x09, 0x3f, 0x5a, 0x94, 0x37, 0x7d, 0xc8, 0x46, 0xac, 0xe1,
-	0xd4, 0xd8, 0x8f, 0xd9, 0x8b, 0x25, 0xc6, 0x59, 0x20, 0x33, 0xf6, 0xeb, 0xba, 0x19, 0x9b, 0x38,
-	0xe6, 0xc1, 0x25, 0x3c, 0xd2, 0x83, 0xcb, 0xd1, 0x03, 0x0e, 0x2e, 0xeb, 0x70, 0xce, 0xe9, 0x24,
-	0xe1, 0x35, 0xe2, 0xf8, 0x73, 0x49, 0x42, 0x5a, 0xed, 0x24, 0xe6, 0xe5, 0xec, 0xc7, 0x98, 0x0b,
-	0x58, 0x05, 0xc6, 0xd5, 0x89, 0xbf, 0xd9, 0x83, 0x84, 0xf3, 0x9f, 0xb5, 0xff, 0xb9, 0x05, 0xe7,
-	0x72, 0xa7, 0xc2, 0xe3, 0x9b, 0x92, 0x60, 0xff, 0x48, 0x05, 0xce, 0xe4, 0xdc, 0x5c, 0x80, 0xba,
-	0xe6, 0x22, 0xb1, 0x8a, 0x88, 0xee, 0x4b, 0x07, 0xab, 0xc9, 0x6f, 0x93, 0xb3, 0x32, 0x0e, 0x17,
-	0x8b, 0xa0, 0xe3, 0x01, 0xca, 0x27, 0x1b, 0x0f, 0x60, 0xcc, 0xf5, 0xa1, 0x47, 0x3a, 0xd7, 0x2b,
-	0x07, 0xcc, 0xf5, 0x9f, 0xb5, 0x60, 0xaa, 0xd5, 0xe7, 0x1a, 0x32, 0x71, 0x9e, 0x74, 0xfb, 0x78,
-	0x2e, 0x39, 0x9b, 0x7f, 0xfa, 0xfe, 0xde, 0x74, 0xdf, 0xdb, 0xdf, 0x70, 0xdf, 0x5e, 0xd9, 0x5f,
-	0x29, 0x03, 0xb3, 0xd7, 0x58, 0x75, 0xea, 0x2e, 0xfa, 0xb8, 0x79, 0x01, 0x8a, 0x55, 0xd4, 0x65,
-	0x1d, 0x9c, 0xb8, 0xba, 0x40, 0x85, 0x8f, 0x60, 0xde, 0x7d, 0x2a, 0x59, 0x49, 0x58, 0x1a, 0x40,
-	0x12, 0xfa, 0xf2, 0xa6, 0x99, 0x72, 0xf1, 0x37, 0xcd, 0xd4, 0xb2, 0xb7, 0xcc, 0xec, 0xff, 0x89,
-	0x87, 0x1e, 0xcb, 0x4f, 0xfc, 0xab, 0x16, 0x17, 0x3c, 0x99, 0xaf, 0xa0, 0xcd, 0x0d, 0x6b, 0x1f,
-	0x73, 0xe3, 0x05, 0xa8, 0xc6, 0x42, 0x32, 0x0b, 0xb3, 0x44, 0x47, 0x8d, 0x89, 0x76, 0xac, 0x30,
-	0xe8, 0xae, 0xcb, 0xf1, 0xfd, 0xf0, 0xde, 0xe5, 0x56, 0x3b, 0xe9, 0x0a, 0x03, 0x45, 0x6d, 0x0b,
-	0xe6, 0x14, 0x04, 0x1b, 0x58, 0xe8, 0x1b, 0x60, 0x84, 0x97, 0x91, 0x68, 0x08, 0xef, 0xce, 0x28,
-	0x5d, 0x88, 0xbc, 0xc8, 0x44, 0x03, 0x4b, 0x98, 0xbd, 0x05, 0xc6, 0xbe, 0xe2, 0xe1, 0x6f, 0xbb,
-	0x3e, 0xf8, 0x02, 0x4b, 0xfb, 0x1f, 0x94, 0x04, 0x2b, 0xbe, 0x4f, 0xd0, 0x61, 0x84, 0xd6, 0x21,
-	0xc3, 0x08, 0x3f, 0x06, 0xe0, 0x86, 0xad, 0x36, 0xdd, 0x39, 0xaf, 0x87, 0xc5, 0x6c, 0xb7, 0x16,
-	0x14, 0x3d, 0x3d, 0xae, 0xba, 0x0d, 0x1b, 0xfc, 0x52, 0xc2, 0xbd, 0x7c, 0xa0, 0x70, 0x4f, 0xc9,
-	0xb9, 0xa1, 0xfd, 0xe5, 0x9c, 0xfd, 0xe7, 0x16, 0xa4, 0xec, 0x3e, 0xd4, 0x86, 0x0a, 0xed, 0x6e,
-	0x57, 0x88, 0x8c, 0xd5, 0xe2, 0x8c, 0x4c, 0x2a, 0xab, 0xc5, 0x3a, 0x64, 0x3f, 0x31, 0x67, 0x84,
-	0x7c, 0x11, 0x32, 0x59, 0xc8, 0xf6, 0xc7, 0x64, 0x78, 0x2d, 0x0c, 0xb7, 0x79, 0x38, 0x91, 0x0e,
-	0xbf, 0xb4, 0x5f, 0x82, 0xc9, 0x9e, 0x4e, 0xb1, 0x1b, 0xb2, 0x43, 0xb9, 0x87, 0x37, 0xd6, 0x0f,
-	0xab, 0xe7, 0x80, 0x39, 0xcc, 0xfe, 0x69, 0x0b, 0x4e, 0x67, 0xc9, 0xa3, 0x37, 0x2c, 0x98, 0x8c,
-	0xb3, 0xf4, 0x8e, 0x6b, 0xec, 0x54, 0x6a, 0x44, 0x0f, 0x08, 0xf7, 0x76, 0xc2, 0xfe, 0x1f, 0x42,
-	0x1f, 0xdc, 0xf1, 0x82, 0x46, 0x78, 0x4f, 0x59, 0x4a, 0x56, 0x5f, 0x4b, 0x89, 0x0a, 0x08, 0x77,
-	0x8b, 0x34, 0x3a, 0x7e, 0x4f, 0x01, 0x89, 0xba, 0x68, 0xc7, 0x0a, 0x83, 0xe5, 0xcb, 0x77, 0xc4,
-	0xce, 0x35, 0x33, 0x29, 0x17, 0x45, 0x3b, 0x56, 0x18, 0xe8, 0xdd, 0x30, 0x66, 0xbc, 0xa4, 0x9c,
-	0x97, 0x6c, 0xdb, 0x61, 0xe8, 0xf0, 0x18, 0xa7, 0xb0, 0xd0, 0x0c, 0x80, 0xb2, 0xba, 0xa4, 0xce,
-	0x66, 0xae, 0x76, 0x25, 0x1a, 0x63, 0x6c, 0x60, 0xb0, 0xea, 0x14, 0x7e, 0x27, 0x66, 0x67, 0xc9,
-	0xc3, 0xfa, 0xbe, 0x86, 0x05, 0xd1, 0x86, 0x15, 0x94, 0x8a, 0xb7, 0x96, 0x13, 0x74, 0x1c, 0x9f,
-	0x8e, 0x90, 0x70, 0x9e, 0xa9, 0x65, 0xb8, 0xa2, 0x20, 0xd8, 0xc0, 0xa2, 0x6f, 0x9c, 0x78, 0x2d,
-	0xf2, 0x81, 0x30, 0x90, 0x21, 0xed, 0x3a, 0xbc, 0x40, 0xb4, 0x63, 0x85, 0x81, 0x5e, 0x82, 0x51,
-	0x27, 0x68, 0x70, 0x13, 0x31, 0x8c, 0xc4, 0x29, 0xa5, 0xda, 0x7f, 0xde, 0x8a, 0xc9, 0x9c, 0x86,
-	0x62, 0x13, 0x35, 0x7b, 0x59, 0x05, 0x0c, 0x78, 0x19, 0xde, 0x9f, 0x59, 0x70, 0x4a, 0xd7, 0x24,
-	0x62, 0x3e, 0xb6, 0x94, 0x73, 0xd1, 0x3a, 0xd0, 0xb9, 0x98, 0xae, 0x3a, 0x52, 0x1a, 0xa8, 0xea,
-	0x88, 0x59, 0x10, 0xa4, 0xbc, 0x6f, 0x41, 0x90, 0x6f, 0x80, 0x91, 0x6d, 0xd2, 0x35, 0x2a, 0x87,
-	0x30, 0xed, 0x70, 0x83, 0x37, 0x61, 0x09, 0x43, 0x36, 0x0c, 0xbb, 0x8e, 0x2a, 0x51, 0x38, 0x26,
-	0xa2, 0xd3, 0xe6, 0x18, 0x92, 0x80, 0xd8, 0xab, 0x50, 0x53, 0xc7, 0xfa, 0xd2, 0xd7, 0x67, 0xe5,
-	0xfb, 0xfa, 0x06, 0xba, 0x56, 0x7f, 0x7e, 0xe3, 0x37, 0xbe, 0xfa, 0xec, 0x5b, 0x7e, 0xe7, 0xab,
-	0xcf, 0xbe, 0xe5, 0x0f, 0xbf, 0xfa, 0xec, 0x5b, 0x3e, 0x71, 0xff, 0x59, 0xeb, 0x37, 0xee, 0x3f,
-	0x6b, 0xfd, 0xce, 0xfd, 0x67, 0xad, 0x3f, 0xbc, 0xff, 0xac, 0xf5, 0x95, 0xfb, 0xcf, 0x5a, 0xaf,
-	0xff, 0x97, 0x67, 0xdf, 0xf2, 0x81, 0xdc, 0x24, 0x0a, 0xfa, 0xe3, 0x1d, 0x6e, 0x63, 0x76, 0xe7,
-	0x5d, 0x2c, 0x8e, 0x9f, 0xae, 0xe7, 0x59, 0x63, 0x12, 0xcf, 0xca, 0xf5, 0xfc, 0xff, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x5a, 0xf6, 0xbf, 0xbd, 0x16, 0x02, 0x01, 0x00,
+	// 13696 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6b, 0x70, 0x25, 0xd9,
+	0x59, 0x98, 0xfb, 0x3e, 0xa4, 0x7b, 0x8f, 0x34, 0xd2, 0x4c, 0xef, 0xcc, 0xee, 0x9d, 0xd9, 0xf5,
+	0xce, 0xd0, 0x0b, 0xb6, 0x89, 0xb1, 0x06, 0xaf, 0x8d, 0xd9, 0xf0, 0xb0, 0xd1, 0x63, 0x1e, 0xda,
+	0x91, 0x46, 0xda, 0xef, 0x6a, 0x67, 0xfc, 0xb6, 0x5b, 0xf7, 0x1e, 0x49, 0xbd, 0xea, 0xdb, 0x7d,
+	0xb7, 0xbb, 0xaf, 0x66, 0xb4, 0x18, 0x1b, 0x03, 0x0e, 0x06, 0xf3, 0x70, 0x20, 0x95, 0x18, 0x12,
+	0xf3, 0x28, 0x48, 0x42, 0x55, 0x8a, 0x82, 0x84, 0x4a, 0x41, 0x05, 0x28, 0x2a, 0x90, 0xa2, 0x20,
+	0x21, 0x81, 0x10, 0x42, 0x08, 0x90, 0x09, 0xde, 0x24, 0x05, 0x45, 0x0a, 0xaa, 0xf2, 0xf8, 0x41,
+	0x6d, 0xa5, 0x52, 0xa9, 0xef, 0xbc, 0x4f, 0xdf, 0xbe, 0xd2, 0xd5, 0xa8, 0x35, 0x33, 0x76, 0xf6,
+	0x97, 0x74, 0xcf, 0xf7, 0xf5, 0xf7, 0x7d, 0x7d, 0xfa, 0x9c, 0xef, 0x9c, 0xf3, 0x9d, 0xef, 0x41,
+	0x56, 0xb6, 0x83, 0x6c, 0x67, 0xb0, 0x39, 0xd7, 0x89, 0x7b, 0x97, 0xfd, 0x64, 0x3b, 0xee, 0x27,
+	0xf1, 0x4b, 0xec, 0x9f, 0xb7, 0x75, 0xba, 0x97, 0xf7, 0xde, 0x71, 0xb9, 0xbf, 0xbb, 0x7d, 0xd9,
+	0xef, 0x07, 0xe9, 0x65, 0xbf, 0xdf, 0x0f, 0x83, 0x8e, 0x9f, 0x05, 0x71, 0x74, 0x79, 0xef, 0xed,
+	0x7e, 0xd8, 0xdf, 0xf1, 0xdf, 0x7e, 0x79, 0x9b, 0x46, 0x34, 0xf1, 0x33, 0xda, 0x9d, 0xeb, 0x27,
+	0x71, 0x16, 0xbb, 0xdf, 0xa0, 0xa9, 0xcd, 0x49, 0x6a, 0xec, 0x9f, 0x8f, 0x74, 0xba, 0x73, 0x7b,
+	0xef, 0x98, 0xeb, 0xef, 0x6e, 0xcf, 0x21, 0xb5, 0x39, 0x83, 0xda, 0x9c, 0xa4, 0x76, 0xe1, 0x6d,
+	0x86, 0x2c, 0xdb, 0xf1, 0x76, 0x7c, 0x99, 0x11, 0xdd, 0x1c, 0x6c, 0xb1, 0x5f, 0xec, 0x07, 0xfb,
+	0x8f, 0x33, 0xbb, 0xe0, 0xed, 0x3e, 0x97, 0xce, 0x05, 0x31, 0x8a, 0x77, 0xb9, 0x13, 0x27, 0xf4,
+	0xf2, 0xde, 0x90, 0x40, 0x17, 0xae, 0x6b, 0x1c, 0x7a, 0x37, 0xa3, 0x51, 0x1a, 0xc4, 0x51, 0xfa,
+	0x36, 0x14, 0x81, 0x26, 0x7b, 0x34, 0x31, 0x5f, 0xcf, 0x40, 0x28, 0xa2, 0xf4, 0x4e, 0x4d, 0xa9,
+	0xe7, 0x77, 0x76, 0x82, 0x88, 0x26, 0xfb, 0xfa, 0xf1, 0x1e, 0xcd, 0xfc, 0xa2, 0xa7, 0x2e, 0x8f,
+	0x7a, 0x2a, 0x19, 0x44, 0x59, 0xd0, 0xa3, 0x43, 0x0f, 0xbc, 0xeb, 0xb0, 0x07, 0xd2, 0xce, 0x0e,
+	0xed, 0xf9, 0x43, 0xcf, 0xbd, 0x63, 0xd4, 0x73, 0x83, 0x2c, 0x08, 0x2f, 0x07, 0x51, 0x96, 0x66,
+	0x49, 0xfe, 0x21, 0xef, 0xef, 0x39, 0xe4, 0xd4, 0xfc, 0xed, 0xf6, 0xfc, 0x20, 0xdb, 0x59, 0x8c,
+	0xa3, 0xad, 0x60, 0xdb, 0xfd, 0x1a, 0x32, 0xd5, 0x09, 0x07, 0x69, 0x46, 0x93, 0x9b, 0x7e, 0x8f,
+	0xb6, 0x9c, 0x4b, 0xce, 0x5b, 0x9a, 0x0b, 0x8f, 0xfd, 0xc6, 0xbd, 0x8b, 0x6f, 0x78, 0xf5, 0xde,
+	0xc5, 0xa9, 0x45, 0x0d, 0x02, 0x13, 0xcf, 0xfd, 0x4a, 0x32, 0x99, 0xc4, 0x21, 0x9d, 0x87, 0x9b,
+	0xad, 0x0a, 0x7b, 0x64, 0x56, 0x3c, 0x32, 0x09, 0xbc, 0x19, 0x24, 0x1c, 0x51, 0xfb, 0x49, 0xbc,
+	0x15, 0x84, 0xb4, 0x55, 0xb5, 0x51, 0xd7, 0x79, 0x33, 0x48, 0xb8, 0xf7, 0x43, 0x15, 0x32, 0x3b,
+	0xdf, 0xef, 0x5f, 0xa7, 0x7e, 0x98, 0xed, 0xb4, 0x33, 0x3f, 0x1b, 0xa4, 0xee, 0x36, 0x99, 0x48,
+	0xd9, 0x7f, 0x42, 0xb6, 0x35, 0xf1, 0xf4, 0x04, 0x87, 0xbf, 0x76, 0xef, 0xe2, 0x37, 0x16, 0x8d,
+	0xe8, 0xed, 0x20, 0x8b, 0xfb, 0xe9, 0xdb, 0x68, 0xb4, 0x1d, 0x44, 0x94, 0xf5, 0xcb, 0x0e, 0xa3,
+	0x3a, 0x67, 0x12, 0x5f, 0x8c, 0xbb, 0x14, 0x04, 0x79, 0x94, 0xb3, 0x47, 0xd3, 0xd4, 0xdf, 0xa6,
+	0xf9, 0x57, 0x5a, 0xe5, 0xcd, 0x20, 0xe1, 0x6e, 0x42, 0xdc, 0xd0, 0x4f, 0xb3, 0x8d, 0xc4, 0x8f,
+	0xd2, 0x00, 0x87, 0xf4, 0x46, 0xd0, 0xe3, 0x6f, 0x37, 0xf5, 0xec, 0x5f, 0x9b, 0xe3, 0x1f, 0x66,
+	0xce, 0xfc, 0x30, 0x7a, 0x1e, 0xe0, 0xb8, 0x99, 0xdb, 0x7b, 0xfb, 0x1c, 0x3e, 0xb1, 0xf0, 0xf8,
+	0xab, 0xf7, 0x2e, 0xba, 0x2b, 0x43, 0x94, 0xa0, 0x80, 0xba, 0xf7, 0xfb, 0x15, 0x42, 0xe6, 0xfb,
+	0xfd, 0xf5, 0x24, 0x7e, 0x89, 0x76, 0x32, 0xf7, 0xa3, 0xa4, 0x81, 0xa4, 0xba, 0x7e, 0xe6, 0xb3,
+	0x8e, 0x99, 0x7a, 0xf6, 0xab, 0xc7, 0x63, 0xbc, 0xb6, 0x89, 0xcf, 0xaf, 0xd2, 0xcc, 0x5f, 0x70,
+	0xc5, 0x0b, 0x12, 0xdd, 0x06, 0x8a, 0xaa, 0x1b, 0x91, 0x5a, 0xda, 0xa7, 0x1d, 0xd6, 0x19, 0x53,
+	0xcf, 0xae, 0xcc, 0x1d, 0x67, 0xa6, 0xcf, 0x69, 0xc9, 0xdb, 0x7d, 0xda, 0x59, 0x98, 0x16, 0x9c,
+	0x6b, 0xf8, 0x0b, 0x18, 0x1f, 0x77, 0x4f, 0x7d, 0x68, 0xde, 0x91, 0x37, 0x4b, 0xe3, 0xc8, 0xa8,
+	0x2e, 0xcc, 0xd8, 0x03, 0x47, 0x7e, 0x77, 0xef, 0x3f, 0x39, 0x64, 0x46, 0x23, 0xaf, 0x04, 0x69,
+	0xe6, 0x7e, 0x70, 0xa8, 0x73, 0xe7, 0xc6, 0xeb, 0x5c, 0x7c, 0x9a, 0x75, 0xed, 0x69, 0xc1, 0xac,
+	0x21, 0x5b, 0x8c, 0x8e, 0xed, 0x91, 0x7a, 0x90, 0xd1, 0x5e, 0xda, 0xaa, 0x5c, 0xaa, 0xbe, 0x65,
+	0xea, 0xd9, 0xeb, 0x65, 0xbd, 0xe7, 0xc2, 0x29, 0xc1, 0xb4, 0xbe, 0x8c, 0xe4, 0x81, 0x73, 0xf1,
+	0x7e, 0x6b, 0xc6, 0x7c, 0x3f, 0xec, 0x70, 0xf7, 0xed, 0x64, 0x2a, 0x8d, 0x07, 0x49, 0x87, 0x02,
+	0xed, 0xc7, 0x38, 0xb1, 0xaa, 0x38, 0xdc, 0x71, 0xc2, 0xb7, 0x75, 0x33, 0x98, 0x38, 0xee, 0xf7,
+	0x39, 0x64, 0xba, 0x4b, 0xd3, 0x2c, 0x88, 0x18, 0x7f, 0x29, 0xfc, 0xc6, 0xb1, 0x85, 0x97, 0x8d,
+	0x4b, 0x9a, 0xf8, 0xc2, 0x59, 0xf1, 0x22, 0xd3, 0x46, 0x63, 0x0a, 0x16, 0x7f, 0x54, 0x5c, 0x5d,
+	0x9a, 0x76, 0x92, 0xa0, 0x8f, 0xbf, 0x5b, 0x55, 0x5b, 0x71, 0x2d, 0x69, 0x10, 0x98, 0x78, 0x6e,
+	0x44, 0xea, 0xa8, 0x98, 0xd2, 0x56, 0x8d, 0xc9, 0xbf, 0x7c, 0x3c, 0xf9, 0x45, 0xa7, 0xa2, 0xce,
+	0xd3, 0xbd, 0x8f, 0xbf, 0x52, 0xe0, 0x6c, 0xdc, 0x7f, 0xe6, 0x90, 0x96, 0x50, 0x9c, 0x40, 0x79,
+	0x87, 0xde, 0xde, 0x09, 0x32, 0x1a, 0x06, 0x69, 0xd6, 0xaa, 0x33, 0x19, 0x3e, 0x78, 0x3c, 0x19,
+	0x16, 0x6d, 0xea, 0x40, 0xd3, 0x2c, 0x09, 0x3a, 0x88, 0x83, 0xc3, 0x60, 0xe1, 0x92, 0x10, 0xab,
+	0xb5, 0x38, 0x42, 0x0a, 0x18, 0x29, 0x9f, 0xfb, 0x83, 0x0e, 0xb9, 0x10, 0xf9, 0x3d, 0x9a, 0xf6,
+	0xfd, 0x0e, 0x95, 0xe0, 0x85, 0xd0, 0xef, 0xec, 0x32, 0xf1, 0x27, 0x98, 0xf8, 0x97, 0xc7, 0x9b,
+	0x1a, 0xd7, 0x92, 0x78, 0xd0, 0xbf, 0x11, 0x44, 0xdd, 0x05, 0x4f, 0x48, 0x74, 0xe1, 0xe6, 0x48,
+	0xd2, 0x70, 0x00, 0x5b, 0xf7, 0x27, 0x1c, 0x72, 0x26, 0x4e, 0xfa, 0x3b, 0x7e, 0x44, 0xbb, 0x12,
+	0x9a, 0xb6, 0x26, 0xd9, 0x3c, 0xfd, 0xf0, 0xf1, 0xfa, 0x72, 0x2d, 0x4f, 0x76, 0x35, 0x8e, 0x82,
+	0x2c, 0x4e, 0xda, 0x34, 0xcb, 0x82, 0x68, 0x3b, 0x5d, 0x38, 0xf7, 0xea, 0xbd, 0x8b, 0x67, 0x86,
+	0xb0, 0x60, 0x58, 0x1e, 0xf7, 0x9b, 0xc9, 0x54, 0xba, 0x1f, 0x75, 0x6e, 0x07, 0x51, 0x37, 0xbe,
+	0x93, 0xb6, 0x1a, 0x65, 0xcc, 0xf5, 0xb6, 0x22, 0x28, 0x66, 0xab, 0x66, 0x00, 0x26, 0xb7, 0xe2,
+	0x0f, 0xa7, 0xc7, 0x5d, 0xb3, 0xec, 0x0f, 0xa7, 0x07, 0xd3, 0x01, 0x6c, 0xdd, 0xef, 0x74, 0xc8,
+	0xa9, 0x34, 0xd8, 0x8e, 0xfc, 0x6c, 0x90, 0xd0, 0x1b, 0x74, 0x3f, 0x6d, 0x11, 0x26, 0xc8, 0xf3,
+	0xc7, 0xec, 0x15, 0x83, 0xe4, 0xc2, 0x39, 0x21, 0xe3, 0x29, 0xb3, 0x35, 0x05, 0x9b, 0x6f, 0xd1,
+	0xac, 0xd4, 0xc3, 0x7a, 0xea, 0x21, 0xce, 0x4a, 0x3d, 0x03, 0x46, 0xca, 0xe7, 0x7e, 0x13, 0x39,
+	0xcd, 0x9b, 0xd4, 0x67, 0x48, 0x5b, 0xd3, 0x4c, 0x85, 0x9f, 0x7d, 0xf5, 0xde, 0xc5, 0xd3, 0xed,
+	0x1c, 0x0c, 0x86, 0xb0, 0xdd, 0x97, 0xc9, 0xc5, 0x3e, 0x4d, 0x7a, 0x41, 0xb6, 0x16, 0x85, 0xfb,
+	0x72, 0x61, 0xe8, 0xc4, 0x7d, 0xda, 0x15, 0xe2, 0xa4, 0xad, 0x53, 0x97, 0x9c, 0xb7, 0x34, 0x16,
+	0xde, 0x2c, 0xc4, 0xbc, 0xb8, 0x7e, 0x30, 0x3a, 0x1c, 0x46, 0xcf, 0xfd, 0x75, 0x87, 0x5c, 0x30,
+	0xf4, 0x77, 0x9b, 0x26, 0x7b, 0x41, 0x87, 0xce, 0x77, 0x3a, 0xf1, 0x20, 0xca, 0xd2, 0xd6, 0x0c,
+	0xeb, 0xf3, 0xcd, 0x93, 0x58, 0x4d, 0x6c, 0x56, 0x7a, 0x10, 0x8f, 0x44, 0x49, 0xe1, 0x00, 0x49,
+	0xbd, 0xdf, 0xac, 0x90, 0xd3, 0xf9, 0xbd, 0x85, 0xfb, 0x0f, 0x1c, 0x32, 0xfb, 0xd2, 0x9d, 0x6c,
+	0x23, 0xde, 0xa5, 0x51, 0xba, 0xb0, 0x8f, 0x2b, 0x00, 0x5b, 0x55, 0xa7, 0x9e, 0xed, 0x94, 0xbb,
+	0x8b, 0x99, 0x7b, 0xde, 0xe6, 0x72, 0x25, 0xca, 0x92, 0xfd, 0x85, 0x27, 0xc4, 0x3b, 0xcd, 0x3e,
+	0x7f, 0x7b, 0xc3, 0x84, 0x42, 0x5e, 0xa8, 0x0b, 0x9f, 0x71, 0xc8, 0xd9, 0x22, 0x12, 0xee, 0x69,
+	0x52, 0xdd, 0xa5, 0xfb, 0x7c, 0x8f, 0x0d, 0xf8, 0xaf, 0xfb, 0x21, 0x52, 0xdf, 0xf3, 0xc3, 0x01,
+	0x15, 0x1b, 0xc0, 0x6b, 0xc7, 0x7b, 0x11, 0x25, 0x19, 0x70, 0xaa, 0x5f, 0x57, 0x79, 0xce, 0xf1,
+	0x7e, 0xbb, 0x4a, 0xa6, 0x8c, 0x8f, 0xf6, 0x00, 0x36, 0xb5, 0xb1, 0xb5, 0xa9, 0x5d, 0x2d, 0x6d,
+	0xbc, 0x8d, 0xdc, 0xd5, 0xde, 0xc9, 0xed, 0x6a, 0xd7, 0xca, 0x63, 0x79, 0xe0, 0xb6, 0xd6, 0xcd,
+	0x48, 0x33, 0xee, 0xd3, 0x84, 0xa1, 0xb6, 0x6a, 0x65, 0x7c, 0xc2, 0x35, 0x49, 0x6e, 0xe1, 0xd4,
+	0xab, 0xf7, 0x2e, 0x36, 0xd5, 0x4f, 0xd0, 0x8c, 0xbc, 0xff, 0xe0, 0x90, 0xb3, 0x86, 0x8c, 0x8b,
+	0x71, 0xd4, 0x65, 0x47, 0x18, 0xf7, 0x12, 0xa9, 0x65, 0xfb, 0x7d, 0x79, 0xc0, 0x54, 0x3d, 0xb5,
+	0xb1, 0xdf, 0xa7, 0xc0, 0x20, 0x8f, 0xfa, 0xf9, 0xeb, 0x25, 0x72, 0xce, 0xd2, 0x2f, 0x7d, 0x1a,
+	0x75, 0x69, 0xd4, 0xd9, 0xc7, 0x37, 0x8b, 0xfc, 0xde, 0xd0, 0x9b, 0xb1, 0x33, 0x33, 0x83, 0xb8,
+	0x97, 0x49, 0x53, 0xad, 0x8a, 0xe2, 0xdd, 0xce, 0x08, 0xb4, 0xa6, 0x5e, 0x4a, 0x35, 0x8e, 0xf7,
+	0x83, 0x0e, 0x79, 0xbc, 0x58, 0x99, 0xb9, 0x6f, 0x22, 0x13, 0xdc, 0x92, 0x21, 0xf8, 0xe9, 0xcf,
+	0xcf, 0x5a, 0x41, 0x40, 0x8f, 0xcc, 0x53, 0xbd, 0x46, 0x75, 0xd4, 0x6b, 0x78, 0xbf, 0xe7, 0x90,
+	0x2f, 0x1f, 0x47, 0xc5, 0x9e, 0x9c, 0x8c, 0x6d, 0x72, 0xae, 0x4b, 0xb7, 0xfc, 0x41, 0x98, 0xd9,
+	0x1c, 0x85, 0xd0, 0x6f, 0x14, 0x0f, 0x9f, 0x5b, 0x2a, 0x42, 0x82, 0xe2, 0x67, 0xbd, 0xff, 0xec,
+	0x90, 0x59, 0xe3, 0xb5, 0x1e, 0xc0, 0x01, 0x30, 0xb2, 0x0f, 0x80, 0xcb, 0xa5, 0xa9, 0x84, 0x11,
+	0x27, 0xc0, 0xef, 0x75, 0xc8, 0x05, 0x03, 0x6b, 0xd5, 0xcf, 0x3a, 0x3b, 0x57, 0xee, 0xf6, 0x13,
+	0x9a, 0xa6, 0x38, 0xa4, 0xde, 0x68, 0xa8, 0xfe, 0x85, 0x29, 0x41, 0xa1, 0x7a, 0x83, 0xee, 0xf3,
+	0x75, 0xe0, 0xab, 0x48, 0x83, 0xcf, 0xef, 0x38, 0x11, 0x1f, 0x49, 0xbd, 0xdb, 0x9a, 0x68, 0x07,
+	0x85, 0xe1, 0x7a, 0x64, 0x82, 0xe9, 0x77, 0xd4, 0x77, 0xb8, 0x25, 0x21, 0xf8, 0xdd, 0x6f, 0xb1,
+	0x16, 0x10, 0x10, 0x2f, 0xb5, 0xc4, 0x59, 0x4f, 0x28, 0x1b, 0x0f, 0xdd, 0xab, 0x01, 0x0d, 0xbb,
+	0x29, 0x1e, 0x4e, 0xfd, 0x28, 0x8a, 0x33, 0x71, 0xce, 0x34, 0x0e, 0xa7, 0xf3, 0xba, 0x19, 0x4c,
+	0x1c, 0x64, 0x1a, 0xfa, 0x9b, 0x34, 0xe4, 0x3d, 0x2a, 0x98, 0xae, 0xb0, 0x16, 0x10, 0x10, 0xef,
+	0xd5, 0x0a, 0x99, 0x31, 0xb8, 0xb6, 0xe9, 0x83, 0xb0, 0xa1, 0x24, 0xd6, 0x72, 0xb3, 0x5e, 0x9e,
+	0xee, 0xa7, 0xa3, 0xed, 0x28, 0xaf, 0xe4, 0x56, 0x1c, 0x28, 0x95, 0xeb, 0xc1, 0xb6, 0x94, 0xcf,
+	0x57, 0xc9, 0x45, 0xfb, 0x81, 0xa1, 0x05, 0x0b, 0x0f, 0xee, 0x06, 0xa3, 0xbc, 0xc5, 0xd1, 0xc0,
+	0x07, 0x13, 0x6f, 0x84, 0xce, 0xaf, 0x9c, 0xa4, 0xce, 0x37, 0x97, 0xa4, 0xea, 0x21, 0x4b, 0xd2,
+	0xa2, 0xea, 0xf5, 0x1a, 0xc3, 0x7c, 0xeb, 0x90, 0x99, 0xf2, 0xfc, 0x7a, 0x12, 0x6f, 0xb3, 0x39,
+	0xb7, 0x47, 0xf1, 0xe0, 0x56, 0x60, 0x82, 0xbc, 0x44, 0x6a, 0x69, 0x46, 0xfb, 0xad, 0xba, 0xad,
+	0x83, 0xdb, 0x19, 0xed, 0x03, 0x83, 0xb8, 0xdf, 0x48, 0x66, 0x33, 0x3f, 0xd9, 0xa6, 0x59, 0x42,
+	0xf7, 0x02, 0x66, 0xba, 0x66, 0xa7, 0xf0, 0xe6, 0xc2, 0x63, 0xb8, 0xfd, 0xdb, 0x60, 0x20, 0x90,
+	0x20, 0xc8, 0xe3, 0x7a, 0x7f, 0x5e, 0x21, 0x4f, 0xd8, 0xdf, 0x47, 0xaf, 0xd0, 0xef, 0xb1, 0x56,
+	0xe8, 0xb7, 0x9a, 0x2b, 0xf4, 0x6b, 0xf7, 0x2e, 0x3e, 0x39, 0xe2, 0xb1, 0x2f, 0x9a, 0x05, 0xdc,
+	0xbd, 0x96, 0xfb, 0x42, 0x97, 0x87, 0xbe, 0xd0, 0x1b, 0x47, 0xbc, 0x63, 0x6e, 0x67, 0xf5, 0x26,
+	0x32, 0x91, 0x50, 0x3f, 0x8d, 0x23, 0xf1, 0x9d, 0xd4, 0x64, 0x00, 0xd6, 0x0a, 0x02, 0xea, 0xfd,
+	0x6e, 0x33, 0xdf, 0xd9, 0xd7, 0xb8, 0x39, 0x3e, 0x4e, 0xdc, 0x80, 0xd4, 0xd8, 0x59, 0x93, 0xab,
+	0x9d, 0x1b, 0xc7, 0x9b, 0xa2, 0xb8, 0xc4, 0x28, 0xd2, 0x0b, 0x0d, 0xfc, 0x6a, 0xd8, 0x04, 0x8c,
+	0x85, 0x7b, 0x97, 0x34, 0x3a, 0xf2, 0x54, 0x57, 0x29, 0xc3, 0xb2, 0x2a, 0xce, 0x74, 0x9a, 0xe3,
+	0x34, 0xae, 0x05, 0xea, 0x28, 0xa8, 0xb8, 0xb9, 0x94, 0x54, 0xb7, 0x83, 0x4c, 0x7c, 0xd6, 0x63,
+	0x1e, 0xf2, 0xaf, 0x05, 0xc6, 0x2b, 0x4e, 0xe2, 0x02, 0x75, 0x2d, 0xc8, 0x00, 0xe9, 0xbb, 0x9f,
+	0x72, 0xc8, 0x54, 0xda, 0xe9, 0xad, 0x27, 0xf1, 0x5e, 0xd0, 0xa5, 0x49, 0xab, 0x56, 0x86, 0xda,
+	0x6b, 0x2f, 0xae, 0x4a, 0x82, 0x9a, 0x2f, 0x37, 0xba, 0x68, 0x08, 0x98, 0x7c, 0xf1, 0x10, 0xf8,
+	0x84, 0x78, 0xf7, 0x25, 0xda, 0x61, 0x33, 0x4e, 0x1e, 0xde, 0x5b, 0xf5, 0x32, 0x36, 0xff, 0x4b,
+	0x83, 0xce, 0x2e, 0xce, 0x37, 0x2d, 0xd0, 0x93, 0xaf, 0xde, 0xbb, 0xf8, 0xc4, 0x62, 0x31, 0x4f,
+	0x18, 0x25, 0x0c, 0xeb, 0xb0, 0xfe, 0x20, 0x0c, 0x81, 0xbe, 0x3c, 0xa0, 0xcc, 0x8e, 0x57, 0x42,
+	0x87, 0xad, 0x6b, 0x82, 0xb9, 0x0e, 0x33, 0x20, 0x60, 0xf2, 0x75, 0x5f, 0x26, 0x13, 0x3d, 0x3f,
+	0x4b, 0x82, 0xbb, 0xad, 0xc9, 0x32, 0x8e, 0x63, 0xab, 0x8c, 0x96, 0x66, 0xce, 0x76, 0x01, 0xbc,
+	0x11, 0x04, 0x23, 0xb4, 0xbd, 0xf7, 0x68, 0xb2, 0x4d, 0x5b, 0x8d, 0x32, 0x6e, 0x35, 0x56, 0x91,
+	0x94, 0x66, 0xd8, 0xc4, 0x9d, 0x17, 0x6b, 0x03, 0xce, 0xc5, 0xfd, 0x10, 0x69, 0xa4, 0x34, 0xa4,
+	0x1d, 0xdc, 0x3b, 0x35, 0x19, 0xc7, 0x77, 0x8c, 0xb9, 0x8f, 0xc4, 0x4d, 0x4b, 0x5b, 0x3c, 0xca,
+	0x27, 0x98, 0xfc, 0x05, 0x8a, 0x24, 0x76, 0x60, 0x3f, 0x1c, 0x6c, 0x07, 0x51, 0x8b, 0x94, 0xd1,
+	0x81, 0xeb, 0x8c, 0x56, 0xae, 0x03, 0x79, 0x23, 0x08, 0x46, 0xde, 0x7f, 0x73, 0x88, 0x6b, 0x2b,
+	0xb5, 0x07, 0xb0, 0x61, 0x7e, 0xd9, 0xde, 0x30, 0xaf, 0x94, 0xb9, 0xa3, 0x19, 0xb1, 0x67, 0xfe,
+	0xc5, 0x26, 0xc9, 0x2d, 0x07, 0x37, 0x69, 0x9a, 0xd1, 0xee, 0xeb, 0x2a, 0xfc, 0x75, 0x15, 0xfe,
+	0xba, 0x0a, 0x97, 0x3f, 0xdc, 0xcd, 0x9c, 0x0a, 0x7f, 0xb7, 0x31, 0xeb, 0xb5, 0x7b, 0xc5, 0x47,
+	0x94, 0xff, 0x85, 0x29, 0x81, 0x81, 0x80, 0x9a, 0xe0, 0xf9, 0xf6, 0xda, 0xcd, 0x42, 0x9d, 0xfd,
+	0x11, 0x5b, 0x67, 0x1f, 0x97, 0xc5, 0xff, 0x0f, 0x5a, 0xfa, 0xd7, 0x1d, 0xf2, 0x66, 0x5b, 0x7b,
+	0xc9, 0x91, 0xb3, 0xbc, 0x1d, 0xc5, 0x09, 0x5d, 0x0a, 0xb6, 0xb6, 0x68, 0x42, 0x23, 0xbc, 0x0c,
+	0x38, 0xdc, 0x7e, 0xf5, 0x4e, 0x32, 0xfd, 0x52, 0x1a, 0x47, 0xeb, 0x71, 0x10, 0x09, 0x15, 0x84,
+	0x27, 0x8e, 0xd3, 0x78, 0x41, 0x8b, 0x3d, 0x2a, 0xdb, 0xc1, 0xc2, 0x72, 0x17, 0xc9, 0x99, 0x97,
+	0x5e, 0x5e, 0xf7, 0x33, 0xc3, 0xd4, 0x20, 0x8d, 0x02, 0xec, 0x16, 0xed, 0xf9, 0x17, 0x72, 0x40,
+	0x18, 0xc6, 0xf7, 0xfe, 0x6e, 0x85, 0x9c, 0xcf, 0xbd, 0x48, 0x1c, 0x86, 0xf1, 0x20, 0xc3, 0x33,
+	0x91, 0xfb, 0x23, 0x0e, 0x39, 0xdd, 0xb3, 0xad, 0x19, 0xa9, 0xb0, 0xbb, 0xbf, 0xb7, 0xb4, 0x35,
+	0x22, 0x67, 0x2e, 0x59, 0x68, 0x89, 0x1e, 0x3a, 0x9d, 0x03, 0xa4, 0x30, 0x24, 0x8b, 0xfb, 0x21,
+	0xd2, 0xec, 0xf9, 0x77, 0x5f, 0xec, 0x77, 0xfd, 0x4c, 0x9e, 0x55, 0x47, 0x9b, 0x18, 0x06, 0x59,
+	0x10, 0xce, 0x71, 0xc7, 0x9d, 0xb9, 0xe5, 0x28, 0x5b, 0x4b, 0xda, 0x59, 0x12, 0x44, 0xdb, 0xdc,
+	0xda, 0xba, 0x2a, 0xc9, 0x80, 0xa6, 0xe8, 0x7d, 0xde, 0x21, 0x6f, 0x1c, 0xd1, 0x3b, 0x89, 0x9f,
+	0xd1, 0xed, 0x7d, 0xf7, 0x63, 0xa4, 0x8e, 0xe7, 0x46, 0xd9, 0x2b, 0xb7, 0xcb, 0x5c, 0x39, 0x8d,
+	0x2f, 0xa1, 0x17, 0x51, 0xfc, 0x95, 0x02, 0x67, 0xea, 0xfd, 0x48, 0x33, 0xbf, 0x59, 0x60, 0xee,
+	0x07, 0xcf, 0x12, 0xb2, 0x1d, 0x6f, 0xd0, 0x5e, 0x3f, 0xf4, 0x33, 0x3e, 0xee, 0x1a, 0xda, 0x8e,
+	0x72, 0x4d, 0x41, 0xc0, 0xc0, 0x72, 0xbf, 0xcb, 0x21, 0x64, 0x5b, 0x8e, 0x79, 0xb9, 0x11, 0x78,
+	0xb1, 0xcc, 0xd7, 0xd1, 0x33, 0x4a, 0xcb, 0xa2, 0x18, 0x82, 0xc1, 0xdc, 0xfd, 0x36, 0x87, 0x34,
+	0x32, 0x29, 0x3e, 0x5f, 0x1a, 0x37, 0xca, 0x94, 0x44, 0xbe, 0xb4, 0xde, 0x13, 0xa9, 0x2e, 0x51,
+	0x7c, 0xdd, 0xbf, 0xe1, 0x10, 0x82, 0x57, 0xbe, 0xeb, 0x71, 0x18, 0x74, 0xf6, 0xc5, 0x8a, 0x79,
+	0xab, 0x54, 0x5b, 0x8f, 0xa2, 0xbe, 0x30, 0x83, 0xbd, 0xa1, 0x7f, 0x83, 0xc1, 0xd9, 0xfd, 0x38,
+	0x69, 0xa4, 0x62, 0xb8, 0xb5, 0xea, 0xe5, 0x77, 0x86, 0x1c, 0xca, 0x42, 0xbd, 0x8a, 0x5f, 0xa0,
+	0x78, 0xba, 0x7f, 0xc7, 0x21, 0xb3, 0x7d, 0xdb, 0x86, 0x28, 0x96, 0xc3, 0xf2, 0x74, 0x40, 0xce,
+	0x46, 0xc9, 0xad, 0x2d, 0xb9, 0x46, 0xc8, 0x4b, 0x81, 0x1a, 0x50, 0x8f, 0xe0, 0xb5, 0x3e, 0xb7,
+	0x67, 0x4e, 0x6a, 0x0d, 0x78, 0x2d, 0x0f, 0x84, 0x61, 0x7c, 0x77, 0x9d, 0x9c, 0x45, 0xe9, 0xf6,
+	0xf9, 0xf6, 0x53, 0x2e, 0x2f, 0x29, 0x5b, 0x0c, 0x1b, 0x0b, 0x4f, 0x89, 0x11, 0x72, 0x76, 0xbe,
+	0x00, 0x07, 0x0a, 0x9f, 0x74, 0x7f, 0xdb, 0x21, 0x4f, 0x05, 0x6c, 0x19, 0x30, 0xad, 0xf9, 0x7a,
+	0x45, 0x10, 0xee, 0x01, 0xb4, 0x54, 0x5d, 0x31, 0x6a, 0xf9, 0x59, 0xf8, 0x72, 0xf1, 0x06, 0x4f,
+	0x2d, 0x1f, 0x20, 0x12, 0x1c, 0x28, 0xb0, 0xfb, 0xb5, 0xe4, 0x94, 0x9c, 0x17, 0xeb, 0xa8, 0x82,
+	0xd9, 0x42, 0xdb, 0x5c, 0x38, 0x83, 0x7e, 0x00, 0x1b, 0x26, 0x00, 0x6c, 0x3c, 0xef, 0xbb, 0x6b,
+	0xe4, 0x6c, 0x7e, 0xb8, 0x31, 0x1b, 0x0f, 0xaa, 0x9b, 0x8e, 0xb4, 0xff, 0x48, 0xed, 0x59, 0xaa,
+	0xba, 0x51, 0xd6, 0x25, 0xad, 0x6e, 0x54, 0x53, 0x0a, 0x06, 0x73, 0xdc, 0x94, 0x9e, 0xf1, 0xf3,
+	0x66, 0x54, 0xa1, 0x01, 0x3f, 0x54, 0xa6, 0x48, 0xc3, 0x97, 0x8b, 0xe7, 0x85, 0x68, 0x67, 0x86,
+	0x40, 0x30, 0x2c, 0x92, 0xfb, 0x2d, 0xa4, 0x99, 0x28, 0x7f, 0x9c, 0x6a, 0x19, 0x47, 0x35, 0x39,
+	0x6c, 0x84, 0x38, 0xea, 0x76, 0x48, 0x7b, 0xde, 0x68, 0x8e, 0xee, 0xbb, 0xc9, 0x8c, 0xfa, 0xb1,
+	0xc8, 0xae, 0x85, 0x50, 0x29, 0x56, 0x17, 0x1e, 0x17, 0x4f, 0xcd, 0x80, 0x05, 0x85, 0x1c, 0xb6,
+	0xf7, 0xe9, 0x0a, 0x79, 0x3c, 0x3f, 0x18, 0x84, 0x8e, 0x39, 0xfc, 0xf6, 0xf2, 0xfb, 0x1c, 0x32,
+	0x95, 0xc4, 0x61, 0x18, 0x44, 0xdb, 0xa8, 0x27, 0xc5, 0x62, 0xff, 0x81, 0x13, 0x59, 0x6f, 0x85,
+	0x42, 0x64, 0x3b, 0x73, 0xd0, 0x3c, 0xc1, 0x14, 0xc0, 0xfd, 0x7a, 0x72, 0xaa, 0x4b, 0x43, 0x8a,
+	0xcf, 0xae, 0x25, 0x78, 0xa6, 0xe2, 0x16, 0x6c, 0xe5, 0x1f, 0xb3, 0x64, 0x02, 0xc1, 0xc6, 0x45,
+	0x9f, 0xc8, 0xd6, 0xa8, 0xc5, 0xc0, 0xa5, 0xe4, 0x49, 0xa9, 0xe9, 0x54, 0x8f, 0xae, 0x45, 0x92,
+	0x9e, 0x58, 0xcf, 0x9f, 0x11, 0x7c, 0x9e, 0x5c, 0x1f, 0x8d, 0x0a, 0x07, 0xd1, 0x71, 0xdf, 0x4f,
+	0x4e, 0x1b, 0x9d, 0x92, 0xaa, 0x5e, 0x6d, 0x2e, 0xcc, 0xe1, 0xee, 0x6b, 0x3e, 0x07, 0x7b, 0xed,
+	0xde, 0xc5, 0xc7, 0xf3, 0x6d, 0x62, 0xb5, 0x1a, 0xa2, 0xe3, 0xfd, 0xe4, 0xd0, 0xa7, 0x56, 0x1b,
+	0x8d, 0xcf, 0x39, 0x43, 0xa6, 0x8c, 0xf7, 0x9e, 0xc4, 0xe2, 0xce, 0x8c, 0x1e, 0xca, 0x19, 0x65,
+	0x34, 0xce, 0x43, 0x74, 0x5e, 0xf0, 0x7e, 0xab, 0x46, 0x0e, 0x90, 0xec, 0x04, 0x6e, 0xbe, 0xdd,
+	0xef, 0x71, 0xd4, 0x55, 0x1e, 0x57, 0x20, 0xdd, 0x93, 0xea, 0x7b, 0x7e, 0x78, 0x4b, 0xb9, 0x03,
+	0x8d, 0x32, 0xe1, 0xdb, 0x97, 0x86, 0xee, 0x8f, 0x39, 0xf6, 0x65, 0x24, 0x77, 0x1a, 0x0d, 0x4e,
+	0x4c, 0x26, 0xe3, 0x86, 0x93, 0x0b, 0xa6, 0xef, 0xc5, 0x46, 0xdd, 0x7d, 0xce, 0x11, 0xb2, 0x15,
+	0x44, 0x7e, 0x18, 0xbc, 0x82, 0x47, 0xb3, 0x3a, 0xdb, 0x5d, 0xb0, 0xed, 0xda, 0x55, 0xd5, 0x0a,
+	0x06, 0xc6, 0x85, 0xbf, 0x4e, 0xa6, 0x8c, 0x37, 0x2f, 0xf0, 0xfb, 0x39, 0x6b, 0xfa, 0xfd, 0x34,
+	0x0d, 0x77, 0x9d, 0x0b, 0xef, 0x26, 0xa7, 0xf3, 0x02, 0x1e, 0xe5, 0x79, 0xef, 0xaf, 0x26, 0xf3,
+	0xb7, 0x83, 0x1b, 0x34, 0xe9, 0xa1, 0x68, 0xaf, 0x5b, 0xd5, 0x5e, 0xb7, 0xaa, 0xbd, 0x6e, 0x55,
+	0x33, 0x2f, 0x46, 0x84, 0xc5, 0x68, 0xf2, 0x01, 0x59, 0x8c, 0x2c, 0x1b, 0x58, 0xa3, 0x74, 0x1b,
+	0x98, 0xf7, 0xa9, 0xa1, 0x6b, 0x83, 0x8d, 0x84, 0x52, 0x37, 0x26, 0xf5, 0x28, 0xee, 0x52, 0xb9,
+	0xc1, 0x7e, 0xbe, 0x9c, 0xdd, 0xe2, 0xcd, 0xb8, 0x6b, 0xb8, 0xe3, 0xe3, 0xaf, 0x14, 0x38, 0x1f,
+	0xef, 0x3b, 0x26, 0x88, 0xb5, 0x97, 0xe5, 0xdf, 0x1d, 0xa3, 0x99, 0x68, 0x3f, 0x7e, 0x11, 0x56,
+	0x5a, 0x8e, 0x7d, 0x73, 0x0d, 0xbc, 0x19, 0x24, 0x1c, 0xd7, 0xbc, 0xbe, 0x9f, 0xed, 0xb4, 0x2a,
+	0xf6, 0x9a, 0x87, 0x76, 0x2b, 0x60, 0x10, 0xdc, 0x86, 0x66, 0xd6, 0x3d, 0xbc, 0xb8, 0x6f, 0x56,
+	0xdb, 0x50, 0xfb, 0x96, 0x1e, 0x72, 0xd8, 0xee, 0xcb, 0xa4, 0xb6, 0x43, 0xc3, 0x9e, 0xf8, 0xf4,
+	0xed, 0xf2, 0xd6, 0x1a, 0xf6, 0xae, 0xd7, 0x69, 0xd8, 0xe3, 0x9a, 0x10, 0xff, 0x03, 0xc6, 0x0a,
+	0xc7, 0x7d, 0x73, 0x77, 0x90, 0x66, 0x71, 0x2f, 0x78, 0x45, 0x9a, 0x59, 0xdf, 0x5b, 0x32, 0xe3,
+	0x1b, 0x92, 0x3e, 0xb7, 0x67, 0xa9, 0x9f, 0xa0, 0x39, 0x33, 0x39, 0xba, 0x41, 0xc2, 0x86, 0xcc,
+	0x7e, 0x8b, 0x9c, 0x88, 0x1c, 0x4b, 0x92, 0x3e, 0x97, 0x43, 0xfd, 0x04, 0xcd, 0xd9, 0xdd, 0x57,
+	0xf3, 0x6f, 0xea, 0x92, 0x53, 0xee, 0xc1, 0x8f, 0xc9, 0xc0, 0xe7, 0x5e, 0xe1, 0x3c, 0x7c, 0x86,
+	0xd4, 0x3b, 0x3b, 0x7e, 0x92, 0xb5, 0xa6, 0xd9, 0xa0, 0x51, 0xa3, 0x78, 0x11, 0x1b, 0x81, 0xc3,
+	0xd0, 0x63, 0x2b, 0xa1, 0x5b, 0xad, 0x53, 0xb6, 0xc7, 0x16, 0xd0, 0x2d, 0xc0, 0x76, 0xb5, 0x2f,
+	0x9b, 0x19, 0xe9, 0xca, 0xf7, 0xe3, 0x15, 0x72, 0x61, 0x48, 0x2a, 0xd5, 0x15, 0x7c, 0x3e, 0x74,
+	0x06, 0x49, 0x2a, 0xad, 0x73, 0xc6, 0x7c, 0x60, 0xcd, 0x20, 0xe1, 0xee, 0x27, 0x1d, 0x32, 0x89,
+	0x66, 0xdf, 0x88, 0x66, 0xad, 0x4a, 0xd9, 0x36, 0x28, 0x26, 0xd6, 0xf3, 0x9c, 0xba, 0x96, 0x41,
+	0x34, 0x80, 0xe4, 0x8b, 0xe2, 0xd2, 0xbb, 0x9d, 0x70, 0xd0, 0x1d, 0x72, 0xd3, 0xb9, 0xc2, 0x9b,
+	0x41, 0xc2, 0x11, 0x35, 0x88, 0x38, 0x6a, 0xcd, 0x46, 0x5d, 0x8e, 0x04, 0xaa, 0x80, 0x7b, 0x3f,
+	0xd7, 0x20, 0xe7, 0x86, 0x84, 0xc1, 0x49, 0x83, 0x5b, 0x2e, 0xb6, 0xa9, 0xb9, 0x1a, 0x84, 0x54,
+	0x3a, 0xa8, 0xb1, 0x2d, 0xd7, 0x2d, 0xd5, 0x0a, 0x06, 0x86, 0xfb, 0x09, 0x42, 0xfa, 0x7e, 0xe2,
+	0xf7, 0xa8, 0xb2, 0x9e, 0x1f, 0x7b, 0x67, 0x83, 0x72, 0xac, 0x4b, 0x9a, 0xda, 0x82, 0xa0, 0x9a,
+	0x52, 0x30, 0x58, 0xa2, 0xcb, 0x55, 0x42, 0x43, 0xea, 0xa7, 0x2c, 0x08, 0x20, 0x1f, 0x2b, 0x05,
+	0x1a, 0x04, 0x26, 0x1e, 0x3a, 0xba, 0x08, 0x5f, 0xbe, 0x9a, 0xed, 0xe8, 0x62, 0xfb, 0xf3, 0xb9,
+	0xdf, 0xef, 0x90, 0x19, 0x8c, 0xdf, 0xd4, 0xdc, 0x45, 0x64, 0xd3, 0xda, 0xf1, 0x5f, 0xf2, 0xaa,
+	0x49, 0x57, 0xeb, 0x50, 0xab, 0x39, 0x85, 0x1c, 0x7b, 0xfc, 0xcc, 0x7b, 0x34, 0x61, 0xca, 0x77,
+	0xc2, 0xfe, 0xcc, 0xb7, 0x78, 0x33, 0x48, 0xb8, 0x3b, 0x4f, 0x66, 0xfb, 0x7e, 0x9a, 0x2e, 0x26,
+	0xb4, 0x4b, 0xa3, 0x2c, 0xf0, 0x43, 0x1e, 0x4a, 0xd4, 0xd0, 0x4e, 0xf5, 0xeb, 0x36, 0x18, 0xf2,
+	0xf8, 0xee, 0xfb, 0xc8, 0x13, 0xdc, 0x3c, 0xb5, 0x1a, 0xa4, 0x69, 0x10, 0x6d, 0xeb, 0x61, 0x20,
+	0xac, 0x74, 0x17, 0x05, 0xa9, 0x27, 0x96, 0x8b, 0xd1, 0x60, 0xd4, 0xf3, 0xe8, 0x7c, 0x99, 0xee,
+	0x06, 0xfd, 0xc5, 0xa4, 0x9b, 0xb2, 0xab, 0xa9, 0x86, 0xb6, 0x09, 0xb7, 0x45, 0x3b, 0x28, 0x0c,
+	0xb7, 0x43, 0xa6, 0xf9, 0x27, 0xe1, 0xce, 0x88, 0x42, 0x83, 0xbe, 0x6d, 0xe4, 0x42, 0x2e, 0x42,
+	0x8c, 0xe7, 0xc0, 0xbf, 0x73, 0x45, 0x5e, 0x94, 0xf1, 0x7b, 0x9d, 0x5b, 0x06, 0x19, 0xb0, 0x88,
+	0xda, 0x67, 0xba, 0xa9, 0x31, 0xce, 0x74, 0x5f, 0x43, 0xa6, 0x76, 0x07, 0x9b, 0x54, 0xf4, 0x7c,
+	0x6b, 0xda, 0x1e, 0x7d, 0x37, 0x34, 0x08, 0x4c, 0x3c, 0xe6, 0x07, 0xda, 0x0f, 0xc4, 0x2f, 0x0c,
+	0x48, 0xd1, 0x7e, 0xa0, 0xeb, 0xcb, 0xb2, 0x19, 0x4c, 0x1c, 0x14, 0x0d, 0xfb, 0x62, 0x83, 0xa6,
+	0x2c, 0xa4, 0x04, 0xbb, 0x4b, 0x89, 0xd6, 0x96, 0x00, 0xd0, 0x38, 0x68, 0x5c, 0xc5, 0x1f, 0x6d,
+	0x16, 0x62, 0x7d, 0xcb, 0x0f, 0x83, 0x2e, 0x77, 0x4a, 0x9c, 0xb5, 0x8d, 0xab, 0xed, 0x02, 0x1c,
+	0x28, 0x7c, 0x12, 0x43, 0x98, 0x5b, 0xa3, 0x54, 0x98, 0x9b, 0xa2, 0xa2, 0xca, 0x6e, 0xf9, 0x89,
+	0xdc, 0xf0, 0x1c, 0x33, 0x1e, 0x4c, 0xd0, 0xbd, 0xe5, 0x27, 0xa6, 0xca, 0x63, 0x0c, 0x40, 0x72,
+	0x72, 0x5f, 0x22, 0xb5, 0x2c, 0xf4, 0x4b, 0x8a, 0x36, 0x35, 0x38, 0x6a, 0x2b, 0xd8, 0xca, 0x7c,
+	0x0a, 0x8c, 0x87, 0xfb, 0x14, 0x9e, 0xde, 0x36, 0xe5, 0x35, 0x9f, 0x38, 0x70, 0x6d, 0xa6, 0xc0,
+	0x5a, 0xbd, 0xbf, 0x75, 0xaa, 0x60, 0xd5, 0x51, 0x1b, 0x01, 0xbc, 0x16, 0xc2, 0x41, 0xb3, 0x9e,
+	0xd0, 0xad, 0xe0, 0xae, 0xd8, 0x88, 0x29, 0xcd, 0x76, 0x53, 0x41, 0xc0, 0xc0, 0x92, 0xcf, 0xb4,
+	0x07, 0x5b, 0xf8, 0x4c, 0x65, 0xf8, 0x19, 0x0e, 0x01, 0x03, 0xcb, 0x7d, 0x27, 0x99, 0x08, 0x7a,
+	0xfe, 0xb6, 0x72, 0x51, 0x7e, 0x0a, 0x55, 0xda, 0x32, 0x6b, 0x79, 0xed, 0xde, 0xc5, 0x19, 0x25,
+	0x10, 0x6b, 0x02, 0x81, 0xeb, 0xfe, 0xa4, 0x43, 0xa6, 0x3b, 0x71, 0xaf, 0x17, 0x47, 0xfc, 0xf8,
+	0x2c, 0x6c, 0x01, 0x2f, 0x9d, 0xd4, 0x36, 0x69, 0x6e, 0xd1, 0x60, 0xc6, 0x8d, 0x01, 0x2a, 0x2c,
+	0xd6, 0x04, 0x81, 0x25, 0x95, 0xa9, 0xf9, 0xea, 0x87, 0x68, 0xbe, 0x5f, 0x70, 0xc8, 0x19, 0xfe,
+	0xac, 0x71, 0xaa, 0x17, 0x41, 0x9d, 0xf1, 0x09, 0xbf, 0xd6, 0x90, 0xa1, 0x43, 0x59, 0x9a, 0x87,
+	0xe0, 0x30, 0x2c, 0xa4, 0x7b, 0x8d, 0x9c, 0xd9, 0x8a, 0x93, 0x0e, 0x35, 0x3b, 0x42, 0xa8, 0x6d,
+	0x45, 0xe8, 0x6a, 0x1e, 0x01, 0x86, 0x9f, 0x71, 0x6f, 0x91, 0xc7, 0x8d, 0x46, 0xb3, 0x1f, 0xb8,
+	0xe6, 0x7e, 0x5a, 0x50, 0x7b, 0xfc, 0x6a, 0x21, 0x16, 0x8c, 0x78, 0xda, 0x56, 0x92, 0xcd, 0x31,
+	0x94, 0xe4, 0x47, 0xc8, 0xf9, 0xce, 0x70, 0xcf, 0xec, 0xa5, 0x83, 0xcd, 0x94, 0xeb, 0xf1, 0xc6,
+	0xc2, 0x97, 0x09, 0x02, 0xe7, 0x17, 0x47, 0x21, 0xc2, 0x68, 0x1a, 0xee, 0xc7, 0x48, 0x23, 0xa1,
+	0xec, 0xab, 0xa4, 0x22, 0xc2, 0xf1, 0x98, 0xd6, 0x0e, 0xbd, 0x83, 0xe7, 0x64, 0xf5, 0xca, 0x24,
+	0x1a, 0x52, 0x50, 0x1c, 0xdd, 0x3b, 0x64, 0xb2, 0x8f, 0x37, 0x2e, 0x22, 0x54, 0xf1, 0xd8, 0x17,
+	0x03, 0x8a, 0x39, 0xbb, 0xc7, 0x31, 0x52, 0x4a, 0x70, 0x26, 0x20, 0xb9, 0xe1, 0x5e, 0xad, 0x13,
+	0xf7, 0xfa, 0x71, 0x44, 0xa3, 0x4c, 0x2e, 0x22, 0x33, 0xfc, 0xb2, 0x45, 0xb6, 0x82, 0x81, 0x31,
+	0xb4, 0x96, 0x6b, 0xb4, 0xd6, 0x99, 0x03, 0xd6, 0x72, 0x83, 0xda, 0xa8, 0xe7, 0x71, 0xb1, 0x61,
+	0x66, 0xc5, 0xdb, 0x41, 0xb6, 0x83, 0x76, 0x7c, 0x79, 0xdc, 0x9e, 0xb1, 0x17, 0x9b, 0x95, 0x02,
+	0x1c, 0x28, 0x7c, 0x32, 0xbf, 0xb2, 0xce, 0xde, 0xdf, 0xca, 0x7a, 0x7a, 0x8c, 0x95, 0xb5, 0x4d,
+	0xce, 0x31, 0x09, 0xc4, 0x2e, 0x59, 0x1a, 0x2d, 0xd3, 0x96, 0xcb, 0x84, 0x57, 0x91, 0x37, 0x2b,
+	0x45, 0x48, 0x50, 0xfc, 0xec, 0x85, 0xf7, 0x90, 0x33, 0x43, 0x4a, 0xee, 0x48, 0x06, 0xc9, 0x25,
+	0xf2, 0x78, 0xb1, 0x3a, 0x39, 0x92, 0x59, 0xf2, 0xe7, 0x72, 0x4e, 0xf1, 0xc6, 0x11, 0x6d, 0x0c,
+	0x13, 0xb7, 0x4f, 0xaa, 0x34, 0xda, 0x13, 0xab, 0xeb, 0xd5, 0xe3, 0x8d, 0xea, 0x2b, 0xd1, 0x1e,
+	0xd7, 0x86, 0xcc, 0x8e, 0x77, 0x25, 0xda, 0x03, 0xa4, 0xed, 0xfe, 0x80, 0x63, 0x1d, 0x20, 0xb8,
+	0x61, 0xfc, 0xc3, 0x27, 0x72, 0x26, 0x1d, 0xfb, 0x4c, 0xe1, 0xfd, 0xeb, 0x0a, 0xb9, 0x74, 0x18,
+	0x91, 0x31, 0xba, 0xef, 0x19, 0xf4, 0xca, 0x4f, 0x82, 0x68, 0x5b, 0x2c, 0x57, 0x53, 0x38, 0x8b,
+	0xb9, 0xe3, 0xcb, 0x47, 0x40, 0x80, 0xdc, 0x90, 0x54, 0x7b, 0x7e, 0x5f, 0xd8, 0x4b, 0x97, 0x8f,
+	0x1b, 0xc5, 0x88, 0xbf, 0xfd, 0x70, 0xd5, 0xef, 0xf3, 0x31, 0x6f, 0x34, 0x00, 0xb2, 0x71, 0x33,
+	0x52, 0xf7, 0x93, 0xc4, 0x97, 0x3e, 0x15, 0x37, 0xca, 0xe1, 0x37, 0x8f, 0x24, 0xf9, 0x95, 0xb4,
+	0xd5, 0x04, 0x9c, 0x19, 0xfa, 0xca, 0xcc, 0xe6, 0xee, 0x64, 0xdc, 0x94, 0x4c, 0x08, 0x33, 0xa9,
+	0x53, 0x76, 0xf0, 0x28, 0x23, 0xcb, 0x2d, 0x10, 0xfc, 0x7f, 0x10, 0xac, 0xdc, 0xcf, 0x38, 0x2c,
+	0xb3, 0x86, 0x8c, 0xed, 0x6b, 0x55, 0x4a, 0xf6, 0xe9, 0x30, 0x13, 0x7d, 0x98, 0xf9, 0x3a, 0x64,
+	0x23, 0x98, 0xdc, 0x45, 0xf6, 0x20, 0x76, 0x9a, 0x19, 0xce, 0x1e, 0x84, 0xcd, 0x20, 0xe1, 0xee,
+	0xdd, 0x02, 0x87, 0x98, 0x12, 0x12, 0x2e, 0x8c, 0xe1, 0x02, 0xf3, 0x63, 0x0e, 0x39, 0x13, 0xe4,
+	0x3d, 0x1b, 0x5a, 0xf5, 0x32, 0x5c, 0xae, 0x46, 0x3b, 0x4e, 0xa8, 0x8d, 0xce, 0x10, 0x08, 0x86,
+	0x85, 0x71, 0xbb, 0xa4, 0x16, 0x44, 0x5b, 0xb1, 0xd8, 0xde, 0x2d, 0x1c, 0x4f, 0xa8, 0xe5, 0x68,
+	0x2b, 0xd6, 0xb3, 0x19, 0x7f, 0x01, 0xa3, 0xee, 0xae, 0x90, 0xb3, 0x32, 0xd8, 0xe8, 0x7a, 0x90,
+	0xa2, 0x2d, 0x69, 0x25, 0xe8, 0x05, 0x19, 0xdb, 0x9a, 0x55, 0x17, 0x5a, 0xb8, 0xbc, 0x41, 0x01,
+	0x1c, 0x0a, 0x9f, 0x72, 0x5f, 0x21, 0x93, 0xd2, 0x9b, 0xa0, 0x51, 0x86, 0x3d, 0x61, 0x78, 0xfc,
+	0xab, 0xc1, 0xc4, 0x7f, 0xa7, 0x20, 0x19, 0xba, 0x9f, 0x76, 0xc8, 0x0c, 0xff, 0xff, 0xfa, 0x7e,
+	0x97, 0x07, 0x3f, 0x36, 0xcb, 0x08, 0x19, 0x68, 0x5b, 0x34, 0x17, 0x5c, 0x34, 0x66, 0xd8, 0x6d,
+	0x90, 0xe3, 0xeb, 0x7e, 0x07, 0x5a, 0x45, 0x59, 0xbc, 0x71, 0xba, 0x16, 0x89, 0x94, 0x19, 0xed,
+	0x12, 0xa7, 0xa3, 0x8c, 0x64, 0xd6, 0x3b, 0xd4, 0x25, 0xc9, 0x0d, 0x34, 0x63, 0xef, 0xe7, 0x4f,
+	0x91, 0x33, 0xf3, 0x07, 0xfb, 0x7c, 0x38, 0x0f, 0xdc, 0xe7, 0xe3, 0x25, 0x52, 0x4b, 0xb5, 0xbb,
+	0x45, 0x09, 0xb3, 0x5d, 0x70, 0xd5, 0xb7, 0xe1, 0xe8, 0x58, 0xc1, 0x78, 0xb8, 0x03, 0x32, 0xc1,
+	0x73, 0x88, 0xb5, 0xaa, 0x65, 0xdc, 0xca, 0xe4, 0x12, 0x9d, 0x69, 0xeb, 0x1a, 0x6f, 0x05, 0xc1,
+	0xcc, 0xbd, 0x4b, 0x26, 0x77, 0xf8, 0xac, 0x10, 0x47, 0xce, 0xd5, 0xe3, 0xf6, 0xaf, 0x35, 0xd5,
+	0xf4, 0x1c, 0x10, 0x0d, 0x20, 0xd9, 0x31, 0x17, 0x43, 0xc3, 0x09, 0x8a, 0xeb, 0xb3, 0xf2, 0xc2,
+	0x49, 0xc7, 0xf7, 0x80, 0xfa, 0x28, 0x99, 0x4e, 0x68, 0x27, 0x8e, 0x3a, 0x41, 0x48, 0xbb, 0xf3,
+	0xf2, 0x5e, 0xee, 0x28, 0x81, 0x82, 0xcc, 0xa8, 0x05, 0x06, 0x0d, 0xb0, 0x28, 0xb2, 0xe9, 0xae,
+	0xb2, 0x18, 0xe0, 0x07, 0xa1, 0xe2, 0xfe, 0x65, 0xa5, 0xa4, 0x9c, 0x09, 0x8c, 0x26, 0x9f, 0xee,
+	0x76, 0x1b, 0xe4, 0xf8, 0xba, 0xef, 0x27, 0x24, 0xde, 0xe4, 0x7e, 0x84, 0xf3, 0x59, 0xab, 0x71,
+	0xe4, 0x57, 0x9d, 0xe1, 0xd1, 0xc8, 0x92, 0x02, 0x18, 0xd4, 0xdc, 0x1b, 0x84, 0xf0, 0x99, 0x83,
+	0xb7, 0xa5, 0xad, 0xa6, 0x15, 0xe9, 0x49, 0xda, 0x0a, 0xf2, 0xda, 0xbd, 0x8b, 0xc3, 0xa6, 0x6f,
+	0x04, 0x80, 0xf1, 0xb8, 0xfb, 0xcd, 0x64, 0x32, 0x1d, 0xf4, 0x7a, 0xbe, 0xba, 0xaa, 0x29, 0x31,
+	0xbe, 0x99, 0xd3, 0x35, 0xf4, 0x33, 0x6f, 0x00, 0xc9, 0xd1, 0x7d, 0x09, 0x57, 0x1a, 0xa1, 0x28,
+	0xf9, 0x2c, 0x62, 0xff, 0x0b, 0x83, 0xe4, 0xbb, 0xe4, 0x61, 0x0a, 0x0a, 0x70, 0xd0, 0x53, 0xc8,
+	0x6e, 0x5f, 0x89, 0x3b, 0xc2, 0xa6, 0x57, 0x44, 0xd3, 0x7d, 0x9e, 0x4c, 0xe9, 0xd7, 0x96, 0xb9,
+	0x76, 0xde, 0xa2, 0xd3, 0xa5, 0xb1, 0xe6, 0xd1, 0x7d, 0x66, 0x3e, 0xec, 0xae, 0x92, 0xc7, 0x3a,
+	0x71, 0x94, 0x25, 0x71, 0x18, 0xf2, 0x54, 0x8a, 0xdc, 0x44, 0xc0, 0xaf, 0x72, 0x9e, 0x14, 0x62,
+	0x3f, 0xb6, 0x38, 0x8c, 0x02, 0x45, 0xcf, 0xe1, 0xd1, 0x20, 0xbf, 0x4c, 0xcd, 0x94, 0x72, 0xcb,
+	0x6f, 0xd1, 0x14, 0x1a, 0x4a, 0x59, 0xdf, 0x0f, 0x59, 0xb0, 0xbe, 0xd7, 0x9c, 0x4c, 0x2f, 0x0c,
+	0xe8, 0x80, 0xb6, 0x66, 0xcb, 0xd0, 0x5c, 0x8c, 0x54, 0x57, 0xa7, 0x21, 0x51, 0xf2, 0xac, 0x59,
+	0xcc, 0x20, 0xc7, 0xdc, 0x8b, 0xec, 0xbb, 0x67, 0x31, 0x82, 0xde, 0x49, 0xa6, 0x31, 0x3a, 0x24,
+	0x89, 0xfc, 0xf0, 0x45, 0x58, 0x91, 0xf7, 0x38, 0x4c, 0x51, 0x5c, 0x31, 0xda, 0xc1, 0xc2, 0xc2,
+	0x54, 0x03, 0xc2, 0x78, 0x68, 0xa4, 0x1a, 0xe0, 0xc6, 0x43, 0x69, 0x2a, 0xf4, 0x7e, 0xb6, 0x6a,
+	0x6d, 0xe5, 0x1f, 0xca, 0x4d, 0x37, 0x4b, 0xb6, 0x25, 0xb3, 0x92, 0x31, 0x40, 0xab, 0x52, 0x3a,
+	0x67, 0xe5, 0x4c, 0xb8, 0x66, 0x32, 0x02, 0x9b, 0xaf, 0xbb, 0x4b, 0xea, 0x3b, 0x71, 0x9a, 0xc9,
+	0x83, 0xeb, 0x31, 0xcf, 0xc8, 0xd7, 0xe3, 0x34, 0x63, 0xfb, 0x4f, 0xf5, 0xda, 0xd8, 0x92, 0x02,
+	0xe7, 0x81, 0x26, 0x91, 0x74, 0xc7, 0x4f, 0xba, 0x96, 0x07, 0xa8, 0x3a, 0x66, 0xb4, 0x35, 0x08,
+	0x4c, 0x3c, 0xef, 0x4f, 0x1d, 0xeb, 0xb2, 0xef, 0x36, 0x0b, 0xe4, 0xd8, 0xa3, 0x11, 0xaa, 0x4c,
+	0xd3, 0xf5, 0xf3, 0x6b, 0x73, 0x61, 0xf1, 0x6f, 0x1e, 0x95, 0x85, 0xf5, 0x0e, 0x52, 0x98, 0x63,
+	0x24, 0x0c, 0x2f, 0xd1, 0x6f, 0x75, 0xec, 0xe4, 0x07, 0x95, 0x32, 0x4e, 0xb4, 0x86, 0xdc, 0x87,
+	0xe7, 0x51, 0xf0, 0x3e, 0x41, 0xa6, 0xe7, 0x07, 0x59, 0x8c, 0x7e, 0xa3, 0x9b, 0x7e, 0x67, 0xd7,
+	0x7d, 0x2b, 0x6e, 0xe0, 0x64, 0x2e, 0x01, 0x3e, 0x07, 0x4e, 0xf1, 0xed, 0x96, 0x68, 0x04, 0x0d,
+	0x77, 0x2f, 0x90, 0x4a, 0xd0, 0x65, 0x52, 0x57, 0x17, 0x88, 0x60, 0x55, 0x59, 0x5e, 0x82, 0x4a,
+	0xd0, 0x35, 0xc2, 0xe2, 0xab, 0x07, 0x86, 0xc5, 0xff, 0x80, 0x43, 0x26, 0x17, 0xfc, 0xce, 0x6e,
+	0xbc, 0xb5, 0x85, 0xd7, 0x5b, 0xdd, 0x41, 0x62, 0x26, 0x82, 0x50, 0x46, 0xc4, 0x25, 0xd1, 0x0e,
+	0x0a, 0x03, 0xe7, 0xde, 0x96, 0xdf, 0x91, 0x79, 0x48, 0xaa, 0x7c, 0xee, 0x5d, 0x65, 0x2d, 0x20,
+	0x20, 0xf8, 0xfd, 0x7b, 0xfe, 0x5d, 0xf9, 0x70, 0xfe, 0xaa, 0x73, 0x55, 0x83, 0xc0, 0xc4, 0xf3,
+	0xfe, 0x85, 0x43, 0x5a, 0x0b, 0x7e, 0x1a, 0x74, 0x30, 0x35, 0xee, 0x42, 0x90, 0x6d, 0x0e, 0x3a,
+	0xbb, 0x34, 0xe3, 0xf9, 0x6a, 0x50, 0xca, 0x41, 0x4a, 0x13, 0xc3, 0x92, 0xa1, 0xa4, 0x7c, 0x51,
+	0xb4, 0x83, 0xc2, 0x70, 0x5f, 0x21, 0x53, 0x78, 0x41, 0x78, 0x27, 0x4e, 0xba, 0x40, 0xb7, 0xca,
+	0xc9, 0x9e, 0xd5, 0xa6, 0x9d, 0x84, 0x66, 0x40, 0xb7, 0x84, 0xe3, 0x90, 0xa6, 0x0f, 0x26, 0x33,
+	0xef, 0xbb, 0x1c, 0x72, 0x76, 0x81, 0xfa, 0x09, 0x4d, 0x58, 0xb2, 0x2d, 0xf5, 0x22, 0xee, 0xcb,
+	0xa4, 0x91, 0x61, 0x0b, 0x4a, 0xe4, 0x94, 0x2b, 0x11, 0x73, 0xf9, 0xd9, 0x10, 0xc4, 0x41, 0xb1,
+	0xf1, 0xbe, 0xcf, 0x21, 0xe7, 0x8b, 0x64, 0x59, 0x0c, 0xe3, 0x41, 0xf7, 0x61, 0x08, 0xf4, 0xc3,
+	0x0e, 0x99, 0x66, 0x6e, 0x14, 0x4b, 0x34, 0xf3, 0x83, 0x70, 0x28, 0x85, 0xa8, 0x33, 0x66, 0x0a,
+	0xd1, 0x4b, 0xa4, 0xb6, 0x13, 0xf7, 0x68, 0xde, 0x05, 0xe8, 0x7a, 0x8c, 0x46, 0x2d, 0x84, 0xa0,
+	0x81, 0xb5, 0xe7, 0x07, 0x51, 0xe6, 0xa3, 0x3e, 0x90, 0xd7, 0x4c, 0xb3, 0x7c, 0x00, 0xaa, 0x66,
+	0x30, 0x71, 0xbc, 0x7f, 0xde, 0x24, 0x93, 0xc2, 0x5f, 0x6d, 0xec, 0xfc, 0x49, 0xd2, 0xba, 0x56,
+	0x19, 0x69, 0x5d, 0x4b, 0xc9, 0x44, 0x87, 0xe5, 0x79, 0x6e, 0x55, 0xcb, 0xb0, 0x65, 0x09, 0x01,
+	0x79, 0xea, 0x68, 0x2d, 0x16, 0xff, 0x0d, 0x82, 0x95, 0xfb, 0x59, 0x87, 0xcc, 0x76, 0xe2, 0x28,
+	0xa2, 0x1d, 0xbd, 0x99, 0xae, 0x95, 0x71, 0x62, 0x5a, 0xb4, 0x89, 0xea, 0x1b, 0xfa, 0x1c, 0x00,
+	0xf2, 0xec, 0xd1, 0x19, 0x9e, 0xf7, 0xd9, 0x2d, 0xeb, 0x6e, 0x4c, 0x27, 0x8b, 0x34, 0x81, 0x60,
+	0xe3, 0xe2, 0x15, 0x42, 0xa4, 0x33, 0x2d, 0x4e, 0xe8, 0x2b, 0x04, 0x23, 0xc7, 0xa2, 0x81, 0x81,
+	0xc9, 0x4d, 0x12, 0xba, 0x95, 0xd0, 0x74, 0x47, 0xf8, 0xf3, 0xb1, 0x8d, 0xfc, 0xe4, 0xfd, 0x25,
+	0x37, 0x81, 0x21, 0x4a, 0x50, 0x40, 0xdd, 0xdd, 0x15, 0xe6, 0x9d, 0x46, 0x19, 0x0b, 0x8a, 0xf8,
+	0xcc, 0x23, 0xad, 0x3c, 0x17, 0x49, 0x9d, 0xad, 0x9d, 0xec, 0x00, 0x51, 0xe5, 0x01, 0xb5, 0x6c,
+	0x65, 0x05, 0xde, 0xee, 0x2e, 0x91, 0xd3, 0xb9, 0xec, 0x95, 0xa9, 0xb8, 0xc3, 0x52, 0xc1, 0x93,
+	0xb9, 0xbc, 0x97, 0x29, 0x0c, 0x3d, 0x61, 0x9a, 0xfe, 0xa6, 0x0e, 0x31, 0xfd, 0xed, 0x2b, 0xaf,
+	0x71, 0x7e, 0xbb, 0xf4, 0x42, 0x29, 0x1d, 0x30, 0x96, 0x8b, 0xf8, 0xf7, 0xe6, 0x5c, 0xc4, 0x4f,
+	0x5d, 0xaa, 0x1e, 0xdf, 0x09, 0x4a, 0x0a, 0x70, 0x74, 0x7f, 0xf0, 0x87, 0xe9, 0xdf, 0xfd, 0xbf,
+	0x1d, 0x22, 0xbf, 0xeb, 0xa2, 0xdf, 0xd9, 0xa1, 0x38, 0x64, 0x0a, 0xa2, 0x72, 0x9c, 0xa3, 0x44,
+	0xe5, 0xe0, 0x4d, 0x2a, 0xf6, 0x13, 0x7f, 0x94, 0xaf, 0xfb, 0xca, 0x24, 0x34, 0xbf, 0xbe, 0x2c,
+	0x9e, 0xd2, 0x38, 0x6e, 0x4c, 0xce, 0x84, 0x7e, 0x9a, 0x31, 0x09, 0xd0, 0x7a, 0x73, 0x9f, 0xa9,
+	0x85, 0x58, 0x84, 0xde, 0x4a, 0x9e, 0x10, 0x0c, 0xd3, 0xf6, 0xfe, 0x6d, 0x9d, 0x9c, 0xb2, 0x34,
+	0xe3, 0x11, 0x37, 0x0c, 0x5f, 0x45, 0x1a, 0x72, 0x0d, 0xcf, 0x27, 0x58, 0x53, 0x0b, 0xbd, 0xc2,
+	0xc0, 0x45, 0x6b, 0x53, 0xaf, 0xaa, 0xf9, 0x0d, 0x8e, 0xb1, 0xe0, 0x82, 0x89, 0xc7, 0x94, 0x72,
+	0x16, 0xa6, 0x8b, 0x61, 0x40, 0xa3, 0x8c, 0x8b, 0x59, 0x8e, 0x52, 0xde, 0x58, 0x69, 0x9b, 0x44,
+	0xb5, 0x52, 0xce, 0x01, 0x20, 0xcf, 0x1e, 0xed, 0x9a, 0xa7, 0xfc, 0x3b, 0xa9, 0x2e, 0x46, 0xd0,
+	0xaa, 0x97, 0xb1, 0x48, 0x59, 0xf5, 0x0d, 0xf8, 0x85, 0x8b, 0xd5, 0x04, 0x36, 0x53, 0x0c, 0xf8,
+	0x71, 0xe9, 0x5d, 0xda, 0x91, 0xee, 0xea, 0x42, 0x96, 0x89, 0x32, 0x4c, 0x1a, 0x57, 0x86, 0xe8,
+	0x72, 0xad, 0x3e, 0xdc, 0x0e, 0x05, 0x32, 0xb8, 0xcf, 0x13, 0xb7, 0x1b, 0xa4, 0xfe, 0x66, 0x88,
+	0x1e, 0x06, 0x32, 0xaa, 0x5c, 0xf8, 0x39, 0x5c, 0x10, 0xfd, 0xec, 0x2e, 0x0d, 0x61, 0x40, 0xc1,
+	0x53, 0x6c, 0x94, 0x25, 0xf1, 0xdd, 0xfd, 0x17, 0x93, 0xb0, 0xd5, 0xc8, 0x8d, 0x32, 0xd1, 0x0e,
+	0x0a, 0xc3, 0xfb, 0xb3, 0xaa, 0x9a, 0xca, 0x3a, 0x36, 0xc3, 0x37, 0x7c, 0xc4, 0x9d, 0xfb, 0xf7,
+	0x11, 0x57, 0x7c, 0x0b, 0x72, 0x25, 0x58, 0xa1, 0xd5, 0x95, 0x87, 0x14, 0x5a, 0xfd, 0x6d, 0x8e,
+	0x95, 0xc4, 0x70, 0xea, 0xd9, 0xf7, 0x97, 0x1b, 0x17, 0x32, 0xc7, 0xbd, 0xeb, 0x72, 0xeb, 0x4a,
+	0xce, 0xa9, 0xf2, 0xab, 0x48, 0x63, 0x2b, 0xf4, 0x59, 0x76, 0x9d, 0x56, 0xcd, 0xf6, 0xfc, 0xbb,
+	0x2a, 0xda, 0x41, 0x61, 0xa0, 0xd6, 0x37, 0x88, 0x1e, 0x49, 0x6b, 0xff, 0x61, 0x95, 0x4c, 0x19,
+	0x2b, 0x7e, 0xe1, 0xf6, 0xcd, 0x79, 0xc4, 0xb6, 0x6f, 0x95, 0x23, 0x6c, 0xdf, 0x3e, 0x41, 0x9a,
+	0x1d, 0xb9, 0x1a, 0x95, 0x53, 0x5a, 0x22, 0xbf, 0xc6, 0xe9, 0x05, 0x49, 0x35, 0x81, 0xe6, 0x89,
+	0xce, 0x4a, 0x06, 0x19, 0xcb, 0x30, 0x51, 0x14, 0x5f, 0x2b, 0x56, 0xb4, 0xe1, 0x67, 0xf2, 0x7e,
+	0x1b, 0xf5, 0xc3, 0xfd, 0x36, 0x30, 0x1f, 0xaf, 0xfc, 0xb8, 0x0f, 0x20, 0x4f, 0xd3, 0x4b, 0x76,
+	0x9e, 0xa6, 0x2b, 0xa5, 0x74, 0xf3, 0x88, 0x04, 0x4d, 0xdf, 0xe5, 0x90, 0xa7, 0x0f, 0x4e, 0xb2,
+	0x8e, 0xbe, 0xf4, 0xdb, 0x49, 0x3c, 0xe8, 0x8b, 0x35, 0x58, 0xd1, 0x61, 0x19, 0xed, 0x81, 0xc3,
+	0xf0, 0x10, 0xb5, 0x1b, 0x44, 0xdd, 0xfc, 0x21, 0x0a, 0x13, 0xde, 0x03, 0x83, 0x8c, 0x91, 0x19,
+	0xf7, 0x26, 0x99, 0x44, 0x3f, 0x14, 0x3f, 0xea, 0xba, 0x5f, 0x41, 0x26, 0x3b, 0xfc, 0x5f, 0x61,
+	0x4c, 0x61, 0x0e, 0x0d, 0x02, 0x0a, 0x12, 0x86, 0x8e, 0x92, 0x7e, 0xb2, 0x2d, 0x8d, 0x88, 0xcc,
+	0x51, 0x72, 0x3e, 0xd9, 0x4e, 0x81, 0xb5, 0x7a, 0xff, 0xc3, 0x21, 0x33, 0xf8, 0x48, 0x90, 0xad,
+	0xca, 0xae, 0x7d, 0x13, 0x99, 0xf0, 0x07, 0xd9, 0x4e, 0x3c, 0x74, 0x26, 0x9c, 0x67, 0xad, 0x20,
+	0xa0, 0x28, 0xac, 0x4a, 0x36, 0x62, 0x08, 0xbb, 0x84, 0xf3, 0x8a, 0x41, 0x70, 0x5b, 0x9d, 0x0e,
+	0x36, 0x8b, 0x6e, 0xd4, 0xdb, 0xbc, 0x19, 0x24, 0x1c, 0x89, 0x6d, 0xc6, 0xdd, 0xfd, 0x56, 0xcd,
+	0x26, 0xb6, 0x10, 0x77, 0xf7, 0x81, 0x41, 0x30, 0x12, 0x21, 0xdd, 0xf1, 0xa5, 0xef, 0x86, 0x40,
+	0xa8, 0xb6, 0xaf, 0xcf, 0x03, 0xb6, 0xab, 0xc0, 0x9a, 0x24, 0x6c, 0x4d, 0x1c, 0x14, 0x58, 0x93,
+	0x84, 0xde, 0x3f, 0xa9, 0x11, 0xe6, 0x93, 0xe5, 0x27, 0xb4, 0xbb, 0x11, 0xb3, 0xbc, 0xd9, 0x27,
+	0xea, 0xfa, 0xa0, 0x0f, 0xd5, 0x8f, 0xb2, 0xfb, 0x83, 0x71, 0x05, 0x5e, 0x7d, 0xd0, 0x57, 0xe0,
+	0xc5, 0x5e, 0x0d, 0xb5, 0x47, 0xc8, 0xab, 0xc1, 0xfb, 0x1e, 0x87, 0xb8, 0xca, 0xc3, 0x4e, 0xbb,
+	0x1d, 0x5d, 0x26, 0x4d, 0xe5, 0xd2, 0x27, 0xe6, 0x8b, 0x56, 0xd1, 0x12, 0x00, 0x1a, 0x67, 0x0c,
+	0x4b, 0xca, 0x33, 0x72, 0xfd, 0xac, 0xda, 0xba, 0x84, 0xad, 0xba, 0x62, 0x39, 0xf5, 0x7e, 0xb5,
+	0x42, 0x1e, 0xe7, 0x5b, 0xb7, 0x55, 0x3f, 0xf2, 0xb7, 0x69, 0x0f, 0xa5, 0x1a, 0xd7, 0x91, 0xac,
+	0x83, 0x47, 0xf8, 0x40, 0x46, 0xd1, 0x1c, 0x57, 0x77, 0x72, 0x3d, 0xc3, 0x35, 0xcb, 0x72, 0x14,
+	0x64, 0xc0, 0x88, 0xbb, 0x29, 0x69, 0xc8, 0x9a, 0x60, 0xad, 0x6a, 0x99, 0x8c, 0xd4, 0xb2, 0x20,
+	0x76, 0x39, 0x14, 0x14, 0x23, 0xdc, 0xca, 0x84, 0x71, 0x67, 0x17, 0xa7, 0x7c, 0x7e, 0x2b, 0xb3,
+	0x22, 0xda, 0x41, 0x61, 0x78, 0x3d, 0x32, 0x2b, 0xfb, 0xb0, 0x8f, 0x49, 0xa8, 0xe9, 0x16, 0xae,
+	0xff, 0x1d, 0xd9, 0x64, 0x94, 0x29, 0x53, 0xeb, 0xff, 0xa2, 0x09, 0x04, 0x1b, 0x57, 0xa6, 0xb7,
+	0xae, 0x14, 0xa7, 0xb7, 0xf6, 0x7e, 0xd5, 0x21, 0xf9, 0x0d, 0x08, 0x33, 0xc0, 0x99, 0x35, 0xc7,
+	0x46, 0xe5, 0xd8, 0x3f, 0x42, 0xc6, 0xdb, 0x0f, 0x92, 0x29, 0x3f, 0xc3, 0x1d, 0x26, 0xb7, 0x06,
+	0x55, 0xef, 0xef, 0x5a, 0x77, 0x35, 0xee, 0x06, 0x5b, 0x01, 0x52, 0x00, 0x93, 0x9c, 0xf7, 0x4f,
+	0xeb, 0xa4, 0xb9, 0x94, 0xec, 0x1f, 0x3d, 0x9c, 0x71, 0x38, 0x58, 0xb1, 0x72, 0xa4, 0x60, 0x45,
+	0x19, 0x0e, 0x59, 0x1d, 0x19, 0x0e, 0x29, 0xc3, 0x19, 0x6b, 0x0f, 0x2b, 0x9c, 0xb1, 0xfe, 0x88,
+	0x84, 0x33, 0x4e, 0x3c, 0x02, 0xe1, 0x8c, 0x93, 0x0f, 0x3a, 0x9c, 0x51, 0x44, 0x2a, 0x36, 0x8a,
+	0x23, 0x15, 0xbd, 0xff, 0x59, 0x23, 0x67, 0x86, 0x82, 0xb7, 0xdd, 0xe7, 0xc8, 0xb4, 0x9a, 0xc2,
+	0xf2, 0x7e, 0xa0, 0x69, 0x46, 0x3f, 0x68, 0x18, 0x58, 0x98, 0x63, 0xe8, 0xf1, 0x65, 0xf2, 0x58,
+	0x82, 0x76, 0xd3, 0x01, 0x9d, 0xdf, 0xca, 0x68, 0xd2, 0xa6, 0xe8, 0x66, 0xc2, 0x53, 0xa5, 0x57,
+	0x17, 0x9e, 0xc0, 0xbb, 0x77, 0x18, 0x06, 0x43, 0xd1, 0x33, 0x6e, 0x9f, 0x9c, 0x0a, 0xcd, 0x83,
+	0x6d, 0xab, 0x76, 0xff, 0x67, 0x62, 0xa5, 0xca, 0xac, 0x66, 0xb0, 0x19, 0xd8, 0xa7, 0xe3, 0xfa,
+	0x43, 0x3a, 0x1d, 0x7f, 0xbb, 0x3e, 0x1d, 0x73, 0x67, 0xc2, 0x0f, 0x94, 0x1c, 0xbc, 0x3f, 0xce,
+	0xf1, 0xf8, 0x38, 0x07, 0xde, 0x17, 0x48, 0x43, 0x3a, 0x5a, 0x8f, 0xe5, 0xa0, 0x6c, 0xd2, 0x19,
+	0xb1, 0xf0, 0xbf, 0x56, 0x21, 0x05, 0x36, 0x1d, 0x54, 0xc4, 0xfa, 0x30, 0x60, 0x29, 0xe2, 0xa3,
+	0x1d, 0x08, 0xdc, 0xbb, 0xdc, 0xc9, 0x9c, 0x6f, 0x01, 0xdf, 0x57, 0xb6, 0x4d, 0x4a, 0xfb, 0x9d,
+	0xab, 0x19, 0xaa, 0x7c, 0xcf, 0x9f, 0x25, 0x44, 0x9f, 0x27, 0xc5, 0x41, 0x40, 0xb9, 0x6b, 0xe9,
+	0x63, 0x27, 0x18, 0x58, 0x68, 0xa2, 0x0c, 0xa2, 0x34, 0xf3, 0xc3, 0xf0, 0x7a, 0x10, 0x65, 0xe2,
+	0x70, 0xa0, 0xf6, 0xba, 0xcb, 0x1a, 0x04, 0x26, 0xde, 0x85, 0x77, 0x19, 0xdf, 0xe5, 0x28, 0xdf,
+	0x73, 0x87, 0x9c, 0xbf, 0x16, 0x64, 0x4a, 0xf3, 0xa9, 0x71, 0xc4, 0xce, 0x80, 0x72, 0x81, 0x72,
+	0x46, 0x2e, 0x50, 0x46, 0xf4, 0x70, 0xc5, 0x0e, 0x76, 0xce, 0x47, 0x0f, 0x7b, 0x1d, 0x72, 0xf6,
+	0x5a, 0x90, 0x61, 0x64, 0xe6, 0x09, 0x32, 0xf9, 0x95, 0x09, 0x32, 0x6d, 0x26, 0xf5, 0x38, 0xca,
+	0x72, 0x8e, 0x59, 0xa8, 0xa4, 0xde, 0x0f, 0x94, 0xcb, 0xc7, 0xed, 0x63, 0x67, 0x18, 0x29, 0xee,
+	0x5c, 0xe3, 0xfc, 0xa2, 0x79, 0x82, 0x29, 0x80, 0x7b, 0x87, 0xd4, 0xb7, 0x58, 0x20, 0x6c, 0xb5,
+	0x0c, 0xe7, 0xc1, 0xa2, 0xce, 0xd7, 0x33, 0x92, 0x87, 0xd2, 0x72, 0x7e, 0xb8, 0xe7, 0x4c, 0xec,
+	0xfc, 0x0b, 0x46, 0x78, 0x12, 0x6f, 0x07, 0x85, 0x31, 0x6a, 0x55, 0xa8, 0xdf, 0xc7, 0xaa, 0x60,
+	0xe9, 0xe8, 0x89, 0x87, 0xa4, 0xa3, 0x59, 0x50, 0x73, 0xb6, 0xc3, 0x4e, 0x44, 0x22, 0x9e, 0x72,
+	0x92, 0x75, 0x82, 0x11, 0xd4, 0x6c, 0x81, 0x21, 0x8f, 0xef, 0x7e, 0x5c, 0x69, 0xf9, 0x46, 0x19,
+	0x37, 0x5a, 0xe6, 0x88, 0x3e, 0x69, 0x05, 0xff, 0x3d, 0x15, 0x32, 0x73, 0x2d, 0x1a, 0xac, 0x5f,
+	0x5b, 0x1f, 0x6c, 0x86, 0x41, 0xe7, 0x06, 0xdd, 0x47, 0x2d, 0xbe, 0x4b, 0xf7, 0x97, 0x97, 0xf2,
+	0xa6, 0xa0, 0x1b, 0xd8, 0x08, 0x1c, 0x86, 0x7a, 0x6b, 0x2b, 0x88, 0xb6, 0x69, 0xd2, 0x4f, 0x02,
+	0x71, 0xd9, 0x64, 0xe8, 0xad, 0xab, 0x1a, 0x04, 0x26, 0x1e, 0xd2, 0x8e, 0xef, 0x44, 0x2a, 0xc3,
+	0x9a, 0xa2, 0xbd, 0x86, 0x8d, 0xc0, 0x61, 0x88, 0x94, 0x25, 0x03, 0x61, 0xcb, 0x35, 0x90, 0x36,
+	0xb0, 0x11, 0x38, 0x4c, 0x98, 0x66, 0x98, 0x6f, 0x66, 0x7d, 0xc8, 0x34, 0x83, 0xcd, 0x20, 0xe1,
+	0x88, 0xba, 0x4b, 0xf7, 0x97, 0xd0, 0x8e, 0x97, 0xb3, 0xac, 0xdc, 0xe0, 0xcd, 0x20, 0xe1, 0x2c,
+	0x65, 0xbb, 0xdd, 0x1d, 0x5f, 0x74, 0x29, 0xdb, 0x6d, 0xf1, 0x47, 0x58, 0x04, 0xff, 0x76, 0x85,
+	0x4c, 0xbf, 0x5e, 0x3a, 0x7a, 0x98, 0xba, 0x77, 0x9b, 0x9c, 0x19, 0x4a, 0xa5, 0x30, 0xc6, 0xce,
+	0xe7, 0xd0, 0x54, 0x37, 0x1e, 0x90, 0x29, 0x24, 0x2c, 0x53, 0x95, 0x2e, 0x92, 0x33, 0x7c, 0xf2,
+	0x22, 0x27, 0x16, 0x19, 0xaf, 0xd2, 0x63, 0xb0, 0xdb, 0xd4, 0x5b, 0x79, 0x20, 0x0c, 0xe3, 0x63,
+	0xb1, 0xaa, 0x53, 0x56, 0x76, 0x8b, 0x92, 0xf6, 0x68, 0x6c, 0x76, 0xc7, 0x2c, 0xae, 0x80, 0x85,
+	0x9b, 0x55, 0xd9, 0x32, 0xac, 0x67, 0xb7, 0x06, 0x81, 0x89, 0xe7, 0xfd, 0x66, 0x95, 0x34, 0xa4,
+	0xcf, 0xe1, 0x18, 0xa2, 0x7c, 0xc6, 0x21, 0xa7, 0xd4, 0x0d, 0x36, 0x3e, 0x23, 0x26, 0xc0, 0xcd,
+	0xe3, 0x7b, 0x3d, 0x2a, 0xa3, 0x19, 0x5e, 0x39, 0xa8, 0x03, 0x03, 0x98, 0xcc, 0xc0, 0xe6, 0xed,
+	0xde, 0xc2, 0x90, 0xa8, 0x34, 0xa3, 0x3d, 0xe3, 0xf2, 0xc3, 0x33, 0x46, 0xd9, 0x5c, 0x27, 0x4e,
+	0x28, 0x8e, 0x29, 0xf4, 0xd4, 0x6c, 0x2b, 0x4c, 0xbd, 0xc3, 0xd3, 0x6d, 0x60, 0x50, 0xc2, 0x1a,
+	0x53, 0xa1, 0x19, 0x05, 0x0f, 0xe5, 0xf8, 0x74, 0x8e, 0xe3, 0x70, 0x71, 0x0c, 0x07, 0x07, 0xef,
+	0x67, 0x2a, 0xe4, 0x74, 0xbe, 0x27, 0xdd, 0x0f, 0x60, 0x70, 0x81, 0x2e, 0x91, 0x9a, 0x73, 0xf4,
+	0x9c, 0x06, 0x03, 0xf6, 0xda, 0xbd, 0x8b, 0x17, 0xb5, 0xc3, 0xe7, 0x65, 0xec, 0xbc, 0xcb, 0x7b,
+	0x86, 0x4f, 0x2c, 0x0e, 0x03, 0x8b, 0x18, 0xf7, 0x7e, 0x10, 0x6e, 0x3a, 0x0b, 0xfb, 0xf3, 0xfd,
+	0xbe, 0x70, 0x61, 0x30, 0xbc, 0x1f, 0x4c, 0x28, 0xe4, 0xb0, 0x31, 0x66, 0xd8, 0x68, 0xb9, 0x49,
+	0x83, 0xed, 0x9d, 0xcd, 0x38, 0x91, 0xe7, 0xd5, 0xa7, 0xb4, 0x9b, 0xfb, 0x30, 0x0e, 0x14, 0x3e,
+	0x89, 0x1b, 0xa3, 0x8e, 0xdf, 0xf7, 0x3b, 0x41, 0xb6, 0x2f, 0x2e, 0xa1, 0x94, 0x1a, 0x5f, 0x14,
+	0xed, 0xa0, 0x30, 0xbc, 0x3f, 0xad, 0x91, 0xd3, 0xdc, 0xaf, 0x9b, 0x2a, 0x27, 0x6b, 0xf7, 0x03,
+	0xa4, 0x99, 0x66, 0x7e, 0xc2, 0x2d, 0x59, 0xce, 0x91, 0x55, 0x97, 0x4e, 0xc9, 0x21, 0x89, 0x80,
+	0xa6, 0x87, 0xe1, 0x0f, 0x5b, 0x41, 0x14, 0xa4, 0x3b, 0x8c, 0x7a, 0xe5, 0xfe, 0xec, 0x64, 0x57,
+	0x15, 0x05, 0x30, 0xa8, 0xb9, 0xdf, 0x40, 0xea, 0xfd, 0x1d, 0x3f, 0x95, 0x46, 0xdc, 0x37, 0x49,
+	0x3d, 0xb1, 0x8e, 0x8d, 0xe8, 0xc0, 0x9f, 0x7f, 0x55, 0x06, 0x00, 0xfe, 0x90, 0xa9, 0xe5, 0x6b,
+	0x87, 0x68, 0xf9, 0x37, 0x91, 0x89, 0x6e, 0xb2, 0xdf, 0xbe, 0x3e, 0x9f, 0x2f, 0x11, 0xb5, 0xc4,
+	0x5a, 0x41, 0x40, 0x51, 0x27, 0xed, 0x70, 0x96, 0x5d, 0x44, 0x9e, 0xb0, 0x77, 0x1c, 0xd7, 0x35,
+	0x08, 0x4c, 0x3c, 0xcc, 0x92, 0x99, 0xf7, 0xfa, 0x9f, 0x3c, 0x81, 0xe0, 0xb4, 0x71, 0xfd, 0xfd,
+	0x17, 0xc9, 0x19, 0xbf, 0xcb, 0x63, 0x75, 0xfc, 0x90, 0xbf, 0x21, 0xdf, 0x35, 0x0a, 0xbd, 0x3f,
+	0x9f, 0x07, 0xc2, 0x30, 0xbe, 0xf7, 0x57, 0x0e, 0x69, 0x8a, 0x17, 0xde, 0x88, 0xd1, 0x04, 0xc4,
+	0x2d, 0x8d, 0x0b, 0x89, 0x1f, 0x75, 0x76, 0xf2, 0x26, 0xa0, 0x0d, 0x03, 0x06, 0x16, 0xe6, 0x50,
+	0x0e, 0xbf, 0x4a, 0x19, 0xe1, 0x10, 0x4a, 0x30, 0x23, 0x65, 0xdf, 0x21, 0x39, 0xfc, 0x8c, 0x53,
+	0x5b, 0xf5, 0xe0, 0x53, 0x9b, 0xf7, 0x0f, 0x1d, 0x72, 0xb6, 0x88, 0x83, 0xbb, 0xc2, 0x7c, 0x36,
+	0x78, 0xf2, 0x46, 0xde, 0x03, 0x5f, 0x6d, 0xf8, 0x6c, 0xb0, 0xf6, 0xd7, 0xee, 0x5d, 0x7c, 0xaa,
+	0xe8, 0x59, 0x09, 0x07, 0x45, 0x01, 0x6d, 0x71, 0x7e, 0x3f, 0xc8, 0x1b, 0xc2, 0xe7, 0xd7, 0x97,
+	0x01, 0xdb, 0x8d, 0x22, 0x8a, 0xd5, 0x91, 0x45, 0x14, 0xff, 0xd0, 0x21, 0xe7, 0xe5, 0x67, 0x37,
+	0x98, 0xb5, 0x55, 0x61, 0xb4, 0x68, 0xd0, 0xdb, 0x14, 0xc2, 0x56, 0xf5, 0xa8, 0xbf, 0xc9, 0x5a,
+	0x41, 0x40, 0x51, 0x90, 0x41, 0x12, 0xe6, 0x05, 0xc1, 0x2e, 0xc1, 0x76, 0xf7, 0x3d, 0x58, 0xb3,
+	0x40, 0x5e, 0x50, 0x34, 0x17, 0xbe, 0x52, 0x97, 0x16, 0xf0, 0x33, 0x9c, 0xa5, 0xad, 0x11, 0x12,
+	0x50, 0xe0, 0xcf, 0xe5, 0x67, 0x55, 0x6d, 0xbc, 0x59, 0xe5, 0xad, 0x92, 0xda, 0x98, 0x8b, 0xfc,
+	0x58, 0x36, 0xa1, 0x17, 0x48, 0x03, 0xc9, 0x49, 0x03, 0x41, 0x19, 0x24, 0x63, 0xd2, 0x90, 0x75,
+	0x94, 0x5d, 0x8f, 0x54, 0x03, 0x5f, 0x3a, 0xd3, 0x29, 0x15, 0xbe, 0x9c, 0xa6, 0x03, 0xa6, 0xf6,
+	0x10, 0xe8, 0x3e, 0x43, 0xaa, 0xf4, 0x6e, 0x3f, 0xef, 0x35, 0x77, 0xe5, 0x6e, 0x3f, 0x48, 0x68,
+	0x8a, 0x48, 0xf4, 0x6e, 0x5f, 0xf8, 0xf4, 0xf3, 0xbe, 0xce, 0xf9, 0xf4, 0x7b, 0x77, 0x49, 0x53,
+	0x32, 0x64, 0x71, 0x1c, 0x7c, 0x4b, 0xef, 0x94, 0x11, 0xc7, 0x21, 0xe9, 0x8e, 0xd8, 0xcc, 0x0f,
+	0x08, 0xd1, 0xb9, 0x86, 0xca, 0xda, 0x02, 0x5e, 0x22, 0xb5, 0x4e, 0x2c, 0xb2, 0xc4, 0x35, 0x34,
+	0x19, 0xb6, 0x97, 0x67, 0x10, 0xef, 0x36, 0x99, 0xb9, 0x11, 0xc5, 0x77, 0x58, 0x59, 0x43, 0x96,
+	0xc5, 0x1f, 0x09, 0x6f, 0xe1, 0x3f, 0xf9, 0x93, 0x23, 0x83, 0x02, 0x87, 0xa9, 0xfc, 0xe0, 0x95,
+	0x51, 0xf9, 0xc1, 0xbd, 0x6f, 0x75, 0xc8, 0xb4, 0xba, 0x24, 0xb8, 0xb6, 0xb7, 0x3b, 0x9e, 0x73,
+	0x82, 0x91, 0xcd, 0xa7, 0x72, 0x48, 0x36, 0x1f, 0xe9, 0xc7, 0x50, 0x1d, 0xe5, 0xc7, 0xe0, 0xfd,
+	0x5f, 0x87, 0x9c, 0x56, 0x22, 0xc8, 0x3d, 0xfb, 0x73, 0x64, 0x7a, 0x73, 0x10, 0x84, 0x5d, 0xf1,
+	0x3b, 0xaf, 0x68, 0x17, 0x0c, 0x18, 0x58, 0x98, 0x68, 0x19, 0xdc, 0x0c, 0x22, 0x3f, 0xd9, 0x5f,
+	0xd7, 0x87, 0x04, 0xb5, 0x6f, 0x5c, 0x50, 0x10, 0x30, 0xb0, 0x30, 0x09, 0xcd, 0x9e, 0x74, 0x5f,
+	0xa9, 0x96, 0x9a, 0x84, 0x46, 0xf4, 0x87, 0x9e, 0x09, 0xca, 0x1f, 0x46, 0x71, 0xf4, 0xbe, 0xbf,
+	0x4a, 0x66, 0xec, 0xc4, 0x31, 0x63, 0x58, 0xee, 0x9e, 0x21, 0x75, 0x96, 0x4b, 0x26, 0x3f, 0xb0,
+	0xd8, 0xf3, 0xc0, 0x61, 0xe8, 0x67, 0xcf, 0x17, 0xa1, 0x72, 0xaa, 0x7c, 0x2b, 0x21, 0xd5, 0xfd,
+	0x00, 0x53, 0xc6, 0xe2, 0x2e, 0x4e, 0xb0, 0x42, 0xff, 0xc9, 0xc9, 0xb8, 0x6f, 0x26, 0xa6, 0x7e,
+	0x5f, 0x99, 0x49, 0x75, 0x44, 0xe6, 0x0a, 0xb1, 0x1b, 0x57, 0x03, 0x4f, 0x0e, 0x06, 0xc9, 0xfa,
+	0xc2, 0xd7, 0x91, 0x69, 0x13, 0xf3, 0xb0, 0x0d, 0x79, 0xc3, 0xdc, 0x90, 0x7f, 0xc6, 0x1c, 0x92,
+	0x22, 0x6d, 0xd0, 0x18, 0x93, 0xfd, 0x45, 0x52, 0xef, 0x28, 0x7f, 0xe0, 0xfb, 0x2a, 0xa9, 0xa3,
+	0xd2, 0x6a, 0x22, 0x19, 0xe0, 0xd4, 0xd0, 0x59, 0x6a, 0xc6, 0x90, 0x26, 0x5d, 0xee, 0xba, 0x09,
+	0xa9, 0x6e, 0xef, 0xed, 0x8a, 0x4d, 0xee, 0xf3, 0x25, 0x75, 0xef, 0xb5, 0xbd, 0x5d, 0x3d, 0xc3,
+	0xcc, 0x56, 0x40, 0x66, 0x63, 0x5c, 0x62, 0x59, 0xd9, 0xa5, 0xaa, 0x63, 0x14, 0x14, 0xff, 0x5c,
+	0x85, 0x9c, 0x19, 0x1a, 0x54, 0xee, 0x2b, 0xa4, 0x9e, 0xe0, 0x5b, 0xb6, 0x9c, 0x32, 0x36, 0x8f,
+	0x76, 0xcf, 0xe9, 0xcd, 0xa3, 0xdd, 0x0e, 0x9c, 0x25, 0xba, 0xb6, 0x6a, 0xaf, 0x75, 0x75, 0x83,
+	0xc6, 0x5f, 0x59, 0xb9, 0xb6, 0xce, 0x0f, 0x61, 0x40, 0xc1, 0x53, 0xe8, 0x1e, 0x60, 0x5f, 0xc4,
+	0xe5, 0x4a, 0x1d, 0x1c, 0x74, 0xa7, 0xe6, 0x7d, 0xd6, 0x1c, 0x82, 0xb7, 0xb4, 0x32, 0x3d, 0xae,
+	0x71, 0x64, 0x48, 0xb3, 0x56, 0xc7, 0xd5, 0xac, 0xde, 0x2f, 0x55, 0xc8, 0x29, 0x2b, 0x75, 0xb9,
+	0x1b, 0x92, 0x06, 0x0d, 0x99, 0x3b, 0x89, 0x5c, 0x7d, 0x8f, 0x5b, 0x05, 0x4d, 0xe9, 0xc9, 0x2b,
+	0x82, 0x2e, 0x28, 0x0e, 0x8f, 0x86, 0x13, 0xee, 0x73, 0x64, 0x5a, 0x0a, 0xf4, 0x3e, 0xbf, 0x17,
+	0xe6, 0xbb, 0xef, 0x8a, 0x01, 0x03, 0x0b, 0xd3, 0xfb, 0xb5, 0x2a, 0x69, 0x71, 0xff, 0x9b, 0xae,
+	0x9a, 0x0c, 0xca, 0x8f, 0xee, 0xbb, 0x75, 0x81, 0x01, 0xde, 0x91, 0x9b, 0xc7, 0x2d, 0x3a, 0x5a,
+	0xcc, 0x68, 0xac, 0xd8, 0x91, 0x1f, 0xc9, 0xc5, 0x8e, 0x70, 0x53, 0xd1, 0xf6, 0x09, 0x49, 0xf4,
+	0xc5, 0x15, 0x4c, 0xf2, 0x53, 0x15, 0x32, 0x9b, 0xab, 0xe8, 0x8a, 0x89, 0x66, 0xcd, 0x22, 0x60,
+	0x4e, 0x19, 0xd7, 0xcf, 0x07, 0x16, 0xf9, 0x3c, 0x5a, 0x29, 0xb0, 0x87, 0x34, 0x55, 0xbc, 0xdf,
+	0xab, 0x90, 0x19, 0xbb, 0x14, 0xed, 0x23, 0xd8, 0x53, 0x6f, 0x25, 0x4d, 0x56, 0x6d, 0xf1, 0x06,
+	0xdd, 0x97, 0xb7, 0xdc, 0xbc, 0xb0, 0x9d, 0x6c, 0x04, 0x0d, 0x7f, 0x24, 0x2a, 0xac, 0x79, 0xff,
+	0xc8, 0x21, 0xe7, 0xf8, 0x5b, 0xe6, 0xc7, 0xe1, 0xdf, 0x2c, 0xea, 0xdd, 0x0f, 0x95, 0x2b, 0x60,
+	0xae, 0x30, 0xc6, 0x61, 0xfd, 0x8b, 0x9b, 0x97, 0xb3, 0x42, 0x5a, 0x7b, 0x28, 0x3c, 0x82, 0xc2,
+	0x1e, 0x69, 0x30, 0x78, 0xff, 0xbe, 0x42, 0xa6, 0xd6, 0x16, 0x97, 0x95, 0x0a, 0x47, 0xef, 0xce,
+	0x84, 0xfa, 0xda, 0xfc, 0x68, 0x7a, 0x77, 0x4a, 0x00, 0x68, 0x1c, 0x3c, 0x45, 0x71, 0xef, 0xe8,
+	0x34, 0x7f, 0x8a, 0xe2, 0xce, 0xd3, 0x29, 0x48, 0x38, 0x5a, 0x47, 0x59, 0x12, 0x07, 0xf4, 0x58,
+	0xae, 0xda, 0xd7, 0xc6, 0x2c, 0xc9, 0x03, 0x1a, 0x29, 0x14, 0x06, 0x12, 0xee, 0xc6, 0x9d, 0x14,
+	0x91, 0x73, 0x16, 0xc1, 0x25, 0x6c, 0x46, 0x1b, 0x8f, 0x80, 0xa3, 0xd0, 0xdc, 0x6a, 0x86, 0xc8,
+	0x75, 0x5b, 0x68, 0x6e, 0x5e, 0x43, 0x74, 0x8d, 0x73, 0x94, 0x14, 0xd6, 0xb9, 0x38, 0xe6, 0xc9,
+	0xf1, 0xe2, 0x98, 0xbd, 0xdf, 0xab, 0x92, 0xa6, 0x36, 0xea, 0x06, 0x22, 0x93, 0x52, 0x29, 0x85,
+	0x57, 0x30, 0x36, 0x4e, 0x91, 0xe6, 0xde, 0x2c, 0x46, 0x22, 0xa5, 0xef, 0x74, 0xd0, 0x41, 0x24,
+	0xc8, 0x02, 0x9f, 0xd9, 0xa6, 0x5b, 0x95, 0x32, 0x42, 0xad, 0x14, 0xbb, 0x65, 0x4e, 0x39, 0x4e,
+	0x4c, 0x97, 0x13, 0xc5, 0x0c, 0x4c, 0xce, 0xee, 0x47, 0x45, 0xd8, 0x6c, 0xb5, 0xb4, 0xac, 0x68,
+	0x8d, 0x5c, 0xac, 0x6c, 0x1f, 0xf7, 0xd8, 0x59, 0x52, 0x52, 0x32, 0x41, 0x40, 0x52, 0xaa, 0x00,
+	0x98, 0x3a, 0xc5, 0xb0, 0x66, 0xe0, 0x8c, 0xbc, 0x94, 0xb8, 0xc3, 0x7d, 0x71, 0xc4, 0x90, 0x44,
+	0x0c, 0xba, 0x1c, 0x64, 0x71, 0x0f, 0xbb, 0x49, 0x38, 0xac, 0xe8, 0xa0, 0x4b, 0x09, 0x00, 0x8d,
+	0xe3, 0x25, 0xe4, 0x09, 0xc5, 0x54, 0xa6, 0x96, 0x10, 0x56, 0xc1, 0xdb, 0xa4, 0xc9, 0xef, 0x4f,
+	0xf7, 0xef, 0xeb, 0xb6, 0x80, 0xe9, 0x85, 0xeb, 0x92, 0x00, 0x68, 0x5a, 0xde, 0xe7, 0x9b, 0x24,
+	0x97, 0x4b, 0xc9, 0xbd, 0x4b, 0x9a, 0x2a, 0xf7, 0x4b, 0x39, 0x69, 0x05, 0xf4, 0x28, 0x56, 0x1d,
+	0xa0, 0x5f, 0x54, 0x33, 0x73, 0xb7, 0xe5, 0xd5, 0x02, 0xd7, 0x30, 0x2f, 0xe4, 0xaf, 0x16, 0xbe,
+	0x69, 0xbc, 0x9b, 0x66, 0x9c, 0x1f, 0x97, 0x79, 0x12, 0xdf, 0xb9, 0x43, 0x6f, 0x21, 0xaa, 0x87,
+	0xdc, 0x42, 0x7c, 0x52, 0x94, 0x08, 0x05, 0x9a, 0x0e, 0xc2, 0x4c, 0x8c, 0xc0, 0x17, 0x4a, 0x9c,
+	0xd9, 0x9c, 0xb0, 0x4e, 0x8d, 0xc8, 0x7f, 0x83, 0xc1, 0xd4, 0xbe, 0x2b, 0x9a, 0x38, 0xd1, 0xbb,
+	0xa2, 0xc9, 0x52, 0xef, 0x8a, 0x9e, 0x25, 0x84, 0xcd, 0x27, 0x1e, 0xae, 0xd5, 0x60, 0x26, 0x54,
+	0xb5, 0xac, 0x81, 0x82, 0x80, 0x81, 0x85, 0xb1, 0xe0, 0xb3, 0x98, 0x2e, 0x2f, 0x88, 0xb6, 0xe7,
+	0xfb, 0x68, 0x77, 0xf7, 0x43, 0x91, 0x35, 0xf0, 0xe6, 0xf1, 0x7b, 0xfd, 0xb6, 0xbf, 0x47, 0x25,
+	0x55, 0x51, 0x80, 0xd4, 0x66, 0x05, 0x79, 0xde, 0x18, 0xfa, 0xe6, 0x8b, 0xff, 0x53, 0x91, 0x38,
+	0xb0, 0x6c, 0x41, 0xb4, 0x5a, 0x90, 0x8c, 0x40, 0xf3, 0x74, 0x5f, 0x21, 0x0d, 0x3f, 0xf2, 0xc3,
+	0xfd, 0x34, 0x48, 0x5b, 0x53, 0x65, 0x68, 0x79, 0xe4, 0x3f, 0x2f, 0x28, 0xca, 0x54, 0x7d, 0xa8,
+	0xc3, 0x64, 0x1b, 0x28, 0x7e, 0xee, 0x27, 0x48, 0x23, 0x11, 0x9a, 0xa8, 0x35, 0x5d, 0x86, 0xdf,
+	0xf3, 0x08, 0x05, 0xc7, 0x05, 0x90, 0x6d, 0xa0, 0x98, 0x7a, 0x5f, 0x4d, 0xec, 0x4c, 0xaf, 0x98,
+	0x37, 0x81, 0x27, 0x96, 0xe5, 0x3e, 0x11, 0x2c, 0x6f, 0x82, 0x95, 0x03, 0xf6, 0x17, 0x1c, 0x62,
+	0xa6, 0xa3, 0x75, 0x5f, 0xe6, 0x79, 0x6f, 0x9d, 0x32, 0xee, 0xd8, 0x0d, 0xba, 0x73, 0xab, 0x7e,
+	0x3f, 0xe7, 0xef, 0x29, 0x93, 0xdf, 0xa2, 0x13, 0xa6, 0x84, 0x1e, 0xe9, 0xb8, 0xf6, 0x71, 0xf2,
+	0x98, 0x4c, 0x02, 0x25, 0xaf, 0xc3, 0x85, 0xdf, 0xd5, 0x83, 0x09, 0xc1, 0xfb, 0x45, 0x87, 0x5c,
+	0xca, 0x0b, 0x90, 0xae, 0xc6, 0x51, 0x90, 0xc5, 0x49, 0x9b, 0x66, 0x59, 0x10, 0x6d, 0xb3, 0xf2,
+	0x04, 0x77, 0xfc, 0x44, 0x96, 0xa8, 0x64, 0x4b, 0xf5, 0x6d, 0x3f, 0x89, 0x80, 0xb5, 0xa2, 0x9b,
+	0x3c, 0x8f, 0x30, 0x12, 0xe7, 0xf0, 0x63, 0x6a, 0xca, 0x82, 0xee, 0xd0, 0x86, 0x00, 0x1e, 0xdd,
+	0x04, 0x82, 0xa1, 0xf7, 0x27, 0x0e, 0x71, 0xd7, 0xf6, 0x68, 0x92, 0x04, 0x5d, 0x23, 0x26, 0x8a,
+	0x15, 0x5e, 0x37, 0x0a, 0xac, 0x9b, 0x29, 0xca, 0x72, 0x85, 0xd7, 0x8d, 0x5f, 0xc5, 0x85, 0xd7,
+	0x2b, 0x47, 0x2b, 0xbc, 0xee, 0xae, 0x91, 0x73, 0x3d, 0x6e, 0x48, 0xe0, 0xc5, 0x8c, 0xb9, 0x55,
+	0x41, 0x25, 0xb3, 0x39, 0x8f, 0xc9, 0xbe, 0x57, 0x8b, 0x10, 0xa0, 0xf8, 0x39, 0xef, 0x5d, 0xc4,
+	0xe5, 0xb1, 0x01, 0x8b, 0x45, 0x0e, 0xfb, 0x23, 0x0d, 0x6d, 0xde, 0xe7, 0xeb, 0x64, 0x36, 0x57,
+	0xc0, 0x0c, 0x8d, 0x38, 0xc3, 0x11, 0x02, 0xc7, 0xd6, 0x2d, 0xc3, 0xe2, 0x8d, 0x15, 0x73, 0x10,
+	0x91, 0x7a, 0x10, 0xf5, 0x07, 0x59, 0x39, 0xc9, 0xbc, 0xb8, 0x10, 0xcb, 0x48, 0xd0, 0xb8, 0x19,
+	0xc3, 0x9f, 0xc0, 0xd9, 0x94, 0x19, 0xc1, 0x60, 0x1d, 0xb3, 0x6b, 0x0f, 0xc9, 0xd0, 0xf7, 0x49,
+	0x1d, 0x4f, 0x50, 0x2f, 0xe3, 0x16, 0x23, 0x37, 0x58, 0x4e, 0xda, 0xd9, 0xf4, 0x67, 0x2b, 0x64,
+	0xca, 0xf8, 0x68, 0xee, 0x8f, 0xdb, 0xc9, 0xda, 0x9d, 0xf2, 0x5e, 0x89, 0xd1, 0x9f, 0xd3, 0xe9,
+	0xd8, 0xf9, 0x2b, 0xbd, 0x69, 0x38, 0x4f, 0xfb, 0x6b, 0xf7, 0x2e, 0x9e, 0xce, 0x65, 0x62, 0xb7,
+	0x72, 0xb7, 0x5f, 0xf8, 0x16, 0x32, 0x9b, 0x23, 0x53, 0xf0, 0xca, 0x1b, 0xe6, 0x2b, 0x1f, 0xdb,
+	0xe0, 0x6c, 0x76, 0xd9, 0x4f, 0x63, 0x97, 0x89, 0x14, 0x3e, 0x71, 0x48, 0xc7, 0xb0, 0xb6, 0xe7,
+	0x4e, 0xb8, 0x95, 0x31, 0x33, 0x75, 0xbd, 0x85, 0x34, 0xfa, 0x71, 0x18, 0x74, 0x02, 0x55, 0xeb,
+	0x85, 0xad, 0xd5, 0xeb, 0xa2, 0x0d, 0x14, 0xd4, 0xbd, 0x43, 0x9a, 0x2f, 0xdd, 0xc9, 0xf8, 0x45,
+	0x77, 0xab, 0x56, 0xea, 0xfd, 0xb6, 0xda, 0x21, 0xc9, 0x96, 0x14, 0x34, 0x2f, 0xf4, 0xba, 0x60,
+	0x8b, 0xa0, 0x0c, 0xe7, 0x67, 0x17, 0x7d, 0x6c, 0x75, 0x4c, 0x41, 0x40, 0xbc, 0x7f, 0x33, 0x45,
+	0xce, 0x16, 0x55, 0x91, 0x74, 0x3f, 0x46, 0x26, 0xb8, 0x8c, 0xe5, 0x14, 0x2a, 0x2e, 0xe2, 0x71,
+	0x8d, 0x11, 0x14, 0x62, 0xb1, 0xff, 0x41, 0xf0, 0x14, 0xdc, 0x43, 0x7f, 0xb3, 0x55, 0x39, 0x41,
+	0xee, 0x2b, 0xbe, 0xe6, 0xbe, 0xe2, 0x73, 0xee, 0xa1, 0xbf, 0xe9, 0xde, 0x25, 0xf5, 0xed, 0x20,
+	0xa3, 0xbe, 0x30, 0x0f, 0xde, 0x3e, 0x11, 0xe6, 0xd4, 0xe7, 0xbb, 0x34, 0xf6, 0x2f, 0x70, 0x86,
+	0x18, 0x17, 0x3d, 0xbb, 0x69, 0xa7, 0x08, 0x14, 0xca, 0xd3, 0x2f, 0x5f, 0x88, 0x5c, 0x2e, 0x42,
+	0xbe, 0xf1, 0xcf, 0x35, 0x42, 0x5e, 0x1c, 0x8c, 0xd1, 0x9a, 0xdc, 0x0a, 0x42, 0xa3, 0x14, 0xdb,
+	0x09, 0x7c, 0x9c, 0xab, 0x8c, 0x81, 0x3e, 0x7f, 0xf2, 0xdf, 0x29, 0x48, 0xce, 0xa3, 0x56, 0xaa,
+	0x89, 0xe3, 0xae, 0x54, 0x93, 0x0f, 0x69, 0xa5, 0xfa, 0xb4, 0x43, 0x9a, 0xaa, 0xa7, 0x45, 0xaa,
+	0xb5, 0x0f, 0x9c, 0xe0, 0x27, 0xe7, 0xb6, 0x0f, 0xf5, 0x13, 0x34, 0x73, 0x4c, 0xd2, 0x32, 0xe5,
+	0xbf, 0x32, 0x48, 0x68, 0x97, 0xee, 0xc5, 0xfd, 0x54, 0x9c, 0x32, 0x3f, 0x54, 0xbe, 0x30, 0xf3,
+	0xc8, 0x64, 0x89, 0xee, 0xad, 0xf5, 0x53, 0x91, 0x6a, 0x44, 0x37, 0x80, 0x29, 0x02, 0x66, 0x0b,
+	0x97, 0xeb, 0x38, 0x29, 0xa3, 0x42, 0x49, 0x91, 0x34, 0x63, 0x65, 0xce, 0xa1, 0xe4, 0xc9, 0x4e,
+	0x1c, 0x65, 0x41, 0x34, 0xa0, 0x6b, 0x11, 0xd0, 0x7e, 0x7c, 0x33, 0xce, 0xae, 0xc6, 0x83, 0xa8,
+	0x7b, 0x25, 0x49, 0xe2, 0xa4, 0x35, 0x65, 0xd7, 0xa7, 0x5f, 0x1c, 0x8d, 0x0a, 0x07, 0xd1, 0x39,
+	0xce, 0x9e, 0xe1, 0x5e, 0x85, 0x5c, 0x3c, 0xa4, 0xb3, 0xf1, 0xfe, 0x33, 0x4e, 0xb6, 0xfd, 0x28,
+	0x78, 0xc5, 0x4c, 0x8f, 0xaa, 0x36, 0xa4, 0x6b, 0x06, 0x0c, 0x2c, 0x4c, 0x33, 0x6f, 0x5e, 0xe5,
+	0x90, 0xbc, 0x79, 0x97, 0x48, 0x2d, 0xc1, 0xa8, 0xfc, 0xdc, 0xb9, 0x0a, 0x5f, 0x16, 0x18, 0x44,
+	0x3a, 0x0d, 0xd6, 0x46, 0x38, 0x0d, 0x9a, 0x69, 0x3c, 0xeb, 0x0f, 0x24, 0x8d, 0xa7, 0xe1, 0xa7,
+	0x38, 0x31, 0xd2, 0x4f, 0xf1, 0x73, 0x55, 0xf2, 0xc6, 0x03, 0xa7, 0x96, 0x0e, 0xda, 0x71, 0x0e,
+	0x08, 0xda, 0x91, 0xdd, 0x53, 0x39, 0xac, 0x7b, 0xaa, 0x23, 0xba, 0xe7, 0xdb, 0x51, 0x63, 0xc8,
+	0xb4, 0xb2, 0x62, 0x91, 0x38, 0x66, 0x20, 0xd5, 0xa8, 0x2c, 0xb5, 0x42, 0x59, 0x48, 0x28, 0x68,
+	0xbe, 0x78, 0x5c, 0xb2, 0x72, 0xc6, 0xd5, 0xcb, 0x58, 0x31, 0x47, 0xa6, 0x76, 0xe5, 0x6a, 0x62,
+	0x54, 0x22, 0x3a, 0xef, 0x97, 0x6b, 0xe4, 0x99, 0x31, 0x16, 0x3a, 0x73, 0x14, 0x3b, 0x63, 0x8e,
+	0xe2, 0x2f, 0xf2, 0xcf, 0xf4, 0xa9, 0xc2, 0xcf, 0x04, 0xe5, 0x7f, 0xa6, 0x83, 0xbf, 0x10, 0xbb,
+	0x03, 0x8b, 0x52, 0xda, 0x19, 0x24, 0x3c, 0x80, 0xd1, 0x48, 0xd7, 0xb1, 0x2c, 0xda, 0x41, 0x61,
+	0xe0, 0xf1, 0xb7, 0xe3, 0xe3, 0xf4, 0x9f, 0x2c, 0x29, 0x47, 0x98, 0x99, 0xf9, 0x83, 0xef, 0xbe,
+	0x16, 0xe7, 0x51, 0x03, 0x70, 0x36, 0x98, 0xa9, 0xf9, 0xc2, 0xe8, 0xdd, 0x08, 0xe6, 0xc8, 0xda,
+	0x64, 0x8e, 0xe0, 0xab, 0xcc, 0x69, 0x4f, 0x0c, 0x1d, 0xf6, 0xbe, 0xba, 0x19, 0x4c, 0x1c, 0xb4,
+	0x97, 0x98, 0x1e, 0xe4, 0xab, 0x86, 0xb7, 0x1f, 0xb3, 0x97, 0x6c, 0xe4, 0x81, 0x30, 0x8c, 0x8f,
+	0x49, 0x62, 0xb3, 0x20, 0x0b, 0x29, 0x7f, 0x5a, 0x64, 0xc0, 0xc6, 0x63, 0xd9, 0x86, 0x6a, 0x05,
+	0x03, 0xc3, 0xfb, 0x42, 0xb5, 0xf8, 0x35, 0xf8, 0x2e, 0xf7, 0x28, 0xa3, 0xff, 0x10, 0xb7, 0x6e,
+	0x53, 0x43, 0x57, 0x1f, 0xb4, 0x86, 0xae, 0x8d, 0xd2, 0xd0, 0x98, 0x22, 0xb6, 0x9f, 0x73, 0xdf,
+	0x16, 0xd7, 0xa2, 0x2a, 0x45, 0xec, 0x90, 0x7b, 0xf7, 0xd0, 0x13, 0x8f, 0xf8, 0x50, 0xfd, 0xf5,
+	0x0a, 0x39, 0x3f, 0xf2, 0x60, 0xf1, 0x80, 0x56, 0x20, 0xf3, 0xf3, 0xd7, 0x1e, 0xcc, 0xe7, 0x37,
+	0x3f, 0x4a, 0xfd, 0xd0, 0x8f, 0x32, 0xce, 0x72, 0xfe, 0xfb, 0x95, 0x91, 0x93, 0x05, 0x0f, 0xa2,
+	0x5f, 0xb2, 0x3d, 0xf9, 0xf5, 0xe4, 0x94, 0xdf, 0xef, 0x73, 0x3c, 0x16, 0x9b, 0x96, 0x4b, 0x5b,
+	0x3d, 0x6f, 0x02, 0xc1, 0xc6, 0x1d, 0xab, 0x63, 0x3f, 0x59, 0x21, 0xb3, 0xb9, 0xaa, 0x1a, 0x22,
+	0x1e, 0xc0, 0x29, 0x8a, 0x07, 0xb0, 0xef, 0x57, 0x2b, 0x0f, 0xf2, 0x7e, 0xf5, 0xbd, 0xa4, 0xc1,
+	0x4e, 0x85, 0xf7, 0x97, 0x3c, 0x49, 0x0d, 0xc0, 0x17, 0x04, 0x0d, 0x50, 0xd4, 0xbc, 0x3f, 0x76,
+	0x48, 0x13, 0xe8, 0x16, 0xd7, 0xf2, 0x58, 0x50, 0x8a, 0x0d, 0x13, 0xa7, 0x8c, 0x82, 0x52, 0x38,
+	0xb8, 0xd2, 0x80, 0x65, 0xe7, 0x29, 0x1a, 0x70, 0xc7, 0x4d, 0xbe, 0xf4, 0x0c, 0xa9, 0x77, 0x76,
+	0xfc, 0x24, 0xcb, 0x07, 0x9e, 0xb3, 0x24, 0xf7, 0xc0, 0x61, 0xde, 0xaf, 0x34, 0xf1, 0xf5, 0xfa,
+	0x31, 0x16, 0x2c, 0x4f, 0x65, 0xe8, 0x8d, 0x33, 0x22, 0xf4, 0xc6, 0xf4, 0x12, 0xa8, 0x1c, 0x29,
+	0x71, 0x71, 0xf5, 0xd0, 0xc4, 0xc5, 0x98, 0xc4, 0x33, 0xdd, 0x59, 0x4f, 0x82, 0x3d, 0x3f, 0xc3,
+	0xcb, 0x90, 0x56, 0xcd, 0x1e, 0xcc, 0xed, 0xf6, 0x75, 0x0d, 0x04, 0x1b, 0x17, 0x73, 0x68, 0xea,
+	0xf4, 0xc1, 0x34, 0xc9, 0x58, 0xe0, 0x3b, 0x9f, 0x0d, 0x2a, 0x63, 0x9c, 0x4e, 0x38, 0x2c, 0x10,
+	0x60, 0xf8, 0x19, 0x5c, 0x77, 0xac, 0x46, 0x14, 0x64, 0xc2, 0x5e, 0x77, 0x2c, 0x3a, 0x28, 0xcb,
+	0xd0, 0x13, 0x58, 0xc5, 0x87, 0x0f, 0x8c, 0xf9, 0x7e, 0xdf, 0x78, 0xa3, 0x49, 0xbb, 0x8a, 0xcf,
+	0xb5, 0x61, 0x14, 0x28, 0x7a, 0x0e, 0xcd, 0x9b, 0xaa, 0x79, 0x79, 0x49, 0x5c, 0x36, 0x2b, 0xf3,
+	0xa6, 0x22, 0xb3, 0xdc, 0x05, 0x13, 0x0f, 0x6b, 0xd5, 0xea, 0x9f, 0x3c, 0x91, 0x0a, 0xf7, 0xfa,
+	0x58, 0x12, 0x99, 0xd9, 0x55, 0xad, 0xda, 0x6b, 0x85, 0x68, 0x5d, 0x18, 0xf5, 0xbc, 0xbb, 0x49,
+	0x2e, 0x28, 0xd0, 0x95, 0x28, 0x63, 0xa9, 0x0e, 0x52, 0xba, 0xe0, 0xa7, 0xcc, 0x7f, 0x89, 0xb0,
+	0xf7, 0xf4, 0x04, 0xf5, 0x0b, 0xd7, 0x82, 0xec, 0x7a, 0x11, 0x26, 0xac, 0xc0, 0x01, 0x54, 0xd0,
+	0xc9, 0x84, 0x46, 0xfe, 0x66, 0x48, 0xd7, 0x16, 0x97, 0xc5, 0xa9, 0x5c, 0xc7, 0x28, 0x49, 0x00,
+	0x68, 0x1c, 0x15, 0x65, 0x33, 0x3d, 0x2a, 0xca, 0x06, 0xc3, 0x65, 0xb7, 0x3b, 0x7d, 0xdc, 0x69,
+	0x07, 0x1d, 0x3a, 0xdf, 0x61, 0x6e, 0xfd, 0xf8, 0x61, 0x78, 0x79, 0x25, 0x15, 0x2e, 0x7b, 0x6d,
+	0x71, 0x7d, 0x08, 0x07, 0x0a, 0x9f, 0x64, 0xe1, 0x1f, 0x98, 0x14, 0xb9, 0xf5, 0x58, 0x2e, 0xfc,
+	0x03, 0x1b, 0x81, 0xc3, 0xd0, 0x99, 0x9d, 0x85, 0x8c, 0x5f, 0xcf, 0xb2, 0xbe, 0xda, 0xda, 0xb7,
+	0xce, 0xda, 0x79, 0x9a, 0xaf, 0x0e, 0x61, 0x40, 0xc1, 0x53, 0xb8, 0xf3, 0x8b, 0x62, 0x46, 0xbd,
+	0xf5, 0x84, 0xbd, 0xf3, 0xbb, 0xc9, 0x9b, 0x41, 0xc2, 0xdd, 0x0f, 0x92, 0xd6, 0x20, 0xa5, 0xcc,
+	0x68, 0x70, 0x3b, 0x4e, 0x76, 0xc3, 0xd8, 0xef, 0x2e, 0x77, 0x69, 0x94, 0x61, 0x68, 0x6f, 0x8b,
+	0x31, 0xbf, 0x24, 0x9e, 0x6d, 0xbd, 0x38, 0x02, 0x0f, 0x46, 0x52, 0xc8, 0x27, 0x1a, 0x3f, 0x3f,
+	0x66, 0xa2, 0xf1, 0x75, 0x72, 0x56, 0xae, 0xed, 0x6b, 0x8b, 0xcb, 0xea, 0xa5, 0x5b, 0x17, 0xec,
+	0x2a, 0xc7, 0xcb, 0x05, 0x38, 0x50, 0xf8, 0xa4, 0xf7, 0x47, 0x0e, 0x39, 0xa5, 0x34, 0xd8, 0x03,
+	0x48, 0x5d, 0x11, 0xda, 0xa9, 0x2b, 0xae, 0x1d, 0x7f, 0x0d, 0x60, 0x92, 0x8f, 0x08, 0x74, 0xfb,
+	0xa5, 0x53, 0x84, 0xe8, 0x75, 0x42, 0x6d, 0x53, 0x9c, 0x91, 0xdb, 0x94, 0x47, 0x56, 0x47, 0x17,
+	0x25, 0x8e, 0xae, 0x3f, 0xdc, 0xc4, 0xd1, 0x6d, 0x72, 0x4e, 0x0e, 0x29, 0x7e, 0xad, 0x8e, 0xd1,
+	0xff, 0x52, 0xe5, 0x1b, 0x65, 0xab, 0x97, 0x8b, 0x90, 0xa0, 0xf8, 0x59, 0x6b, 0x7f, 0x3b, 0x79,
+	0xe8, 0xfe, 0x56, 0x69, 0xb9, 0x95, 0x2d, 0x59, 0x54, 0x3e, 0xa7, 0xe5, 0x56, 0xae, 0xb6, 0x41,
+	0xe3, 0x14, 0x2f, 0x75, 0xcd, 0x92, 0x96, 0x3a, 0x72, 0xe4, 0xa5, 0x4e, 0x2a, 0xdd, 0xa9, 0x91,
+	0x4a, 0x57, 0x5e, 0xdf, 0x4d, 0x8f, 0xbc, 0xbe, 0x7b, 0x37, 0x99, 0x09, 0xa2, 0x1d, 0x9a, 0x04,
+	0x19, 0xed, 0xb2, 0xb9, 0xc0, 0x14, 0x72, 0x43, 0x6f, 0x74, 0x96, 0x2d, 0x28, 0xe4, 0xb0, 0xed,
+	0x95, 0x62, 0x66, 0x8c, 0x95, 0x62, 0xc4, 0xfa, 0x3c, 0x5b, 0xce, 0xfa, 0x7c, 0xfa, 0xf8, 0xeb,
+	0xf3, 0x99, 0x13, 0x5d, 0x9f, 0xdd, 0x52, 0xd6, 0xe7, 0xb1, 0x96, 0x3e, 0xc3, 0x50, 0x71, 0xf6,
+	0x10, 0x43, 0xc5, 0xa8, 0xc5, 0xf9, 0xdc, 0x7d, 0x2f, 0xce, 0xc5, 0xeb, 0xee, 0xe3, 0xaf, 0xaf,
+	0xbb, 0x65, 0xac, 0xbb, 0xf8, 0xfd, 0xbb, 0xb4, 0x9f, 0xed, 0xb4, 0x9e, 0x64, 0x83, 0x55, 0x7d,
+	0xff, 0x25, 0x6c, 0x04, 0x0e, 0xf3, 0x3e, 0x5d, 0x21, 0xe7, 0xf4, 0xf2, 0x85, 0x4a, 0x23, 0xd8,
+	0x42, 0x05, 0x4e, 0xd1, 0x81, 0x92, 0x7b, 0x06, 0x18, 0x59, 0x55, 0x74, 0x5e, 0x19, 0x05, 0x01,
+	0x03, 0x8b, 0x25, 0x27, 0xa1, 0x09, 0xab, 0x98, 0x97, 0x5f, 0xdb, 0x16, 0x45, 0x3b, 0x28, 0x0c,
+	0xec, 0x29, 0xfc, 0x5f, 0xe4, 0xc6, 0xca, 0x97, 0x42, 0x59, 0xd4, 0x20, 0x30, 0xf1, 0xd0, 0x2b,
+	0xa0, 0x23, 0xf5, 0x2a, 0xae, 0x6f, 0xd3, 0xfc, 0xfc, 0xad, 0x54, 0xa9, 0x82, 0x4a, 0x71, 0x58,
+	0xf2, 0x9c, 0xfa, 0xb0, 0x38, 0xd8, 0x0e, 0x0a, 0xc3, 0xfb, 0x5f, 0x0e, 0x39, 0x5f, 0xd8, 0x15,
+	0x0f, 0x60, 0xcf, 0x72, 0xd7, 0xde, 0xb3, 0xb4, 0xcb, 0x3a, 0xb7, 0x1a, 0x6f, 0x31, 0x62, 0xff,
+	0xf2, 0x1f, 0x1d, 0x32, 0xa3, 0xf1, 0x1f, 0xc0, 0xab, 0x06, 0xf6, 0xab, 0x96, 0x77, 0x44, 0x6f,
+	0x0e, 0xbd, 0xdb, 0xaf, 0x55, 0x88, 0x2a, 0x4f, 0x34, 0xdf, 0xc9, 0xc6, 0x8b, 0x0c, 0xc5, 0x6c,
+	0xbb, 0x7e, 0xe2, 0xf7, 0xd2, 0x72, 0xdc, 0x08, 0x6d, 0xfe, 0xcc, 0x6d, 0x47, 0xdf, 0x7c, 0xb2,
+	0x9f, 0x29, 0x08, 0x86, 0xac, 0x9c, 0x22, 0xaf, 0xfc, 0xd2, 0x15, 0x39, 0x0e, 0x74, 0x39, 0x45,
+	0xd1, 0x0e, 0x0a, 0x03, 0x57, 0xd5, 0xa0, 0x13, 0x47, 0x8b, 0xa1, 0x9f, 0xa6, 0x62, 0xa3, 0xa7,
+	0x56, 0xd5, 0x65, 0x09, 0x00, 0x8d, 0xc3, 0xbc, 0x70, 0x82, 0xb4, 0x1f, 0xfa, 0xfb, 0x86, 0x31,
+	0xca, 0xc8, 0x01, 0xa9, 0x40, 0x60, 0xe2, 0x79, 0x3d, 0xd2, 0xb2, 0x5f, 0x62, 0x89, 0x6e, 0xb1,
+	0x20, 0x8c, 0xb1, 0xba, 0x13, 0x43, 0x11, 0xd8, 0x53, 0x2b, 0x03, 0xbf, 0x55, 0xb1, 0xa5, 0x9c,
+	0x97, 0x00, 0xd0, 0x38, 0xde, 0xd7, 0x92, 0xc7, 0x0a, 0xfa, 0x6c, 0x0c, 0x4f, 0xc3, 0x5f, 0xaa,
+	0x90, 0x59, 0xfb, 0xc9, 0x94, 0x85, 0x29, 0x73, 0x99, 0x83, 0xb4, 0x13, 0xef, 0xd1, 0x64, 0x1f,
+	0xc5, 0x70, 0x72, 0x61, 0xca, 0x43, 0x18, 0x50, 0xf0, 0x14, 0xab, 0x14, 0xd6, 0x55, 0xaf, 0x2e,
+	0x87, 0xc7, 0xad, 0x32, 0x87, 0x87, 0xee, 0x59, 0xe3, 0xbb, 0x68, 0x96, 0x60, 0xf2, 0xc7, 0x4d,
+	0x12, 0x0b, 0xb2, 0xc2, 0x48, 0xe4, 0x2c, 0x88, 0xc4, 0x2b, 0x8b, 0x81, 0xa3, 0x36, 0x49, 0xab,
+	0xc3, 0x28, 0x50, 0xf4, 0x9c, 0xf7, 0xe7, 0x75, 0xa2, 0x92, 0x65, 0x31, 0xef, 0xd5, 0x92, 0x7c,
+	0x7f, 0x8f, 0x1a, 0xec, 0xae, 0xbe, 0x74, 0xed, 0x20, 0x77, 0x32, 0x6e, 0x4a, 0x33, 0xef, 0x1d,
+	0x54, 0x87, 0x6d, 0x68, 0x10, 0x98, 0x78, 0x28, 0x49, 0x18, 0xec, 0x51, 0xfe, 0xd0, 0x84, 0x2d,
+	0xc9, 0x8a, 0x04, 0x80, 0xc6, 0x41, 0x49, 0xba, 0xc1, 0xd6, 0x56, 0x6b, 0xd2, 0x96, 0x04, 0x7b,
+	0x07, 0x18, 0x84, 0xd7, 0x92, 0x8c, 0x77, 0xc5, 0xc1, 0xc0, 0xa8, 0x25, 0x19, 0xef, 0x02, 0x83,
+	0xe0, 0x57, 0x8a, 0xe2, 0xa4, 0xe7, 0x87, 0xc1, 0x2b, 0xb4, 0xab, 0xb8, 0x88, 0x03, 0x81, 0xfa,
+	0x4a, 0x37, 0x87, 0x51, 0xa0, 0xe8, 0x39, 0x1c, 0xd0, 0xfd, 0x84, 0x76, 0x83, 0x4e, 0x66, 0x52,
+	0x23, 0xf6, 0x80, 0x5e, 0x1f, 0xc2, 0x80, 0x82, 0xa7, 0x30, 0xcb, 0xa8, 0x4c, 0x76, 0x26, 0x13,
+	0x04, 0x4f, 0xd9, 0x59, 0x46, 0xc1, 0x06, 0x43, 0x1e, 0x1f, 0x35, 0x56, 0x4f, 0xe4, 0xb4, 0x6f,
+	0x4d, 0xdb, 0x1a, 0x4b, 0xe6, 0xba, 0x07, 0x85, 0xc1, 0x02, 0xc7, 0x58, 0xc2, 0x15, 0x66, 0xd9,
+	0x97, 0xb5, 0xf6, 0xd6, 0xcb, 0x99, 0x41, 0x57, 0x15, 0x61, 0x33, 0xe7, 0xa7, 0x62, 0x06, 0x26,
+	0x67, 0xef, 0x37, 0x1d, 0xe2, 0x0e, 0x3f, 0x38, 0x5e, 0x22, 0x60, 0xee, 0x1f, 0x9d, 0x0c, 0xa5,
+	0x8a, 0xe4, 0xcd, 0x20, 0xe1, 0x38, 0xdc, 0xb4, 0xb1, 0x3d, 0x37, 0xf0, 0x0b, 0x6d, 0xe4, 0x58,
+	0xfb, 0x78, 0xb0, 0x29, 0xbb, 0x38, 0x9f, 0xf7, 0xa8, 0xad, 0x41, 0x60, 0xe2, 0x79, 0x9f, 0xac,
+	0xe2, 0xbe, 0x65, 0x44, 0x41, 0x8e, 0x07, 0xe6, 0xc1, 0x6f, 0xcf, 0xf3, 0xda, 0x18, 0xf3, 0x1c,
+	0xbd, 0xe3, 0xd3, 0x38, 0x52, 0xde, 0xf1, 0xf5, 0x91, 0xde, 0xf1, 0x06, 0x56, 0xb1, 0x77, 0xfc,
+	0x44, 0x59, 0xde, 0xf1, 0x93, 0xf7, 0xe9, 0x1d, 0xff, 0xaf, 0xea, 0x44, 0xd5, 0xa4, 0xbf, 0x49,
+	0xb3, 0x3b, 0x71, 0xb2, 0x1b, 0x44, 0xdb, 0x2c, 0x1d, 0xd5, 0x8f, 0x39, 0x32, 0x17, 0xda, 0x8a,
+	0x99, 0xb7, 0x60, 0xab, 0xa4, 0x3a, 0xde, 0x16, 0xb3, 0xb9, 0x0d, 0x83, 0x11, 0xf7, 0xb2, 0xca,
+	0xe5, 0x5c, 0xe3, 0x20, 0xb0, 0x24, 0x72, 0xbf, 0x85, 0x10, 0x79, 0x35, 0xb1, 0x25, 0xd7, 0xb5,
+	0xe5, 0x72, 0xe4, 0xc3, 0xeb, 0x31, 0x75, 0x6a, 0xd8, 0x50, 0x4c, 0xc0, 0x60, 0x88, 0x7e, 0x79,
+	0x66, 0xea, 0xb2, 0xa9, 0x67, 0x3f, 0x7a, 0x22, 0x7d, 0x33, 0x4e, 0x46, 0x07, 0x20, 0x93, 0x41,
+	0xb4, 0x8d, 0xe3, 0x44, 0x78, 0x11, 0xbf, 0xb9, 0x28, 0xdb, 0xe6, 0x4a, 0xec, 0x77, 0x17, 0xfc,
+	0xd0, 0x8f, 0x3a, 0x58, 0x73, 0x8d, 0xa1, 0x6b, 0x3d, 0x20, 0x1a, 0x40, 0x12, 0x1a, 0x2a, 0x54,
+	0x5f, 0x1f, 0xa7, 0x50, 0xfd, 0x85, 0xf7, 0x90, 0x33, 0x43, 0x1f, 0xf3, 0x48, 0x09, 0x1c, 0x8e,
+	0x91, 0x67, 0xf3, 0x97, 0x27, 0xf4, 0x56, 0x00, 0x33, 0x8b, 0xb2, 0xba, 0xe7, 0x89, 0xfe, 0xa2,
+	0xe2, 0x54, 0x50, 0xe2, 0x10, 0x51, 0x5a, 0xce, 0x68, 0x04, 0x93, 0x25, 0x8e, 0xd1, 0xbe, 0x9f,
+	0xd0, 0xe8, 0xa4, 0xc7, 0xe8, 0xba, 0x62, 0x02, 0x06, 0x43, 0x77, 0xc7, 0x8a, 0x34, 0xbe, 0x7a,
+	0xfc, 0x48, 0x63, 0x96, 0xfb, 0xbc, 0xa8, 0x3a, 0xef, 0x67, 0x1d, 0x32, 0x13, 0x59, 0x23, 0xb7,
+	0x9c, 0xd0, 0x8e, 0xe2, 0x59, 0xb1, 0xe0, 0xa2, 0xfd, 0xce, 0x6e, 0x83, 0x1c, 0xff, 0xa2, 0x8d,
+	0x42, 0xfd, 0x88, 0x1b, 0x05, 0x8f, 0x4c, 0xb0, 0xb0, 0x7b, 0xeb, 0x36, 0x9b, 0x85, 0xe4, 0xa7,
+	0x20, 0x20, 0x6e, 0x44, 0x26, 0x78, 0x74, 0x70, 0x6b, 0xb2, 0x8c, 0x7c, 0x4d, 0x66, 0xba, 0x67,
+	0xce, 0x8f, 0xb7, 0x80, 0xe0, 0x82, 0x91, 0xcd, 0x3a, 0x11, 0x41, 0xe3, 0xfe, 0x22, 0x9b, 0x8b,
+	0x12, 0x16, 0x78, 0xff, 0xb2, 0x4e, 0x4e, 0xcb, 0x1e, 0x91, 0x61, 0x61, 0xb8, 0x3e, 0x72, 0xbe,
+	0xfa, 0x04, 0xa2, 0xd6, 0xc7, 0xeb, 0x12, 0x00, 0x1a, 0x07, 0xb7, 0x03, 0x83, 0x14, 0x73, 0x99,
+	0x46, 0x2b, 0xc1, 0x66, 0x2a, 0x5c, 0x31, 0xd4, 0x44, 0x79, 0x51, 0x83, 0xc0, 0xc4, 0xc3, 0x0d,
+	0xe7, 0x20, 0xa5, 0x8b, 0x71, 0xc4, 0x33, 0x74, 0xa6, 0xd7, 0x75, 0x97, 0x1a, 0xc7, 0x82, 0x17,
+	0x87, 0x51, 0xa0, 0xe8, 0x39, 0x96, 0x7c, 0xa1, 0x63, 0x66, 0x40, 0xd2, 0xc9, 0x17, 0x3a, 0x22,
+	0x93, 0x98, 0x80, 0xbb, 0x3f, 0x54, 0x58, 0x70, 0xac, 0x9c, 0xec, 0x00, 0x43, 0xc1, 0x75, 0x47,
+	0xab, 0x34, 0xe6, 0xfe, 0x7d, 0x87, 0x9c, 0xe3, 0xad, 0xf2, 0xc3, 0xbc, 0xd8, 0xef, 0xfa, 0x19,
+	0x4d, 0x5b, 0x13, 0x27, 0x24, 0x9f, 0xbe, 0x9c, 0x28, 0x62, 0x0b, 0xc5, 0xd2, 0x60, 0xe2, 0x97,
+	0xd9, 0x5d, 0x2b, 0x83, 0xa1, 0x5c, 0x89, 0x8e, 0x9b, 0xde, 0xcb, 0x22, 0xaa, 0x67, 0xae, 0xdd,
+	0x9e, 0x42, 0x9e, 0x3b, 0x16, 0x33, 0x34, 0xb5, 0xf2, 0x83, 0x4f, 0x7c, 0x78, 0xf4, 0x9d, 0xa5,
+	0xdc, 0xac, 0xd6, 0x47, 0x6e, 0x56, 0xd1, 0x8f, 0x22, 0xe8, 0xb6, 0x26, 0x72, 0x7e, 0x14, 0xcb,
+	0x4b, 0x80, 0xed, 0xde, 0xcf, 0x4d, 0x68, 0xc3, 0x91, 0x08, 0x84, 0xff, 0x92, 0x78, 0xed, 0x2d,
+	0x95, 0x51, 0x9f, 0xbf, 0xf9, 0xcd, 0xa1, 0x8c, 0xfa, 0xdf, 0x70, 0xf4, 0x3c, 0x07, 0xbc, 0x83,
+	0x46, 0x25, 0xd4, 0x9f, 0x3c, 0x24, 0xc9, 0xc1, 0x4b, 0xa4, 0x81, 0xe7, 0x64, 0x66, 0x01, 0x6e,
+	0x58, 0x42, 0x35, 0xae, 0x8b, 0xf6, 0xd7, 0xee, 0x5d, 0xfc, 0xba, 0xa3, 0x8b, 0x25, 0x9f, 0x06,
+	0x45, 0xdf, 0x4d, 0x49, 0x13, 0xff, 0x67, 0xf9, 0x18, 0xc4, 0x09, 0xfc, 0x45, 0xa5, 0x82, 0x25,
+	0xa0, 0x94, 0x64, 0x0f, 0x9a, 0x8f, 0x1b, 0x91, 0x26, 0x22, 0x72, 0xa6, 0xfc, 0xa0, 0xbe, 0x2e,
+	0x99, 0xb6, 0x25, 0xe0, 0xb5, 0x7b, 0x17, 0xbf, 0xfe, 0xe8, 0x4c, 0xd5, 0xe3, 0xa0, 0x59, 0x18,
+	0x2b, 0xed, 0xd4, 0xc8, 0x95, 0x76, 0x83, 0x34, 0xf0, 0x01, 0xb6, 0xf0, 0x4d, 0x1f, 0x79, 0xe1,
+	0x63, 0xe6, 0xf6, 0xb6, 0x78, 0x1e, 0x14, 0x25, 0xef, 0xff, 0xd4, 0xf4, 0xac, 0x11, 0xc9, 0x43,
+	0xbe, 0x24, 0x66, 0xcd, 0x73, 0xb9, 0x59, 0x73, 0x69, 0x68, 0xd6, 0xcc, 0x60, 0x6f, 0x14, 0x14,
+	0x96, 0x78, 0xd0, 0x3b, 0x9a, 0xc3, 0xcd, 0x51, 0x6c, 0x2b, 0xf7, 0xf2, 0x20, 0x48, 0x68, 0xba,
+	0x9e, 0x0c, 0x22, 0xac, 0xa4, 0xd0, 0x64, 0xc8, 0xc6, 0x56, 0xce, 0x02, 0x43, 0x1e, 0x1f, 0x6d,
+	0x3e, 0xa9, 0x48, 0x23, 0xd1, 0x22, 0x76, 0xfa, 0x62, 0x99, 0x5e, 0x02, 0x14, 0x86, 0xbb, 0x43,
+	0x9e, 0x92, 0x04, 0x96, 0x68, 0x48, 0xf1, 0x85, 0x98, 0xe7, 0x6d, 0xd2, 0xf3, 0x33, 0x69, 0x71,
+	0x6a, 0x2c, 0x7c, 0xb9, 0xa0, 0xf0, 0x14, 0x1c, 0x80, 0x0b, 0x07, 0x52, 0xf2, 0xfe, 0x80, 0xf9,
+	0x99, 0x18, 0x09, 0x76, 0x70, 0xf4, 0x85, 0x41, 0x2f, 0x90, 0x59, 0x96, 0xd5, 0xe8, 0x5b, 0xc1,
+	0x46, 0xe0, 0x30, 0xf7, 0x0e, 0x99, 0xc4, 0x74, 0x0f, 0xf1, 0xd6, 0x56, 0x39, 0xa5, 0x3b, 0x17,
+	0x38, 0x31, 0x56, 0xe1, 0x63, 0x52, 0xfc, 0x78, 0x4d, 0xff, 0x0b, 0x92, 0x1b, 0xcf, 0x30, 0xbe,
+	0x95, 0xd0, 0x74, 0x47, 0xd8, 0x6c, 0x8d, 0x0c, 0xe3, 0xac, 0x19, 0x24, 0xdc, 0xfb, 0xdd, 0x3a,
+	0x99, 0x95, 0x6e, 0x83, 0xd7, 0x83, 0x94, 0x79, 0x9a, 0x98, 0x15, 0x92, 0x2a, 0x87, 0x56, 0x48,
+	0xfa, 0x30, 0x21, 0x5d, 0xda, 0x0f, 0xe3, 0x7d, 0x36, 0xe7, 0x6b, 0x47, 0x9e, 0xf3, 0xea, 0x7c,
+	0xb4, 0xa4, 0xa8, 0x80, 0x41, 0x51, 0x78, 0x9d, 0xf2, 0x82, 0x4b, 0x79, 0xaf, 0x53, 0x5d, 0x0b,
+	0x78, 0xe2, 0xc1, 0xd6, 0x02, 0x0e, 0xc8, 0x2c, 0x17, 0x51, 0x65, 0x9f, 0xb9, 0x8f, 0x24, 0x33,
+	0x2c, 0x62, 0x73, 0xc9, 0x26, 0x03, 0x79, 0xba, 0x66, 0xa1, 0xdf, 0xc6, 0x83, 0x2e, 0xf4, 0xfb,
+	0x56, 0xd2, 0x94, 0xdf, 0x19, 0x23, 0x09, 0x55, 0x36, 0x36, 0x39, 0x0c, 0x52, 0xd0, 0xf0, 0xa1,
+	0xe4, 0x5d, 0xe4, 0x61, 0x25, 0xef, 0xf2, 0x3e, 0x5b, 0xc5, 0x53, 0x12, 0x97, 0xeb, 0xc8, 0x75,
+	0xb2, 0xaf, 0x1b, 0x75, 0xb2, 0x8f, 0xf6, 0x3d, 0x1b, 0xb9, 0x7a, 0xda, 0x4f, 0x91, 0x5a, 0xe6,
+	0x6f, 0xcb, 0x00, 0x73, 0x06, 0xdd, 0xf0, 0xb1, 0x72, 0x1f, 0xb6, 0x1e, 0xa5, 0x68, 0x04, 0x3a,
+	0x5f, 0x05, 0xdb, 0x91, 0x9f, 0xa1, 0xc7, 0x91, 0xbe, 0x72, 0xd6, 0xce, 0x57, 0x26, 0x10, 0x6c,
+	0x5c, 0x0c, 0x61, 0x22, 0x09, 0x55, 0x87, 0xa6, 0x89, 0x32, 0xc6, 0x90, 0x52, 0x03, 0x92, 0xae,
+	0x99, 0x00, 0x49, 0x1d, 0x96, 0x0c, 0xb6, 0xde, 0xa7, 0x1c, 0x72, 0x66, 0xe8, 0x29, 0xb7, 0x4f,
+	0x26, 0x3a, 0xac, 0x9a, 0x79, 0x39, 0x89, 0x86, 0xed, 0xca, 0xe8, 0x7c, 0x1d, 0xe3, 0x6d, 0x20,
+	0xf8, 0x78, 0xbf, 0x32, 0x4d, 0xce, 0xb6, 0x17, 0x57, 0x65, 0x11, 0x84, 0x13, 0x8b, 0x98, 0x2f,
+	0xe2, 0xf1, 0xe0, 0x22, 0xe6, 0x47, 0x70, 0x0f, 0x8d, 0x88, 0xf9, 0xd0, 0x88, 0x98, 0xb7, 0xc3,
+	0x97, 0xab, 0x65, 0x84, 0x2f, 0x17, 0x49, 0x30, 0x4e, 0xf8, 0xf2, 0x89, 0x85, 0xd0, 0x1f, 0x28,
+	0xd0, 0x91, 0x42, 0xe8, 0x55, 0x7e, 0x81, 0x52, 0xa2, 0x25, 0x47, 0x7c, 0xaa, 0xc2, 0xfc, 0x02,
+	0x2a, 0xb6, 0x9b, 0x47, 0x02, 0xb7, 0x26, 0xca, 0x88, 0xed, 0x2e, 0x12, 0x60, 0x8c, 0xd8, 0x6e,
+	0xfe, 0xc3, 0xca, 0x27, 0x30, 0x59, 0x46, 0x3e, 0x81, 0x22, 0x71, 0x0e, 0xcd, 0x27, 0x80, 0x65,
+	0xc0, 0xc3, 0x38, 0xa2, 0xeb, 0x49, 0x9c, 0xc5, 0x9d, 0x38, 0x6c, 0x35, 0x6c, 0x05, 0xb9, 0x68,
+	0x02, 0xc1, 0xc6, 0x1d, 0x95, 0x8c, 0xa0, 0x79, 0xdc, 0x64, 0x04, 0xe4, 0x21, 0x25, 0x23, 0x30,
+	0xc2, 0xed, 0xa7, 0xca, 0x08, 0xb7, 0x2f, 0xfa, 0x22, 0x63, 0x85, 0xdb, 0x7f, 0xce, 0x21, 0xa7,
+	0xfc, 0x3b, 0xec, 0xdc, 0xc2, 0xb5, 0xb0, 0x38, 0x11, 0x7e, 0xe4, 0x04, 0x06, 0xec, 0xed, 0xb6,
+	0x66, 0xb3, 0x70, 0x86, 0x85, 0x40, 0x99, 0x4d, 0x60, 0x0b, 0x72, 0x9c, 0x10, 0xfd, 0xcf, 0x57,
+	0xc8, 0x97, 0x1d, 0x2a, 0x82, 0x7b, 0x07, 0x2f, 0xbe, 0xb6, 0xc5, 0x40, 0x6d, 0x39, 0x65, 0xf8,
+	0x8b, 0x6f, 0x48, 0x7a, 0x22, 0x7c, 0x54, 0x91, 0x07, 0x83, 0x15, 0x73, 0x13, 0x8f, 0xc3, 0xa1,
+	0x1a, 0x01, 0x10, 0x87, 0x14, 0x18, 0x04, 0x37, 0x42, 0x09, 0xdd, 0xd6, 0x57, 0xc7, 0xea, 0xf3,
+	0x01, 0x6b, 0x05, 0x01, 0x45, 0x2b, 0xb1, 0x1f, 0x86, 0x3c, 0x94, 0x95, 0xa6, 0xa2, 0x3e, 0xbf,
+	0xce, 0x0c, 0xae, 0x41, 0x60, 0xe2, 0x79, 0x7f, 0x59, 0x21, 0x17, 0x0f, 0xd1, 0x29, 0x43, 0x29,
+	0x0c, 0xea, 0x63, 0xa7, 0x30, 0x10, 0xa1, 0x78, 0x13, 0x23, 0x42, 0xf1, 0xd0, 0x7f, 0x83, 0x62,
+	0xa5, 0x52, 0xee, 0x78, 0x9a, 0x4b, 0x78, 0xbb, 0xa1, 0x41, 0x60, 0xe2, 0xa1, 0x16, 0x9b, 0xf1,
+	0x3b, 0x1d, 0x9a, 0xa6, 0x32, 0xd6, 0x4e, 0x58, 0xed, 0x4b, 0x0b, 0xe4, 0x63, 0x97, 0x21, 0xf3,
+	0x16, 0x0b, 0xc8, 0xb1, 0xcc, 0x77, 0x78, 0x73, 0xcc, 0x0e, 0xff, 0x89, 0x0a, 0x79, 0xe3, 0x81,
+	0xab, 0xdb, 0xd8, 0x61, 0x90, 0x18, 0x1b, 0x90, 0x1f, 0x38, 0x18, 0x39, 0x00, 0x0c, 0xc2, 0x7b,
+	0xa9, 0xdf, 0x57, 0xd1, 0x01, 0xe5, 0xc7, 0x0d, 0xf3, 0x5e, 0xb2, 0x58, 0x40, 0x8e, 0xe5, 0xfd,
+	0x0e, 0xcb, 0xdf, 0xad, 0x91, 0x67, 0xc6, 0xd8, 0x03, 0x94, 0x18, 0x5f, 0x6d, 0xe7, 0x0e, 0xa8,
+	0x3e, 0xa4, 0xdc, 0x01, 0xf7, 0xd7, 0x5d, 0xaf, 0xa7, 0x1c, 0x18, 0x2b, 0x8e, 0xfb, 0xa7, 0x2b,
+	0xe4, 0xc2, 0xe8, 0x0d, 0x8b, 0xfb, 0x8d, 0x68, 0x12, 0x93, 0x5e, 0xa4, 0x66, 0xda, 0x81, 0xc7,
+	0xb8, 0x39, 0xcc, 0x02, 0x41, 0x1e, 0x17, 0x33, 0x07, 0xf4, 0xfd, 0x6c, 0x27, 0xbd, 0x72, 0x37,
+	0x60, 0xe5, 0xea, 0xaa, 0x32, 0x73, 0xc0, 0xba, 0x6a, 0x05, 0x03, 0x03, 0xd9, 0xb1, 0x5f, 0x4b,
+	0x98, 0x8f, 0x86, 0x3f, 0xc4, 0x8f, 0x9e, 0x8f, 0xc9, 0xba, 0xce, 0x06, 0x08, 0xf2, 0xb8, 0xc8,
+	0x8e, 0xf9, 0x2a, 0x70, 0x41, 0x6b, 0x3a, 0x51, 0xc1, 0x8a, 0x6a, 0x05, 0x03, 0x23, 0x9f, 0x50,
+	0xa1, 0x7e, 0x78, 0x42, 0x05, 0xef, 0xe7, 0x2b, 0xe4, 0xfc, 0xc8, 0x0d, 0xef, 0x78, 0x6a, 0xea,
+	0xd1, 0x4b, 0x6a, 0x70, 0x9f, 0x33, 0xec, 0x48, 0xc1, 0xf0, 0xde, 0x1f, 0x8f, 0x18, 0x69, 0x22,
+	0xd0, 0xfd, 0xfe, 0x73, 0x02, 0x3d, 0x7a, 0xfd, 0x39, 0x14, 0xdb, 0x5e, 0x3b, 0x42, 0x6c, 0x7b,
+	0xee, 0x63, 0xd4, 0xc7, 0x5c, 0x1d, 0xfe, 0x6b, 0x6d, 0x64, 0xf7, 0xe2, 0x01, 0x79, 0xac, 0xcb,
+	0x86, 0x25, 0x72, 0x3a, 0x88, 0x58, 0xa5, 0xfe, 0xf6, 0x60, 0x53, 0xa4, 0xee, 0xe3, 0x19, 0xd2,
+	0x55, 0x54, 0xd5, 0x72, 0x0e, 0x0e, 0x43, 0x4f, 0x3c, 0x82, 0xb9, 0x06, 0xee, 0xaf, 0x4b, 0x8f,
+	0xa8, 0xb9, 0xd7, 0xc8, 0x39, 0xd9, 0x15, 0x3b, 0x7e, 0x42, 0xbb, 0x62, 0xb1, 0x4d, 0x85, 0x77,
+	0xc1, 0x79, 0x1e, 0x8b, 0x57, 0x80, 0x00, 0xc5, 0xcf, 0xe1, 0x27, 0xcb, 0xe2, 0x7e, 0xd0, 0x69,
+	0x35, 0xec, 0x4f, 0xb6, 0x81, 0x8d, 0xc0, 0x61, 0x7a, 0xbd, 0x68, 0x3e, 0x98, 0xf5, 0xe2, 0xc3,
+	0xa4, 0xa9, 0xfa, 0x9b, 0x87, 0xc1, 0xa8, 0x41, 0x3e, 0x14, 0x06, 0xa3, 0x46, 0xb8, 0x81, 0xe5,
+	0xbe, 0x91, 0x1f, 0x54, 0x72, 0xb3, 0x15, 0xf9, 0x61, 0xbb, 0xf7, 0x0e, 0x32, 0xad, 0x6c, 0x81,
+	0xe3, 0x16, 0xb7, 0xf7, 0x7e, 0xb8, 0x46, 0x72, 0x75, 0x5c, 0x31, 0x9b, 0x03, 0xd6, 0xa1, 0x65,
+	0x8d, 0xe5, 0x64, 0xcb, 0x5f, 0x92, 0xe4, 0xf4, 0x9d, 0x99, 0x6a, 0x02, 0xcd, 0xcc, 0xfd, 0x18,
+	0x4f, 0x4c, 0x2f, 0x58, 0x57, 0xca, 0xc8, 0xb5, 0xd0, 0x56, 0xf4, 0xcc, 0xea, 0xd5, 0xb2, 0x0d,
+	0x0c, 0x7e, 0x6e, 0x46, 0x9a, 0x3b, 0xb2, 0x64, 0x6a, 0x39, 0xea, 0x4e, 0x55, 0x60, 0x15, 0xe5,
+	0x0a, 0xe4, 0x4f, 0xd0, 0x8c, 0xd0, 0xbb, 0xe5, 0xac, 0x5d, 0xf6, 0x56, 0xd8, 0xfb, 0x6b, 0x65,
+	0x1c, 0x1b, 0x75, 0xcf, 0xab, 0x50, 0xaf, 0xf9, 0x02, 0x66, 0x50, 0x28, 0x82, 0xf7, 0x67, 0x55,
+	0x72, 0xd6, 0x1e, 0x1c, 0xe2, 0xfe, 0xf5, 0x67, 0x1c, 0xf2, 0x44, 0xe8, 0xa7, 0x59, 0x7b, 0xc0,
+	0x0e, 0x31, 0x5b, 0x83, 0x70, 0x2d, 0x57, 0x5f, 0xe1, 0xb8, 0x86, 0x20, 0x45, 0x38, 0x5f, 0x7b,
+	0x79, 0xe1, 0x49, 0x8c, 0x8c, 0x5c, 0x29, 0x66, 0x0e, 0xa3, 0xa4, 0x42, 0xeb, 0xd9, 0xe9, 0xce,
+	0x20, 0x49, 0x68, 0x94, 0xad, 0xe5, 0x52, 0x95, 0xdc, 0x2c, 0xe5, 0x23, 0x6b, 0x01, 0xcf, 0xa2,
+	0xb2, 0x5f, 0xcc, 0xf1, 0x82, 0x21, 0xee, 0x2c, 0xff, 0x9a, 0x59, 0x92, 0xb8, 0x94, 0x8c, 0xa5,
+	0x23, 0xcb, 0xf0, 0x1e, 0x5c, 0x97, 0xd8, 0xfb, 0x6d, 0xdc, 0x62, 0x8c, 0xec, 0xf4, 0xd7, 0x2b,
+	0x57, 0x1f, 0xbd, 0x72, 0xf5, 0x0f, 0x39, 0xc4, 0x35, 0xeb, 0x12, 0xac, 0xd2, 0x2c, 0x09, 0x3a,
+	0xe3, 0xd5, 0xac, 0x7d, 0x79, 0x40, 0x93, 0xfd, 0x7c, 0x69, 0xd1, 0x17, 0xb0, 0x11, 0x38, 0x0c,
+	0xd7, 0x82, 0x5e, 0x10, 0xe5, 0x77, 0x0a, 0xab, 0x41, 0x04, 0xd8, 0xce, 0xc0, 0xfe, 0xdd, 0x7c,
+	0x7e, 0xc6, 0x55, 0xff, 0x2e, 0xa6, 0xf3, 0xbf, 0xeb, 0x7d, 0xc0, 0x16, 0x4d, 0xf8, 0x22, 0x95,
+	0x54, 0x8e, 0xf8, 0x8f, 0xaa, 0x36, 0x75, 0xa1, 0x33, 0x54, 0x29, 0x14, 0xe7, 0xc1, 0x95, 0x42,
+	0xa9, 0x1c, 0x72, 0xb7, 0x66, 0x95, 0x21, 0xa9, 0x96, 0x5c, 0x86, 0x04, 0xfd, 0x40, 0x77, 0x68,
+	0x67, 0xf7, 0x3e, 0xaf, 0xc6, 0xb9, 0x1f, 0xa8, 0x24, 0x00, 0x9a, 0x96, 0xfb, 0xcd, 0x78, 0xc3,
+	0x8f, 0x5f, 0x4c, 0x66, 0xf1, 0x2d, 0xb1, 0x7a, 0x86, 0xa8, 0xdd, 0x62, 0xf8, 0x0c, 0x30, 0x46,
+	0x20, 0x39, 0x7a, 0xff, 0xae, 0x49, 0x4e, 0x59, 0xd5, 0x5e, 0x2c, 0x8f, 0x01, 0xe7, 0x50, 0x8f,
+	0x01, 0x16, 0x3e, 0x3e, 0x88, 0x44, 0xd5, 0x57, 0x33, 0x7c, 0x7c, 0x10, 0xe1, 0x27, 0xc4, 0x3f,
+	0x42, 0xdd, 0xc0, 0x20, 0x12, 0x2e, 0x0c, 0xa6, 0xba, 0x81, 0x41, 0x04, 0x02, 0x8a, 0x0e, 0xe4,
+	0xd3, 0x6c, 0x05, 0x17, 0xae, 0x19, 0xad, 0x5a, 0x19, 0xfe, 0x30, 0x6d, 0x83, 0x22, 0x77, 0xa8,
+	0x37, 0x5b, 0xc0, 0xe2, 0x88, 0xe5, 0x76, 0x9b, 0xd2, 0x2b, 0x59, 0x5e, 0xb0, 0xb6, 0xcb, 0x2d,
+	0xa6, 0x93, 0xdb, 0x3a, 0xc9, 0x16, 0x76, 0xff, 0x2e, 0xfe, 0xc5, 0x52, 0xc3, 0xfc, 0x5f, 0xa1,
+	0x38, 0x4b, 0xf7, 0x13, 0x20, 0x05, 0x8e, 0x10, 0x58, 0xaf, 0xcd, 0x8f, 0x82, 0x2d, 0x9a, 0x66,
+	0x52, 0x3f, 0xf2, 0x7a, 0x6d, 0xb2, 0x11, 0x34, 0x1c, 0x2d, 0x06, 0x29, 0x7b, 0xb1, 0xcc, 0x70,
+	0x28, 0x60, 0x8b, 0x52, 0x5b, 0x37, 0x83, 0x89, 0x63, 0x7a, 0x3f, 0x90, 0x87, 0xea, 0xfd, 0x30,
+	0x75, 0x88, 0xf7, 0x43, 0x9b, 0x9c, 0xf3, 0x07, 0x59, 0x8c, 0x6e, 0x53, 0xf3, 0x19, 0xde, 0xc5,
+	0x64, 0x29, 0x2f, 0x10, 0x34, 0xcd, 0xee, 0x91, 0x94, 0xcf, 0x6e, 0x9b, 0x86, 0x5b, 0x43, 0x48,
+	0x50, 0xfc, 0x2c, 0xee, 0xc3, 0xa5, 0xbb, 0x13, 0xcf, 0x8c, 0x51, 0x4a, 0xfd, 0x35, 0x24, 0x07,
+	0x7e, 0xb4, 0x2d, 0x14, 0x8c, 0x6c, 0x4a, 0x41, 0x33, 0x73, 0x7b, 0x7c, 0x1f, 0xce, 0xb4, 0x6a,
+	0xda, 0x9a, 0x61, 0x2f, 0xbf, 0x2a, 0x77, 0xce, 0xbc, 0xf5, 0xb8, 0x9e, 0x85, 0x06, 0x03, 0x36,
+	0x8b, 0xb1, 0x0b, 0x64, 0xb1, 0x9c, 0xd6, 0x6c, 0x19, 0xb3, 0x78, 0xde, 0xa0, 0xc8, 0x67, 0xb1,
+	0xd9, 0x02, 0x16, 0x47, 0xef, 0x1f, 0x3b, 0xe4, 0x5c, 0xe1, 0xb4, 0x7b, 0x74, 0x03, 0xdd, 0xbc,
+	0x4f, 0x4d, 0x90, 0xc7, 0x0a, 0xea, 0x6e, 0xb9, 0xfb, 0xa6, 0x42, 0x72, 0xca, 0x70, 0xf2, 0xb6,
+	0x7d, 0x96, 0xe5, 0x3c, 0x28, 0xd0, 0x42, 0x47, 0x73, 0x1e, 0xd3, 0x0e, 0x5c, 0xd5, 0x07, 0xeb,
+	0xc0, 0x65, 0xe8, 0x95, 0xda, 0x43, 0xd5, 0x2b, 0xf5, 0x43, 0xf4, 0xca, 0xcf, 0x3a, 0xa4, 0xd5,
+	0x1b, 0x51, 0xb8, 0xb7, 0x35, 0x51, 0xc6, 0xa5, 0xc2, 0xa8, 0xb2, 0xc0, 0x0b, 0x4f, 0x61, 0x9e,
+	0x92, 0x51, 0x50, 0x18, 0x29, 0x95, 0xdb, 0x27, 0xb5, 0x7e, 0xe8, 0x47, 0xc2, 0x1f, 0xa0, 0x84,
+	0xba, 0x62, 0xeb, 0xa1, 0xaf, 0x17, 0x41, 0x1d, 0x3b, 0x8b, 0xad, 0x8c, 0x93, 0xf7, 0xdf, 0x6b,
+	0xe4, 0x74, 0x1e, 0xf1, 0x4b, 0xc6, 0xaf, 0x9e, 0x07, 0xc1, 0xe4, 0xfd, 0xea, 0x79, 0x8c, 0xcc,
+	0xfd, 0xf9, 0xd5, 0xb3, 0xdd, 0x1e, 0x7b, 0x1e, 0x04, 0x75, 0xdb, 0x97, 0x7c, 0xf2, 0xe4, 0x7d,
+	0xc9, 0x4d, 0x57, 0xdf, 0xc6, 0xa1, 0xae, 0xbe, 0xa6, 0x2b, 0x7f, 0xf3, 0x84, 0x5d, 0xf9, 0x65,
+	0x68, 0x3e, 0x19, 0x15, 0x9a, 0xef, 0xfd, 0xc9, 0x24, 0xe1, 0xcb, 0x60, 0x1c, 0x06, 0x9d, 0x7d,
+	0xf7, 0xe3, 0x66, 0x49, 0x4c, 0xa7, 0xac, 0x52, 0x8a, 0x9c, 0xb8, 0x2a, 0xa9, 0xc9, 0x35, 0x44,
+	0x51, 0x85, 0xcd, 0xfc, 0xae, 0xaa, 0x32, 0xc6, 0xae, 0x2a, 0x94, 0xb5, 0x47, 0xab, 0xe5, 0xd7,
+	0x1e, 0x6d, 0xe6, 0xeb, 0x8e, 0x1e, 0xac, 0xc2, 0x6a, 0x8f, 0xa4, 0x0a, 0x7b, 0xc5, 0x28, 0x11,
+	0x58, 0x2f, 0xab, 0x3c, 0x21, 0xff, 0xa4, 0x6a, 0x43, 0x32, 0xa2, 0x3a, 0xa0, 0x55, 0x1a, 0x71,
+	0xa2, 0x5c, 0xde, 0xf2, 0x88, 0x37, 0xb2, 0x34, 0xe2, 0x0f, 0x38, 0x64, 0x46, 0xa5, 0x0d, 0x60,
+	0x29, 0x71, 0x5b, 0x93, 0x65, 0x7c, 0x20, 0x2d, 0xc2, 0x9a, 0x45, 0x9d, 0xdf, 0xf8, 0xdb, 0x6d,
+	0x90, 0x93, 0xc0, 0x7d, 0x99, 0xd4, 0x71, 0x76, 0x4a, 0xff, 0xe7, 0x95, 0xb2, 0x44, 0xc1, 0xc9,
+	0xaf, 0x97, 0x05, 0xfc, 0x95, 0x02, 0xe7, 0xe4, 0xfd, 0x85, 0xb0, 0xdc, 0xd8, 0xdd, 0xc6, 0xd2,
+	0xe8, 0x0c, 0x12, 0xf3, 0x8a, 0x4d, 0xa7, 0xd1, 0x11, 0xed, 0xa0, 0x30, 0xf8, 0x0d, 0x48, 0x46,
+	0x13, 0x2c, 0xf6, 0x99, 0xdb, 0x0a, 0x2d, 0x8b, 0x76, 0x50, 0x18, 0x78, 0xa4, 0xef, 0x31, 0xfb,
+	0x90, 0x8c, 0x55, 0x2f, 0xf1, 0x48, 0xcf, 0x0d, 0x4f, 0xa6, 0x15, 0x84, 0x31, 0x02, 0xc9, 0xd1,
+	0xfb, 0x35, 0x87, 0x3c, 0x66, 0xbc, 0xaf, 0xd2, 0x2d, 0xea, 0xa8, 0xee, 0x1c, 0x70, 0x54, 0x47,
+	0x5d, 0x2e, 0x4e, 0x35, 0xe2, 0x48, 0xaf, 0x75, 0xb9, 0x68, 0x07, 0x85, 0x81, 0xd7, 0x1e, 0x7e,
+	0x18, 0xc6, 0x77, 0xae, 0xf4, 0xfa, 0xd9, 0xbe, 0x38, 0xdc, 0x2b, 0xbb, 0xfc, 0xbc, 0x82, 0x80,
+	0x81, 0xe5, 0x7e, 0x05, 0x99, 0xe4, 0x29, 0xfc, 0xba, 0xe2, 0x7a, 0x75, 0x0a, 0xdf, 0x82, 0x27,
+	0xf8, 0xeb, 0x82, 0x84, 0x79, 0x3f, 0x5a, 0x25, 0x33, 0xf6, 0xe7, 0x1d, 0xc3, 0xa0, 0xf5, 0x61,
+	0x91, 0xc6, 0x90, 0x7f, 0xa1, 0xe7, 0xcd, 0x34, 0x86, 0xc7, 0x5c, 0x53, 0x18, 0x5d, 0xec, 0x42,
+	0x16, 0x1b, 0x95, 0xcf, 0xc5, 0xcc, 0x02, 0xa7, 0x80, 0xc3, 0xf0, 0x05, 0xf1, 0x69, 0x3f, 0xea,
+	0x8a, 0xcc, 0xf8, 0xec, 0x05, 0x17, 0x79, 0x13, 0x48, 0x18, 0x7a, 0x94, 0xfb, 0xc9, 0xb6, 0xdc,
+	0x34, 0x32, 0x8f, 0xf2, 0xf9, 0x04, 0x3d, 0xca, 0xb1, 0xd5, 0xf5, 0x49, 0x95, 0x46, 0x7b, 0xad,
+	0x89, 0x32, 0x02, 0xc9, 0xaf, 0x44, 0x7b, 0xdc, 0xb9, 0x6f, 0x12, 0xad, 0x86, 0x57, 0xa2, 0x3d,
+	0x40, 0xda, 0x98, 0xaf, 0x31, 0xb5, 0x92, 0xed, 0x89, 0xbd, 0x82, 0x36, 0xab, 0x5a, 0x50, 0xc8,
+	0x61, 0x7b, 0xef, 0x21, 0xad, 0x51, 0xaa, 0x60, 0xac, 0x98, 0x1a, 0xef, 0x05, 0x73, 0x5e, 0x4a,
+	0xe5, 0x89, 0x57, 0xc6, 0x3c, 0x4e, 0x09, 0xcd, 0x66, 0xf1, 0x40, 0xba, 0xee, 0xa8, 0x2b, 0xe3,
+	0xeb, 0x26, 0x10, 0x6c, 0x5c, 0xef, 0x07, 0x2b, 0xc4, 0xb8, 0x0f, 0xc2, 0xab, 0x74, 0xb3, 0x88,
+	0x43, 0xfe, 0x2a, 0xdd, 0xac, 0xf9, 0x00, 0x16, 0xa6, 0xca, 0xf1, 0x52, 0x39, 0x28, 0xc7, 0x4b,
+	0x42, 0xfb, 0xf1, 0x8b, 0xb0, 0x92, 0x0f, 0x79, 0x06, 0xde, 0x0c, 0x12, 0xee, 0xce, 0x63, 0xe6,
+	0x8a, 0x7d, 0x7c, 0x17, 0xbe, 0x91, 0xfc, 0x4a, 0x9d, 0x57, 0x02, 0x5b, 0x5f, 0xbb, 0x77, 0xf1,
+	0x09, 0x69, 0x4b, 0x57, 0xb6, 0x16, 0x0e, 0x02, 0xf1, 0x20, 0xf6, 0x8a, 0x48, 0xe9, 0x05, 0xd4,
+	0xef, 0xf6, 0xa4, 0x9b, 0x81, 0xea, 0x95, 0x25, 0x13, 0x08, 0x36, 0xae, 0xf7, 0xa3, 0xb2, 0x57,
+	0xb8, 0xe9, 0x56, 0x47, 0xaa, 0x39, 0x47, 0x8c, 0x54, 0xfb, 0x18, 0x21, 0x9d, 0xb8, 0xd7, 0xc7,
+	0xcb, 0xd9, 0x8d, 0xb8, 0x9c, 0x1b, 0xbd, 0x45, 0x45, 0x4f, 0x6b, 0x0e, 0xdd, 0x06, 0x06, 0x3f,
+	0xeb, 0x38, 0x5a, 0x3d, 0xf4, 0x38, 0x6a, 0x9d, 0xcc, 0x6a, 0x07, 0x9f, 0xcc, 0xbc, 0xbf, 0x74,
+	0x88, 0x65, 0x15, 0xc4, 0x8a, 0xee, 0x28, 0xee, 0xbe, 0xd8, 0x04, 0xae, 0x95, 0x67, 0x82, 0xc4,
+	0xd3, 0xa5, 0xd8, 0x59, 0xb1, 0x7f, 0x81, 0x33, 0x72, 0x43, 0x11, 0x95, 0x57, 0x29, 0xab, 0x94,
+	0xb4, 0x64, 0xc8, 0x96, 0xc6, 0x86, 0x1d, 0xe1, 0xe7, 0x3d, 0x47, 0xce, 0x0c, 0x09, 0x85, 0xb3,
+	0x96, 0xe5, 0xcc, 0xcc, 0xaf, 0x10, 0x2c, 0x5b, 0x24, 0x70, 0x98, 0xf7, 0xd3, 0x0e, 0x39, 0x6d,
+	0x3e, 0xca, 0x54, 0xf3, 0xe7, 0x1c, 0x72, 0x26, 0xcd, 0xd3, 0x3b, 0xa9, 0xbe, 0x53, 0x31, 0xfd,
+	0x43, 0x20, 0x18, 0x16, 0xc2, 0xfb, 0xa9, 0x0a, 0x97, 0xd7, 0x2c, 0x68, 0xed, 0x6e, 0xda, 0xb7,
+	0x17, 0x2b, 0xf9, 0xdb, 0x8b, 0x63, 0x1d, 0x8d, 0x38, 0x69, 0x54, 0x21, 0x77, 0xf0, 0x48, 0x54,
+	0x61, 0x2a, 0x50, 0xa9, 0x10, 0x76, 0x1c, 0x62, 0x10, 0xb6, 0x7c, 0x32, 0x89, 0x58, 0x8c, 0x55,
+	0xd5, 0xf6, 0x1a, 0x98, 0x57, 0x10, 0x30, 0xb0, 0xb0, 0x1a, 0xba, 0xfc, 0x75, 0x5f, 0xf7, 0x10,
+	0x33, 0x26, 0x6d, 0x0c, 0xcf, 0xd3, 0xd4, 0xbc, 0x2b, 0xfc, 0x2e, 0x40, 0xd9, 0x14, 0x71, 0x8d,
+	0xda, 0x4a, 0xe2, 0x9e, 0xd0, 0xe2, 0x6c, 0x0c, 0x5d, 0x4d, 0xe2, 0x1e, 0xb0, 0x56, 0xf7, 0x71,
+	0x52, 0xc9, 0x62, 0xf1, 0x7a, 0x13, 0x18, 0xc9, 0xb7, 0x11, 0x43, 0x25, 0x8b, 0xbd, 0xbf, 0xa8,
+	0x72, 0x75, 0x73, 0x3b, 0x88, 0xba, 0xf1, 0x1d, 0x75, 0xd6, 0x76, 0x46, 0x9e, 0xb5, 0x71, 0xd3,
+	0xd1, 0xd9, 0xa1, 0xdd, 0x41, 0x38, 0x94, 0x10, 0xb4, 0x2d, 0xda, 0x41, 0x61, 0x58, 0x1b, 0xb7,
+	0xea, 0xa1, 0x1b, 0xb7, 0x77, 0x92, 0x69, 0x63, 0x58, 0x49, 0x4d, 0xc0, 0x0d, 0x88, 0x46, 0x3b,
+	0x58, 0x58, 0xe8, 0x3f, 0xa7, 0x4e, 0xf6, 0x72, 0x89, 0x66, 0x3d, 0xa7, 0x8e, 0x17, 0x29, 0x18,
+	0x18, 0x2c, 0xdb, 0x68, 0x38, 0x48, 0x99, 0x83, 0xf8, 0x84, 0xae, 0x41, 0xba, 0x28, 0xda, 0x40,
+	0x41, 0xf1, 0x9b, 0xf7, 0xfc, 0x68, 0xe0, 0x87, 0xd8, 0x43, 0xc2, 0x23, 0x46, 0x7d, 0xf3, 0x55,
+	0x05, 0x01, 0x03, 0x0b, 0xdf, 0x38, 0x0b, 0x7a, 0xf4, 0xfd, 0x71, 0x24, 0xa3, 0xdf, 0x75, 0xcc,
+	0x80, 0x68, 0x07, 0x85, 0xe1, 0x3e, 0x47, 0xa6, 0xfc, 0x48, 0x94, 0xfa, 0x88, 0x13, 0xe1, 0x7a,
+	0xac, 0x16, 0x75, 0xcc, 0x55, 0xab, 0xa1, 0x60, 0xa2, 0xe6, 0x0b, 0xb0, 0x92, 0xf1, 0x0a, 0xb0,
+	0x7a, 0x7f, 0xe6, 0x90, 0x59, 0x9d, 0x63, 0x9a, 0x39, 0xce, 0x58, 0x1e, 0x43, 0xce, 0xa1, 0x1e,
+	0x43, 0x76, 0x16, 0xd9, 0xca, 0x58, 0x59, 0x64, 0xcd, 0x04, 0xaf, 0xd5, 0x03, 0x13, 0xbc, 0x7e,
+	0x05, 0x99, 0xdc, 0xa5, 0xfb, 0x46, 0x26, 0x58, 0xb6, 0x21, 0xbb, 0xc1, 0x9b, 0x40, 0xc2, 0x30,
+	0x24, 0xbe, 0xe3, 0xab, 0x92, 0x13, 0xd3, 0x22, 0xe4, 0x6c, 0x9e, 0x21, 0x09, 0x88, 0xb7, 0x46,
+	0x9a, 0xca, 0x57, 0x5f, 0x3a, 0xf0, 0x38, 0xc5, 0x0e, 0x3c, 0x63, 0xdd, 0xae, 0x2e, 0x6c, 0xfe,
+	0xc6, 0x17, 0x9e, 0x7e, 0xc3, 0xef, 0x7c, 0xe1, 0xe9, 0x37, 0xfc, 0xc1, 0x17, 0x9e, 0x7e, 0xc3,
+	0xb7, 0xbe, 0xfa, 0xb4, 0xf3, 0x1b, 0xaf, 0x3e, 0xed, 0xfc, 0xce, 0xab, 0x4f, 0x3b, 0x7f, 0xf0,
+	0xea, 0xd3, 0xce, 0x9f, 0xbc, 0xfa, 0xb4, 0xf3, 0xd9, 0xff, 0xf2, 0xf4, 0x1b, 0xde, 0x5f, 0x68,
+	0x17, 0xc2, 0x7f, 0xde, 0xd6, 0xe9, 0x5e, 0xde, 0x7b, 0x07, 0xd3, 0x45, 0x38, 0xd5, 0x2f, 0x1b,
+	0x83, 0xf8, 0xb2, 0xd4, 0xa0, 0xff, 0x6f, 0x00, 0x5d, 0x89, 0x23, 0x33, 0x0d, 0x1e, 0x01, 0x00,
 }
 
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -6261,6 +6832,39 @@ func (m *ApplicationCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationDependency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationDependency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationDependency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationDestination) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8371,6 +8975,20 @@ func (m *ApplicationSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DependsOn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.SourceHydrator != nil {
 		{
 			size, err := m.SourceHydrator.MarshalToSizedBuffer(dAtA[:i])
@@ -8492,6 +9110,20 @@ func (m *ApplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OperationQueue) > 0 {
+		for iNdEx := len(m.OperationQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperationQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	{
 		size, err := m.SourceHydrator.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -8784,6 +9416,46 @@ func (m *ApplicationWatchEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoRollback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoRollback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoRollback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ID))
+	i--
+	dAtA[i] = 0x10
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Revisions[iNdEx])
+			copy(dAtA[i:], m.Revisions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revisions[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Backoff) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9834,6 +10506,59 @@ func (m *DrySource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Ref)
+	copy(dAtA[i:], m.Ref)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Ref)))
+	i--
+	dAtA[i] = 0x42
+	if m.Plugin != nil {
+		{
+			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Directory != nil {
+		{
+			size, err := m.Directory.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Kustomize != nil {
+		{
+			size, err := m.Kustomize.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Helm != nil {
+		{
+			size, err := m.Helm.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	i -= len(m.Path)
 	copy(dAtA[i:], m.Path)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
@@ -10599,6 +11324,15 @@ func (m *HydrateOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AdditionalDrySHAs) > 0 {
+		for iNdEx := len(m.AdditionalDrySHAs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AdditionalDrySHAs[iNdEx])
+			copy(dAtA[i:], m.AdditionalDrySHAs[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.AdditionalDrySHAs[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size, err := m.SourceHydrator.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -10674,6 +11408,23 @@ func (m *HydrateTo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
+	i--
+	dAtA[i] = 0x1a
+	if m.PullRequest != nil {
+		{
+			size, err := m.PullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	i -= len(m.TargetBranch)
 	copy(dAtA[i:], m.TargetBranch)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TargetBranch)))
@@ -10682,6 +11433,89 @@ func (m *HydrateTo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HydrateToPullRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HydrateToPullRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HydrateToPullRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Labels[iNdEx])
+			copy(dAtA[i:], m.Labels[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Labels[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.API)
+	copy(dAtA[i:], m.API)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.API)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Provider)
+	copy(dAtA[i:], m.Provider)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Provider)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HydratorPullRequestStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HydratorPullRequestStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HydratorPullRequestStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.HydratedSHA)
+	copy(dAtA[i:], m.HydratedSHA)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HydratedSHA)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.State)
+	copy(dAtA[i:], m.State)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.State)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Number))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *Info) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11684,6 +12518,41 @@ func (m *OperationInitiator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OperationRollbackStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationRollbackStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationRollbackStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HealthyAt != nil {
+		{
+			size, err := m.HealthyAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OperationState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11704,6 +12573,56 @@ func (m *OperationState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Rollback != nil {
+		{
+			size, err := m.Rollback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Analysis != nil {
+		{
+			size, err := m.Analysis.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Approvals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.PendingApproval != nil {
+		{
+			size, err := m.PendingApproval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	i = encodeVarintGenerated(dAtA, i, uint64(m.RetryCount))
 	i--
 	dAtA[i] = 0x40
@@ -12823,7 +13742,7 @@ func (m *PullRequestGeneratorGithub) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *RefTarget) Marshal() (dAtA []byte, err error) {
+func (m *QueuedOperation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -12833,28 +13752,28 @@ func (m *RefTarget) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RefTarget) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueuedOperation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RefTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueuedOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Chart)
-	copy(dAtA[i:], m.Chart)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Chart)))
+	{
+		size, err := m.QueuedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0x1a
-	i -= len(m.TargetRevision)
-	copy(dAtA[i:], m.TargetRevision)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TargetRevision)))
-	i--
-	dAtA[i] = 0x12
 	{
-		size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Operation.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -12862,11 +13781,16 @@ func (m *RefTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
+	dAtA[i] = 0x12
+	i -= len(m.ID)
+	copy(dAtA[i:], m.ID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ID)))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *RepoCreds) Marshal() (dAtA []byte, err error) {
+func (m *RefTarget) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -12876,170 +13800,28 @@ func (m *RepoCreds) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RepoCreds) MarshalTo(dAtA []byte) (int, error) {
+func (m *RefTarget) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RepoCreds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RefTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i--
-	if m.InsecureOCIForceHttp {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xd0
-	i -= len(m.BearerToken)
-	copy(dAtA[i:], m.BearerToken)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.BearerToken)))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xca
-	i--
-	if m.UseAzureWorkloadIdentity {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xc0
-	i -= len(m.NoProxy)
-	copy(dAtA[i:], m.NoProxy)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.NoProxy)))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xba
-	i--
-	if m.ForceHttpBasicAuth {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xa0
-	i -= len(m.Proxy)
-	copy(dAtA[i:], m.Proxy)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Proxy)))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x9a
-	i -= len(m.GCPServiceAccountKey)
-	copy(dAtA[i:], m.GCPServiceAccountKey)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GCPServiceAccountKey)))
-	i--
-	dAtA[i] = 0x6a
-	i -= len(m.Type)
-	copy(dAtA[i:], m.Type)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
-	i--
-	dAtA[i] = 0x62
-	i--
-	if m.EnableOCI {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i--
-	dAtA[i] = 0x58
-	i -= len(m.GitHubAppEnterpriseBaseURL)
-	copy(dAtA[i:], m.GitHubAppEnterpriseBaseURL)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GitHubAppEnterpriseBaseURL)))
-	i--
-	dAtA[i] = 0x52
-	i = encodeVarintGenerated(dAtA, i, uint64(m.GithubAppInstallationId))
-	i--
-	dAtA[i] = 0x48
-	i = encodeVarintGenerated(dAtA, i, uint64(m.GithubAppId))
-	i--
-	dAtA[i] = 0x40
-	i -= len(m.GithubAppPrivateKey)
-	copy(dAtA[i:], m.GithubAppPrivateKey)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GithubAppPrivateKey)))
-	i--
-	dAtA[i] = 0x3a
-	i -= len(m.TLSClientCertKey)
-	copy(dAtA[i:], m.TLSClientCertKey)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TLSClientCertKey)))
-	i--
-	dAtA[i] = 0x32
-	i -= len(m.TLSClientCertData)
-	copy(dAtA[i:], m.TLSClientCertData)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TLSClientCertData)))
-	i--
-	dAtA[i] = 0x2a
-	i -= len(m.SSHPrivateKey)
-	copy(dAtA[i:], m.SSHPrivateKey)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SSHPrivateKey)))
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.Password)
-	copy(dAtA[i:], m.Password)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Password)))
+	i -= len(m.Chart)
+	copy(dAtA[i:], m.Chart)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Chart)))
 	i--
 	dAtA[i] = 0x1a
-	i -= len(m.Username)
-	copy(dAtA[i:], m.Username)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Username)))
+	i -= len(m.TargetRevision)
+	copy(dAtA[i:], m.TargetRevision)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TargetRevision)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.URL)
-	copy(dAtA[i:], m.URL)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *RepoCredsList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *RepoCredsList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RepoCredsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
 	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -13051,7 +13833,7 @@ func (m *RepoCredsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Repository) Marshal() (dAtA []byte, err error) {
+func (m *RepoCreds) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -13061,21 +13843,206 @@ func (m *Repository) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Repository) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepoCreds) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Repository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RepoCreds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Depth))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xd8
+	i--
+	if m.InsecureOCIForceHttp {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd0
+	i -= len(m.BearerToken)
+	copy(dAtA[i:], m.BearerToken)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.BearerToken)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	i--
+	if m.UseAzureWorkloadIdentity {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc0
+	i -= len(m.NoProxy)
+	copy(dAtA[i:], m.NoProxy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.NoProxy)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	i--
+	if m.ForceHttpBasicAuth {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa0
+	i -= len(m.Proxy)
+	copy(dAtA[i:], m.Proxy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Proxy)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	i -= len(m.GCPServiceAccountKey)
+	copy(dAtA[i:], m.GCPServiceAccountKey)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GCPServiceAccountKey)))
+	i--
+	dAtA[i] = 0x6a
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0x62
+	i--
+	if m.EnableOCI {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x58
+	i -= len(m.GitHubAppEnterpriseBaseURL)
+	copy(dAtA[i:], m.GitHubAppEnterpriseBaseURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GitHubAppEnterpriseBaseURL)))
+	i--
+	dAtA[i] = 0x52
+	i = encodeVarintGenerated(dAtA, i, uint64(m.GithubAppInstallationId))
+	i--
+	dAtA[i] = 0x48
+	i = encodeVarintGenerated(dAtA, i, uint64(m.GithubAppId))
+	i--
+	dAtA[i] = 0x40
+	i -= len(m.GithubAppPrivateKey)
+	copy(dAtA[i:], m.GithubAppPrivateKey)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GithubAppPrivateKey)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.TLSClientCertKey)
+	copy(dAtA[i:], m.TLSClientCertKey)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TLSClientCertKey)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.TLSClientCertData)
+	copy(dAtA[i:], m.TLSClientCertData)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TLSClientCertData)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.SSHPrivateKey)
+	copy(dAtA[i:], m.SSHPrivateKey)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SSHPrivateKey)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Password)
+	copy(dAtA[i:], m.Password)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Password)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Username)
+	copy(dAtA[i:], m.Username)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Username)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RepoCredsList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoCredsList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepoCredsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Repository) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Repository) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Repository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Depth))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd8
 	i--
 	if m.InsecureOCIForceHttp {
 		dAtA[i] = 1
@@ -13591,6 +14558,20 @@ func (m *ResourceDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FieldOwners) > 0 {
+		for iNdEx := len(m.FieldOwners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FieldOwners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	i--
 	if m.Modified {
 		dAtA[i] = 1
@@ -13660,6 +14641,49 @@ func (m *ResourceDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResourceFieldOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceFieldOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceFieldOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Subresource)
+	copy(dAtA[i:], m.Subresource)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Subresource)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Operation)
+	copy(dAtA[i:], m.Operation)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Operation)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Manager)
+	copy(dAtA[i:], m.Manager)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Manager)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ResourceIgnoreDifferences) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13969,6 +14993,14 @@ func (m *ResourceOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.UseConditionsHealth {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x38
 	{
 		size, err := m.IgnoreResourceUpdates.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -14097,6 +15129,18 @@ func (m *ResourceResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SyncedAt != nil {
+		{
+			size, err := m.SyncedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.Images) > 0 {
 		for iNdEx := len(m.Images) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Images[iNdEx])
@@ -15275,6 +16319,20 @@ func (m *SourceHydrator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AdditionalDrySources) > 0 {
+		for iNdEx := len(m.AdditionalDrySources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalDrySources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.HydrateTo != nil {
 		{
 			size, err := m.HydrateTo.MarshalToSizedBuffer(dAtA[:i])
@@ -15330,6 +16388,18 @@ func (m *SourceHydratorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PullRequest != nil {
+		{
+			size, err := m.PullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.CurrentOperation != nil {
 		{
 			size, err := m.CurrentOperation.MarshalToSizedBuffer(dAtA[:i])
@@ -15377,6 +16447,15 @@ func (m *SuccessfulHydrateOperation) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.AdditionalDrySHAs) > 0 {
+		for iNdEx := len(m.AdditionalDrySHAs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AdditionalDrySHAs[iNdEx])
+			copy(dAtA[i:], m.AdditionalDrySHAs[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.AdditionalDrySHAs[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size, err := m.SourceHydrator.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -15400,6 +16479,151 @@ func (m *SuccessfulHydrateOperation) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncAnalysisMetric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncAnalysisMetric) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncAnalysisMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Max)
+	copy(dAtA[i:], m.Max)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Max)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Min)
+	copy(dAtA[i:], m.Min)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Min)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Query)
+	copy(dAtA[i:], m.Query)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Query)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncAnalysisResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncAnalysisResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncAnalysisResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncAnalysisStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncAnalysisStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncAnalysisStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.CheckedAt != nil {
+		{
+			size, err := m.CheckedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncOperation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15420,6 +16644,39 @@ func (m *SyncOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoRollback != nil {
+		{
+			size, err := m.AutoRollback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.SyncPhases) > 0 {
+		for iNdEx := len(m.SyncPhases) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SyncPhases[iNdEx])
+			copy(dAtA[i:], m.SyncPhases[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.SyncPhases[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.SyncWaves != nil {
+		{
+			size, err := m.SyncWaves.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	i = encodeVarintGenerated(dAtA, i, uint64(m.SelfHealAttemptsCount))
 	i--
 	dAtA[i] = 0x60
@@ -15589,6 +16846,20 @@ func (m *SyncOperationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Plan) > 0 {
+		for iNdEx := len(m.Plan) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Plan[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.ManagedNamespaceMetadata != nil {
 		{
 			size, err := m.ManagedNamespaceMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -15656,6 +16927,77 @@ func (m *SyncOperationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncPlanResource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncPlanResource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncPlanResource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Diff)
+	copy(dAtA[i:], m.Diff)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Diff)))
+	i--
+	dAtA[i] = 0x52
+	i -= len(m.HookType)
+	copy(dAtA[i:], m.HookType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HookType)))
+	i--
+	dAtA[i] = 0x4a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SyncWave))
+	i--
+	dAtA[i] = 0x40
+	i -= len(m.SyncPhase)
+	copy(dAtA[i:], m.SyncPhase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SyncPhase)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.Action)
+	copy(dAtA[i:], m.Action)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Action)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15676,6 +17018,56 @@ func (m *SyncPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Hooks) > 0 {
+		for iNdEx := len(m.Hooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.OperationQueue != nil {
+		{
+			size, err := m.OperationQueue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Analysis != nil {
+		{
+			size, err := m.Analysis.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Rollback != nil {
+		{
+			size, err := m.Rollback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ManagedNamespaceMetadata != nil {
 		{
 			size, err := m.ManagedNamespaceMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -15724,6 +17116,53 @@ func (m *SyncPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncPolicyAnalysis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncPolicyAnalysis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncPolicyAnalysis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metrics) > 0 {
+		for iNdEx := len(m.Metrics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metrics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Interval)
+	copy(dAtA[i:], m.Interval)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Interval)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Duration)
+	copy(dAtA[i:], m.Duration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncPolicyAutomated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15781,6 +17220,135 @@ func (m *SyncPolicyAutomated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncPolicyHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncPolicyHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncPolicyHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ServiceAccount)
+	copy(dAtA[i:], m.ServiceAccount)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccount)))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Env) > 0 {
+		for iNdEx := len(m.Env) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Env[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Command) > 0 {
+		for iNdEx := len(m.Command) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Command[iNdEx])
+			copy(dAtA[i:], m.Command[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Command[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.Image)
+	copy(dAtA[i:], m.Image)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Image)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncPolicyOperationQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncPolicyOperationQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncPolicyOperationQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Limit))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncPolicyRollback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncPolicyRollback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncPolicyRollback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.HealthTimeout)
+	copy(dAtA[i:], m.HealthTimeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HealthTimeout)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15801,6 +17369,24 @@ func (m *SyncSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.DisableReadme {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	i -= len(m.Layout)
+	copy(dAtA[i:], m.Layout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Layout)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
+	i--
+	dAtA[i] = 0x1a
 	i -= len(m.Path)
 	copy(dAtA[i:], m.Path)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
@@ -15977,6 +17563,87 @@ func (m *SyncStrategyHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncWaveApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWaveApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWaveApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApprovedAt != nil {
+		{
+			size, err := m.ApprovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.ApprovedBy)
+	copy(dAtA[i:], m.ApprovedBy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ApprovedBy)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Wave))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncWaveRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWaveRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWaveRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.To != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.To))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.From != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.From))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncWindow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -16369,6 +18036,19 @@ func (m *ApplicationCondition) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationDependency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ApplicationDestination) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17193,6 +18873,12 @@ func (m *ApplicationSpec) Size() (n int) {
 		l = m.SourceHydrator.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.DependsOn) > 0 {
+		for _, e := range m.DependsOn {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -17252,6 +18938,12 @@ func (m *ApplicationStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.SourceHydrator.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.OperationQueue) > 0 {
+		for _, e := range m.OperationQueue {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -17317,6 +19009,24 @@ func (m *ApplicationWatchEvent) Size() (n int) {
 	return n
 }
 
+func (m *AutoRollback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Revisions) > 0 {
+		for _, s := range m.Revisions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.ID))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Backoff) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17707,6 +19417,24 @@ func (m *DrySource) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Path)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Helm != nil {
+		l = m.Helm.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Kustomize != nil {
+		l = m.Kustomize.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Directory != nil {
+		l = m.Directory.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Plugin != nil {
+		l = m.Plugin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Ref)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -18004,6 +19732,12 @@ func (m *HydrateOperation) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.SourceHydrator.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.AdditionalDrySHAs) > 0 {
+		for _, s := range m.AdditionalDrySHAs {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -18015,6 +19749,47 @@ func (m *HydrateTo) Size() (n int) {
 	_ = l
 	l = len(m.TargetBranch)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *HydrateToPullRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.API)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Labels) > 0 {
+		for _, s := range m.Labels {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HydratorPullRequestStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Number))
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.State)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.HydratedSHA)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -18394,6 +20169,19 @@ func (m *OperationInitiator) Size() (n int) {
 	return n
 }
 
+func (m *OperationRollbackStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HealthyAt != nil {
+		l = m.HealthyAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *OperationState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18417,6 +20205,24 @@ func (m *OperationState) Size() (n int) {
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	n += 1 + sovGenerated(uint64(m.RetryCount))
+	if m.PendingApproval != nil {
+		l = m.PendingApproval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Approvals) > 0 {
+		for _, e := range m.Approvals {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Analysis != nil {
+		l = m.Analysis.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Rollback != nil {
+		l = m.Rollback.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -18833,6 +20639,21 @@ func (m *PullRequestGeneratorGithub) Size() (n int) {
 	return n
 }
 
+func (m *QueuedOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Operation.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.QueuedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *RefTarget) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19105,6 +20926,29 @@ func (m *ResourceDiff) Size() (n int) {
 	l = len(m.ResourceVersion)
 	n += 1 + l + sovGenerated(uint64(l))
 	n += 2
+	if len(m.FieldOwners) > 0 {
+		for _, e := range m.FieldOwners {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ResourceFieldOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Manager)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Operation)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Subresource)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -19250,6 +21094,7 @@ func (m *ResourceOverride) Size() (n int) {
 	n += 2
 	l = m.IgnoreResourceUpdates.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -19306,6 +21151,10 @@ func (m *ResourceResult) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.SyncedAt != nil {
+		l = m.SyncedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19713,6 +21562,12 @@ func (m *SourceHydrator) Size() (n int) {
 		l = m.HydrateTo.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.AdditionalDrySources) > 0 {
+		for _, e := range m.AdditionalDrySources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -19730,6 +21585,10 @@ func (m *SourceHydratorStatus) Size() (n int) {
 		l = m.CurrentOperation.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19745,6 +21604,67 @@ func (m *SuccessfulHydrateOperation) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.SourceHydrator.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.AdditionalDrySHAs) > 0 {
+		for _, s := range m.AdditionalDrySHAs {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SyncAnalysisMetric) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Query)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Min)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Max)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SyncAnalysisResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SyncAnalysisStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CheckedAt != nil {
+		l = m.CheckedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -19797,6 +21717,20 @@ func (m *SyncOperation) Size() (n int) {
 		}
 	}
 	n += 1 + sovGenerated(uint64(m.SelfHealAttemptsCount))
+	if m.SyncWaves != nil {
+		l = m.SyncWaves.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.SyncPhases) > 0 {
+		for _, s := range m.SyncPhases {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.AutoRollback != nil {
+		l = m.AutoRollback.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19849,6 +21783,40 @@ func (m *SyncOperationResult) Size() (n int) {
 		l = m.ManagedNamespaceMetadata.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Plan) > 0 {
+		for _, e := range m.Plan {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SyncPlanResource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Action)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SyncPhase)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.SyncWave))
+	l = len(m.HookType)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Diff)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -19876,6 +21844,43 @@ func (m *SyncPolicy) Size() (n int) {
 		l = m.ManagedNamespaceMetadata.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Rollback != nil {
+		l = m.Rollback.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Analysis != nil {
+		l = m.Analysis.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.OperationQueue != nil {
+		l = m.OperationQueue.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Hooks) > 0 {
+		for _, e := range m.Hooks {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SyncPolicyAnalysis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Duration)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Interval)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Metrics) > 0 {
+		for _, e := range m.Metrics {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -19894,6 +21899,62 @@ func (m *SyncPolicyAutomated) Size() (n int) {
 	return n
 }
 
+func (m *SyncPolicyHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Image)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Command) > 0 {
+		for _, s := range m.Command {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Env) > 0 {
+		for _, e := range m.Env {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.ServiceAccount)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SyncPolicyOperationQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Limit))
+	return n
+}
+
+func (m *SyncPolicyRollback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HealthTimeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *SyncSource) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19904,6 +21965,11 @@ func (m *SyncSource) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Path)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Layout)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -19966,6 +22032,39 @@ func (m *SyncStrategyHook) Size() (n int) {
 	return n
 }
 
+func (m *SyncWaveApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Wave))
+	l = len(m.ApprovedBy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ApprovedAt != nil {
+		l = m.ApprovedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncWaveRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.From != nil {
+		n += 1 + sovGenerated(uint64(*m.From))
+	}
+	if m.To != nil {
+		n += 1 + sovGenerated(uint64(*m.To))
+	}
+	return n
+}
+
 func (m *SyncWindow) Size() (n int) {
 	if m == nil {
 		return 0
@@ -20213,6 +22312,17 @@ func (this *ApplicationCondition) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationDependency) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationDependency{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationDestination) String() string {
 	if this == nil {
 		return "nil"
@@ -20770,6 +22880,11 @@ func (this *ApplicationSpec) String() string {
 		repeatedStringForSources += strings.Replace(strings.Replace(f.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForSources += "}"
+	repeatedStringForDependsOn := "[]ApplicationDependency{"
+	for _, f := range this.DependsOn {
+		repeatedStringForDependsOn += strings.Replace(strings.Replace(f.String(), "ApplicationDependency", "ApplicationDependency", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDependsOn += "}"
 	s := strings.Join([]string{`&ApplicationSpec{`,
 		`Source:` + strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1) + `,`,
 		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
@@ -20780,6 +22895,7 @@ func (this *ApplicationSpec) String() string {
 		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
 		`Sources:` + repeatedStringForSources + `,`,
 		`SourceHydrator:` + strings.Replace(this.SourceHydrator.String(), "SourceHydrator", "SourceHydrator", 1) + `,`,
+		`DependsOn:` + repeatedStringForDependsOn + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20803,6 +22919,11 @@ func (this *ApplicationStatus) String() string {
 		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "ApplicationCondition", "ApplicationCondition", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForConditions += "}"
+	repeatedStringForOperationQueue := "[]QueuedOperation{"
+	for _, f := range this.OperationQueue {
+		repeatedStringForOperationQueue += strings.Replace(strings.Replace(f.String(), "QueuedOperation", "QueuedOperation", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForOperationQueue += "}"
 	s := strings.Join([]string{`&ApplicationStatus{`,
 		`Resources:` + repeatedStringForResources + `,`,
 		`Sync:` + strings.Replace(strings.Replace(this.Sync.String(), "SyncStatus", "SyncStatus", 1), `&`, ``, 1) + `,`,
@@ -20818,6 +22939,7 @@ func (this *ApplicationStatus) String() string {
 		`SourceTypes:` + fmt.Sprintf("%v", this.SourceTypes) + `,`,
 		`ControllerNamespace:` + fmt.Sprintf("%v", this.ControllerNamespace) + `,`,
 		`SourceHydrator:` + strings.Replace(strings.Replace(this.SourceHydrator.String(), "SourceHydratorStatus", "SourceHydratorStatus", 1), `&`, ``, 1) + `,`,
+		`OperationQueue:` + repeatedStringForOperationQueue + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20872,6 +22994,18 @@ func (this *ApplicationWatchEvent) String() string {
 	}, "")
 	return s
 }
+func (this *AutoRollback) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AutoRollback{`,
+		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Backoff) String() string {
 	if this == nil {
 		return "nil"
@@ -21168,6 +23302,11 @@ func (this *DrySource) String() string {
 		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
 		`TargetRevision:` + fmt.Sprintf("%v", this.TargetRevision) + `,`,
 		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Helm:` + strings.Replace(this.Helm.String(), "ApplicationSourceHelm", "ApplicationSourceHelm", 1) + `,`,
+		`Kustomize:` + strings.Replace(this.Kustomize.String(), "ApplicationSourceKustomize", "ApplicationSourceKustomize", 1) + `,`,
+		`Directory:` + strings.Replace(this.Directory.String(), "ApplicationSourceDirectory", "ApplicationSourceDirectory", 1) + `,`,
+		`Plugin:` + strings.Replace(this.Plugin.String(), "ApplicationSourcePlugin", "ApplicationSourcePlugin", 1) + `,`,
+		`Ref:` + fmt.Sprintf("%v", this.Ref) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21420,6 +23559,7 @@ func (this *HydrateOperation) String() string {
 		`DrySHA:` + fmt.Sprintf("%v", this.DrySHA) + `,`,
 		`HydratedSHA:` + fmt.Sprintf("%v", this.HydratedSHA) + `,`,
 		`SourceHydrator:` + strings.Replace(strings.Replace(this.SourceHydrator.String(), "SourceHydrator", "SourceHydrator", 1), `&`, ``, 1) + `,`,
+		`AdditionalDrySHAs:` + fmt.Sprintf("%v", this.AdditionalDrySHAs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21430,6 +23570,33 @@ func (this *HydrateTo) String() string {
 	}
 	s := strings.Join([]string{`&HydrateTo{`,
 		`TargetBranch:` + fmt.Sprintf("%v", this.TargetBranch) + `,`,
+		`PullRequest:` + strings.Replace(this.PullRequest.String(), "HydrateToPullRequest", "HydrateToPullRequest", 1) + `,`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HydrateToPullRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HydrateToPullRequest{`,
+		`Provider:` + fmt.Sprintf("%v", this.Provider) + `,`,
+		`API:` + fmt.Sprintf("%v", this.API) + `,`,
+		`Labels:` + fmt.Sprintf("%v", this.Labels) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HydratorPullRequestStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HydratorPullRequestStatus{`,
+		`Number:` + fmt.Sprintf("%v", this.Number) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`HydratedSHA:` + fmt.Sprintf("%v", this.HydratedSHA) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21762,10 +23929,25 @@ func (this *OperationInitiator) String() string {
 	}, "")
 	return s
 }
+func (this *OperationRollbackStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OperationRollbackStatus{`,
+		`HealthyAt:` + strings.Replace(fmt.Sprintf("%v", this.HealthyAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *OperationState) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForApprovals := "[]SyncWaveApproval{"
+	for _, f := range this.Approvals {
+		repeatedStringForApprovals += strings.Replace(strings.Replace(f.String(), "SyncWaveApproval", "SyncWaveApproval", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForApprovals += "}"
 	s := strings.Join([]string{`&OperationState{`,
 		`Operation:` + strings.Replace(strings.Replace(this.Operation.String(), "Operation", "Operation", 1), `&`, ``, 1) + `,`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
@@ -21774,6 +23956,10 @@ func (this *OperationState) String() string {
 		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
 		`RetryCount:` + fmt.Sprintf("%v", this.RetryCount) + `,`,
+		`PendingApproval:` + strings.Replace(this.PendingApproval.String(), "SyncWaveApproval", "SyncWaveApproval", 1) + `,`,
+		`Approvals:` + repeatedStringForApprovals + `,`,
+		`Analysis:` + strings.Replace(this.Analysis.String(), "SyncAnalysisStatus", "SyncAnalysisStatus", 1) + `,`,
+		`Rollback:` + strings.Replace(this.Rollback.String(), "OperationRollbackStatus", "OperationRollbackStatus", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22059,6 +24245,18 @@ func (this *PullRequestGeneratorGithub) String() string {
 	}, "")
 	return s
 }
+func (this *QueuedOperation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueuedOperation{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Operation:` + strings.Replace(strings.Replace(this.Operation.String(), "Operation", "Operation", 1), `&`, ``, 1) + `,`,
+		`QueuedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.QueuedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RefTarget) String() string {
 	if this == nil {
 		return "nil"
@@ -22258,6 +24456,11 @@ func (this *ResourceDiff) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForFieldOwners := "[]ResourceFieldOwner{"
+	for _, f := range this.FieldOwners {
+		repeatedStringForFieldOwners += strings.Replace(strings.Replace(f.String(), "ResourceFieldOwner", "ResourceFieldOwner", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForFieldOwners += "}"
 	s := strings.Join([]string{`&ResourceDiff{`,
 		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
 		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
@@ -22271,6 +24474,20 @@ func (this *ResourceDiff) String() string {
 		`PredictedLiveState:` + fmt.Sprintf("%v", this.PredictedLiveState) + `,`,
 		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
 		`Modified:` + fmt.Sprintf("%v", this.Modified) + `,`,
+		`FieldOwners:` + repeatedStringForFieldOwners + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceFieldOwner) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceFieldOwner{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Manager:` + fmt.Sprintf("%v", this.Manager) + `,`,
+		`Operation:` + fmt.Sprintf("%v", this.Operation) + `,`,
+		`Subresource:` + fmt.Sprintf("%v", this.Subresource) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22378,6 +24595,7 @@ func (this *ResourceOverride) String() string {
 		`KnownTypeFields:` + repeatedStringForKnownTypeFields + `,`,
 		`UseOpenLibs:` + fmt.Sprintf("%v", this.UseOpenLibs) + `,`,
 		`IgnoreResourceUpdates:` + strings.Replace(strings.Replace(this.IgnoreResourceUpdates.String(), "OverrideIgnoreDiff", "OverrideIgnoreDiff", 1), `&`, ``, 1) + `,`,
+		`UseConditionsHealth:` + fmt.Sprintf("%v", this.UseConditionsHealth) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22413,6 +24631,7 @@ func (this *ResourceResult) String() string {
 		`HookPhase:` + fmt.Sprintf("%v", this.HookPhase) + `,`,
 		`SyncPhase:` + fmt.Sprintf("%v", this.SyncPhase) + `,`,
 		`Images:` + fmt.Sprintf("%v", this.Images) + `,`,
+		`SyncedAt:` + strings.Replace(fmt.Sprintf("%v", this.SyncedAt), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22683,10 +24902,16 @@ func (this *SourceHydrator) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForAdditionalDrySources := "[]DrySource{"
+	for _, f := range this.AdditionalDrySources {
+		repeatedStringForAdditionalDrySources += strings.Replace(strings.Replace(f.String(), "DrySource", "DrySource", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForAdditionalDrySources += "}"
 	s := strings.Join([]string{`&SourceHydrator{`,
 		`DrySource:` + strings.Replace(strings.Replace(this.DrySource.String(), "DrySource", "DrySource", 1), `&`, ``, 1) + `,`,
 		`SyncSource:` + strings.Replace(strings.Replace(this.SyncSource.String(), "SyncSource", "SyncSource", 1), `&`, ``, 1) + `,`,
 		`HydrateTo:` + strings.Replace(this.HydrateTo.String(), "HydrateTo", "HydrateTo", 1) + `,`,
+		`AdditionalDrySources:` + repeatedStringForAdditionalDrySources + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22698,6 +24923,7 @@ func (this *SourceHydratorStatus) String() string {
 	s := strings.Join([]string{`&SourceHydratorStatus{`,
 		`LastSuccessfulOperation:` + strings.Replace(this.LastSuccessfulOperation.String(), "SuccessfulHydrateOperation", "SuccessfulHydrateOperation", 1) + `,`,
 		`CurrentOperation:` + strings.Replace(this.CurrentOperation.String(), "HydrateOperation", "HydrateOperation", 1) + `,`,
+		`PullRequest:` + strings.Replace(this.PullRequest.String(), "HydratorPullRequestStatus", "HydratorPullRequestStatus", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22710,6 +24936,50 @@ func (this *SuccessfulHydrateOperation) String() string {
 		`DrySHA:` + fmt.Sprintf("%v", this.DrySHA) + `,`,
 		`HydratedSHA:` + fmt.Sprintf("%v", this.HydratedSHA) + `,`,
 		`SourceHydrator:` + strings.Replace(strings.Replace(this.SourceHydrator.String(), "SourceHydrator", "SourceHydrator", 1), `&`, ``, 1) + `,`,
+		`AdditionalDrySHAs:` + fmt.Sprintf("%v", this.AdditionalDrySHAs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncAnalysisMetric) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncAnalysisMetric{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`Min:` + fmt.Sprintf("%v", this.Min) + `,`,
+		`Max:` + fmt.Sprintf("%v", this.Max) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncAnalysisResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncAnalysisResult{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncAnalysisStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForResults := "[]SyncAnalysisResult{"
+	for _, f := range this.Results {
+		repeatedStringForResults += strings.Replace(strings.Replace(f.String(), "SyncAnalysisResult", "SyncAnalysisResult", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForResults += "}"
+	s := strings.Join([]string{`&SyncAnalysisStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`CheckedAt:` + strings.Replace(fmt.Sprintf("%v", this.CheckedAt), "Time", "v1.Time", 1) + `,`,
+		`Results:` + repeatedStringForResults + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22740,6 +25010,9 @@ func (this *SyncOperation) String() string {
 		`Sources:` + repeatedStringForSources + `,`,
 		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`SelfHealAttemptsCount:` + fmt.Sprintf("%v", this.SelfHealAttemptsCount) + `,`,
+		`SyncWaves:` + strings.Replace(this.SyncWaves.String(), "SyncWaveRange", "SyncWaveRange", 1) + `,`,
+		`SyncPhases:` + fmt.Sprintf("%v", this.SyncPhases) + `,`,
+		`AutoRollback:` + strings.Replace(this.AutoRollback.String(), "AutoRollback", "AutoRollback", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22771,6 +25044,11 @@ func (this *SyncOperationResult) String() string {
 		repeatedStringForSources += strings.Replace(strings.Replace(f.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForSources += "}"
+	repeatedStringForPlan := "[]SyncPlanResource{"
+	for _, f := range this.Plan {
+		repeatedStringForPlan += strings.Replace(strings.Replace(f.String(), "SyncPlanResource", "SyncPlanResource", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPlan += "}"
 	s := strings.Join([]string{`&SyncOperationResult{`,
 		`Resources:` + repeatedStringForResources + `,`,
 		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
@@ -22778,6 +25056,26 @@ func (this *SyncOperationResult) String() string {
 		`Sources:` + repeatedStringForSources + `,`,
 		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`ManagedNamespaceMetadata:` + strings.Replace(this.ManagedNamespaceMetadata.String(), "ManagedNamespaceMetadata", "ManagedNamespaceMetadata", 1) + `,`,
+		`Plan:` + repeatedStringForPlan + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncPlanResource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncPlanResource{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`SyncPhase:` + fmt.Sprintf("%v", this.SyncPhase) + `,`,
+		`SyncWave:` + fmt.Sprintf("%v", this.SyncWave) + `,`,
+		`HookType:` + fmt.Sprintf("%v", this.HookType) + `,`,
+		`Diff:` + fmt.Sprintf("%v", this.Diff) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22786,11 +25084,37 @@ func (this *SyncPolicy) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForHooks := "[]SyncPolicyHook{"
+	for _, f := range this.Hooks {
+		repeatedStringForHooks += strings.Replace(strings.Replace(f.String(), "SyncPolicyHook", "SyncPolicyHook", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHooks += "}"
 	s := strings.Join([]string{`&SyncPolicy{`,
 		`Automated:` + strings.Replace(this.Automated.String(), "SyncPolicyAutomated", "SyncPolicyAutomated", 1) + `,`,
 		`SyncOptions:` + fmt.Sprintf("%v", this.SyncOptions) + `,`,
 		`Retry:` + strings.Replace(this.Retry.String(), "RetryStrategy", "RetryStrategy", 1) + `,`,
 		`ManagedNamespaceMetadata:` + strings.Replace(this.ManagedNamespaceMetadata.String(), "ManagedNamespaceMetadata", "ManagedNamespaceMetadata", 1) + `,`,
+		`Rollback:` + strings.Replace(this.Rollback.String(), "SyncPolicyRollback", "SyncPolicyRollback", 1) + `,`,
+		`Analysis:` + strings.Replace(this.Analysis.String(), "SyncPolicyAnalysis", "SyncPolicyAnalysis", 1) + `,`,
+		`OperationQueue:` + strings.Replace(this.OperationQueue.String(), "SyncPolicyOperationQueue", "SyncPolicyOperationQueue", 1) + `,`,
+		`Hooks:` + repeatedStringForHooks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncPolicyAnalysis) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMetrics := "[]SyncAnalysisMetric{"
+	for _, f := range this.Metrics {
+		repeatedStringForMetrics += strings.Replace(strings.Replace(f.String(), "SyncAnalysisMetric", "SyncAnalysisMetric", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMetrics += "}"
+	s := strings.Join([]string{`&SyncPolicyAnalysis{`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`Interval:` + fmt.Sprintf("%v", this.Interval) + `,`,
+		`Metrics:` + repeatedStringForMetrics + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22808,6 +25132,47 @@ func (this *SyncPolicyAutomated) String() string {
 	}, "")
 	return s
 }
+func (this *SyncPolicyHook) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEnv := "[]*EnvEntry{"
+	for _, f := range this.Env {
+		repeatedStringForEnv += strings.Replace(f.String(), "EnvEntry", "EnvEntry", 1) + ","
+	}
+	repeatedStringForEnv += "}"
+	s := strings.Join([]string{`&SyncPolicyHook{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`Command:` + fmt.Sprintf("%v", this.Command) + `,`,
+		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
+		`Env:` + repeatedStringForEnv + `,`,
+		`ServiceAccount:` + fmt.Sprintf("%v", this.ServiceAccount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncPolicyOperationQueue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncPolicyOperationQueue{`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncPolicyRollback) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncPolicyRollback{`,
+		`HealthTimeout:` + fmt.Sprintf("%v", this.HealthTimeout) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SyncSource) String() string {
 	if this == nil {
 		return "nil"
@@ -22815,6 +25180,9 @@ func (this *SyncSource) String() string {
 	s := strings.Join([]string{`&SyncSource{`,
 		`TargetBranch:` + fmt.Sprintf("%v", this.TargetBranch) + `,`,
 		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Layout:` + fmt.Sprintf("%v", this.Layout) + `,`,
+		`DisableReadme:` + fmt.Sprintf("%v", this.DisableReadme) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22863,6 +25231,30 @@ func (this *SyncStrategyHook) String() string {
 	}, "")
 	return s
 }
+func (this *SyncWaveApproval) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncWaveApproval{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Wave:` + fmt.Sprintf("%v", this.Wave) + `,`,
+		`ApprovedBy:` + fmt.Sprintf("%v", this.ApprovedBy) + `,`,
+		`ApprovedAt:` + strings.Replace(fmt.Sprintf("%v", this.ApprovedAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncWaveRange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncWaveRange{`,
+		`From:` + valueToStringGenerated(this.From) + `,`,
+		`To:` + valueToStringGenerated(this.To) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SyncWindow) String() string {
 	if this == nil {
 		return "nil"
@@ -24499,7 +26891,7 @@ func (m *ApplicationCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
+func (m *ApplicationDependency) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24522,15 +26914,15 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationDestination: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationDependency: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationDependency: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24558,7 +26950,7 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Server = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -24592,9 +26984,123 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 			}
 			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Server = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31380,6 +33886,40 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, ApplicationDependency{})
+			if err := m.DependsOn[len(m.DependsOn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -31900,6 +34440,40 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperationQueue = append(m.OperationQueue, QueuedOperation{})
+			if err := m.OperationQueue[len(m.OperationQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32321,7 +34895,7 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Backoff) Unmarshal(dAtA []byte) error {
+func (m *AutoRollback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32344,15 +34918,15 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoRollback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoRollback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32380,13 +34954,13 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Duration = string(dAtA[iNdEx:postIndex])
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var v int64
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32396,97 +34970,14 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				m.ID |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Factor = &v
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MaxDuration = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BasicAuthBitbucketServer: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BasicAuthBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32514,43 +35005,259 @@ func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordRef", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PasswordRef == nil {
-				m.PasswordRef = &SecretRef{}
-			}
-			if err := m.PasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Backoff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Factor = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasicAuthBitbucketServer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasicAuthBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PasswordRef == nil {
+				m.PasswordRef = &SecretRef{}
+			}
+			if err := m.PasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35928,61 +38635,11 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 			}
 			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DuckTypeGenerator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DuckTypeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35992,29 +38649,33 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigMapRef = string(dAtA[iNdEx:postIndex])
+			if m.Helm == nil {
+				m.Helm = &ApplicationSourceHelm{}
+			}
+			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36024,47 +38685,269 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
+			if m.Kustomize == nil {
+				m.Kustomize = &ApplicationSourceKustomize{}
 			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.RequeueAfterSeconds = &v
-		case 4:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Directory == nil {
+				m.Directory = &ApplicationSourceDirectory{}
+			}
+			if err := m.Directory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plugin == nil {
+				m.Plugin = &ApplicationSourcePlugin{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ref = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DuckTypeGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DuckTypeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigMapRef = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequeueAfterSeconds = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38806,6 +41689,38 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalDrySHAs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalDrySHAs = append(m.AdditionalDrySHAs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -38888,61 +41803,11 @@ func (m *HydrateTo) Unmarshal(dAtA []byte) error {
 			}
 			m.TargetBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Info) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Info: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38952,27 +41817,31 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.PullRequest == nil {
+				m.PullRequest = &HydrateToPullRequest{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39000,7 +41869,7 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39023,7 +41892,7 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InfoItem) Unmarshal(dAtA []byte) error {
+func (m *HydrateToPullRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39046,15 +41915,15 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: HydrateToPullRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HydrateToPullRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39082,11 +41951,11 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Provider = HydrateToPullRequestProvider(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39114,7 +41983,39 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.API = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39137,7 +42038,7 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JWTToken) Unmarshal(dAtA []byte) error {
+func (m *HydratorPullRequestStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39160,17 +42061,17 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: HydratorPullRequestStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HydratorPullRequestStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			m.IssuedAt = 0
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39180,16 +42081,16 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IssuedAt |= int64(b&0x7F) << shift
+				m.Number |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			m.ExpiresAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39199,14 +42100,27 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiresAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39234,7 +42148,39 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.State = HydratorPullRequestState(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSHA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HydratedSHA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39257,7 +42203,7 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JWTTokens) Unmarshal(dAtA []byte) error {
+func (m *Info) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39280,17 +42226,17 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JWTTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: Info: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39300,25 +42246,55 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, JWTToken{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39341,7 +42317,7 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
+func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39364,10 +42340,10 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
+			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -39434,26 +42410,6 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Code = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -39475,7 +42431,345 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
+func (m *JWTToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			}
+			m.IssuedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JWTTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JWTTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JWTTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, JWTToken{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Code = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42184,6 +45478,92 @@ func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *OperationRollbackStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperationRollbackStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperationRollbackStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthyAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HealthyAt == nil {
+				m.HealthyAt = &v1.Time{}
+			}
+			if err := m.HealthyAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *OperationState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -42434,6 +45814,148 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingApproval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingApproval == nil {
+				m.PendingApproval = &SyncWaveApproval{}
+			}
+			if err := m.PendingApproval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, SyncWaveApproval{})
+			if err := m.Approvals[len(m.Approvals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Analysis == nil {
+				m.Analysis = &SyncAnalysisStatus{}
+			}
+			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rollback == nil {
+				m.Rollback = &OperationRollbackStatus{}
+			}
+			if err := m.Rollback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -46017,7 +49539,7 @@ func (m *PullRequestGeneratorGithub) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefTarget) Unmarshal(dAtA []byte) error {
+func (m *QueuedOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46040,17 +49562,17 @@ func (m *RefTarget) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefTarget: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueuedOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueuedOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46060,30 +49582,29 @@ func (m *RefTarget) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46093,29 +49614,30 @@ func (m *RefTarget) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetRevision = string(dAtA[iNdEx:postIndex])
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46125,23 +49647,24 @@ func (m *RefTarget) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chart = string(dAtA[iNdEx:postIndex])
+			if err := m.QueuedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -46164,7 +49687,7 @@ func (m *RefTarget) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoCreds) Unmarshal(dAtA []byte) error {
+func (m *RefTarget) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46187,17 +49710,17 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoCreds: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefTarget: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoCreds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefTarget: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -46207,27 +49730,174 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chart = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoCreds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoCreds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoCreds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49012,6 +52682,40 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Modified = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldOwners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FieldOwners = append(m.FieldOwners, ResourceFieldOwner{})
+			if err := m.FieldOwners[len(m.FieldOwners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -49033,7 +52737,7 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
+func (m *ResourceFieldOwner) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -49056,15 +52760,15 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceIgnoreDifferences: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceFieldOwner: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceIgnoreDifferences: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceFieldOwner: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49092,11 +52796,11 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49124,11 +52828,11 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Manager = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49156,11 +52860,11 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Operation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subresource", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49188,11 +52892,61 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Subresource = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceIgnoreDifferences: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceIgnoreDifferences: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49220,11 +52974,11 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JQPathExpressions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49252,11 +53006,139 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JQPathExpressions = append(m.JQPathExpressions, string(dAtA[iNdEx:postIndex]))
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagedFieldsManagers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JQPathExpressions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JQPathExpressions = append(m.JQPathExpressions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedFieldsManagers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50247,6 +54129,26 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseConditionsHealth", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseConditionsHealth = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -50891,6 +54793,42 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			}
 			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncedAt == nil {
+				m.SyncedAt = &v1.Time{}
+			}
+			if err := m.SyncedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -54611,95 +58549,9 @@ func (m *SourceHydrator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SourceHydratorStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SourceHydratorStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SourceHydratorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastSuccessfulOperation", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastSuccessfulOperation == nil {
-				m.LastSuccessfulOperation = &SuccessfulHydrateOperation{}
-			}
-			if err := m.LastSuccessfulOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentOperation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalDrySources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -54726,10 +58578,8 @@ func (m *SourceHydratorStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrentOperation == nil {
-				m.CurrentOperation = &HydrateOperation{}
-			}
-			if err := m.CurrentOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AdditionalDrySources = append(m.AdditionalDrySources, DrySource{})
+			if err := m.AdditionalDrySources[len(m.AdditionalDrySources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -54754,7 +58604,7 @@ func (m *SourceHydratorStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
+func (m *SourceHydratorStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -54777,17 +58627,17 @@ func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuccessfulHydrateOperation: wiretype end group for non-group")
+			return fmt.Errorf("proto: SourceHydratorStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuccessfulHydrateOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SourceHydratorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 5:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DrySHA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSuccessfulOperation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54797,29 +58647,33 @@ func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DrySHA = string(dAtA[iNdEx:postIndex])
+			if m.LastSuccessfulOperation == nil {
+				m.LastSuccessfulOperation = &SuccessfulHydrateOperation{}
+			}
+			if err := m.LastSuccessfulOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSHA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentOperation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -54829,27 +58683,31 @@ func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HydratedSHA = string(dAtA[iNdEx:postIndex])
+			if m.CurrentOperation == nil {
+				m.CurrentOperation = &HydrateOperation{}
+			}
+			if err := m.CurrentOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -54876,7 +58734,10 @@ func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PullRequest == nil {
+				m.PullRequest = &HydratorPullRequestStatus{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -54901,7 +58762,7 @@ func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperation) Unmarshal(dAtA []byte) error {
+func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -54924,15 +58785,15 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperation: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuccessfulHydrateOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuccessfulHydrateOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySHA", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -54960,53 +58821,13 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.DrySHA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Prune = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DryRun = bool(v != 0)
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSHA", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55016,31 +58837,27 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncStrategy == nil {
-				m.SyncStrategy = &SyncStrategy{}
-			}
-			if err := m.SyncStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HydratedSHA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -55067,16 +58884,15 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, SyncOperationResource{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalDrySHAs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55086,31 +58902,77 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Source == nil {
-				m.Source = &ApplicationSource{}
-			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AdditionalDrySHAs = append(m.AdditionalDrySHAs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 8:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncAnalysisMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncAnalysisMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncAnalysisMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55138,11 +59000,11 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55170,13 +59032,13 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55186,29 +59048,27 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, ApplicationSource{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Min = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55236,27 +59096,8 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			m.Max = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SelfHealAttemptsCount", wireType)
-			}
-			m.SelfHealAttemptsCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SelfHealAttemptsCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -55278,7 +59119,7 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
+func (m *SyncAnalysisResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -55301,15 +59142,1290 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperationResource: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncAnalysisResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperationResource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncAnalysisResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncAnalysisStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncAnalysisStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncAnalysisStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = github_com_argoproj_gitops_engine_pkg_sync_common.OperationPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CheckedAt == nil {
+				m.CheckedAt = &v1.Time{}
+			}
+			if err := m.CheckedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, SyncAnalysisResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Prune = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncStrategy == nil {
+				m.SyncStrategy = &SyncStrategy{}
+			}
+			if err := m.SyncStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, SyncOperationResource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Source == nil {
+				m.Source = &ApplicationSource{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfHealAttemptsCount", wireType)
+			}
+			m.SelfHealAttemptsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfHealAttemptsCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncWaves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncWaves == nil {
+				m.SyncWaves = &SyncWaveRange{}
+			}
+			if err := m.SyncWaves.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPhases", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncPhases = append(m.SyncPhases, github_com_argoproj_gitops_engine_pkg_sync_common.SyncPhase(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRollback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoRollback == nil {
+				m.AutoRollback = &AutoRollback{}
+			}
+			if err := m.AutoRollback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncOperationResource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncOperationResource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncOperationResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, &ResourceResult{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ManagedNamespaceMetadata == nil {
+				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
+			}
+			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plan = append(m.Plan, SyncPlanResource{})
+			if err := m.Plan[len(m.Plan)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncPlanResource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncPlanResource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncPlanResource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55340,6 +60456,38 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
@@ -55369,11 +60517,158 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = github_com_argoproj_gitops_engine_pkg_sync_common.SyncAction(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPhase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncPhase = github_com_argoproj_gitops_engine_pkg_sync_common.SyncPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncWave", wireType)
+			}
+			m.SyncWave = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SyncWave |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HookType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55401,11 +60696,11 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.HookType = github_com_argoproj_gitops_engine_pkg_sync_common.HookType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55433,7 +60728,7 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Diff = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -55456,7 +60751,7 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
+func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -55479,15 +60774,15 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperationResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -55514,14 +60809,16 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, &ResourceResult{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Automated == nil {
+				m.Automated = &SyncPolicyAutomated{}
+			}
+			if err := m.Automated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55549,11 +60846,11 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -55580,13 +60877,16 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Retry == nil {
+				m.Retry = &RetryStrategy{}
+			}
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -55613,14 +60913,208 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, ApplicationSource{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ManagedNamespaceMetadata == nil {
+				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
+			}
+			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rollback == nil {
+				m.Rollback = &SyncPolicyRollback{}
+			}
+			if err := m.Rollback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Analysis == nil {
+				m.Analysis = &SyncPolicyAnalysis{}
+			}
+			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OperationQueue == nil {
+				m.OperationQueue = &SyncPolicyOperationQueue{}
+			}
+			if err := m.OperationQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hooks = append(m.Hooks, SyncPolicyHook{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncPolicyAnalysis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncPolicyAnalysis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncPolicyAnalysis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55648,11 +61142,43 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Interval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -55679,10 +61205,8 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ManagedNamespaceMetadata == nil {
-				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
-			}
-			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Metrics = append(m.Metrics, SyncAnalysisMetric{})
+			if err := m.Metrics[len(m.Metrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -55707,7 +61231,138 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
+func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncPolicyAutomated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncPolicyAutomated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Prune = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfHeal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SelfHeal = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowEmpty", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowEmpty = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Enabled = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncPolicyHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -55717,30 +61372,126 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenerated
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncPolicyHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncPolicyHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = github_com_argoproj_gitops_engine_pkg_sync_common.HookType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncPolicy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55750,31 +61501,27 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Automated == nil {
-				m.Automated = &SyncPolicyAutomated{}
-			}
-			if err := m.Automated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -55802,11 +61549,11 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -55833,18 +61580,16 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Retry == nil {
-				m.Retry = &RetryStrategy{}
-			}
-			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Env = append(m.Env, &EnvEntry{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55854,27 +61599,23 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ManagedNamespaceMetadata == nil {
-				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
-			}
-			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServiceAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -55897,7 +61638,7 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
+func (m *SyncPolicyOperationQueue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -55920,17 +61661,17 @@ func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncPolicyAutomated: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncPolicyOperationQueue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncPolicyAutomated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncPolicyOperationQueue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var v int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -55940,57 +61681,66 @@ func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Limit |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Prune = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SelfHeal", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.SelfHeal = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowEmpty", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncPolicyRollback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			m.AllowEmpty = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncPolicyRollback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncPolicyRollback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthTimeout", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -56000,13 +61750,24 @@ func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Enabled = &b
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HealthTimeout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -56121,6 +61882,90 @@ func (m *SyncSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Layout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Layout = HydratedManifestsLayout(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableReadme", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableReadme = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -56596,6 +62441,265 @@ func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SyncWaveApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWaveApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWaveApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = github_com_argoproj_gitops_engine_pkg_sync_common.SyncPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wave", wireType)
+			}
+			m.Wave = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Wave |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApprovedAt == nil {
+				m.ApprovedAt = &v1.Time{}
+			}
+			if err := m.ApprovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncWaveRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWaveRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWaveRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.From = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.To = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
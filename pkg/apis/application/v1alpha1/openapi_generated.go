@@ -21,6 +21,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AppProjectStatus":                        schema_pkg_apis_application_v1alpha1_AppProjectStatus(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Application":                             schema_pkg_apis_application_v1alpha1_Application(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationCondition":                    schema_pkg_apis_application_v1alpha1_ApplicationCondition(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDependency":                   schema_pkg_apis_application_v1alpha1_ApplicationDependency(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination":                  schema_pkg_apis_application_v1alpha1_ApplicationDestination(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestinationServiceAccount":    schema_pkg_apis_application_v1alpha1_ApplicationDestinationServiceAccount(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationList":                         schema_pkg_apis_application_v1alpha1_ApplicationList(ref),
@@ -55,6 +56,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSummary":                      schema_pkg_apis_application_v1alpha1_ApplicationSummary(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationTree":                         schema_pkg_apis_application_v1alpha1_ApplicationTree(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationWatchEvent":                   schema_pkg_apis_application_v1alpha1_ApplicationWatchEvent(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AutoRollback":                            schema_pkg_apis_application_v1alpha1_AutoRollback(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Backoff":                                 schema_pkg_apis_application_v1alpha1_Backoff(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.BasicAuthBitbucketServer":                schema_pkg_apis_application_v1alpha1_BasicAuthBitbucketServer(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.BearerTokenBitbucket":                    schema_pkg_apis_application_v1alpha1_BearerTokenBitbucket(ref),
@@ -89,6 +91,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HostResourceInfo":                        schema_pkg_apis_application_v1alpha1_HostResourceInfo(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydrateOperation":                        schema_pkg_apis_application_v1alpha1_HydrateOperation(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydrateTo":                               schema_pkg_apis_application_v1alpha1_HydrateTo(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydrateToPullRequest":                    schema_pkg_apis_application_v1alpha1_HydrateToPullRequest(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydratorPullRequestStatus":               schema_pkg_apis_application_v1alpha1_HydratorPullRequestStatus(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Info":                                    schema_pkg_apis_application_v1alpha1_Info(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.InfoItem":                                schema_pkg_apis_application_v1alpha1_InfoItem(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.JWTToken":                                schema_pkg_apis_application_v1alpha1_JWTToken(ref),
@@ -109,6 +113,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.NestedMergeGenerator":                    schema_pkg_apis_application_v1alpha1_NestedMergeGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Operation":                               schema_pkg_apis_application_v1alpha1_Operation(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OperationInitiator":                      schema_pkg_apis_application_v1alpha1_OperationInitiator(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OperationRollbackStatus":                 schema_pkg_apis_application_v1alpha1_OperationRollbackStatus(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OperationState":                          schema_pkg_apis_application_v1alpha1_OperationState(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OptionalArray":                           schema_pkg_apis_application_v1alpha1_OptionalArray(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OptionalMap":                             schema_pkg_apis_application_v1alpha1_OptionalMap(ref),
@@ -127,6 +132,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorGitLab":              schema_pkg_apis_application_v1alpha1_PullRequestGeneratorGitLab(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorGitea":               schema_pkg_apis_application_v1alpha1_PullRequestGeneratorGitea(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorGithub":              schema_pkg_apis_application_v1alpha1_PullRequestGeneratorGithub(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.QueuedOperation":                         schema_pkg_apis_application_v1alpha1_QueuedOperation(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RefTarget":                               schema_pkg_apis_application_v1alpha1_RefTarget(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RepoCreds":                               schema_pkg_apis_application_v1alpha1_RepoCreds(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RepoCredsList":                           schema_pkg_apis_application_v1alpha1_RepoCredsList(ref),
@@ -139,6 +145,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceActionParam":                     schema_pkg_apis_application_v1alpha1_ResourceActionParam(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceActions":                         schema_pkg_apis_application_v1alpha1_ResourceActions(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceDiff":                            schema_pkg_apis_application_v1alpha1_ResourceDiff(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceFieldOwner":                      schema_pkg_apis_application_v1alpha1_ResourceFieldOwner(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceIgnoreDifferences":               schema_pkg_apis_application_v1alpha1_ResourceIgnoreDifferences(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceNetworkingInfo":                  schema_pkg_apis_application_v1alpha1_ResourceNetworkingInfo(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceNode":                            schema_pkg_apis_application_v1alpha1_ResourceNode(ref),
@@ -163,16 +170,26 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator":                          schema_pkg_apis_application_v1alpha1_SourceHydrator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydratorStatus":                    schema_pkg_apis_application_v1alpha1_SourceHydratorStatus(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SuccessfulHydrateOperation":              schema_pkg_apis_application_v1alpha1_SuccessfulHydrateOperation(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncAnalysisMetric":                      schema_pkg_apis_application_v1alpha1_SyncAnalysisMetric(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncAnalysisResult":                      schema_pkg_apis_application_v1alpha1_SyncAnalysisResult(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncAnalysisStatus":                      schema_pkg_apis_application_v1alpha1_SyncAnalysisStatus(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperation":                           schema_pkg_apis_application_v1alpha1_SyncOperation(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperationResource":                   schema_pkg_apis_application_v1alpha1_SyncOperationResource(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperationResult":                     schema_pkg_apis_application_v1alpha1_SyncOperationResult(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPlanResource":                        schema_pkg_apis_application_v1alpha1_SyncPlanResource(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicy":                              schema_pkg_apis_application_v1alpha1_SyncPolicy(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicyAnalysis":                      schema_pkg_apis_application_v1alpha1_SyncPolicyAnalysis(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicyAutomated":                     schema_pkg_apis_application_v1alpha1_SyncPolicyAutomated(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicyHook":                          schema_pkg_apis_application_v1alpha1_SyncPolicyHook(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicyOperationQueue":                schema_pkg_apis_application_v1alpha1_SyncPolicyOperationQueue(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicyRollback":                      schema_pkg_apis_application_v1alpha1_SyncPolicyRollback(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncSource":                              schema_pkg_apis_application_v1alpha1_SyncSource(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStatus":                              schema_pkg_apis_application_v1alpha1_SyncStatus(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategy":                            schema_pkg_apis_application_v1alpha1_SyncStrategy(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategyApply":                       schema_pkg_apis_application_v1alpha1_SyncStrategyApply(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategyHook":                        schema_pkg_apis_application_v1alpha1_SyncStrategyHook(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWaveApproval":                        schema_pkg_apis_application_v1alpha1_SyncWaveApproval(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWaveRange":                           schema_pkg_apis_application_v1alpha1_SyncWaveRange(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWindow":                              schema_pkg_apis_application_v1alpha1_SyncWindow(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.TLSClientConfig":                         schema_pkg_apis_application_v1alpha1_TLSClientConfig(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.TagFilter":                               schema_pkg_apis_application_v1alpha1_TagFilter(ref),
@@ -623,6 +640,35 @@ func schema_pkg_apis_application_v1alpha1_ApplicationCondition(ref common.Refere
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_ApplicationDependency(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationDependency is a reference to an application which another application depends on",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the application",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the application. Defaults to the namespace of the dependent application.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_ApplicationDestination(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2256,7 +2302,7 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSpec(ref common.ReferenceCa
 					"destination": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Destination is a reference to the target Kubernetes server and namespace",
-							Default:     map[string]any{},
+							Default:     map[string]interface{}{},
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination"),
 						},
 					},
@@ -2281,7 +2327,7 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSpec(ref common.ReferenceCa
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]any{},
+										Default: map[string]interface{}{},
 										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceIgnoreDifferences"),
 									},
 								},
@@ -2295,7 +2341,7 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSpec(ref common.ReferenceCa
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]any{},
+										Default: map[string]interface{}{},
 										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Info"),
 									},
 								},
@@ -2316,7 +2362,7 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSpec(ref common.ReferenceCa
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]any{},
+										Default: map[string]interface{}{},
 										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource"),
 									},
 								},
@@ -2329,12 +2375,26 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSpec(ref common.ReferenceCa
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator"),
 						},
 					},
+					"dependsOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependsOn is a list of applications which must be Synced and Healthy before this application is synced",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDependency"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"destination", "project"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Info", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceIgnoreDifferences", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicy"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDependency", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Info", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceIgnoreDifferences", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicy"},
 	}
 }
 
@@ -2352,7 +2412,7 @@ func schema_pkg_apis_application_v1alpha1_ApplicationStatus(ref common.Reference
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]any{},
+										Default: map[string]interface{}{},
 										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceStatus"),
 									},
 								},
@@ -2362,15 +2422,15 @@ func schema_pkg_apis_application_v1alpha1_ApplicationStatus(ref common.Reference
 					"sync": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Sync contains information about the application's current sync status",
-							Default:     map[string]any{},
+							Default:     map[string]interface{}{},
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStatus"),
 						},
 					},
 					"health": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Health contains information about the application's current health status",
-							Default:     map[string]any{},
-							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HealthStatus"),
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AppHealthStatus"),
 						},
 					},
 					"history": {
@@ -2380,7 +2440,7 @@ func schema_pkg_apis_application_v1alpha1_ApplicationStatus(ref common.Reference
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]any{},
+										Default: map[string]interface{}{},
 										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RevisionHistory"),
 									},
 								},
@@ -2394,7 +2454,7 @@ func schema_pkg_apis_application_v1alpha1_ApplicationStatus(ref common.Reference
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]any{},
+										Default: map[string]interface{}{},
 										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationCondition"),
 									},
 								},
@@ -2429,7 +2489,7 @@ func schema_pkg_apis_application_v1alpha1_ApplicationStatus(ref common.Reference
 					"summary": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Summary contains a list of URLs and container images used by this application",
-							Default:     map[string]any{},
+							Default:     map[string]interface{}{},
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSummary"),
 						},
 					},
@@ -2465,15 +2525,29 @@ func schema_pkg_apis_application_v1alpha1_ApplicationStatus(ref common.Reference
 					"sourceHydrator": {
 						SchemaProps: spec.SchemaProps{
 							Description: "SourceHydrator stores information about the current state of source hydration",
-							Default:     map[string]any{},
+							Default:     map[string]interface{}{},
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydratorStatus"),
 						},
 					},
+					"operationQueue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OperationQueue contains the operations which are queued behind the operation in progress",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.QueuedOperation"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationCondition", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSummary", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HealthStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OperationState", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RevisionHistory", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydratorStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AppHealthStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationCondition", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSummary", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OperationState", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.QueuedOperation", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RevisionHistory", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydratorStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -2607,6 +2681,50 @@ func schema_pkg_apis_application_v1alpha1_ApplicationWatchEvent(ref common.Refer
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_AutoRollback(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AutoRollback contains information about an automatic rollback of a failed sync",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"revisions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revisions are the revisions of the failed sync which was rolled back",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the ID of the history entry which the application was rolled back to",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is why the failed sync was rolled back",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"id"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_Backoff(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3396,10 +3514,43 @@ func schema_pkg_apis_application_v1alpha1_DrySource(ref common.ReferenceCallback
 							Format:      "",
 						},
 					},
+					"helm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Helm specifies helm specific options",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourceHelm"),
+						},
+					},
+					"kustomize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kustomize specifies kustomize specific options",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourceKustomize"),
+						},
+					},
+					"directory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Directory specifies path/directory specific options",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourceDirectory"),
+						},
+					},
+					"plugin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Plugin specifies config management plugin specific options",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourcePlugin"),
+						},
+					},
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ref is reference to another dry source within SourceHydrator.AdditionalDrySources. This field should only be used in combination with helm value files.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"repoURL", "targetRevision", "path"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourceDirectory", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourceHelm", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourceKustomize", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSourcePlugin"},
 	}
 }
 
@@ -4045,6 +4196,21 @@ func schema_pkg_apis_application_v1alpha1_HydrateOperation(ref common.ReferenceC
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator"),
 						},
 					},
+					"additionalDrySHAs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdditionalDrySHAs holds the resolved revisions of the additional dry sources, in the order of the additional dry sources of the hydrator config",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"phase", "message"},
 			},
@@ -4058,7 +4224,7 @@ func schema_pkg_apis_application_v1alpha1_HydrateTo(ref common.ReferenceCallback
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HydrateTo specifies a location to which hydrated manifests should be pushed as a \"staging area\" before being moved to the SyncSource. The RepoURL and Path are assumed based on the associated SyncSource config in the SourceHydrator.",
+				Description: "HydrateTo specifies a location to which hydrated manifests should be pushed as a \"staging area\" before being moved to the SyncSource. The Path is assumed based on the associated SyncSource config in the SourceHydrator, and the RepoURL defaults to the SyncSource's.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"targetBranch": {
@@ -4069,10 +4235,113 @@ func schema_pkg_apis_application_v1alpha1_HydrateTo(ref common.ReferenceCallback
 							Format:      "",
 						},
 					},
+					"pullRequest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PullRequest configures the commit server to open a pull request from the HydrateTo branch to the SyncSource branch whenever the hydrated manifests on the two branches differ.",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydrateToPullRequest"),
+						},
+					},
+					"repoURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RepoURL is the URL of the repository to which hydrated manifests should be pushed. It defaults to the SyncSource RepoURL. Besides git and OCI (oci://) repositories, an S3-compatible bucket (s3://bucket/prefix) may be used, in which case the manifests are uploaded under the TargetBranch key prefix.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"targetBranch"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydrateToPullRequest"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_HydrateToPullRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HydrateToPullRequest configures the pull request opened from the HydrateTo branch to the SyncSource branch. The pull request is created and updated with the repository write credentials of the hydrated repository, and it is closed when the two branches no longer differ.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider is the SCM provider hosting the hydrated repository.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"api": {
+						SchemaProps: spec.SchemaProps{
+							Description: "API is the URL of the SCM provider's API. If empty, the public API of the provider is used (https://api.github.com or https://gitlab.com).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels are applied to the pull request when it is opened.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"provider"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_HydratorPullRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HydratorPullRequestStatus contains information about the pull request from the hydrateTo branch to the syncSource branch",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"number": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number is the number of the pull request",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the web URL of the pull request",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the state of the pull request",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hydratedSHA": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HydratedSHA is the hydrated commit the pull request was last updated for",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"number", "state"},
+			},
+		},
 	}
 }
 
@@ -4794,6 +5063,27 @@ func schema_pkg_apis_application_v1alpha1_OperationInitiator(ref common.Referenc
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_OperationRollbackStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OperationRollbackStatus contains the state of the automated rollback of a completed operation",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"healthyAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HealthyAt is the time the application was first observed Healthy after the operation completed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_OperationState(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4804,7 +5094,7 @@ func schema_pkg_apis_application_v1alpha1_OperationState(ref common.ReferenceCal
 					"operation": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Operation is the original requested operation",
-							Default:     map[string]any{},
+							Default:     map[string]interface{}{},
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Operation"),
 						},
 					},
@@ -4848,12 +5138,44 @@ func schema_pkg_apis_application_v1alpha1_OperationState(ref common.ReferenceCal
 							Format:      "int64",
 						},
 					},
+					"pendingApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingApproval is the sync wave the operation waits to be approved before syncing it",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWaveApproval"),
+						},
+					},
+					"approvals": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approvals contains the sync waves which were approved during the operation",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWaveApproval"),
+									},
+								},
+							},
+						},
+					},
+					"analysis": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Analysis contains the state of the analysis of the metrics after the sync",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncAnalysisStatus"),
+						},
+					},
+					"rollback": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollback contains the state of the automated rollback of the operation. It is only set if the rollback policy of the application was enabled when the operation completed.",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OperationRollbackStatus"),
+						},
+					},
 				},
 				Required: []string{"operation", "phase", "startedAt"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Operation", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperationResult", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Operation", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OperationRollbackStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncAnalysisStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperationResult", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWaveApproval", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -5694,7 +6016,44 @@ func schema_pkg_apis_application_v1alpha1_PullRequestGeneratorGithub(ref common.
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_RefTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_QueuedOperation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QueuedOperation is an operation which is started once the operation in progress completed",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID identifies the queued operation",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"operation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operation is the queued operation",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Operation"),
+						},
+					},
+					"queuedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueuedAt is the time the operation was queued",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"id", "operation", "queuedAt"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Operation", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_RefTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -6367,87 +6726,153 @@ func schema_pkg_apis_application_v1alpha1_ResourceDiff(ref common.ReferenceCallb
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ResourceDiff holds the diff of a live and target resource object",
+				Description: "ResourceDiff holds the diff between a live and target resource object in Argo CD. It is used to compare the desired state (from Git/Helm) with the actual state in the cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"group": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Group represents the API group of the resource (e.g., \"apps\" for Deployments).",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind represents the Kubernetes resource kind (e.g., \"Deployment\", \"Service\").",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Namespace specifies the namespace where the resource exists.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Name is the name of the resource.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"targetState": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetState contains the JSON serialized resource manifest defined in the Git/Helm",
+							Description: "TargetState contains the JSON-serialized resource manifest as defined in the Git/Helm repository.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"liveState": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetState contains the JSON live resource manifest",
+							Description: "LiveState contains the JSON-serialized resource manifest of the resource currently running in the cluster.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"diff": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Diff contains the JSON patch between target and live resource Deprecated: use NormalizedLiveState and PredictedLiveState to render the difference",
+							Description: "Diff contains the JSON patch representing the difference between the live and target resource. Deprecated: Use NormalizedLiveState and PredictedLiveState instead to compute differences.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"hook": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "Hook indicates whether this resource is a hook resource (e.g., pre-sync or post-sync hooks).",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 					"normalizedLiveState": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NormalizedLiveState contains JSON serialized live resource state with applied normalizations",
+							Description: "NormalizedLiveState contains the JSON-serialized live resource state after applying normalizations. Normalizations may include ignoring irrelevant fields like timestamps or defaults applied by Kubernetes.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"predictedLiveState": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PredictedLiveState contains JSON serialized resource state that is calculated based on normalized and target resource state",
+							Description: "PredictedLiveState contains the JSON-serialized resource state that Argo CD predicts based on the combination of the normalized live state and the desired target state.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"resourceVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ResourceVersion is the Kubernetes resource version, which helps in tracking changes.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"modified": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "Modified indicates whether the live resource has changes compared to the target resource.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"fieldOwners": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FieldOwners lists the field managers which own the differing fields in the live resource. It helps identifying whether a difference is caused by Argo CD, a controller such as an HPA, or a manual change.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceFieldOwner"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceFieldOwner"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ResourceFieldOwner(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceFieldOwner holds the field manager which owns a differing field of a live resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the path of the differing field (e.g., \".spec.replicas\").",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"manager": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Manager is the name of the field manager which owns the field in the live resource (e.g., \"kube-controller-manager\"). It is empty if no field manager owns the field.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"operation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operation is the operation the field manager owns the field with, either \"Apply\" or \"Update\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subresource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subresource is the subresource the field manager updated the field through (e.g., \"scale\").",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"path"},
 			},
 		},
 	}
@@ -6742,44 +7167,58 @@ func schema_pkg_apis_application_v1alpha1_ResourceOverride(ref common.ReferenceC
 				Properties: map[string]spec.Schema{
 					"HealthLua": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "HealthLua contains a Lua script that defines custom health checks for the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"UseOpenLibs": {
 						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
-							Format:  "",
+							Description: "UseOpenLibs indicates whether to use open-source libraries for the resource.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"UseConditionsHealth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UseConditionsHealth indicates whether to assess the health of the resource using the Ready condition in its status if no health check is defined for the resource.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 					"Actions": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Actions defines the set of actions that can be performed on the resource, as a Lua script.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"IgnoreDifferences": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]any{},
-							Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OverrideIgnoreDiff"),
+							Description: "IgnoreDifferences contains configuration for which differences should be ignored during the resource diffing.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OverrideIgnoreDiff"),
 						},
 					},
 					"IgnoreResourceUpdates": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]any{},
-							Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OverrideIgnoreDiff"),
+							Description: "IgnoreResourceUpdates holds configuration for ignoring updates to specific resource fields.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OverrideIgnoreDiff"),
 						},
 					},
 					"KnownTypeFields": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "KnownTypeFields lists fields for which unit conversions should be applied.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]any{},
+										Default: map[string]interface{}{},
 										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.KnownTypeField"),
 									},
 								},
@@ -6787,7 +7226,7 @@ func schema_pkg_apis_application_v1alpha1_ResourceOverride(ref common.ReferenceC
 						},
 					},
 				},
-				Required: []string{"HealthLua", "UseOpenLibs", "Actions", "IgnoreDifferences", "IgnoreResourceUpdates", "KnownTypeFields"},
+				Required: []string{"HealthLua", "UseOpenLibs", "UseConditionsHealth", "Actions", "IgnoreDifferences", "IgnoreResourceUpdates", "KnownTypeFields"},
 			},
 		},
 		Dependencies: []string{
@@ -6926,10 +7365,33 @@ func schema_pkg_apis_application_v1alpha1_ResourceResult(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"images": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Images contains the images related to the ResourceResult",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"syncedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncedAt is the time the resource or hook was first synced during the operation",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 				Required: []string{"group", "version", "kind", "namespace", "name"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -7802,23 +8264,37 @@ func schema_pkg_apis_application_v1alpha1_SourceHydrator(ref common.ReferenceCal
 					"drySource": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DrySource specifies where the dry \"don't repeat yourself\" manifest source lives.",
-							Default:     map[string]any{},
+							Default:     map[string]interface{}{},
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.DrySource"),
 						},
 					},
 					"syncSource": {
 						SchemaProps: spec.SchemaProps{
 							Description: "SyncSource specifies where to sync hydrated manifests from.",
-							Default:     map[string]any{},
+							Default:     map[string]interface{}{},
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncSource"),
 						},
 					},
 					"hydrateTo": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HydrateTo specifies an optional \"staging\" location to push hydrated manifests to. An external system would then have to move manifests to the SyncSource, e.g. by pull request.",
+							Description: "HydrateTo specifies an optional \"staging\" location to push hydrated manifests to. Unless HydrateTo.PullRequest is set, an external system would then have to move manifests to the SyncSource, e.g. by pull request.",
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydrateTo"),
 						},
 					},
+					"additionalDrySources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdditionalDrySources specifies further dry sources to render alongside the DrySource. The manifests of all dry sources are combined and committed to the SyncSource path. A source with a Ref and no Path is only used to resolve $ref value files.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.DrySource"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"drySource", "syncSource"},
 			},
@@ -7847,11 +8323,17 @@ func schema_pkg_apis_application_v1alpha1_SourceHydratorStatus(ref common.Refere
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydrateOperation"),
 						},
 					},
+					"pullRequest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PullRequest holds the status of the pull request from the hydrateTo branch to the syncSource branch, if spec.sourceHydrator.hydrateTo.pullRequest is configured",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydratorPullRequestStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydrateOperation", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SuccessfulHydrateOperation"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydrateOperation", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydratorPullRequestStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SuccessfulHydrateOperation"},
 	}
 }
 
@@ -7883,6 +8365,21 @@ func schema_pkg_apis_application_v1alpha1_SuccessfulHydrateOperation(ref common.
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator"),
 						},
 					},
+					"additionalDrySHAs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdditionalDrySHAs holds the resolved revisions of the additional dry sources, in the order of the additional dry sources of the hydrator config",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -7891,72 +8388,203 @@ func schema_pkg_apis_application_v1alpha1_SuccessfulHydrateOperation(ref common.
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_SyncOperation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_SyncAnalysisMetric(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SyncOperation contains details about a sync operation.",
+				Description: "SyncAnalysisMetric is a metric query and the range its value must be within",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"revision": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Revision is the revision (Git) or chart version (Helm) which to sync the application to If omitted, will use the revision specified in app spec.",
+							Description: "Name is the name of the metric",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"prune": {
+					"query": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Prune specifies to delete resources from the cluster that are no longer tracked in git",
-							Type:        []string{"boolean"},
+							Description: "Query is a PromQL query which returns a single value",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"dryRun": {
+					"min": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DryRun specifies to perform a `kubectl apply --dry-run` without actually performing the sync",
-							Type:        []string{"boolean"},
+							Description: "Min is the minimum value of the metric (e.g. \"0.99\"). The value is not bounded if empty.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"syncStrategy": {
+					"max": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SyncStrategy describes how to perform the sync",
-							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategy"),
+							Description: "Max is the maximum value of the metric (e.g. \"0.05\"). The value is not bounded if empty.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"resources": {
+				},
+				Required: []string{"name", "query"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SyncAnalysisResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncAnalysisResult is the value of a metric of the analysis",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resources describes which resources shall be part of the sync",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]any{},
-										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperationResource"),
-									},
-								},
-							},
+							Description: "Name is the name of the metric",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"source": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Source overrides the source definition set in the application. This is typically set in a Rollback operation and is nil during a Sync operation",
-							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource"),
+							Description: "Value is the value returned by the query of the metric",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"manifests": {
+				},
+				Required: []string{"name", "value"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SyncAnalysisStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncAnalysisStatus contains the state of the analysis of the metrics after a sync",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Manifests is an optional field that overrides sync source with a local directory for development",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
+							Description: "Phase is the phase of the analysis",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message contains a human-readable message indicating details about the analysis",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartedAt is the time the analysis started",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"checkedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CheckedAt is the time the metrics were most recently analyzed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"results": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Results are the values of the metrics from the most recent analysis",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncAnalysisResult"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"phase", "startedAt"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncAnalysisResult", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SyncOperation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncOperation contains details about a sync operation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the revision (Git) or chart version (Helm) which to sync the application to If omitted, will use the revision specified in app spec.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prune": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prune specifies to delete resources from the cluster that are no longer tracked in git",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"dryRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DryRun specifies to perform a `kubectl apply --dry-run` without actually performing the sync",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"syncStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncStrategy describes how to perform the sync",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategy"),
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources describes which resources shall be part of the sync",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperationResource"),
+									},
+								},
+							},
+						},
+					},
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Source overrides the source definition set in the application. This is typically set in a Rollback operation and is nil during a Sync operation",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource"),
+						},
+					},
+					"manifests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Manifests is an optional field that overrides sync source with a local directory for development",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
 							},
 						},
 					},
@@ -7982,7 +8610,7 @@ func schema_pkg_apis_application_v1alpha1_SyncOperation(ref common.ReferenceCall
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]any{},
+										Default: map[string]interface{}{},
 										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource"),
 									},
 								},
@@ -8004,11 +8632,45 @@ func schema_pkg_apis_application_v1alpha1_SyncOperation(ref common.ReferenceCall
 							},
 						},
 					},
+					"autoHealAttemptsCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SelfHealAttemptsCount contains the number of auto-heal attempts",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"syncWaves": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncWaves limits the sync to the resources and hooks within the sync wave range. SyncFail hooks are not limited.",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWaveRange"),
+						},
+					},
+					"syncPhases": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncPhases limits the sync to the resources and hooks of the given phases (PreSync, Sync, PostSync). SyncFail hooks are not limited.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"autoRollback": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoRollback is set when the operation is an automatic rollback of a failed sync",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AutoRollback"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperationResource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategy"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AutoRollback", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperationResource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategy", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWaveRange"},
 	}
 }
 
@@ -8083,7 +8745,7 @@ func schema_pkg_apis_application_v1alpha1_SyncOperationResult(ref common.Referen
 					"source": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Source records the application source information of the sync, used for comparing auto-sync",
-							Default:     map[string]any{},
+							Default:     map[string]interface{}{},
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource"),
 						},
 					},
@@ -8094,7 +8756,7 @@ func schema_pkg_apis_application_v1alpha1_SyncOperationResult(ref common.Referen
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]any{},
+										Default: map[string]interface{}{},
 										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource"),
 									},
 								},
@@ -8122,12 +8784,116 @@ func schema_pkg_apis_application_v1alpha1_SyncOperationResult(ref common.Referen
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata"),
 						},
 					},
+					"plan": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Plan holds the actions a dry-run sync operation would take for each individual resource",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPlanResource"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"revision"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceResult"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceResult", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPlanResource"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SyncPlanResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncPlanResource holds the action a dry-run sync would take for a specific resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Group specifies the API group of the resource",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version specifies the API version of the resource",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind specifies the API kind of the resource",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace specifies the target namespace of the resource",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name specifies the name of the resource",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action is the action the sync would take for the resource: Create, Patch, Replace, Prune or Hook",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"syncPhase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncPhase is the phase of the sync in which the action would be taken",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"syncWave": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncWave is the sync wave in which the action would be taken",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"hookType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HookType specifies the type of the hook. Empty for non-hook resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"diff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Diff is the unified diff between the live state of the resource and the state predicted by a server-side dry-run apply of the resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"group", "version", "kind", "namespace", "name", "action"},
+			},
+		},
 	}
 }
 
@@ -8171,11 +8937,88 @@ func schema_pkg_apis_application_v1alpha1_SyncPolicy(ref common.ReferenceCallbac
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata"),
 						},
 					},
+					"rollback": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollback controls the automatic rollback of failed syncs to the last successful sync",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicyRollback"),
+						},
+					},
+					"analysis": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Analysis controls the analysis of metrics after a sync, before the sync is considered successful",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicyAnalysis"),
+						},
+					},
+					"operationQueue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OperationQueue enables the queueing of manual syncs which are requested while another operation is in progress",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicyOperationQueue"),
+						},
+					},
+					"hooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hooks are sync hooks which are declared on the application instead of in its source, and are run as Jobs",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicyHook"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RetryStrategy", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicyAutomated"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RetryStrategy", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicyAnalysis", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicyAutomated", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicyHook", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicyOperationQueue", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicyRollback"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SyncPolicyAnalysis(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncPolicyAnalysis controls the analysis of metrics after a sync. The metrics are queried from the Prometheus compatible endpoint configured in the argocd-cm ConfigMap, and the sync fails if any metric is out of its range.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is how long the metrics are analyzed after the sync. Default unit is seconds, but could also be a duration (e.g. \"2m\", \"1h\")",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval is the time between two analyses of the metrics. Default unit is seconds, but could also be a duration (e.g. \"2m\", \"1h\"). Defaults to one minute.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metrics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metrics are the metrics which are analyzed",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncAnalysisMetric"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"duration", "metrics"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncAnalysisMetric"},
 	}
 }
 
@@ -8213,6 +9056,136 @@ func schema_pkg_apis_application_v1alpha1_SyncPolicyAutomated(ref common.Referen
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_SyncPolicyHook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncPolicyHook is a sync hook which is declared on the application, and which the controller runs as a Job in the destination namespace of the application",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the Job of the hook, which has to be unique in the destination namespace",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the hook: PreSync, PostSync or SyncFail",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image is the container image of the hook",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Command is the entrypoint of the container of the hook",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"args": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Args are the arguments of the entrypoint of the container of the hook",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"env": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Env is the list of environment variables of the container of the hook",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.EnvEntry"),
+									},
+								},
+							},
+						},
+					},
+					"serviceAccount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccount is the name of the service account the hook runs as",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "type", "image"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.EnvEntry"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SyncPolicyOperationQueue(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncPolicyOperationQueue controls the queueing of the operations which are requested while another operation is in progress. Queued operations are started in order, once the operation in progress completed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"limit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Limit is the maximum number of queued operations. Defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SyncPolicyRollback(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncPolicyRollback controls the automatic rollback of failed syncs. A sync is rolled back once it failed and its retries and SyncFail hooks completed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"healthTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HealthTimeout is the duration within which the application must be Healthy after a successful sync, otherwise the sync is rolled back. Default unit is seconds, but could also be a duration (e.g. \"2m\", \"1h\"). The health of the application is not checked if empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_SyncSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8222,7 +9195,7 @@ func schema_pkg_apis_application_v1alpha1_SyncSource(ref common.ReferenceCallbac
 				Properties: map[string]spec.Schema{
 					"targetBranch": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetBranch is the branch to which hydrated manifests should be committed",
+							Description: "TargetBranch is the branch from which hydrated manifests will be synced. If HydrateTo is not set, this is also the branch to which hydrated manifests are committed.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -8230,12 +9203,33 @@ func schema_pkg_apis_application_v1alpha1_SyncSource(ref common.ReferenceCallbac
 					},
 					"path": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Path is a directory path within the git repository where hydrated manifests should be committed to and synced from. If hydrateTo is set, this is just the path from which hydrated manifests will be synced.",
+							Description: "Path is a directory path within the git repository where hydrated manifests should be committed to and synced from. The Path should never point to the root of the repo. If hydrateTo is set, this is just the path from which hydrated manifests will be synced.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"repoURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RepoURL is the URL of the repository from which hydrated manifests will be synced. It defaults to the DrySource RepoURL. If it is an OCI repository (oci://), hydrated manifests are pushed as an OCI artifact tagged with TargetBranch.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"layout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Layout is the layout of the hydrated manifests written to the Path. Single (the default) writes all manifests to manifest.yaml, PerResource writes one <kind>-<name>.yaml file per resource, and PerKind writes one <kind>.yaml file per kind of resource.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"disableReadme": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DisableReadme disables the generation of the README.md file next to the hydrated manifests.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"targetBranch", "path"},
 			},
@@ -8363,6 +9357,78 @@ func schema_pkg_apis_application_v1alpha1_SyncStrategyHook(ref common.ReferenceC
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_SyncWaveApproval(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncWaveApproval identifies a sync wave which requires approval before it is synced",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the sync phase of the wave",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"wave": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Wave is the sync wave",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"approvedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovedBy is the user who approved the sync wave",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"approvedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovedAt is the time the sync wave was approved",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"phase", "wave"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SyncWaveRange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncWaveRange is an inclusive range of sync waves. A missing bound leaves the range open on that side.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Description: "From is the first sync wave of the range",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"to": {
+						SchemaProps: spec.SchemaProps{
+							Description: "To is the last sync wave of the range",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_SyncWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -95,6 +95,26 @@ type ApplicationSpec struct {
 
 	// SourceHydrator provides a way to push hydrated manifests back to git before syncing them to the cluster.
 	SourceHydrator *SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,9,opt,name=sourceHydrator"`
+
+	// DependsOn is a list of applications which must be Synced and Healthy before this application is synced
+	DependsOn []ApplicationDependency `json:"dependsOn,omitempty" protobuf:"bytes,10,rep,name=dependsOn"`
+}
+
+// ApplicationDependency is a reference to an application which another application depends on
+type ApplicationDependency struct {
+	// Name is the name of the application
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Namespace is the namespace of the application. Defaults to the namespace of the dependent application.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+}
+
+// GetNamespace returns the namespace of the application, or the given namespace of the dependent application if the
+// namespace is not set
+func (d ApplicationDependency) GetNamespace(dependentNamespace string) string {
+	if d.Namespace == "" {
+		return dependentNamespace
+	}
+	return d.Namespace
 }
 
 type IgnoreDifferences []ResourceIgnoreDifferences
@@ -251,7 +271,7 @@ func (spec *ApplicationSpec) GetHydrateToSource() ApplicationSource {
 			targetRevision = spec.SourceHydrator.HydrateTo.TargetBranch
 		}
 		return ApplicationSource{
-			RepoURL:        spec.SourceHydrator.DrySource.RepoURL,
+			RepoURL:        spec.SourceHydrator.GetHydrateToRepoURL(),
 			Path:           spec.SourceHydrator.SyncSource.Path,
 			TargetRevision: targetRevision,
 		}
@@ -401,33 +421,68 @@ type SourceHydrator struct {
 	DrySource DrySource `json:"drySource" protobuf:"bytes,1,name=drySource"`
 	// SyncSource specifies where to sync hydrated manifests from.
 	SyncSource SyncSource `json:"syncSource" protobuf:"bytes,2,name=syncSource"`
-	// HydrateTo specifies an optional "staging" location to push hydrated manifests to. An external system would then
-	// have to move manifests to the SyncSource, e.g. by pull request.
+	// HydrateTo specifies an optional "staging" location to push hydrated manifests to. Unless HydrateTo.PullRequest
+	// is set, an external system would then have to move manifests to the SyncSource, e.g. by pull request.
 	HydrateTo *HydrateTo `json:"hydrateTo,omitempty" protobuf:"bytes,3,opt,name=hydrateTo"`
+	// AdditionalDrySources specifies further dry sources to render alongside the DrySource. The manifests of all dry
+	// sources are combined and committed to the SyncSource path. A source with a Ref and no Path is only used to
+	// resolve $ref value files.
+	AdditionalDrySources []DrySource `json:"additionalDrySources,omitempty" protobuf:"bytes,4,rep,name=additionalDrySources"`
 }
 
 // GetSyncSource gets the source from which we should sync when a source hydrator is configured.
 func (s SourceHydrator) GetSyncSource() ApplicationSource {
 	return ApplicationSource{
-		// Pull the RepoURL from the dry source. The SyncSource's RepoURL is assumed to be the same.
-		RepoURL:        s.DrySource.RepoURL,
+		RepoURL:        s.GetSyncRepoURL(),
 		Path:           s.SyncSource.Path,
 		TargetRevision: s.SyncSource.TargetBranch,
 	}
 }
 
+// GetSyncRepoURL returns the URL of the repository from which hydrated manifests are synced. Unless the SyncSource
+// sets a RepoURL, this is the dry source repository.
+func (s SourceHydrator) GetSyncRepoURL() string {
+	if s.SyncSource.RepoURL != "" {
+		return s.SyncSource.RepoURL
+	}
+	return s.DrySource.RepoURL
+}
+
+// GetHydrateToRepoURL returns the URL of the repository to which hydrated manifests are pushed. Unless HydrateTo sets
+// a RepoURL, this is the sync repository.
+func (s SourceHydrator) GetHydrateToRepoURL() string {
+	if s.HydrateTo != nil && s.HydrateTo.RepoURL != "" {
+		return s.HydrateTo.RepoURL
+	}
+	return s.GetSyncRepoURL()
+}
+
 // GetDrySource gets the dry source when a source hydrator is configured.
 func (s SourceHydrator) GetDrySource() ApplicationSource {
-	return ApplicationSource{
-		RepoURL:        s.DrySource.RepoURL,
-		Path:           s.DrySource.Path,
-		TargetRevision: s.DrySource.TargetRevision,
+	return s.DrySource.ToApplicationSource()
+}
+
+// GetDrySources gets all dry sources when a source hydrator is configured. The primary DrySource is always first.
+func (s SourceHydrator) GetDrySources() ApplicationSources {
+	sources := make(ApplicationSources, 0, len(s.AdditionalDrySources)+1)
+	sources = append(sources, s.DrySource.ToApplicationSource())
+	for _, drySource := range s.AdditionalDrySources {
+		sources = append(sources, drySource.ToApplicationSource())
 	}
+	return sources
 }
 
 // DeepEquals returns true if the SourceHydrator is deeply equal to the given SourceHydrator.
 func (s SourceHydrator) DeepEquals(hydrator SourceHydrator) bool {
-	return s.DrySource == hydrator.DrySource && s.SyncSource == hydrator.SyncSource && s.HydrateTo.DeepEquals(hydrator.HydrateTo)
+	if len(s.AdditionalDrySources) != len(hydrator.AdditionalDrySources) {
+		return false
+	}
+	for i := range s.AdditionalDrySources {
+		if !s.AdditionalDrySources[i].DeepEquals(hydrator.AdditionalDrySources[i]) {
+			return false
+		}
+	}
+	return s.DrySource.DeepEquals(hydrator.DrySource) && s.SyncSource == hydrator.SyncSource && s.HydrateTo.DeepEquals(hydrator.HydrateTo)
 }
 
 // DrySource specifies a location for dry "don't repeat yourself" manifest source information.
@@ -438,6 +493,42 @@ type DrySource struct {
 	TargetRevision string `json:"targetRevision" protobuf:"bytes,2,name=targetRevision"`
 	// Path is a directory path within the Git repository where the manifests are located
 	Path string `json:"path" protobuf:"bytes,3,name=path"`
+	// Helm specifies helm specific options
+	Helm *ApplicationSourceHelm `json:"helm,omitempty" protobuf:"bytes,4,opt,name=helm"`
+	// Kustomize specifies kustomize specific options
+	Kustomize *ApplicationSourceKustomize `json:"kustomize,omitempty" protobuf:"bytes,5,opt,name=kustomize"`
+	// Directory specifies path/directory specific options
+	Directory *ApplicationSourceDirectory `json:"directory,omitempty" protobuf:"bytes,6,opt,name=directory"`
+	// Plugin specifies config management plugin specific options
+	Plugin *ApplicationSourcePlugin `json:"plugin,omitempty" protobuf:"bytes,7,opt,name=plugin"`
+	// Ref is reference to another dry source within SourceHydrator.AdditionalDrySources. This field should only be
+	// used in combination with helm value files.
+	Ref string `json:"ref,omitempty" protobuf:"bytes,8,opt,name=ref"`
+}
+
+// ToApplicationSource converts the DrySource to an ApplicationSource for manifest generation.
+func (d DrySource) ToApplicationSource() ApplicationSource {
+	return ApplicationSource{
+		RepoURL:        d.RepoURL,
+		Path:           d.Path,
+		TargetRevision: d.TargetRevision,
+		Helm:           d.Helm,
+		Kustomize:      d.Kustomize,
+		Directory:      d.Directory,
+		Plugin:         d.Plugin,
+		Ref:            d.Ref,
+	}
+}
+
+// DeepEquals returns true if the DrySource is deeply equal to the given DrySource.
+func (d DrySource) DeepEquals(other DrySource) bool {
+	if !d.Plugin.Equals(other.Plugin) {
+		return false
+	}
+	// The plugin fields are equal, so leave them out of the DeepEqual comparison. See ApplicationSource.Equals.
+	d.Plugin = nil
+	other.Plugin = nil
+	return reflect.DeepEqual(d, other)
 }
 
 // SyncSource specifies a location from which hydrated manifests may be synced. RepoURL is assumed based on the
@@ -454,13 +545,44 @@ type SyncSource struct {
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:Pattern=`^.{2,}|[^./]$`
 	Path string `json:"path" protobuf:"bytes,2,name=path"`
-}
+	// RepoURL is the URL of the repository from which hydrated manifests will be synced. It defaults to the DrySource
+	// RepoURL. If it is an OCI repository (oci://), hydrated manifests are pushed as an OCI artifact tagged with
+	// TargetBranch.
+	RepoURL string `json:"repoURL,omitempty" protobuf:"bytes,3,opt,name=repoURL"`
+	// Layout is the layout of the hydrated manifests written to the Path. Single (the default) writes all manifests to
+	// manifest.yaml, PerResource writes one <kind>-<name>.yaml file per resource, and PerKind writes one <kind>.yaml
+	// file per kind of resource.
+	Layout HydratedManifestsLayout `json:"layout,omitempty" protobuf:"bytes,4,opt,name=layout,casttype=HydratedManifestsLayout"`
+	// DisableReadme disables the generation of the README.md file next to the hydrated manifests.
+	DisableReadme bool `json:"disableReadme,omitempty" protobuf:"varint,5,opt,name=disableReadme"`
+}
+
+// HydratedManifestsLayout is the layout of the hydrated manifests written to the SyncSource path.
+// +kubebuilder:validation:Enum=Single;PerResource;PerKind
+type HydratedManifestsLayout string
+
+const (
+	// HydratedManifestsLayoutSingle writes all hydrated manifests to a single manifest.yaml file.
+	HydratedManifestsLayoutSingle HydratedManifestsLayout = "Single"
+	// HydratedManifestsLayoutPerResource writes each hydrated manifest to its own <kind>-<name>.yaml file.
+	HydratedManifestsLayoutPerResource HydratedManifestsLayout = "PerResource"
+	// HydratedManifestsLayoutPerKind writes the hydrated manifests of each kind to a <kind>.yaml file.
+	HydratedManifestsLayoutPerKind HydratedManifestsLayout = "PerKind"
+)
 
 // HydrateTo specifies a location to which hydrated manifests should be pushed as a "staging area" before being moved to
-// the SyncSource. The RepoURL and Path are assumed based on the associated SyncSource config in the SourceHydrator.
+// the SyncSource. The Path is assumed based on the associated SyncSource config in the SourceHydrator, and the RepoURL
+// defaults to the SyncSource's.
 type HydrateTo struct {
 	// TargetBranch is the branch to which hydrated manifests should be committed
 	TargetBranch string `json:"targetBranch" protobuf:"bytes,1,name=targetBranch"`
+	// PullRequest configures the commit server to open a pull request from the HydrateTo branch to the SyncSource
+	// branch whenever the hydrated manifests on the two branches differ.
+	PullRequest *HydrateToPullRequest `json:"pullRequest,omitempty" protobuf:"bytes,2,opt,name=pullRequest"`
+	// RepoURL is the URL of the repository to which hydrated manifests should be pushed. It defaults to the SyncSource
+	// RepoURL. Besides git and OCI (oci://) repositories, an S3-compatible bucket (s3://bucket/prefix) may be used,
+	// in which case the manifests are uploaded under the TargetBranch key prefix.
+	RepoURL string `json:"repoURL,omitempty" protobuf:"bytes,3,opt,name=repoURL"`
 }
 
 // DeepEquals returns true if the HydrateTo is deeply equal to the given HydrateTo.
@@ -472,8 +594,30 @@ func (in *HydrateTo) DeepEquals(to *HydrateTo) bool {
 		// We already know in is not nil.
 		return false
 	}
-	// Compare de-referenced structs.
-	return *in == *to
+	return in.TargetBranch == to.TargetBranch && in.RepoURL == to.RepoURL && reflect.DeepEqual(in.PullRequest, to.PullRequest)
+}
+
+// HydrateToPullRequestProvider is the SCM provider used to manage the pull request from the HydrateTo branch to the
+// SyncSource branch.
+// +kubebuilder:validation:Enum=github;gitlab
+type HydrateToPullRequestProvider string
+
+const (
+	HydrateToPullRequestProviderGitHub HydrateToPullRequestProvider = "github"
+	HydrateToPullRequestProviderGitLab HydrateToPullRequestProvider = "gitlab"
+)
+
+// HydrateToPullRequest configures the pull request opened from the HydrateTo branch to the SyncSource branch. The
+// pull request is created and updated with the repository write credentials of the hydrated repository, and it is
+// closed when the two branches no longer differ.
+type HydrateToPullRequest struct {
+	// Provider is the SCM provider hosting the hydrated repository.
+	Provider HydrateToPullRequestProvider `json:"provider" protobuf:"bytes,1,name=provider,casttype=HydrateToPullRequestProvider"`
+	// API is the URL of the SCM provider's API. If empty, the public API of the provider is used
+	// (https://api.github.com or https://gitlab.com).
+	API string `json:"api,omitempty" protobuf:"bytes,2,opt,name=api"`
+	// Labels are applied to the pull request when it is opened.
+	Labels []string `json:"labels,omitempty" protobuf:"bytes,3,rep,name=labels"`
 }
 
 // RefreshType specifies how to refresh the sources of a given application
@@ -1176,6 +1320,18 @@ type ApplicationStatus struct {
 	ControllerNamespace string `json:"controllerNamespace,omitempty" protobuf:"bytes,13,opt,name=controllerNamespace"`
 	// SourceHydrator stores information about the current state of source hydration
 	SourceHydrator SourceHydratorStatus `json:"sourceHydrator,omitempty" protobuf:"bytes,14,opt,name=sourceHydrator"`
+	// OperationQueue contains the operations which are queued behind the operation in progress
+	OperationQueue []QueuedOperation `json:"operationQueue,omitempty" protobuf:"bytes,15,rep,name=operationQueue"`
+}
+
+// QueuedOperation is an operation which is started once the operation in progress completed
+type QueuedOperation struct {
+	// ID identifies the queued operation
+	ID string `json:"id" protobuf:"bytes,1,opt,name=id"`
+	// Operation is the queued operation
+	Operation Operation `json:"operation" protobuf:"bytes,2,opt,name=operation"`
+	// QueuedAt is the time the operation was queued
+	QueuedAt metav1.Time `json:"queuedAt" protobuf:"bytes,3,opt,name=queuedAt"`
 }
 
 // SourceHydratorStatus contains information about the current state of source hydration
@@ -1184,6 +1340,30 @@ type SourceHydratorStatus struct {
 	LastSuccessfulOperation *SuccessfulHydrateOperation `json:"lastSuccessfulOperation,omitempty" protobuf:"bytes,1,opt,name=lastSuccessfulOperation"`
 	// CurrentOperation holds the status of the hydrate operation
 	CurrentOperation *HydrateOperation `json:"currentOperation,omitempty" protobuf:"bytes,2,opt,name=currentOperation"`
+	// PullRequest holds the status of the pull request from the hydrateTo branch to the syncSource branch, if
+	// spec.sourceHydrator.hydrateTo.pullRequest is configured
+	PullRequest *HydratorPullRequestStatus `json:"pullRequest,omitempty" protobuf:"bytes,3,opt,name=pullRequest"`
+}
+
+// HydratorPullRequestState is the state of the pull request from the hydrateTo branch to the syncSource branch
+type HydratorPullRequestState string
+
+const (
+	HydratorPullRequestStateOpen   HydratorPullRequestState = "Open"
+	HydratorPullRequestStateClosed HydratorPullRequestState = "Closed"
+)
+
+// HydratorPullRequestStatus contains information about the pull request from the hydrateTo branch to the syncSource
+// branch
+type HydratorPullRequestStatus struct {
+	// Number is the number of the pull request
+	Number int64 `json:"number" protobuf:"varint,1,opt,name=number"`
+	// URL is the web URL of the pull request
+	URL string `json:"url,omitempty" protobuf:"bytes,2,opt,name=url"`
+	// State is the state of the pull request
+	State HydratorPullRequestState `json:"state" protobuf:"bytes,3,opt,name=state,casttype=HydratorPullRequestState"`
+	// HydratedSHA is the hydrated commit the pull request was last updated for
+	HydratedSHA string `json:"hydratedSHA,omitempty" protobuf:"bytes,4,opt,name=hydratedSHA"`
 }
 
 func (status *ApplicationStatus) FindResource(key kube.ResourceKey) (*ResourceStatus, bool) {
@@ -1212,6 +1392,9 @@ type HydrateOperation struct {
 	HydratedSHA string `json:"hydratedSHA,omitempty" protobuf:"bytes,6,opt,name=hydratedSHA"`
 	// SourceHydrator holds the hydrator config used for the hydrate operation
 	SourceHydrator SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,7,opt,name=sourceHydrator"`
+	// AdditionalDrySHAs holds the resolved revisions of the additional dry sources, in the order of the additional dry
+	// sources of the hydrator config
+	AdditionalDrySHAs []string `json:"additionalDrySHAs,omitempty" protobuf:"bytes,8,rep,name=additionalDrySHAs"`
 }
 
 // SuccessfulHydrateOperation contains information about the most recent successful hydrate operation
@@ -1222,6 +1405,9 @@ type SuccessfulHydrateOperation struct {
 	HydratedSHA string `json:"hydratedSHA,omitempty" protobuf:"bytes,6,opt,name=hydratedSHA"`
 	// SourceHydrator holds the hydrator config used for the hydrate operation
 	SourceHydrator SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,7,opt,name=sourceHydrator"`
+	// AdditionalDrySHAs holds the resolved revisions of the additional dry sources, in the order of the additional dry
+	// sources of the hydrator config
+	AdditionalDrySHAs []string `json:"additionalDrySHAs,omitempty" protobuf:"bytes,8,rep,name=additionalDrySHAs"`
 }
 
 // HydrateOperationPhase indicates the status of a hydrate operation
@@ -1372,6 +1558,31 @@ type SyncOperation struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,11,opt,name=revisions"`
 	// SelfHealAttemptsCount contains the number of auto-heal attempts
 	SelfHealAttemptsCount int64 `json:"autoHealAttemptsCount,omitempty" protobuf:"bytes,12,opt,name=autoHealAttemptsCount"`
+	// SyncWaves limits the sync to the resources and hooks within the sync wave range. SyncFail hooks are not limited.
+	SyncWaves *SyncWaveRange `json:"syncWaves,omitempty" protobuf:"bytes,13,opt,name=syncWaves"`
+	// SyncPhases limits the sync to the resources and hooks of the given phases (PreSync, Sync, PostSync). SyncFail
+	// hooks are not limited.
+	SyncPhases []synccommon.SyncPhase `json:"syncPhases,omitempty" protobuf:"bytes,14,rep,name=syncPhases"`
+	// AutoRollback is set when the operation is an automatic rollback of a failed sync
+	AutoRollback *AutoRollback `json:"autoRollback,omitempty" protobuf:"bytes,15,opt,name=autoRollback"`
+}
+
+// AutoRollback contains information about an automatic rollback of a failed sync
+type AutoRollback struct {
+	// Revisions are the revisions of the failed sync which was rolled back
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,1,opt,name=revisions"`
+	// ID is the ID of the history entry which the application was rolled back to
+	ID int64 `json:"id" protobuf:"bytes,2,opt,name=id"`
+	// Reason is why the failed sync was rolled back
+	Reason string `json:"reason,omitempty" protobuf:"bytes,3,opt,name=reason"`
+}
+
+// SyncWaveRange is an inclusive range of sync waves. A missing bound leaves the range open on that side.
+type SyncWaveRange struct {
+	// From is the first sync wave of the range
+	From *int64 `json:"from,omitempty" protobuf:"varint,1,opt,name=from"`
+	// To is the last sync wave of the range
+	To *int64 `json:"to,omitempty" protobuf:"varint,2,opt,name=to"`
 }
 
 // IsApplyStrategy returns true if the sync strategy is "apply"
@@ -1395,6 +1606,65 @@ type OperationState struct {
 	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,7,opt,name=finishedAt"`
 	// RetryCount contains time of operation retries
 	RetryCount int64 `json:"retryCount,omitempty" protobuf:"bytes,8,opt,name=retryCount"`
+	// PendingApproval is the sync wave the operation waits to be approved before syncing it
+	PendingApproval *SyncWaveApproval `json:"pendingApproval,omitempty" protobuf:"bytes,9,opt,name=pendingApproval"`
+	// Approvals contains the sync waves which were approved during the operation
+	Approvals []SyncWaveApproval `json:"approvals,omitempty" protobuf:"bytes,10,rep,name=approvals"`
+	// Analysis contains the state of the analysis of the metrics after the sync
+	Analysis *SyncAnalysisStatus `json:"analysis,omitempty" protobuf:"bytes,11,opt,name=analysis"`
+	// Rollback contains the state of the automated rollback of the operation. It is only set if the rollback policy of
+	// the application was enabled when the operation completed.
+	Rollback *OperationRollbackStatus `json:"rollback,omitempty" protobuf:"bytes,12,opt,name=rollback"`
+}
+
+// OperationRollbackStatus contains the state of the automated rollback of a completed operation
+type OperationRollbackStatus struct {
+	// HealthyAt is the time the application was first observed Healthy after the operation completed
+	HealthyAt *metav1.Time `json:"healthyAt,omitempty" protobuf:"bytes,1,opt,name=healthyAt"`
+}
+
+// SyncAnalysisStatus contains the state of the analysis of the metrics after a sync
+type SyncAnalysisStatus struct {
+	// Phase is the phase of the analysis
+	Phase synccommon.OperationPhase `json:"phase" protobuf:"bytes,1,opt,name=phase"`
+	// Message contains a human-readable message indicating details about the analysis
+	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+	// StartedAt is the time the analysis started
+	StartedAt metav1.Time `json:"startedAt" protobuf:"bytes,3,opt,name=startedAt"`
+	// CheckedAt is the time the metrics were most recently analyzed
+	CheckedAt *metav1.Time `json:"checkedAt,omitempty" protobuf:"bytes,4,opt,name=checkedAt"`
+	// Results are the values of the metrics from the most recent analysis
+	Results []SyncAnalysisResult `json:"results,omitempty" protobuf:"bytes,5,rep,name=results"`
+}
+
+// SyncAnalysisResult is the value of a metric of the analysis
+type SyncAnalysisResult struct {
+	// Name is the name of the metric
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Value is the value returned by the query of the metric
+	Value string `json:"value" protobuf:"bytes,2,opt,name=value"`
+}
+
+// SyncWaveApproval identifies a sync wave which requires approval before it is synced
+type SyncWaveApproval struct {
+	// Phase is the sync phase of the wave
+	Phase synccommon.SyncPhase `json:"phase" protobuf:"bytes,1,opt,name=phase"`
+	// Wave is the sync wave
+	Wave int64 `json:"wave" protobuf:"varint,2,opt,name=wave"`
+	// ApprovedBy is the user who approved the sync wave
+	ApprovedBy string `json:"approvedBy,omitempty" protobuf:"bytes,3,opt,name=approvedBy"`
+	// ApprovedAt is the time the sync wave was approved
+	ApprovedAt *metav1.Time `json:"approvedAt,omitempty" protobuf:"bytes,4,opt,name=approvedAt"`
+}
+
+// IsSyncWaveApproved returns true if the given sync wave was approved during the operation
+func (s *OperationState) IsSyncWaveApproved(phase synccommon.SyncPhase, wave int) bool {
+	for _, approval := range s.Approvals {
+		if approval.Phase == phase && approval.Wave == int64(wave) {
+			return true
+		}
+	}
+	return false
 }
 
 type Info struct {
@@ -1451,9 +1721,36 @@ type SyncPolicy struct {
 	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,3,opt,name=retry"`
 	// ManagedNamespaceMetadata controls metadata in the given namespace (if CreateNamespace=true)
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,4,opt,name=managedNamespaceMetadata"`
+	// Rollback controls the automatic rollback of failed syncs to the last successful sync
+	Rollback *SyncPolicyRollback `json:"rollback,omitempty" protobuf:"bytes,5,opt,name=rollback"`
+	// Analysis controls the analysis of metrics after a sync, before the sync is considered successful
+	Analysis *SyncPolicyAnalysis `json:"analysis,omitempty" protobuf:"bytes,6,opt,name=analysis"`
+	// OperationQueue enables the queueing of manual syncs which are requested while another operation is in progress
+	OperationQueue *SyncPolicyOperationQueue `json:"operationQueue,omitempty" protobuf:"bytes,7,opt,name=operationQueue"`
+	// Hooks are sync hooks which are declared on the application instead of in its source, and are run as Jobs
+	Hooks []SyncPolicyHook `json:"hooks,omitempty" protobuf:"bytes,8,rep,name=hooks"`
 	// If you add a field here, be sure to update IsZero.
 }
 
+// SyncPolicyHook is a sync hook which is declared on the application, and which the controller runs as a Job in the
+// destination namespace of the application
+type SyncPolicyHook struct {
+	// Name is the name of the Job of the hook, which has to be unique in the destination namespace
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Type is the type of the hook: PreSync, PostSync or SyncFail
+	Type synccommon.HookType `json:"type" protobuf:"bytes,2,opt,name=type"`
+	// Image is the container image of the hook
+	Image string `json:"image" protobuf:"bytes,3,opt,name=image"`
+	// Command is the entrypoint of the container of the hook
+	Command []string `json:"command,omitempty" protobuf:"bytes,4,rep,name=command"`
+	// Args are the arguments of the entrypoint of the container of the hook
+	Args []string `json:"args,omitempty" protobuf:"bytes,5,rep,name=args"`
+	// Env is the list of environment variables of the container of the hook
+	Env Env `json:"env,omitempty" protobuf:"bytes,6,rep,name=env"`
+	// ServiceAccount is the name of the service account the hook runs as
+	ServiceAccount string `json:"serviceAccount,omitempty" protobuf:"bytes,7,opt,name=serviceAccount"`
+}
+
 // IsAutomatedSyncEnabled checks if the automated sync is enabled or disabled
 func (p *SyncPolicy) IsAutomatedSyncEnabled() bool {
 	if p.Automated != nil && (p.Automated.Enabled == nil || *p.Automated.Enabled) {
@@ -1464,7 +1761,84 @@ func (p *SyncPolicy) IsAutomatedSyncEnabled() bool {
 
 // IsZero returns true if the sync policy is empty
 func (p *SyncPolicy) IsZero() bool {
-	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil)
+	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil && p.Rollback == nil && p.Analysis == nil && p.OperationQueue == nil && len(p.Hooks) == 0)
+}
+
+// SyncPolicyRollback controls the automatic rollback of failed syncs. A sync is rolled back once it failed and its
+// retries and SyncFail hooks completed.
+type SyncPolicyRollback struct {
+	// HealthTimeout is the duration within which the application must be Healthy after a successful sync, otherwise the
+	// sync is rolled back. Default unit is seconds, but could also be a duration (e.g. "2m", "1h"). The health of the
+	// application is not checked if empty.
+	HealthTimeout string `json:"healthTimeout,omitempty" protobuf:"bytes,1,opt,name=healthTimeout"`
+}
+
+// SyncPolicyOperationQueue controls the queueing of the operations which are requested while another operation is in
+// progress. Queued operations are started in order, once the operation in progress completed.
+type SyncPolicyOperationQueue struct {
+	// Limit is the maximum number of queued operations. Defaults to 10.
+	Limit int64 `json:"limit,omitempty" protobuf:"varint,1,opt,name=limit"`
+}
+
+// DefaultOperationQueueLimit is the default maximum number of queued operations of an application
+const DefaultOperationQueueLimit = 10
+
+// GetLimit returns the maximum number of queued operations
+func (q *SyncPolicyOperationQueue) GetLimit() int {
+	if q.Limit <= 0 {
+		return DefaultOperationQueueLimit
+	}
+	return int(q.Limit)
+}
+
+// SyncPolicyAnalysis controls the analysis of metrics after a sync. The metrics are queried from the Prometheus
+// compatible endpoint configured in the argocd-cm ConfigMap, and the sync fails if any metric is out of its range.
+type SyncPolicyAnalysis struct {
+	// Duration is how long the metrics are analyzed after the sync. Default unit is seconds, but could also be a
+	// duration (e.g. "2m", "1h")
+	Duration string `json:"duration" protobuf:"bytes,1,opt,name=duration"`
+	// Interval is the time between two analyses of the metrics. Default unit is seconds, but could also be a duration
+	// (e.g. "2m", "1h"). Defaults to one minute.
+	Interval string `json:"interval,omitempty" protobuf:"bytes,2,opt,name=interval"`
+	// Metrics are the metrics which are analyzed
+	Metrics []SyncAnalysisMetric `json:"metrics" protobuf:"bytes,3,rep,name=metrics"`
+}
+
+// SyncAnalysisMetric is a metric query and the range its value must be within
+type SyncAnalysisMetric struct {
+	// Name is the name of the metric
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Query is a PromQL query which returns a single value
+	Query string `json:"query" protobuf:"bytes,2,opt,name=query"`
+	// Min is the minimum value of the metric (e.g. "0.99"). The value is not bounded if empty.
+	Min string `json:"min,omitempty" protobuf:"bytes,3,opt,name=min"`
+	// Max is the maximum value of the metric (e.g. "0.05"). The value is not bounded if empty.
+	Max string `json:"max,omitempty" protobuf:"bytes,4,opt,name=max"`
+}
+
+// DefaultSyncAnalysisInterval is the default time between two analyses of the metrics after a sync
+const DefaultSyncAnalysisInterval = time.Minute
+
+// GetDuration returns how long the metrics are analyzed after the sync
+func (a *SyncPolicyAnalysis) GetDuration() (time.Duration, error) {
+	return parseStringToDuration(a.Duration)
+}
+
+// GetInterval returns the time between two analyses of the metrics
+func (a *SyncPolicyAnalysis) GetInterval() (time.Duration, error) {
+	if a.Interval == "" {
+		return DefaultSyncAnalysisInterval, nil
+	}
+	return parseStringToDuration(a.Interval)
+}
+
+// GetHealthTimeout returns the duration within which the application must be Healthy after a successful sync, or zero
+// if the health of the application is not checked
+func (r *SyncPolicyRollback) GetHealthTimeout() (time.Duration, error) {
+	if r == nil || r.HealthTimeout == "" {
+		return 0, nil
+	}
+	return parseStringToDuration(r.HealthTimeout)
 }
 
 // RetryStrategy contains information about the strategy to apply when a sync failed
@@ -1667,6 +2041,33 @@ type SyncOperationResult struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,5,opt,name=revisions"`
 	// ManagedNamespaceMetadata contains the current sync state of managed namespace metadata
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,6,opt,name=managedNamespaceMetadata"`
+	// Plan holds the actions a dry-run sync operation would take for each individual resource
+	Plan []SyncPlanResource `json:"plan,omitempty" protobuf:"bytes,7,rep,name=plan"`
+}
+
+// SyncPlanResource holds the action a dry-run sync would take for a specific resource
+type SyncPlanResource struct {
+	// Group specifies the API group of the resource
+	Group string `json:"group" protobuf:"bytes,1,opt,name=group"`
+	// Version specifies the API version of the resource
+	Version string `json:"version" protobuf:"bytes,2,opt,name=version"`
+	// Kind specifies the API kind of the resource
+	Kind string `json:"kind" protobuf:"bytes,3,opt,name=kind"`
+	// Namespace specifies the target namespace of the resource
+	Namespace string `json:"namespace" protobuf:"bytes,4,opt,name=namespace"`
+	// Name specifies the name of the resource
+	Name string `json:"name" protobuf:"bytes,5,opt,name=name"`
+	// Action is the action the sync would take for the resource: Create, Patch, Replace, Prune or Hook
+	Action synccommon.SyncAction `json:"action" protobuf:"bytes,6,opt,name=action"`
+	// SyncPhase is the phase of the sync in which the action would be taken
+	SyncPhase synccommon.SyncPhase `json:"syncPhase,omitempty" protobuf:"bytes,7,opt,name=syncPhase"`
+	// SyncWave is the sync wave in which the action would be taken
+	SyncWave int64 `json:"syncWave,omitempty" protobuf:"bytes,8,opt,name=syncWave"`
+	// HookType specifies the type of the hook. Empty for non-hook resources
+	HookType synccommon.HookType `json:"hookType,omitempty" protobuf:"bytes,9,opt,name=hookType"`
+	// Diff is the unified diff between the live state of the resource and the state predicted by a server-side
+	// dry-run apply of the resource
+	Diff string `json:"diff,omitempty" protobuf:"bytes,10,opt,name=diff"`
 }
 
 // ResourceResult holds the operation result details of a specific resource
@@ -1694,6 +2095,8 @@ type ResourceResult struct {
 	SyncPhase synccommon.SyncPhase `json:"syncPhase,omitempty" protobuf:"bytes,10,opt,name=syncPhase"`
 	// Images contains the images related to the ResourceResult
 	Images []string `json:"images,omitempty" protobuf:"bytes,11,opt,name=images"`
+	// SyncedAt is the time the resource or hook was first synced during the operation
+	SyncedAt *metav1.Time `json:"syncedAt,omitempty" protobuf:"bytes,12,opt,name=syncedAt"`
 }
 
 // GroupVersionKind returns the GVK schema information for a given resource within a sync result
@@ -1814,6 +2217,10 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionRollbackWarning indicates that a failed sync was automatically rolled back
+	ApplicationConditionRollbackWarning = "RollbackWarning"
+	// ApplicationConditionUnmetDependencyWarning indicates that the applications an application depends on are not Synced and Healthy
+	ApplicationConditionUnmetDependencyWarning = "UnmetDependencyWarning"
 )
 
 // ApplicationCondition contains details about an application condition, which is usually an error or warning
@@ -2181,6 +2588,22 @@ type ResourceDiff struct {
 	ResourceVersion string `json:"resourceVersion,omitempty" protobuf:"bytes,11,opt,name=resourceVersion"`
 	// Modified indicates whether the live resource has changes compared to the target resource.
 	Modified bool `json:"modified,omitempty" protobuf:"bytes,12,opt,name=modified"`
+	// FieldOwners lists the field managers which own the differing fields in the live resource.
+	// It helps identifying whether a difference is caused by Argo CD, a controller such as an HPA, or a manual change.
+	FieldOwners []ResourceFieldOwner `json:"fieldOwners,omitempty" protobuf:"bytes,13,rep,name=fieldOwners"`
+}
+
+// ResourceFieldOwner holds the field manager which owns a differing field of a live resource
+type ResourceFieldOwner struct {
+	// Path is the path of the differing field (e.g., ".spec.replicas").
+	Path string `json:"path" protobuf:"bytes,1,opt,name=path"`
+	// Manager is the name of the field manager which owns the field in the live resource (e.g., "kube-controller-manager").
+	// It is empty if no field manager owns the field.
+	Manager string `json:"manager,omitempty" protobuf:"bytes,2,opt,name=manager"`
+	// Operation is the operation the field manager owns the field with, either "Apply" or "Update".
+	Operation string `json:"operation,omitempty" protobuf:"bytes,3,opt,name=operation"`
+	// Subresource is the subresource the field manager updated the field through (e.g., "scale").
+	Subresource string `json:"subresource,omitempty" protobuf:"bytes,4,opt,name=subresource"`
 }
 
 // FullName returns full name of a node that was used for diffing in the format "group/kind/namespace/name"
@@ -2452,6 +2875,7 @@ type OverrideIgnoreDiff struct {
 type rawResourceOverride struct {
 	HealthLua             string           `json:"health.lua,omitempty"`
 	UseOpenLibs           bool             `json:"health.lua.useOpenLibs,omitempty"`
+	UseConditionsHealth   bool             `json:"health.useConditions,omitempty"`
 	Actions               string           `json:"actions,omitempty"`
 	IgnoreDifferences     string           `json:"ignoreDifferences,omitempty"`
 	IgnoreResourceUpdates string           `json:"ignoreResourceUpdates,omitempty"`
@@ -2464,6 +2888,9 @@ type ResourceOverride struct {
 	HealthLua string `protobuf:"bytes,1,opt,name=healthLua"`
 	// UseOpenLibs indicates whether to use open-source libraries for the resource.
 	UseOpenLibs bool `protobuf:"bytes,5,opt,name=useOpenLibs"`
+	// UseConditionsHealth indicates whether to assess the health of the resource using the Ready condition in its status
+	// if no health check is defined for the resource.
+	UseConditionsHealth bool `protobuf:"bytes,7,opt,name=useConditionsHealth"`
 	// Actions defines the set of actions that can be performed on the resource, as a Lua script.
 	Actions string `protobuf:"bytes,3,opt,name=actions"`
 	// IgnoreDifferences contains configuration for which differences should be ignored during the resource diffing.
@@ -2485,6 +2912,7 @@ func (ro *ResourceOverride) UnmarshalJSON(data []byte) error {
 	ro.KnownTypeFields = raw.KnownTypeFields
 	ro.HealthLua = raw.HealthLua
 	ro.UseOpenLibs = raw.UseOpenLibs
+	ro.UseConditionsHealth = raw.UseConditionsHealth
 	ro.Actions = raw.Actions
 	err := yaml.Unmarshal([]byte(raw.IgnoreDifferences), &ro.IgnoreDifferences)
 	if err != nil {
@@ -2508,7 +2936,7 @@ func (ro ResourceOverride) MarshalJSON() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	raw := &rawResourceOverride{ro.HealthLua, ro.UseOpenLibs, ro.Actions, string(ignoreDifferencesData), string(ignoreResourceUpdatesData), ro.KnownTypeFields}
+	raw := &rawResourceOverride{ro.HealthLua, ro.UseOpenLibs, ro.UseConditionsHealth, ro.Actions, string(ignoreDifferencesData), string(ignoreResourceUpdatesData), ro.KnownTypeFields}
 	return json.Marshal(raw)
 }
 
@@ -2573,6 +3001,7 @@ var validActions = map[string]bool{
 	rbac.ActionDelete:   true,
 	rbac.ActionSync:     true,
 	rbac.ActionOverride: true,
+	rbac.ActionApprove:  true,
 	"*":                 true,
 }
 
@@ -3344,6 +3773,18 @@ func (app *Application) UnSetPostDeleteFinalizer(stage ...string) {
 	setFinalizer(&app.ObjectMeta, strings.Join(append([]string{PostDeleteFinalizerName}, stage...), "/"), false)
 }
 
+func (app *Application) HasPreDeleteFinalizer() bool {
+	return getFinalizerIndex(app.ObjectMeta, PreDeleteFinalizerName) > -1
+}
+
+func (app *Application) SetPreDeleteFinalizer() {
+	setFinalizer(&app.ObjectMeta, PreDeleteFinalizerName, true)
+}
+
+func (app *Application) UnSetPreDeleteFinalizer() {
+	setFinalizer(&app.ObjectMeta, PreDeleteFinalizerName, false)
+}
+
 // SetCascadedDeletion will enable cascaded deletion by setting the propagation policy finalizer
 func (app *Application) SetCascadedDeletion(finalizer string) {
 	setFinalizer(&app.ObjectMeta, finalizer, true)
@@ -1013,6 +1013,91 @@ func TestAppSourceEquality(t *testing.T) {
 	assert.False(t, left.Equals(right))
 }
 
+func TestSourceHydratorDeepEquals(t *testing.T) {
+	left := SourceHydrator{
+		DrySource: DrySource{
+			RepoURL:        "https://github.com/argoproj/argocd-example-apps",
+			TargetRevision: "HEAD",
+			Path:           "helm-guestbook",
+			Helm: &ApplicationSourceHelm{
+				ValueFiles: []string{"values-dev.yaml"},
+			},
+		},
+		SyncSource: SyncSource{TargetBranch: "env/dev", Path: "helm-guestbook"},
+	}
+	right := *left.DeepCopy()
+	assert.True(t, left.DeepEquals(right))
+	right.DrySource.Helm.ValueFiles = []string{"values-prod.yaml"}
+	assert.False(t, left.DeepEquals(right))
+
+	right = *left.DeepCopy()
+	right.DrySource.Plugin = &ApplicationSourcePlugin{Name: "my-plugin"}
+	assert.False(t, left.DeepEquals(right))
+	left.DrySource.Plugin = &ApplicationSourcePlugin{Name: "my-plugin"}
+	assert.True(t, left.DeepEquals(right))
+}
+
+func TestSourceHydratorGetDrySource(t *testing.T) {
+	hydrator := SourceHydrator{
+		DrySource: DrySource{
+			RepoURL:        "https://github.com/argoproj/argocd-example-apps",
+			TargetRevision: "HEAD",
+			Path:           "kustomize-guestbook",
+			Kustomize: &ApplicationSourceKustomize{
+				Images: KustomizeImages{"quay.io/argoprojlabs/argocd-e2e-container:0.2"},
+			},
+		},
+	}
+	assert.Equal(t, ApplicationSource{
+		RepoURL:        "https://github.com/argoproj/argocd-example-apps",
+		TargetRevision: "HEAD",
+		Path:           "kustomize-guestbook",
+		Kustomize: &ApplicationSourceKustomize{
+			Images: KustomizeImages{"quay.io/argoprojlabs/argocd-e2e-container:0.2"},
+		},
+	}, hydrator.GetDrySource())
+}
+
+func TestSourceHydratorGetDrySources(t *testing.T) {
+	hydrator := SourceHydrator{
+		DrySource: DrySource{
+			RepoURL:        "https://github.com/argoproj/argocd-example-apps",
+			TargetRevision: "HEAD",
+			Path:           "helm-guestbook",
+			Helm: &ApplicationSourceHelm{
+				ValueFiles: []string{"$values/values.yaml"},
+			},
+		},
+		AdditionalDrySources: []DrySource{{
+			RepoURL:        "https://github.com/argoproj/argocd-example-values",
+			TargetRevision: "main",
+			Ref:            "values",
+		}},
+	}
+	assert.Equal(t, ApplicationSources{
+		{
+			RepoURL:        "https://github.com/argoproj/argocd-example-apps",
+			TargetRevision: "HEAD",
+			Path:           "helm-guestbook",
+			Helm: &ApplicationSourceHelm{
+				ValueFiles: []string{"$values/values.yaml"},
+			},
+		},
+		{
+			RepoURL:        "https://github.com/argoproj/argocd-example-values",
+			TargetRevision: "main",
+			Ref:            "values",
+		},
+	}, hydrator.GetDrySources())
+
+	other := hydrator.DeepCopy()
+	assert.True(t, hydrator.DeepEquals(*other))
+	other.AdditionalDrySources[0].TargetRevision = "release"
+	assert.False(t, hydrator.DeepEquals(*other))
+	other.AdditionalDrySources = nil
+	assert.False(t, hydrator.DeepEquals(*other))
+}
+
 func TestAppSource_GetKubeVersionOrDefault(t *testing.T) {
 	t.Parallel()
 
@@ -3783,6 +3868,14 @@ func Test_validatePolicy_ValidResource(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestOperationState_IsSyncWaveApproved(t *testing.T) {
+	state := &OperationState{Approvals: []SyncWaveApproval{{Phase: common.SyncPhaseSync, Wave: 2}}}
+	assert.True(t, state.IsSyncWaveApproved(common.SyncPhaseSync, 2))
+	assert.False(t, state.IsSyncWaveApproved(common.SyncPhaseSync, 3))
+	assert.False(t, state.IsSyncWaveApproved(common.SyncPhasePostSync, 2))
+	assert.False(t, (&OperationState{}).IsSyncWaveApproved(common.SyncPhaseSync, 0))
+}
+
 func TestIsValidAction(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -3796,6 +3889,7 @@ func TestIsValidAction(t *testing.T) {
 		{"ValidDelete", "delete", true},
 		{"ValidSync", "sync", true},
 		{"ValidOverride", "override", true},
+		{"ValidApprove", "approve", true},
 		{"ValidWildcard", "*", true},
 
 		// pattern matches
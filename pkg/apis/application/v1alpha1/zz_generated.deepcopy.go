@@ -19,6 +19,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	common "github.com/argoproj/gitops-engine/pkg/sync/common"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -283,6 +284,22 @@ func (in *ApplicationCondition) DeepCopy() *ApplicationCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationDependency) DeepCopyInto(out *ApplicationDependency) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDependency.
+func (in *ApplicationDependency) DeepCopy() *ApplicationDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationDestination) DeepCopyInto(out *ApplicationDestination) {
 	*out = *in
@@ -1377,6 +1394,11 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 		*out = new(SourceHydrator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]ApplicationDependency, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1436,6 +1458,13 @@ func (in *ApplicationStatus) DeepCopyInto(out *ApplicationStatus) {
 		copy(*out, *in)
 	}
 	in.SourceHydrator.DeepCopyInto(&out.SourceHydrator)
+	if in.OperationQueue != nil {
+		in, out := &in.OperationQueue, &out.OperationQueue
+		*out = make([]QueuedOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1529,6 +1558,27 @@ func (in *ApplicationWatchEvent) DeepCopy() *ApplicationWatchEvent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoRollback) DeepCopyInto(out *AutoRollback) {
+	*out = *in
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoRollback.
+func (in *AutoRollback) DeepCopy() *AutoRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Backoff) DeepCopyInto(out *Backoff) {
 	*out = *in
@@ -1967,6 +2017,26 @@ func (in *ConnectionState) DeepCopy() *ConnectionState {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DrySource) DeepCopyInto(out *DrySource) {
 	*out = *in
+	if in.Helm != nil {
+		in, out := &in.Helm, &out.Helm
+		*out = new(ApplicationSourceHelm)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kustomize != nil {
+		in, out := &in.Kustomize, &out.Kustomize
+		*out = new(ApplicationSourceKustomize)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Directory != nil {
+		in, out := &in.Directory, &out.Directory
+		*out = new(ApplicationSourceDirectory)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(ApplicationSourcePlugin)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2316,6 +2386,11 @@ func (in *HydrateOperation) DeepCopyInto(out *HydrateOperation) {
 		*out = (*in).DeepCopy()
 	}
 	in.SourceHydrator.DeepCopyInto(&out.SourceHydrator)
+	if in.AdditionalDrySHAs != nil {
+		in, out := &in.AdditionalDrySHAs, &out.AdditionalDrySHAs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2332,6 +2407,11 @@ func (in *HydrateOperation) DeepCopy() *HydrateOperation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HydrateTo) DeepCopyInto(out *HydrateTo) {
 	*out = *in
+	if in.PullRequest != nil {
+		in, out := &in.PullRequest, &out.PullRequest
+		*out = new(HydrateToPullRequest)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2345,6 +2425,43 @@ func (in *HydrateTo) DeepCopy() *HydrateTo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HydrateToPullRequest) DeepCopyInto(out *HydrateToPullRequest) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydrateToPullRequest.
+func (in *HydrateToPullRequest) DeepCopy() *HydrateToPullRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(HydrateToPullRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HydratorPullRequestStatus) DeepCopyInto(out *HydratorPullRequestStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydratorPullRequestStatus.
+func (in *HydratorPullRequestStatus) DeepCopy() *HydratorPullRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HydratorPullRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in IgnoreDifferences) DeepCopyInto(out *IgnoreDifferences) {
 	{
@@ -2886,6 +3003,26 @@ func (in *OperationInitiator) DeepCopy() *OperationInitiator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationRollbackStatus) DeepCopyInto(out *OperationRollbackStatus) {
+	*out = *in
+	if in.HealthyAt != nil {
+		in, out := &in.HealthyAt, &out.HealthyAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationRollbackStatus.
+func (in *OperationRollbackStatus) DeepCopy() *OperationRollbackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationRollbackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperationState) DeepCopyInto(out *OperationState) {
 	*out = *in
@@ -2900,6 +3037,28 @@ func (in *OperationState) DeepCopyInto(out *OperationState) {
 		in, out := &in.FinishedAt, &out.FinishedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.PendingApproval != nil {
+		in, out := &in.PendingApproval, &out.PendingApproval
+		*out = new(SyncWaveApproval)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Approvals != nil {
+		in, out := &in.Approvals, &out.Approvals
+		*out = make([]SyncWaveApproval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(SyncAnalysisStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(OperationRollbackStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3416,6 +3575,24 @@ func (in *PullRequestGeneratorGithub) DeepCopy() *PullRequestGeneratorGithub {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuedOperation) DeepCopyInto(out *QueuedOperation) {
+	*out = *in
+	in.Operation.DeepCopyInto(&out.Operation)
+	in.QueuedAt.DeepCopyInto(&out.QueuedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuedOperation.
+func (in *QueuedOperation) DeepCopy() *QueuedOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuedOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RefTarget) DeepCopyInto(out *RefTarget) {
 	*out = *in
@@ -3694,6 +3871,11 @@ func (in *ResourceActions) DeepCopy() *ResourceActions {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceDiff) DeepCopyInto(out *ResourceDiff) {
 	*out = *in
+	if in.FieldOwners != nil {
+		in, out := &in.FieldOwners, &out.FieldOwners
+		*out = make([]ResourceFieldOwner, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3707,6 +3889,22 @@ func (in *ResourceDiff) DeepCopy() *ResourceDiff {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceFieldOwner) DeepCopyInto(out *ResourceFieldOwner) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceFieldOwner.
+func (in *ResourceFieldOwner) DeepCopy() *ResourceFieldOwner {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceFieldOwner)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceIgnoreDifferences) DeepCopyInto(out *ResourceIgnoreDifferences) {
 	*out = *in
@@ -3878,6 +4076,10 @@ func (in *ResourceResult) DeepCopyInto(out *ResourceResult) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SyncedAt != nil {
+		in, out := &in.SyncedAt, &out.SyncedAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -4389,12 +4591,19 @@ func (in *SignatureKey) DeepCopy() *SignatureKey {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SourceHydrator) DeepCopyInto(out *SourceHydrator) {
 	*out = *in
-	out.DrySource = in.DrySource
+	in.DrySource.DeepCopyInto(&out.DrySource)
 	out.SyncSource = in.SyncSource
 	if in.HydrateTo != nil {
 		in, out := &in.HydrateTo, &out.HydrateTo
 		*out = new(HydrateTo)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalDrySources != nil {
+		in, out := &in.AdditionalDrySources, &out.AdditionalDrySources
+		*out = make([]DrySource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -4422,6 +4631,11 @@ func (in *SourceHydratorStatus) DeepCopyInto(out *SourceHydratorStatus) {
 		*out = new(HydrateOperation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PullRequest != nil {
+		in, out := &in.PullRequest, &out.PullRequest
+		*out = new(HydratorPullRequestStatus)
+		**out = **in
+	}
 	return
 }
 
@@ -4439,6 +4653,11 @@ func (in *SourceHydratorStatus) DeepCopy() *SourceHydratorStatus {
 func (in *SuccessfulHydrateOperation) DeepCopyInto(out *SuccessfulHydrateOperation) {
 	*out = *in
 	in.SourceHydrator.DeepCopyInto(&out.SourceHydrator)
+	if in.AdditionalDrySHAs != nil {
+		in, out := &in.AdditionalDrySHAs, &out.AdditionalDrySHAs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4452,6 +4671,64 @@ func (in *SuccessfulHydrateOperation) DeepCopy() *SuccessfulHydrateOperation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncAnalysisMetric) DeepCopyInto(out *SyncAnalysisMetric) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncAnalysisMetric.
+func (in *SyncAnalysisMetric) DeepCopy() *SyncAnalysisMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncAnalysisMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncAnalysisResult) DeepCopyInto(out *SyncAnalysisResult) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncAnalysisResult.
+func (in *SyncAnalysisResult) DeepCopy() *SyncAnalysisResult {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncAnalysisResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncAnalysisStatus) DeepCopyInto(out *SyncAnalysisStatus) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.CheckedAt != nil {
+		in, out := &in.CheckedAt, &out.CheckedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = make([]SyncAnalysisResult, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncAnalysisStatus.
+func (in *SyncAnalysisStatus) DeepCopy() *SyncAnalysisStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncAnalysisStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncOperation) DeepCopyInto(out *SyncOperation) {
 	*out = *in
@@ -4492,6 +4769,21 @@ func (in *SyncOperation) DeepCopyInto(out *SyncOperation) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SyncWaves != nil {
+		in, out := &in.SyncWaves, &out.SyncWaves
+		*out = new(SyncWaveRange)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SyncPhases != nil {
+		in, out := &in.SyncPhases, &out.SyncPhases
+		*out = make([]common.SyncPhase, len(*in))
+		copy(*out, *in)
+	}
+	if in.AutoRollback != nil {
+		in, out := &in.AutoRollback, &out.AutoRollback
+		*out = new(AutoRollback)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4553,6 +4845,11 @@ func (in *SyncOperationResult) DeepCopyInto(out *SyncOperationResult) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = make([]SyncPlanResource, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4586,6 +4883,22 @@ func (in SyncOptions) DeepCopy() SyncOptions {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncPlanResource) DeepCopyInto(out *SyncPlanResource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncPlanResource.
+func (in *SyncPlanResource) DeepCopy() *SyncPlanResource {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncPlanResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 	*out = *in
@@ -4609,6 +4922,28 @@ func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(SyncPolicyRollback)
+		**out = **in
+	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(SyncPolicyAnalysis)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OperationQueue != nil {
+		in, out := &in.OperationQueue, &out.OperationQueue
+		*out = new(SyncPolicyOperationQueue)
+		**out = **in
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make([]SyncPolicyHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -4622,6 +4957,27 @@ func (in *SyncPolicy) DeepCopy() *SyncPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncPolicyAnalysis) DeepCopyInto(out *SyncPolicyAnalysis) {
+	*out = *in
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]SyncAnalysisMetric, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncPolicyAnalysis.
+func (in *SyncPolicyAnalysis) DeepCopy() *SyncPolicyAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncPolicyAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncPolicyAutomated) DeepCopyInto(out *SyncPolicyAutomated) {
 	*out = *in
@@ -4643,6 +4999,75 @@ func (in *SyncPolicyAutomated) DeepCopy() *SyncPolicyAutomated {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncPolicyHook) DeepCopyInto(out *SyncPolicyHook) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make(Env, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(EnvEntry)
+				**out = **in
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncPolicyHook.
+func (in *SyncPolicyHook) DeepCopy() *SyncPolicyHook {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncPolicyHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncPolicyOperationQueue) DeepCopyInto(out *SyncPolicyOperationQueue) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncPolicyOperationQueue.
+func (in *SyncPolicyOperationQueue) DeepCopy() *SyncPolicyOperationQueue {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncPolicyOperationQueue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncPolicyRollback) DeepCopyInto(out *SyncPolicyRollback) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncPolicyRollback.
+func (in *SyncPolicyRollback) DeepCopy() *SyncPolicyRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncPolicyRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncSource) DeepCopyInto(out *SyncSource) {
 	*out = *in
@@ -4740,6 +5165,52 @@ func (in *SyncStrategyHook) DeepCopy() *SyncStrategyHook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWaveApproval) DeepCopyInto(out *SyncWaveApproval) {
+	*out = *in
+	if in.ApprovedAt != nil {
+		in, out := &in.ApprovedAt, &out.ApprovedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWaveApproval.
+func (in *SyncWaveApproval) DeepCopy() *SyncWaveApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWaveApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWaveRange) DeepCopyInto(out *SyncWaveRange) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = new(int64)
+		**out = **in
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWaveRange.
+func (in *SyncWaveRange) DeepCopy() *SyncWaveRange {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWaveRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncWindow) DeepCopyInto(out *SyncWindow) {
 	*out = *in
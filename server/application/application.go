@@ -2039,6 +2039,11 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if a.DeletionTimestamp != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
 	}
+	if !syncReq.GetDryRun() {
+		if unmetDependencies := argo.GetUnmetDependencies(a, s.appLister); len(unmetDependencies) > 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot sync: dependencies are not met: %s", strings.Join(unmetDependencies, "; "))
+		}
+	}
 
 	revision, displayRevision, sourceRevisions, displayRevisions, err := s.resolveSourceRevisions(ctx, a, syncReq)
 	if err != nil {
@@ -2067,6 +2072,11 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 		return nil, status.Errorf(codes.FailedPrecondition, "Cannot use local sync when signature keys are required.")
 	}
 
+	syncPhases, err := getSyncPhases(syncReq.GetSyncWaves(), syncReq.GetSyncPhases())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	resources := []v1alpha1.SyncOperationResource{}
 	if syncReq.GetResources() != nil {
 		for _, r := range syncReq.GetResources() {
@@ -2093,6 +2103,8 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 			Manifests:    syncReq.Manifests,
 			Sources:      a.Spec.Sources,
 			Revisions:    sourceRevisions,
+			SyncWaves:    syncReq.GetSyncWaves(),
+			SyncPhases:   syncPhases,
 		},
 		InitiatedBy: v1alpha1.OperationInitiator{Username: session.Username(ctx)},
 		Info:        syncReq.Infos,
@@ -2104,12 +2116,12 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	appName := syncReq.GetName()
 	appNs := s.appNamespaceOrDefault(syncReq.GetAppNamespace())
 	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
-	a, err = argo.SetAppOperation(appIf, appName, &op)
+	a, queued, err := argo.SetOrQueueAppOperation(appIf, appName, &op)
 	if err != nil {
 		return nil, fmt.Errorf("error setting app operation: %w", err)
 	}
 	partial := ""
-	if len(syncReq.Resources) > 0 {
+	if len(syncReq.Resources) > 0 || syncReq.GetSyncWaves() != nil || len(syncPhases) > 0 {
 		partial = "partial "
 	}
 	var reason string
@@ -2121,10 +2133,31 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if syncReq.Manifests != nil {
 		reason = fmt.Sprintf("initiated %ssync locally", partial)
 	}
+	if queued {
+		s.logAppEvent(ctx, a, argo.EventReasonOperationQueued, "queued "+strings.TrimPrefix(reason, "initiated "))
+		return a, nil
+	}
 	s.logAppEvent(ctx, a, argo.EventReasonOperationStarted, reason)
 	return a, nil
 }
 
+// getSyncPhases validates the sync wave range and phases a sync is limited to, and returns the phases.
+func getSyncPhases(syncWaves *v1alpha1.SyncWaveRange, phases []string) ([]common.SyncPhase, error) {
+	if syncWaves != nil && syncWaves.From != nil && syncWaves.To != nil && *syncWaves.From > *syncWaves.To {
+		return nil, fmt.Errorf("invalid sync wave range %d:%d: the first wave is after the last wave", *syncWaves.From, *syncWaves.To)
+	}
+	var syncPhases []common.SyncPhase
+	for _, phase := range phases {
+		switch common.SyncPhase(phase) {
+		case common.SyncPhasePreSync, common.SyncPhaseSync, common.SyncPhasePostSync:
+			syncPhases = append(syncPhases, common.SyncPhase(phase))
+		default:
+			return nil, fmt.Errorf("invalid sync phase %q: must be one of %s, %s or %s", phase, common.SyncPhasePreSync, common.SyncPhaseSync, common.SyncPhasePostSync)
+		}
+	}
+	return syncPhases, nil
+}
+
 func (s *Server) resolveSourceRevisions(ctx context.Context, a *v1alpha1.Application, syncReq *application.ApplicationSyncRequest) (string, string, []string, []string, error) {
 	requireOverridePrivilegeForRevisionSync, err := s.settingsMgr.RequireOverridePrivilegeForRevisionSync()
 	if err != nil {
@@ -2463,6 +2496,186 @@ func (s *Server) TerminateOperation(ctx context.Context, termOpReq *application.
 	return nil, status.Errorf(codes.Internal, "Failed to terminate app. Too many conflicts")
 }
 
+// ApproveOperation approves the sync wave the running operation of the application waits for.
+func (s *Server) ApproveOperation(ctx context.Context, approveReq *application.OperationApproveRequest) (*application.OperationApproveResponse, error) {
+	appName := approveReq.GetName()
+	appNs := s.appNamespaceOrDefault(approveReq.GetAppNamespace())
+	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionApprove, approveReq.GetProject(), appNs, appName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < 10; i++ {
+		if a.Operation == nil || a.Status.OperationState == nil || a.Status.OperationState.Phase != common.OperationWaitingForApproval || a.Status.OperationState.PendingApproval == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Unable to approve operation. No operation is waiting for approval")
+		}
+		state := a.Status.OperationState
+		approval := *state.PendingApproval
+		approval.ApprovedBy = session.Username(ctx)
+		approval.ApprovedAt = ptr.To(metav1.Now())
+		state.Approvals = append(state.Approvals, approval)
+		state.PendingApproval = nil
+		state.Phase = common.OperationRunning
+		state.Message = fmt.Sprintf("%s wave %d approved", approval.Phase, approval.Wave)
+		updated, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Update(ctx, a, metav1.UpdateOptions{})
+		if err == nil {
+			s.waitSync(updated)
+			s.logAppEvent(ctx, a, argo.EventReasonResourceUpdated, fmt.Sprintf("approved %s wave %d of running operation", approval.Phase, approval.Wave))
+			return &application.OperationApproveResponse{}, nil
+		}
+		if !apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("error updating application: %w", err)
+		}
+		log.Warnf("failed to approve operation for app %q due to update conflict. retrying again...", appName)
+		time.Sleep(100 * time.Millisecond)
+		a, err = s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting application by name: %w", err)
+		}
+	}
+	return nil, status.Errorf(codes.Internal, "Failed to approve operation. Too many conflicts")
+}
+
+// DependencyGraph returns the applications the application depends on, directly or transitively, and the applications
+// which depend on it. The applications the user is not permitted to get are omitted, and the graph is not walked
+// through them.
+func (s *Server) DependencyGraph(ctx context.Context, q *application.ApplicationDependencyGraphQuery) (*application.ApplicationDependencyGraph, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	apps, err := s.appLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("error listing apps with selectors: %w", err)
+	}
+	appsByKey := map[string]*v1alpha1.Application{}
+	hidden := map[string]bool{}
+	dependents := map[string][]*v1alpha1.Application{}
+	for _, app := range apps {
+		key := app.Namespace + "/" + app.Name
+		if !s.enf.Enforce(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, app.RBACName(s.ns)) {
+			hidden[key] = true
+			continue
+		}
+		appsByKey[key] = app
+		for _, dep := range app.Spec.DependsOn {
+			key := dep.GetNamespace(app.Namespace) + "/" + dep.Name
+			dependents[key] = append(dependents[key], app)
+		}
+	}
+
+	// walk visits the applications reachable from the application, in breadth-first order
+	walk := func(next func(app *v1alpha1.Application) []string) []string {
+		start := a.Namespace + "/" + a.Name
+		visited := map[string]bool{start: true}
+		queue := []string{start}
+		var keys []string
+		for len(queue) > 0 {
+			key := queue[0]
+			queue = queue[1:]
+			app, ok := appsByKey[key]
+			if !ok {
+				continue
+			}
+			for _, nextKey := range next(app) {
+				if !visited[nextKey] && !hidden[nextKey] {
+					visited[nextKey] = true
+					keys = append(keys, nextKey)
+					queue = append(queue, nextKey)
+				}
+			}
+		}
+		return keys
+	}
+	keys := []string{a.Namespace + "/" + a.Name}
+	keys = append(keys, walk(func(app *v1alpha1.Application) []string {
+		var next []string
+		for _, dep := range app.Spec.DependsOn {
+			next = append(next, dep.GetNamespace(app.Namespace)+"/"+dep.Name)
+		}
+		return next
+	})...)
+	keys = append(keys, walk(func(app *v1alpha1.Application) []string {
+		var next []string
+		for _, dependent := range dependents[app.Namespace+"/"+app.Name] {
+			next = append(next, dependent.Namespace+"/"+dependent.Name)
+		}
+		return next
+	})...)
+
+	graph := &application.ApplicationDependencyGraph{}
+	added := map[string]bool{}
+	for _, key := range keys {
+		if added[key] {
+			// applications of a dependency cycle are both dependencies and dependents
+			continue
+		}
+		added[key] = true
+		namespace, name, _ := strings.Cut(key, "/")
+		node := &application.ApplicationDependencyNode{Name: ptr.To(name), Namespace: ptr.To(namespace)}
+		graph.Nodes = append(graph.Nodes, node)
+		app, ok := appsByKey[key]
+		if !ok {
+			node.Missing = ptr.To(true)
+			continue
+		}
+		for i := range app.Spec.DependsOn {
+			node.DependsOn = append(node.DependsOn, &app.Spec.DependsOn[i])
+		}
+		node.SyncStatus = ptr.To(string(app.Status.Sync.Status))
+		node.HealthStatus = ptr.To(string(app.Status.Health.Status))
+	}
+	return graph, nil
+}
+
+// ListQueuedOperations returns the operations which are queued behind the operation in progress.
+func (s *Server) ListQueuedOperations(ctx context.Context, q *application.ApplicationOperationQueueRequest) (*application.ApplicationOperationQueueResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName(), "")
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*v1alpha1.QueuedOperation, len(a.Status.OperationQueue))
+	for i := range a.Status.OperationQueue {
+		items[i] = &a.Status.OperationQueue[i]
+	}
+	return &application.ApplicationOperationQueueResponse{Items: items}, nil
+}
+
+// CancelQueuedOperation removes an operation from the operation queue of the application.
+func (s *Server) CancelQueuedOperation(ctx context.Context, cancelReq *application.QueuedOperationCancelRequest) (*application.QueuedOperationCancelResponse, error) {
+	appName := cancelReq.GetName()
+	appNs := s.appNamespaceOrDefault(cancelReq.GetAppNamespace())
+	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionSync, cancelReq.GetProject(), appNs, appName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < 10; i++ {
+		idx := slices.IndexFunc(a.Status.OperationQueue, func(op v1alpha1.QueuedOperation) bool {
+			return op.ID == cancelReq.GetId()
+		})
+		if idx < 0 {
+			return nil, status.Errorf(codes.NotFound, "queued operation %q not found", cancelReq.GetId())
+		}
+		a.Status.OperationQueue = slices.Delete(a.Status.OperationQueue, idx, idx+1)
+		_, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Update(ctx, a, metav1.UpdateOptions{})
+		if err == nil {
+			s.logAppEvent(ctx, a, argo.EventReasonResourceUpdated, fmt.Sprintf("canceled queued operation %s", cancelReq.GetId()))
+			return &application.QueuedOperationCancelResponse{}, nil
+		}
+		if !apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("error updating application: %w", err)
+		}
+		log.Warnf("failed to cancel queued operation for app %q due to update conflict. retrying again...", appName)
+		time.Sleep(100 * time.Millisecond)
+		a, err = s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting application by name: %w", err)
+		}
+	}
+	return nil, status.Errorf(codes.Internal, "Failed to cancel queued operation. Too many conflicts")
+}
+
 func (s *Server) logAppEvent(ctx context.Context, a *v1alpha1.Application, reason string, action string) {
 	eventInfo := argo.EventInfo{Type: corev1.EventTypeNormal, Reason: reason}
 	user := session.Username(ctx)
@@ -3040,6 +3253,14 @@ func (s *Server) ServerSideDiff(ctx context.Context, q *application.ApplicationS
 				i, len(q.GetLiveResources()), len(targetObjs))
 		}
 
+		var fieldOwners []v1alpha1.ResourceFieldOwner
+		if i < len(liveObjs) {
+			fieldOwners, err = argodiff.FieldOwners(liveObjs[i], &diffRes, gvkParser)
+			if err != nil {
+				log.Warnf("Failed to get field owners of %s/%s: %v", kind, name, err)
+			}
+		}
+
 		// Create ResourceDiff with StateDiffs results
 		// TargetState = PredictedLive (what the target should be after applying)
 		// LiveState = NormalizedLive (current normalized live state)
@@ -3054,6 +3275,7 @@ func (s *Server) ServerSideDiff(ctx context.Context, q *application.ApplicationS
 			Hook:            hook,
 			Modified:        diffRes.Modified,
 			ResourceVersion: resourceVersion,
+			FieldOwners:     fieldOwners,
 		})
 	}
 
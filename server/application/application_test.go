@@ -5,6 +5,7 @@ import (
 	stderrors "errors"
 	"fmt"
 	"io"
+	"maps"
 	"slices"
 	"strconv"
 	"strings"
@@ -976,6 +977,20 @@ func TestNoAppEnumeration(t *testing.T) {
 		assert.EqualError(t, err, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", "when the request specifies a project, we can return the standard k8s error message")
 	})
 
+	t.Run("ApproveOperation", func(t *testing.T) {
+		_, err := appServer.ApproveOperation(noRoleCtx, &application.OperationApproveRequest{Name: ptr.To("test")})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.ApproveOperation(adminCtx, &application.OperationApproveRequest{Name: ptr.To("doest-not-exist")})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+	})
+
+	t.Run("CancelQueuedOperation", func(t *testing.T) {
+		_, err := appServer.CancelQueuedOperation(noRoleCtx, &application.QueuedOperationCancelRequest{Name: ptr.To("test"), Id: ptr.To("id")})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.CancelQueuedOperation(adminCtx, &application.QueuedOperationCancelRequest{Name: ptr.To("doest-not-exist"), Id: ptr.To("id")})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+	})
+
 	t.Run("Rollback", func(t *testing.T) {
 		unsetSyncRunningOperationState(t, appServer)
 		_, err := appServer.Rollback(adminCtx, &application.ApplicationRollbackRequest{Name: ptr.To("test")})
@@ -2593,6 +2608,22 @@ func TestSyncRBACOverrideNotRequired_DiffRevisionWithAutosyncPrevented(t *testin
 		"should not be able to sync to different revision with auto-sync enabled, multi-source app")
 }
 
+func TestGetSyncPhases(t *testing.T) {
+	syncPhases, err := getSyncPhases(&v1alpha1.SyncWaveRange{From: ptr.To(int64(1)), To: ptr.To(int64(3))}, []string{"PreSync", "Sync"})
+	require.NoError(t, err)
+	assert.Equal(t, []synccommon.SyncPhase{synccommon.SyncPhasePreSync, synccommon.SyncPhaseSync}, syncPhases)
+
+	syncPhases, err = getSyncPhases(&v1alpha1.SyncWaveRange{From: ptr.To(int64(2))}, nil)
+	require.NoError(t, err)
+	assert.Empty(t, syncPhases)
+
+	_, err = getSyncPhases(&v1alpha1.SyncWaveRange{From: ptr.To(int64(3)), To: ptr.To(int64(1))}, nil)
+	require.ErrorContains(t, err, "invalid sync wave range 3:1")
+
+	_, err = getSyncPhases(nil, []string{"SyncFail"})
+	require.ErrorContains(t, err, `invalid sync phase "SyncFail"`)
+}
+
 func TestSyncAndTerminate(t *testing.T) {
 	ctx := t.Context()
 	appServer := newTestAppServer(t)
@@ -2634,6 +2665,175 @@ func TestSyncAndTerminate(t *testing.T) {
 	assert.Equal(t, synccommon.OperationTerminating, app.Status.OperationState.Phase)
 }
 
+func TestSyncUnmetDependencies(t *testing.T) {
+	ctx := t.Context()
+	dep := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "crds"
+		app.Status.Sync.Status = v1alpha1.SyncStatusCodeOutOfSync
+		app.Status.Health.Status = health.HealthStatusProgressing
+	})
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.Source.RepoURL = "https://github.com/argoproj/argo-cd.git"
+		app.Spec.DependsOn = []v1alpha1.ApplicationDependency{{Name: "crds"}}
+	})
+	appServer := newTestAppServer(t, testApp, dep)
+
+	_, err := appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &testApp.Name})
+	require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = cannot sync: dependencies are not met: application default/crds is OutOfSync and Progressing")
+
+	// dry runs are not blocked by the dependencies
+	_, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &testApp.Name, DryRun: ptr.To(true)})
+	require.NoError(t, err)
+}
+
+func TestDependencyGraph(t *testing.T) {
+	newApp := func(name string, dependsOn ...string) *v1alpha1.Application {
+		return newTestApp(func(app *v1alpha1.Application) {
+			app.Name = name
+			app.Status.Sync.Status = v1alpha1.SyncStatusCodeSynced
+			app.Status.Health.Status = health.HealthStatusHealthy
+			for _, dep := range dependsOn {
+				app.Spec.DependsOn = append(app.Spec.DependsOn, v1alpha1.ApplicationDependency{Name: dep})
+			}
+		})
+	}
+	appServer := newTestAppServer(t,
+		newApp("crds"),
+		newApp("operators", "crds", "missing"),
+		newApp("tenants", "operators"),
+		newApp("monitoring", "crds"),
+	)
+
+	graph, err := appServer.DependencyGraph(t.Context(), &application.ApplicationDependencyGraphQuery{Name: ptr.To("operators")})
+	require.NoError(t, err)
+	var names []string
+	for _, node := range graph.Nodes {
+		names = append(names, node.GetName())
+	}
+	// the other dependents of the dependencies are not part of the graph
+	assert.Equal(t, []string{"operators", "crds", "missing", "tenants"}, names)
+	assert.Len(t, graph.Nodes[0].DependsOn, 2)
+	assert.Equal(t, "Healthy", graph.Nodes[1].GetHealthStatus())
+	assert.True(t, graph.Nodes[2].GetMissing())
+	assert.Equal(t, "operators", graph.Nodes[3].DependsOn[0].Name)
+}
+
+func TestDependencyGraph_OmitsUnauthorizedApps(t *testing.T) {
+	newApp := func(name, project string, dependsOn ...string) *v1alpha1.Application {
+		return newTestApp(func(app *v1alpha1.Application) {
+			app.Name = name
+			app.Spec.Project = project
+			for _, dep := range dependsOn {
+				app.Spec.DependsOn = append(app.Spec.DependsOn, v1alpha1.ApplicationDependency{Name: dep})
+			}
+		})
+	}
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetUserPolicy(`
+p, role:test, applications, get, default/*, allow
+g, group-test, role:test
+`)
+		enf.SetDefaultRole("")
+	}
+	appServer := newTestAppServerWithEnforcerConfigure(t, f, map[string]string{},
+		newApp("crds", "default"),
+		newApp("secret", "restricted", "crds"),
+		newApp("operators", "default", "crds", "secret"),
+		newApp("tenants", "restricted", "operators"),
+		newApp("monitoring", "default", "tenants"),
+	)
+	//nolint:staticcheck
+	ctx := context.WithValue(t.Context(), "claims", &jwt.MapClaims{"groups": []string{"group-test"}})
+
+	graph, err := appServer.DependencyGraph(ctx, &application.ApplicationDependencyGraphQuery{Name: ptr.To("operators")})
+	require.NoError(t, err)
+	var names []string
+	for _, node := range graph.Nodes {
+		names = append(names, node.GetName())
+		assert.False(t, node.GetMissing())
+	}
+	// the applications of the restricted project are omitted, and so is monitoring, which only depends on operators
+	// through tenants
+	assert.Equal(t, []string{"operators", "crds"}, names)
+}
+
+func TestSyncAndApprove(t *testing.T) {
+	ctx := t.Context()
+	appServer := newTestAppServer(t)
+	testApp := newTestApp()
+	testApp.Spec.Source.RepoURL = "https://github.com/argoproj/argo-cd.git"
+	app, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: testApp})
+	require.NoError(t, err)
+	app, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &app.Name})
+	require.NoError(t, err)
+
+	// set status.operationState to pretend that the operation is running and not waiting for approval
+	app.Status.OperationState = &v1alpha1.OperationState{
+		Operation: *app.Operation,
+		Phase:     synccommon.OperationRunning,
+		StartedAt: metav1.NewTime(time.Now()),
+	}
+	app, err = appServer.appclientset.ArgoprojV1alpha1().Applications(appServer.ns).Update(t.Context(), app, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	_, err = appServer.ApproveOperation(ctx, &application.OperationApproveRequest{Name: &app.Name})
+	require.ErrorContains(t, err, "No operation is waiting for approval")
+
+	// set status.operationState to pretend that the controller waits for the approval of a sync wave
+	app.Status.OperationState.Phase = synccommon.OperationWaitingForApproval
+	app.Status.OperationState.PendingApproval = &v1alpha1.SyncWaveApproval{Phase: synccommon.SyncPhaseSync, Wave: 2}
+	_, err = appServer.appclientset.ArgoprojV1alpha1().Applications(appServer.ns).Update(t.Context(), app, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	resp, err := appServer.ApproveOperation(ctx, &application.OperationApproveRequest{Name: &app.Name})
+	require.NoError(t, err)
+	assert.NotNil(t, resp)
+
+	app, err = appServer.Get(ctx, &application.ApplicationQuery{Name: &app.Name})
+	require.NoError(t, err)
+	assert.Equal(t, synccommon.OperationRunning, app.Status.OperationState.Phase)
+	assert.Nil(t, app.Status.OperationState.PendingApproval)
+	require.Len(t, app.Status.OperationState.Approvals, 1)
+	assert.True(t, app.Status.OperationState.IsSyncWaveApproved(synccommon.SyncPhaseSync, 2))
+	assert.NotNil(t, app.Status.OperationState.Approvals[0].ApprovedAt)
+}
+
+func TestSyncQueued(t *testing.T) {
+	ctx := t.Context()
+	appServer := newTestAppServer(t)
+	testApp := newTestApp()
+	testApp.Spec.Source.RepoURL = "https://github.com/argoproj/argo-cd.git"
+	testApp.Spec.SyncPolicy = &v1alpha1.SyncPolicy{OperationQueue: &v1alpha1.SyncPolicyOperationQueue{Limit: 1}}
+	app, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: testApp})
+	require.NoError(t, err)
+	_, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &app.Name})
+	require.NoError(t, err)
+
+	// the sync is queued behind the operation in progress
+	app, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &app.Name, Prune: ptr.To(true)})
+	require.NoError(t, err)
+	require.Len(t, app.Status.OperationQueue, 1)
+	assert.True(t, app.Status.OperationQueue[0].Operation.Sync.Prune)
+	assert.False(t, app.Operation.Sync.Prune)
+
+	_, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{Name: &app.Name})
+	require.ErrorContains(t, err, "the operation queue is full (limit 1)")
+
+	queue, err := appServer.ListQueuedOperations(ctx, &application.ApplicationOperationQueueRequest{Name: &app.Name})
+	require.NoError(t, err)
+	require.Len(t, queue.Items, 1)
+
+	_, err = appServer.CancelQueuedOperation(ctx, &application.QueuedOperationCancelRequest{Name: &app.Name, Id: ptr.To("unknown")})
+	require.ErrorContains(t, err, `queued operation "unknown" not found`)
+
+	_, err = appServer.CancelQueuedOperation(ctx, &application.QueuedOperationCancelRequest{Name: &app.Name, Id: &queue.Items[0].ID})
+	require.NoError(t, err)
+
+	app, err = appServer.Get(ctx, &application.ApplicationQuery{Name: &app.Name})
+	require.NoError(t, err)
+	assert.Empty(t, app.Status.OperationQueue)
+}
+
 func TestSyncHelm(t *testing.T) {
 	ctx := t.Context()
 	appServer := newTestAppServer(t)
@@ -2726,6 +2926,111 @@ func TestGetManifests_WithNoCache(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestHydratePreview(t *testing.T) {
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.Source = nil
+		app.Spec.SourceHydrator = &v1alpha1.SourceHydrator{
+			DrySource: v1alpha1.DrySource{
+				RepoURL:        "https://github.com/argoproj/argocd-example-apps.git",
+				TargetRevision: "HEAD",
+				Path:           "guestbook",
+			},
+			SyncSource: v1alpha1.SyncSource{
+				TargetBranch: "env/test",
+				Path:         "apps/guestbook",
+			},
+		}
+	})
+
+	t.Run("compares to the sync branch", func(t *testing.T) {
+		appServer := newTestAppServer(t, testApp)
+
+		mockRepoServiceClient := mocks.NewRepoServerServiceClient(t)
+		mockRepoServiceClient.EXPECT().GenerateManifest(mock.Anything, mock.MatchedBy(func(mr *apiclient.ManifestRequest) bool {
+			return mr.Revision == "feature" && mr.ApplicationSource.Path == "guestbook"
+		})).Return(&apiclient.ManifestResponse{
+			Revision: "abc123",
+			Manifests: []string{
+				`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cm"},"data":{"key":"new"}}`,
+				`{"apiVersion":"v1","kind":"Secret","metadata":{"name":"secret"},"data":{"password":"c2VjcmV0"}}`,
+			},
+		}, nil).Once()
+		mockRepoServiceClient.EXPECT().GetRevisionMetadata(mock.Anything, mock.MatchedBy(func(r *apiclient.RepoServerRevisionMetadataRequest) bool {
+			return r.Revision == "abc123"
+		})).Return(&v1alpha1.RevisionMetadata{Author: "author"}, nil).Once()
+		mockRepoServiceClient.EXPECT().ListRefs(mock.Anything, mock.Anything).Return(&apiclient.Refs{Branches: []string{"main", "env/test"}}, nil).Once()
+		mockRepoServiceClient.EXPECT().GetGitFiles(mock.Anything, mock.MatchedBy(func(r *apiclient.GitFilesRequest) bool {
+			return r.Revision == "env/test" && r.Path == "apps/guestbook"
+		})).Return(&apiclient.GitFilesResponse{Map: map[string][]byte{
+			"apps/guestbook/manifest.yaml": []byte("apiVersion: v1\ndata:\n  key: old\nkind: ConfigMap\nmetadata:\n  name: cm\n"),
+			"apps/guestbook/stale.yaml":    []byte("stale\n"),
+		}}, nil).Once()
+		mockRepoServiceClient.EXPECT().GetGitFiles(mock.Anything, mock.Anything).Return(&apiclient.GitFilesResponse{}, nil)
+		appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: mockRepoServiceClient}
+
+		preview, err := appServer.HydratePreview(t.Context(), &application.ApplicationHydrateQuery{
+			Name:     &testApp.Name,
+			Revision: ptr.To("feature"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "abc123", preview.GetDrySha())
+		assert.Equal(t, "env/test", preview.GetSyncBranch())
+
+		files := map[string]*application.HydratedFile{}
+		for _, file := range preview.Files {
+			files[file.GetPath()] = file
+		}
+		assert.ElementsMatch(t, []string{
+			".gitattributes",
+			"hydrator.metadata",
+			"apps/guestbook/README.md",
+			"apps/guestbook/hydrator.metadata",
+			"apps/guestbook/manifest.yaml",
+			"apps/guestbook/stale.yaml",
+		}, slices.Collect(maps.Keys(files)))
+
+		manifest := files["apps/guestbook/manifest.yaml"]
+		assert.Contains(t, manifest.GetDiff(), "-  key: old\n+  key: new\n")
+		assert.NotContains(t, manifest.GetContent(), "c2VjcmV0")
+		assert.Contains(t, manifest.GetContent(), "password: ++++++++")
+
+		stale := files["apps/guestbook/stale.yaml"]
+		assert.Empty(t, stale.GetContent())
+		assert.Equal(t, "stale\n", stale.GetCurrentContent())
+		assert.Contains(t, stale.GetDiff(), "-stale\n")
+
+		assert.Contains(t, files["apps/guestbook/hydrator.metadata"].GetContent(), `"drySha": "abc123"`)
+	})
+
+	t.Run("sync branch does not exist", func(t *testing.T) {
+		appServer := newTestAppServer(t, testApp)
+
+		mockRepoServiceClient := mocks.NewRepoServerServiceClient(t)
+		mockRepoServiceClient.EXPECT().GenerateManifest(mock.Anything, mock.Anything).Return(&apiclient.ManifestResponse{
+			Revision:  "abc123",
+			Manifests: []string{`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cm"}}`},
+		}, nil).Once()
+		mockRepoServiceClient.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{}, nil).Once()
+		mockRepoServiceClient.EXPECT().ListRefs(mock.Anything, mock.Anything).Return(&apiclient.Refs{Branches: []string{"main"}}, nil).Once()
+		appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: mockRepoServiceClient}
+
+		preview, err := appServer.HydratePreview(t.Context(), &application.ApplicationHydrateQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		for _, file := range preview.Files {
+			assert.Empty(t, file.GetCurrentContent())
+			assert.NotEmpty(t, file.GetDiff())
+		}
+	})
+
+	t.Run("application without source hydrator", func(t *testing.T) {
+		app := newTestApp()
+		appServer := newTestAppServer(t, app)
+
+		_, err := appServer.HydratePreview(t.Context(), &application.ApplicationHydrateQuery{Name: &app.Name})
+		require.ErrorContains(t, err, "does not use the source hydrator")
+	})
+}
+
 func TestRollbackApp(t *testing.T) {
 	testApp := newTestApp()
 	testApp.Status.History = []v1alpha1.RevisionHistory{{
@@ -0,0 +1,352 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sort"
+
+	kubecache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/pmezard/go-difflib/difflib"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/hydrator"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/security"
+)
+
+// HydratePreview renders the dry source of an application and returns the files the source hydrator would commit to
+// the sync branch, along with a diff against the files currently on the sync branch. Nothing is committed.
+func (s *Server) HydratePreview(ctx context.Context, q *application.ApplicationHydrateQuery) (*application.ApplicationHydrateResponse, error) {
+	if q.Name == nil || *q.Name == "" {
+		return nil, errors.New("invalid request: application name is missing")
+	}
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.isNamespaceEnabled(a.Namespace) {
+		return nil, security.NamespaceNotPermittedError(a.Namespace)
+	}
+
+	if a.Spec.SourceHydrator == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "application %s does not use the source hydrator", a.QualifiedName())
+	}
+	syncSource := a.Spec.SourceHydrator.GetSyncSource()
+	if syncSource.IsOCI() {
+		return nil, status.Errorf(codes.InvalidArgument, "hydration previews are only supported for git sync sources")
+	}
+	drySources := a.Spec.SourceHydrator.GetDrySources()
+	if q.GetRevision() != "" {
+		drySources[0].TargetRevision = q.GetRevision()
+	}
+
+	var rendered, current map[string]string
+	var drySha string
+	err = s.queryRepoServer(ctx, proj, func(
+		client apiclient.RepoServerServiceClient, helmRepos []*v1alpha1.Repository, helmCreds []*v1alpha1.RepoCreds, ociRepos []*v1alpha1.Repository, ociCreds []*v1alpha1.RepoCreds, helmOptions *v1alpha1.HelmOptions, enableGenerateManifests map[string]bool,
+	) error {
+		var pathDetails *commitclient.PathDetails
+		drySha, pathDetails, err = s.getHydratedPathDetails(ctx, client, a, proj, drySources, helmRepos, helmCreds, ociRepos, ociCreds, helmOptions, enableGenerateManifests)
+		if err != nil {
+			return err
+		}
+
+		dryRepo, err := s.db.GetRepository(ctx, drySources[0].RepoURL, proj.Name)
+		if err != nil {
+			return fmt.Errorf("error getting repository: %w", err)
+		}
+		revisionMetadata, err := client.GetRevisionMetadata(ctx, &apiclient.RepoServerRevisionMetadataRequest{
+			Repo:     dryRepo,
+			Revision: drySha,
+		})
+		if err != nil {
+			return fmt.Errorf("error getting revision metadata: %w", err)
+		}
+
+		rendered, err = hydrator.RenderForPaths(drySources[0].RepoURL, drySha, revisionMetadata, []*commitclient.PathDetails{pathDetails})
+		if err != nil {
+			return fmt.Errorf("error rendering hydrated files: %w", err)
+		}
+
+		current, err = s.getSyncBranchFiles(ctx, client, proj, syncSource, rendered)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The hydrator removes all files below the sync path before writing, so files which are not rendered again are
+	// deleted.
+	paths := make([]string, 0, len(rendered)+len(current))
+	for p := range rendered {
+		paths = append(paths, p)
+	}
+	for p := range current {
+		if _, ok := rendered[p]; !ok {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+
+	files := make([]*application.HydratedFile, 0, len(paths))
+	for _, p := range paths {
+		fileDiff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(current[p]),
+			B:        difflib.SplitLines(rendered[p]),
+			FromFile: "a/" + p,
+			ToFile:   "b/" + p,
+			Context:  3,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error computing diff for %s: %w", p, err)
+		}
+		files = append(files, &application.HydratedFile{
+			Path:           ptr.To(p),
+			Content:        ptr.To(rendered[p]),
+			CurrentContent: ptr.To(current[p]),
+			Diff:           ptr.To(fileDiff),
+		})
+	}
+
+	return &application.ApplicationHydrateResponse{
+		DrySha:     &drySha,
+		SyncBranch: &syncSource.TargetRevision,
+		Files:      files,
+	}, nil
+}
+
+// getHydratedPathDetails renders the given dry sources the way the source hydrator does, and returns the resolved
+// revision of the primary dry source and the hydrated manifests of the sync path. The data of Secrets is hidden.
+func (s *Server) getHydratedPathDetails(
+	ctx context.Context, client apiclient.RepoServerServiceClient, a *v1alpha1.Application, proj *v1alpha1.AppProject, drySources v1alpha1.ApplicationSources,
+	helmRepos []*v1alpha1.Repository, helmCreds []*v1alpha1.RepoCreds, ociRepos []*v1alpha1.Repository, ociCreds []*v1alpha1.RepoCreds, helmOptions *v1alpha1.HelmOptions, enableGenerateManifests map[string]bool,
+) (string, *commitclient.PathDetails, error) {
+	appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting app instance label key from settings: %w", err)
+	}
+	trackingMethod, err := s.settingsMgr.GetTrackingMethod()
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting trackingMethod from settings: %w", err)
+	}
+	kustomizeSettings, err := s.settingsMgr.GetKustomizeSettings()
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting kustomize settings: %w", err)
+	}
+	installationID, err := s.settingsMgr.GetInstallationID()
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting installation ID: %w", err)
+	}
+
+	config, err := s.getApplicationClusterConfig(ctx, a)
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting application cluster config: %w", err)
+	}
+	serverVersion, err := s.kubectl.GetServerVersion(config)
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting server version: %w", err)
+	}
+	apiResources, err := s.kubectl.GetAPIResources(config, false, kubecache.NewNoopSettings())
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting API resources: %w", err)
+	}
+
+	refSources, err := argo.GetRefSources(ctx, drySources, a.Spec.Project, s.db.GetRepository, []string{})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get ref sources: %w", err)
+	}
+
+	var revision string
+	var commands []string
+	var manifests []*commitclient.HydratedManifestDetails
+	var additionalDrySHAs []string
+	for i, source := range drySources {
+		repo, err := s.db.GetRepository(ctx, source.RepoURL, proj.Name)
+		if err != nil {
+			return "", nil, fmt.Errorf("error getting repository: %w", err)
+		}
+
+		repos := helmRepos
+		helmRepoCreds := helmCreds
+		if source.IsOCI() {
+			repos = slices.Clone(helmRepos)
+			helmRepoCreds = slices.Clone(helmCreds)
+			repos = append(repos, ociRepos...)
+			helmRepoCreds = append(helmRepoCreds, ociCreds...)
+		}
+
+		manifestInfo, err := client.GenerateManifest(ctx, &apiclient.ManifestRequest{
+			Repo:               repo,
+			Revision:           source.TargetRevision,
+			AppLabelKey:        appInstanceLabelKey,
+			AppName:            a.InstanceName(s.ns),
+			Namespace:          a.Spec.Destination.Namespace,
+			ApplicationSource:  &source,
+			Repos:              repos,
+			KustomizeOptions:   kustomizeSettings,
+			KubeVersion:        serverVersion,
+			ApiVersions:        argo.APIResourcesToStrings(apiResources, true),
+			HelmRepoCreds:      helmRepoCreds,
+			HelmOptions:        helmOptions,
+			TrackingMethod:     trackingMethod,
+			EnabledSourceTypes: enableGenerateManifests,
+			ProjectName:        proj.Name,
+			ProjectSourceRepos: proj.Spec.SourceRepos,
+			HasMultipleSources: len(drySources) > 1,
+			RefSources:         refSources,
+			InstallationID:     installationID,
+		})
+		if err != nil {
+			return "", nil, fmt.Errorf("error generating manifests: %w", err)
+		}
+		// The revision of the primary dry source is the dry SHA.
+		if i == 0 {
+			revision = manifestInfo.Revision
+		} else {
+			additionalDrySHAs = append(additionalDrySHAs, manifestInfo.Revision)
+		}
+		commands = append(commands, manifestInfo.Commands...)
+
+		for _, manifest := range manifestInfo.Manifests {
+			obj := &unstructured.Unstructured{}
+			err = json.Unmarshal([]byte(manifest), obj)
+			if err != nil {
+				return "", nil, fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
+			}
+			// Hydrated manifests are committed without tracking metadata.
+			err = argo.NewResourceTracking().RemoveAppInstance(obj, trackingMethod)
+			if err != nil {
+				return "", nil, fmt.Errorf("failed to remove the app instance value: %w", err)
+			}
+			manifestJSON, err := s.hideSecretData(obj)
+			if err != nil {
+				return "", nil, err
+			}
+			manifests = append(manifests, &commitclient.HydratedManifestDetails{ManifestJSON: manifestJSON})
+		}
+	}
+
+	return revision, &commitclient.PathDetails{
+		Path:              a.Spec.SourceHydrator.SyncSource.Path,
+		Manifests:         manifests,
+		Commands:          commands,
+		Layout:            string(a.Spec.SourceHydrator.SyncSource.Layout),
+		DisableReadme:     a.Spec.SourceHydrator.SyncSource.DisableReadme,
+		AdditionalDrySHAs: additionalDrySHAs,
+	}, nil
+}
+
+// getSyncBranchFiles returns the files on the sync branch that the source hydrator would overwrite or remove when
+// writing the given rendered files. No files are returned if the sync branch does not exist yet.
+func (s *Server) getSyncBranchFiles(ctx context.Context, client apiclient.RepoServerServiceClient, proj *v1alpha1.AppProject, syncSource v1alpha1.ApplicationSource, rendered map[string]string) (map[string]string, error) {
+	repo, err := s.db.GetRepository(ctx, syncSource.RepoURL, proj.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting repository: %w", err)
+	}
+	refs, err := client.ListRefs(ctx, &apiclient.ListRefsRequest{Repo: repo})
+	if err != nil {
+		return nil, fmt.Errorf("error listing refs: %w", err)
+	}
+	current := make(map[string]string)
+	if !slices.Contains(refs.Branches, syncSource.TargetRevision) {
+		return current, nil
+	}
+
+	// The hydrator clears the sync path before writing to it, unless it is the root of the repository.
+	var patterns []string
+	if hydrator.IsRootPath(syncSource.Path) {
+		for p := range rendered {
+			patterns = append(patterns, p)
+		}
+	} else {
+		patterns = append(patterns, filepath.Clean(syncSource.Path))
+		for p := range rendered {
+			if filepath.Dir(p) == "." {
+				patterns = append(patterns, p)
+			}
+		}
+	}
+	sort.Strings(patterns)
+
+	for _, pattern := range patterns {
+		resp, err := client.GetGitFiles(ctx, &apiclient.GitFilesRequest{
+			Repo:     repo,
+			Revision: syncSource.TargetRevision,
+			Path:     pattern,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error getting files from sync branch: %w", err)
+		}
+		for p, content := range resp.GetMap() {
+			current[filepath.ToSlash(p)] = string(content)
+		}
+	}
+
+	// Hide the data of Secrets on the sync branch, the same way it is hidden in the rendered manifests.
+	for p, content := range current {
+		if filepath.Ext(p) != ".yaml" {
+			continue
+		}
+		current[p], err = s.hideSecretDataInManifests(content)
+		if err != nil {
+			return nil, fmt.Errorf("error hiding secret data in %s: %w", p, err)
+		}
+	}
+	return current, nil
+}
+
+// hideSecretData returns the JSON representation of the given object, with the data hidden if it is a Secret.
+func (s *Server) hideSecretData(obj *unstructured.Unstructured) (string, error) {
+	if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
+		var err error
+		obj, _, err = diff.HideSecretData(obj, nil, s.settingsMgr.GetSensitiveAnnotations())
+		if err != nil {
+			return "", fmt.Errorf("error hiding secret data: %w", err)
+		}
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling manifest: %w", err)
+	}
+	return string(data), nil
+}
+
+// hideSecretDataInManifests hides the data of all Secrets in the given hydrated manifest file content. The content is
+// returned unchanged if it does not contain any Secrets, or if it is not a YAML stream of Kubernetes resources.
+func (s *Server) hideSecretDataInManifests(content string) (string, error) {
+	objs, err := kube.SplitYAML([]byte(content))
+	if err != nil {
+		return content, nil
+	}
+	hasSecrets := false
+	manifests := make([]*commitclient.HydratedManifestDetails, 0, len(objs))
+	for _, obj := range objs {
+		if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
+			hasSecrets = true
+		}
+		manifestJSON, err := s.hideSecretData(obj)
+		if err != nil {
+			return "", err
+		}
+		manifests = append(manifests, &commitclient.HydratedManifestDetails{ManifestJSON: manifestJSON})
+	}
+	if !hasSecrets {
+		return content, nil
+	}
+	return hydrator.RenderManifests(manifests)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo/drift"
 	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
 	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v3/util/env"
@@ -85,6 +86,10 @@ func (c *Cache) SetClusterInfo(server string, res *appv1.ClusterInfo) error {
 	return c.cache.SetClusterInfo(server, res)
 }
 
+func (c *Cache) GetDriftRecords(shard int, res *[]*drift.Record) error {
+	return c.cache.GetDriftRecords(shard, res)
+}
+
 func (c *Cache) GetCache() *cacheutil.Cache {
 	return c.cache.Cache
 }
@@ -5,10 +5,14 @@ import (
 	"fmt"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	servercache "github.com/argoproj/argo-cd/v3/server/cache"
+	"github.com/argoproj/argo-cd/v3/util/argo/drift"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
 
 	sessionmgr "github.com/argoproj/argo-cd/v3/util/session"
 
@@ -21,7 +25,9 @@ import (
 type Server struct {
 	mgr                       *settings.SettingsManager
 	repoClient                apiclient.Clientset
+	cache                     *servercache.Cache
 	authenticator             Authenticator
+	enf                       *rbac.Enforcer
 	disableAuth               bool
 	appsInAnyNamespaceEnabled bool
 	hydratorEnabled           bool
@@ -33,8 +39,8 @@ type Authenticator interface {
 }
 
 // NewServer returns a new instance of the Settings service
-func NewServer(mgr *settings.SettingsManager, repoClient apiclient.Clientset, authenticator Authenticator, disableAuth, appsInAnyNamespaceEnabled bool, hydratorEnabled bool, syncWithReplaceAllowed bool) *Server {
-	return &Server{mgr: mgr, repoClient: repoClient, authenticator: authenticator, disableAuth: disableAuth, appsInAnyNamespaceEnabled: appsInAnyNamespaceEnabled, hydratorEnabled: hydratorEnabled, syncWithReplaceAllowed: syncWithReplaceAllowed}
+func NewServer(mgr *settings.SettingsManager, repoClient apiclient.Clientset, cache *servercache.Cache, authenticator Authenticator, enf *rbac.Enforcer, disableAuth, appsInAnyNamespaceEnabled bool, hydratorEnabled bool, syncWithReplaceAllowed bool) *Server {
+	return &Server{mgr: mgr, repoClient: repoClient, cache: cache, authenticator: authenticator, enf: enf, disableAuth: disableAuth, appsInAnyNamespaceEnabled: appsInAnyNamespaceEnabled, hydratorEnabled: hydratorEnabled, syncWithReplaceAllowed: syncWithReplaceAllowed}
 }
 
 // Get returns Argo CD settings
@@ -168,6 +174,31 @@ func (s *Server) GetPlugins(ctx context.Context, _ *settingspkg.SettingsQuery) (
 	return &settingspkg.SettingsPluginsResponse{Plugins: plugins}, nil
 }
 
+// GetIgnoreDifferencesSuggestions returns ignoreDifferences rules for the fields which keep differing after successful
+// syncs. The suggestions are collected across all applications, so the user must be allowed to get all applications.
+func (s *Server) GetIgnoreDifferencesSuggestions(ctx context.Context, q *settingspkg.IgnoreDifferencesSuggestionsQuery) (*settingspkg.IgnoreDifferencesSuggestionsResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, "*/*"); err != nil {
+		return nil, err
+	}
+	records, err := drift.GetRecords(s.cache)
+	if err != nil {
+		return nil, err
+	}
+	res := &settingspkg.IgnoreDifferencesSuggestionsResponse{}
+	for _, r := range drift.Suggest(records, q.MinObservations, int(q.MinApplications)) {
+		rule := r.IgnoreDifferences()
+		lastObserved := metav1.NewTime(r.LastObserved)
+		res.Items = append(res.Items, &settingspkg.IgnoreDifferencesSuggestion{
+			Rule:         &rule,
+			Observations: r.Observations,
+			Applications: int64(len(r.Apps)),
+			Managers:     r.Managers,
+			LastObserved: &lastObserved,
+		})
+	}
+	return res, nil
+}
+
 func (s *Server) plugins(ctx context.Context) ([]*settingspkg.Plugin, error) {
 	closer, client, err := s.repoClient.NewRepoServerClient()
 	if err != nil {
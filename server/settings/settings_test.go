@@ -3,15 +3,28 @@ package settings
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/argoproj/argo-cd/v3/common"
+	settingspkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/settings"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	servercache "github.com/argoproj/argo-cd/v3/server/cache"
+	"github.com/argoproj/argo-cd/v3/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v3/test"
+	"github.com/argoproj/argo-cd/v3/util/argo/drift"
+	"github.com/argoproj/argo-cd/v3/util/assets"
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
+	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
@@ -57,7 +70,7 @@ func fixtures(ctx context.Context, data map[string]string) (*fake.Clientset, *se
 func TestSettingsServer(t *testing.T) {
 	newServer := func(data map[string]string) *Server {
 		_, settingsMgr := fixtures(t.Context(), data)
-		return NewServer(settingsMgr, nil, nil, false, false, false, false)
+		return NewServer(settingsMgr, nil, nil, nil, nil, false, false, false, false)
 	}
 
 	t.Run("TestGetInstallationID", func(t *testing.T) {
@@ -115,3 +128,76 @@ func TestSettingsServer(t *testing.T) {
 		assert.NotEmpty(t, resp.ResourceOverrides["*/*"])
 	})
 }
+
+func TestGetIgnoreDifferencesSuggestions(t *testing.T) {
+	kubeClient, settingsMgr := fixtures(t.Context(), nil)
+	appStateCache := appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Hour)
+	enforcer := rbac.NewEnforcer(kubeClient, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	require.NoError(t, enforcer.SetBuiltinPolicy(assets.BuiltinPolicyCSV))
+	require.NoError(t, enforcer.SetUserPolicy(`
+p, role:test, applications, get, default/*, allow
+g, test, role:test
+g, admin, role:readonly
+`))
+	enforcer.SetClaimsEnforcerFunc(rbacpolicy.NewRBACPolicyEnforcer(enforcer, test.NewFakeProjLister()).EnforceClaims)
+	settingsServer := NewServer(settingsMgr, nil, servercache.NewCache(appStateCache, time.Hour, time.Hour), nil, enforcer, false, false, false, false)
+	//nolint:staticcheck // it's ok to use built-in type string as key for value for testing purposes
+	ctx := context.WithValue(t.Context(), "claims", &jwt.MapClaims{"sub": "admin"})
+
+	t.Run("NoRecords", func(t *testing.T) {
+		resp, err := settingsServer.GetIgnoreDifferencesSuggestions(ctx, &settingspkg.IgnoreDifferencesSuggestionsQuery{})
+		require.NoError(t, err)
+		assert.Empty(t, resp.Items)
+	})
+
+	lastObserved := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, appStateCache.SetDriftRecords(0, []*drift.Record{{
+		Group:        "apps",
+		Kind:         "Deployment",
+		JSONPointer:  "/spec/replicas",
+		Observations: 15,
+		Apps:         []string{"argocd/guestbook"},
+		Managers:     []string{"kube-controller-manager"},
+		LastObserved: lastObserved,
+	}, {
+		Kind:         "Service",
+		JSONPointer:  "/spec/clusterIP",
+		Observations: 5,
+		Apps:         []string{"argocd/guestbook"},
+		LastObserved: lastObserved,
+	}}))
+	require.NoError(t, appStateCache.SetDriftRecords(1, []*drift.Record{{
+		Group:        "apps",
+		Kind:         "Deployment",
+		JSONPointer:  "/spec/replicas",
+		Observations: 5,
+		Apps:         []string{"argocd/helm-guestbook"},
+		Managers:     []string{"kube-controller-manager"},
+		LastObserved: lastObserved,
+	}}))
+
+	t.Run("Filtered", func(t *testing.T) {
+		resp, err := settingsServer.GetIgnoreDifferencesSuggestions(ctx, &settingspkg.IgnoreDifferencesSuggestionsQuery{MinObservations: 10, MinApplications: 2})
+		require.NoError(t, err)
+		require.Len(t, resp.Items, 1)
+		item := resp.Items[0]
+		assert.Equal(t, v1alpha1.ResourceIgnoreDifferences{Group: "apps", Kind: "Deployment", JSONPointers: []string{"/spec/replicas"}}, *item.Rule)
+		assert.Equal(t, int64(20), item.Observations)
+		assert.Equal(t, int64(2), item.Applications)
+		assert.Equal(t, []string{"kube-controller-manager"}, item.Managers)
+		assert.True(t, lastObserved.Equal(item.LastObserved.Time))
+	})
+
+	t.Run("All", func(t *testing.T) {
+		resp, err := settingsServer.GetIgnoreDifferencesSuggestions(ctx, &settingspkg.IgnoreDifferencesSuggestionsQuery{})
+		require.NoError(t, err)
+		assert.Len(t, resp.Items, 2)
+	})
+
+	t.Run("PermissionDenied", func(t *testing.T) {
+		//nolint:staticcheck // it's ok to use built-in type string as key for value for testing purposes
+		ctx := context.WithValue(t.Context(), "claims", &jwt.MapClaims{"sub": "test"})
+		_, err := settingsServer.GetIgnoreDifferencesSuggestions(ctx, &settingspkg.IgnoreDifferencesSuggestionsQuery{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
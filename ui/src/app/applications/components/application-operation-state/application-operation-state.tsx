@@ -115,6 +115,30 @@ export const ApplicationOperationState: React.StatelessComponent<Props> = ({appl
             )
         });
     }
+    if (operationState.phase === models.OperationPhases.WaitingForApproval) {
+        operationAttributes.push({
+            title: '',
+            value: (
+                <button
+                    className='argo-button argo-button--base'
+                    onClick={async () => {
+                        const confirmed = await ctx.apis.popup.confirm('Approve sync wave', 'Are you sure you want to approve the sync wave and continue the operation?');
+                        if (confirmed) {
+                            try {
+                                await services.applications.approveOperation(application.metadata.name, application.metadata.namespace);
+                            } catch (e) {
+                                ctx.apis.notifications.show({
+                                    content: <ErrorNotification title='Unable to approve operation' e={e} />,
+                                    type: NotificationType.Error
+                                });
+                            }
+                        }
+                    }}>
+                    Approve
+                </button>
+            )
+        });
+    }
     if (operationState.syncResult) {
         operationAttributes.push({
             title: 'REVISION',
@@ -17,12 +17,14 @@ export interface ApplicationResourcesDiffProps {
 export const ApplicationResourcesDiff = (props: ApplicationResourcesDiffProps) => (
     <DataLoader key='resource-diff' load={() => services.viewPreferences.getPreferences()}>
         {pref => {
+            const fieldOwners = new Map<string, models.ResourceFieldOwner[]>();
             const diffText = props.states
                 .map(state => {
                     return {
                         a: state.normalizedLiveState ? jsYaml.dump(state.normalizedLiveState, {indent: 2}) : '',
                         b: state.predictedLiveState ? jsYaml.dump(state.predictedLiveState, {indent: 2}) : '',
                         hook: state.hook,
+                        fieldOwners: state.fieldOwners || [],
                         // doubles as sort order
                         name: (state.group || '') + '/' + state.kind + '/' + (state.namespace ? state.namespace + '/' : '') + state.name
                     };
@@ -30,6 +32,7 @@ export const ApplicationResourcesDiff = (props: ApplicationResourcesDiffProps) =
                 .filter(i => !i.hook)
                 .filter(i => i.a !== i.b)
                 .map(i => {
+                    fieldOwners.set(i.name, i.fieldOwners);
                     const context = pref.appDetails.compactDiff ? 2 : Number.MAX_SAFE_INTEGER;
                     // react-diff-view, awesome as it is, does not accept unidiff format, you must add a git header section
                     return `diff --git a/${i.name} b/${i.name}
@@ -75,7 +78,14 @@ ${formatLines(diffLines(i.a, i.b), {context, aname: `a/${name}}`, bname: `b/${i.
                     {files
                         .sort((a: any, b: any) => a.newPath.localeCompare(b.newPath))
                         .map((file: any) => (
-                            <IndividualDiffSection key={file.newPath} file={file} showPath={showPath} whiteBox={whiteBox} viewType={viewType} />
+                            <IndividualDiffSection
+                                key={file.newPath}
+                                file={file}
+                                showPath={showPath}
+                                whiteBox={whiteBox}
+                                viewType={viewType}
+                                fieldOwners={fieldOwners.get(file.newPath.replace(/^b\//, ''))}
+                            />
                         ))}
                 </div>
             );
@@ -2,6 +2,7 @@ import * as React from 'react';
 import {useState} from 'react';
 import {Diff, Hunk, tokenize, markEdits} from 'react-diff-view';
 import 'react-diff-view/style/index.css';
+import * as models from '../../../shared/models';
 
 import './application-resources-diff.scss';
 
@@ -10,10 +11,11 @@ export interface IndividualDiffSectionProps {
     showPath: boolean;
     whiteBox: string;
     viewType: string;
+    fieldOwners?: models.ResourceFieldOwner[];
 }
 
 export const IndividualDiffSection = (props: IndividualDiffSectionProps) => {
-    const {file, showPath, whiteBox, viewType} = props;
+    const {file, showPath, whiteBox, viewType, fieldOwners} = props;
     const [collapsed, setCollapsed] = useState(false);
     const options = {
         highlight: false,
@@ -34,6 +36,32 @@ export const IndividualDiffSection = (props: IndividualDiffSectionProps) => {
                     {(hunks: any) => hunks.map((hunk: any) => <Hunk className={'custom-diff-hunk'} key={hunk.content} hunk={hunk} />)}
                 </Diff>
             )}
+            {!collapsed && fieldOwners && fieldOwners.length > 0 && (
+                <div className='argo-table-list application-resources-diff__field-owners'>
+                    <div className='argo-table-list__head'>
+                        <div className='row'>
+                            <div className='columns small-6'>FIELD</div>
+                            <div className='columns small-3'>MANAGER</div>
+                            <div className='columns small-1'>OPERATION</div>
+                            <div className='columns small-2'>SUBRESOURCE</div>
+                        </div>
+                    </div>
+                    {fieldOwners.map((owner, i) => (
+                        <div className='argo-table-list__row' key={i}>
+                            <div className='row'>
+                                <div className='columns small-6' title={owner.path}>
+                                    {owner.path}
+                                </div>
+                                <div className='columns small-3' title={owner.manager}>
+                                    {owner.manager || '<none>'}
+                                </div>
+                                <div className='columns small-1'>{owner.operation}</div>
+                                <div className='columns small-2'>{owner.subresource}</div>
+                            </div>
+                        </div>
+                    ))}
+                </div>
+            )}
         </div>
     );
 };
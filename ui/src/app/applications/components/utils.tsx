@@ -1221,6 +1221,8 @@ const getOperationStateTitle = (app: appModels.Application) => {
                     return 'Sync OK';
                 case 'Terminating':
                     return 'Terminated';
+                case 'WaitingForApproval':
+                    return 'Waiting for approval';
             }
     }
     return 'Unknown';
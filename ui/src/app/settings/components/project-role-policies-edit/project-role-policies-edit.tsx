@@ -19,7 +19,7 @@ function generatePolicy(project: string, role: string, resource?: string, action
     return `p, proj:${project}:${role}, ${resource || ''}, ${action || ''}, ${object ? project + '/' + object : ''}, ${permission || ''}`;
 }
 
-const actions = ['get', 'create', 'update', 'delete', 'sync', 'override'];
+const actions = ['get', 'create', 'update', 'delete', 'sync', 'override', 'approve'];
 
 export const ProjectRolePoliciesEdit = (props: ProjectRolePoliciesProps) => (
     <DataLoader load={() => services.applications.list([props.projName], {fields: ['items.metadata.name']}).then(list => list.items)}>
@@ -63,7 +63,7 @@ export interface Operation {
     initiatedBy: OperationInitiator;
 }
 
-export type OperationPhase = 'Running' | 'Error' | 'Failed' | 'Succeeded' | 'Terminating' | 'Progressing' | 'Pending' | 'Waiting';
+export type OperationPhase = 'Running' | 'Error' | 'Failed' | 'Succeeded' | 'Terminating' | 'Progressing' | 'Pending' | 'Waiting' | 'WaitingForApproval';
 
 export const OperationPhases = {
     Running: 'Running' as OperationPhase,
@@ -73,7 +73,8 @@ export const OperationPhases = {
     Terminating: 'Terminating' as OperationPhase,
     Progressing: 'Progressing' as OperationPhase,
     Pending: 'Pending' as OperationPhase,
-    Waiting: 'Waiting' as OperationPhase
+    Waiting: 'Waiting' as OperationPhase,
+    WaitingForApproval: 'WaitingForApproval' as OperationPhase
 };
 
 /**
@@ -86,6 +87,34 @@ export interface OperationState {
     syncResult: SyncOperationResult;
     startedAt: models.Time;
     finishedAt: models.Time;
+    pendingApproval?: SyncWaveApproval;
+    approvals?: SyncWaveApproval[];
+    analysis?: SyncAnalysisStatus;
+    rollback?: OperationRollbackStatus;
+}
+
+export interface OperationRollbackStatus {
+    healthyAt?: models.Time;
+}
+
+export interface SyncAnalysisStatus {
+    phase: OperationPhase;
+    message?: string;
+    startedAt: models.Time;
+    checkedAt?: models.Time;
+    results?: SyncAnalysisResult[];
+}
+
+export interface SyncAnalysisResult {
+    name: string;
+    value: string;
+}
+
+export interface SyncWaveApproval {
+    phase: string;
+    wave: number;
+    approvedBy?: string;
+    approvedAt?: models.Time;
 }
 
 export type HookType = 'PreSync' | 'Sync' | 'PostSync' | 'SyncFail' | 'Skip';
@@ -117,6 +146,22 @@ export interface SyncOperationResult {
     resources: ResourceResult[];
     revision: string;
     revisions: string[];
+    plan?: SyncPlanResource[];
+}
+
+export type SyncAction = 'Create' | 'Patch' | 'Replace' | 'Prune' | 'Hook';
+
+export interface SyncPlanResource {
+    group: string;
+    version: string;
+    kind: string;
+    namespace: string;
+    name: string;
+    action: SyncAction;
+    syncPhase?: string;
+    syncWave?: number;
+    hookType?: HookType;
+    diff?: string;
 }
 
 export type ResultCode = 'Synced' | 'SyncFailed' | 'Pruned' | 'PruneSkipped';
@@ -233,21 +278,38 @@ export interface SourceHydrator {
     drySource: DrySource;
     syncSource: SyncSource;
     hydrateTo?: HydrateTo;
+    additionalDrySources?: DrySource[];
 }
 
 export interface DrySource {
     repoURL: string;
     targetRevision: string;
     path: string;
+    helm?: ApplicationSourceHelm;
+    kustomize?: ApplicationSourceKustomize;
+    plugin?: ApplicationSourcePlugin;
+    directory?: ApplicationSourceDirectory;
+    ref?: string;
 }
 
 export interface SyncSource {
     targetBranch: string;
     path: string;
+    repoURL?: string;
+    layout?: 'Single' | 'PerResource' | 'PerKind';
+    disableReadme?: boolean;
 }
 
 export interface HydrateTo {
     targetBranch: string;
+    pullRequest?: HydrateToPullRequest;
+    repoURL?: string;
+}
+
+export interface HydrateToPullRequest {
+    provider: string;
+    api?: string;
+    labels?: string[];
 }
 
 export interface ApplicationSourceHelm {
@@ -311,6 +373,41 @@ export interface SyncPolicy {
     automated?: Automated;
     syncOptions?: string[];
     retry?: RetryStrategy;
+    rollback?: SyncPolicyRollback;
+    analysis?: SyncPolicyAnalysis;
+    operationQueue?: SyncPolicyOperationQueue;
+    hooks?: SyncPolicyHook[];
+}
+
+export interface SyncPolicyHook {
+    name: string;
+    type: HookType;
+    image: string;
+    command?: string[];
+    args?: string[];
+    env?: EnvEntry[];
+    serviceAccount?: string;
+}
+
+export interface SyncPolicyOperationQueue {
+    limit?: number;
+}
+
+export interface SyncPolicyRollback {
+    healthTimeout?: string;
+}
+
+export interface SyncPolicyAnalysis {
+    duration: string;
+    interval?: string;
+    metrics: SyncAnalysisMetric[];
+}
+
+export interface SyncAnalysisMetric {
+    name: string;
+    query: string;
+    min?: string;
+    max?: string;
 }
 
 export interface Info {
@@ -328,6 +425,21 @@ export interface ApplicationSpec {
     ignoreDifferences?: ResourceIgnoreDifferences[];
     info?: Info[];
     revisionHistoryLimit?: number;
+    dependsOn?: ApplicationDependency[];
+}
+
+export interface ApplicationDependency {
+    name: string;
+    namespace?: string;
+}
+
+export interface ApplicationDependencyNode {
+    name: string;
+    namespace: string;
+    syncStatus?: string;
+    healthStatus?: string;
+    dependsOn?: ApplicationDependency[];
+    missing?: boolean;
 }
 
 export interface ResourceIgnoreDifferences {
@@ -469,6 +581,14 @@ export interface ResourceDiff extends ResourceID {
     predictedLiveState: State;
     normalizedLiveState: State;
     hook: boolean;
+    fieldOwners?: ResourceFieldOwner[];
+}
+
+export interface ResourceFieldOwner {
+    path: string;
+    manager?: string;
+    operation?: string;
+    subresource?: string;
 }
 
 export interface SyncStatus {
@@ -499,11 +619,26 @@ export interface ApplicationStatus {
     operationState?: OperationState;
     summary?: ApplicationSummary;
     sourceHydrator?: SourceHydratorStatus;
+    operationQueue?: QueuedOperation[];
+}
+
+export interface QueuedOperation {
+    id: string;
+    operation: Operation;
+    queuedAt: models.Time;
 }
 
 export interface SourceHydratorStatus {
     lastSuccessfulOperation?: SuccessfulHydrateOperation;
     currentOperation?: HydrateOperation;
+    pullRequest?: HydratorPullRequestStatus;
+}
+
+export interface HydratorPullRequestStatus {
+    number: number;
+    url?: string;
+    state: string;
+    hydratedSHA?: string;
 }
 
 export interface HydrateOperation {
@@ -514,12 +649,14 @@ export interface HydrateOperation {
     drySHA: string;
     hydratedSHA: string;
     sourceHydrator: SourceHydrator;
+    additionalDrySHAs?: string[];
 }
 
 export interface SuccessfulHydrateOperation {
     drySHA: string;
     hydratedSHA: string;
     sourceHydrator: SourceHydrator;
+    additionalDrySHAs?: string[];
 }
 
 export type HydrateOperationPhase = 'Hydrating' | 'Failed' | 'Hydrated';
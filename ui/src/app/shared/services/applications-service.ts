@@ -436,6 +436,28 @@ export class ApplicationsService {
             .then(() => true);
     }
 
+    public approveOperation(applicationName: string, appNamespace: string): Promise<boolean> {
+        return requests
+            .post(`/applications/${applicationName}/operation/approve`)
+            .send({appNamespace})
+            .then(() => true);
+    }
+
+    public dependencyGraph(applicationName: string, appNamespace: string): Promise<models.ApplicationDependencyNode[]> {
+        return requests
+            .get(`/applications/${applicationName}/dependencies`)
+            .query({appNamespace})
+            .then(res => (res.body.nodes || []) as models.ApplicationDependencyNode[]);
+    }
+
+    public cancelQueuedOperation(applicationName: string, appNamespace: string, id: string): Promise<boolean> {
+        return requests
+            .delete(`/applications/${applicationName}/operation/queue/${id}`)
+            .query({appNamespace})
+            .send()
+            .then(() => true);
+    }
+
     public getLinks(applicationName: string, namespace: string): Promise<models.LinksResponse> {
         return requests
             .get(`/applications/${applicationName}/links`)
@@ -0,0 +1,146 @@
+package analysis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// MetricsClient runs metric queries
+type MetricsClient interface {
+	// Query runs the query and returns its single value
+	Query(ctx context.Context, query string) (float64, error)
+}
+
+// maxResponseSize is the maximum size of a query response. The queries return a single value, so a larger response is
+// rejected instead of being read into memory.
+const maxResponseSize = 1024 * 1024
+
+type prometheusClient struct {
+	address    string
+	httpClient *http.Client
+}
+
+// NewPrometheusClient returns a MetricsClient which runs PromQL queries against the Prometheus compatible HTTP API at
+// the given address
+func NewPrometheusClient(address string, httpClient *http.Client) MetricsClient {
+	return &prometheusClient{address: strings.TrimSuffix(address, "/"), httpClient: httpClient}
+}
+
+type prometheusResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+type prometheusSample struct {
+	Value []any `json:"value"`
+}
+
+func (c *prometheusClient) Query(ctx context.Context, query string) (float64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/api/v1/query?query=%s", c.address, url.QueryEscape(query)), http.NoBody)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query metrics: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize+1))
+	if err != nil {
+		return 0, fmt.Errorf("failed to read response: %w", err)
+	}
+	if len(body) > maxResponseSize {
+		return 0, fmt.Errorf("response exceeds the maximum size of %d bytes", maxResponseSize)
+	}
+
+	var res prometheusResponse
+	if err := json.Unmarshal(body, &res); err != nil {
+		return 0, fmt.Errorf("failed to parse response with status %d: %w", resp.StatusCode, err)
+	}
+	if res.Status != "success" {
+		return 0, fmt.Errorf("query failed: %s", res.Error)
+	}
+
+	var value []any
+	switch res.Data.ResultType {
+	case "scalar":
+		if err := json.Unmarshal(res.Data.Result, &value); err != nil {
+			return 0, fmt.Errorf("failed to parse scalar result: %w", err)
+		}
+	case "vector":
+		var samples []prometheusSample
+		if err := json.Unmarshal(res.Data.Result, &samples); err != nil {
+			return 0, fmt.Errorf("failed to parse vector result: %w", err)
+		}
+		if len(samples) != 1 {
+			return 0, fmt.Errorf("query returned %d series, expected a single value", len(samples))
+		}
+		value = samples[0].Value
+	default:
+		return 0, fmt.Errorf("unsupported result type %q, expected a scalar or a vector", res.Data.ResultType)
+	}
+	// values are a [<unix time>, "<value>"] pair
+	if len(value) != 2 {
+		return 0, errors.New("query returned an invalid value")
+	}
+	text, ok := value[1].(string)
+	if !ok {
+		return 0, errors.New("query returned an invalid value")
+	}
+	return strconv.ParseFloat(text, 64)
+}
+
+// Analyze queries the metrics and checks that their values are within their range. It returns the values of the
+// metrics, and a message describing the first metric which is out of its range, if any.
+func Analyze(ctx context.Context, client MetricsClient, metrics []v1alpha1.SyncAnalysisMetric) ([]v1alpha1.SyncAnalysisResult, string, error) {
+	results := make([]v1alpha1.SyncAnalysisResult, 0, len(metrics))
+	violation := ""
+	for _, metric := range metrics {
+		value, err := client.Query(ctx, metric.Query)
+		if err != nil {
+			return results, "", fmt.Errorf("failed to query metric %s: %w", metric.Name, err)
+		}
+		results = append(results, v1alpha1.SyncAnalysisResult{Name: metric.Name, Value: strconv.FormatFloat(value, 'g', -1, 64)})
+		if violation != "" {
+			continue
+		}
+		// NaN, e.g. the result of 0/0 for an error ratio without traffic, is neither below nor above any limit
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			violation = fmt.Sprintf("metric %s is %g, expected a finite number", metric.Name, value)
+			continue
+		}
+		if metric.Min != "" {
+			limit, err := strconv.ParseFloat(metric.Min, 64)
+			if err != nil {
+				return results, "", fmt.Errorf("invalid minimum %q of metric %s: %w", metric.Min, metric.Name, err)
+			}
+			if value < limit {
+				violation = fmt.Sprintf("metric %s is %g, below the minimum of %s", metric.Name, value, metric.Min)
+			}
+		}
+		if metric.Max != "" {
+			limit, err := strconv.ParseFloat(metric.Max, 64)
+			if err != nil {
+				return results, "", fmt.Errorf("invalid maximum %q of metric %s: %w", metric.Max, metric.Name, err)
+			}
+			if value > limit {
+				violation = fmt.Sprintf("metric %s is %g, above the maximum of %s", metric.Name, value, metric.Max)
+			}
+		}
+	}
+	return results, violation, nil
+}
@@ -0,0 +1,148 @@
+package analysis
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newPrometheusServer(t *testing.T, responses map[string]string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/query", r.URL.Path)
+		response, ok := responses[r.URL.Query().Get("query")]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"status":"error","error":"unknown query"}`))
+			return
+		}
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestPrometheusClient_Query(t *testing.T) {
+	server := newPrometheusServer(t, map[string]string{
+		"vector":   `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"0.25"]}]}}`,
+		"scalar":   `{"status":"success","data":{"resultType":"scalar","result":[1700000000,"3"]}}`,
+		"empty":    `{"status":"success","data":{"resultType":"vector","result":[]}}`,
+		"multiple": `{"status":"success","data":{"resultType":"vector","result":[{"value":[1700000000,"1"]},{"value":[1700000000,"2"]}]}}`,
+		"matrix":   `{"status":"success","data":{"resultType":"matrix","result":[]}}`,
+		"nan":      `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"NaN"]}]}}`,
+		"large":    `{"status":"success","data":{"resultType":"scalar","result":[1700000000,"3"]},"padding":"` + strings.Repeat("x", maxResponseSize) + `"}`,
+	})
+	client := NewPrometheusClient(server.URL+"/", server.Client())
+
+	t.Run("Vector", func(t *testing.T) {
+		value, err := client.Query(t.Context(), "vector")
+		require.NoError(t, err)
+		assert.InDelta(t, 0.25, value, 0.0001)
+	})
+	t.Run("Scalar", func(t *testing.T) {
+		value, err := client.Query(t.Context(), "scalar")
+		require.NoError(t, err)
+		assert.InDelta(t, 3, value, 0.0001)
+	})
+	t.Run("NoData", func(t *testing.T) {
+		_, err := client.Query(t.Context(), "empty")
+		require.EqualError(t, err, "query returned 0 series, expected a single value")
+	})
+	t.Run("MultipleSeries", func(t *testing.T) {
+		_, err := client.Query(t.Context(), "multiple")
+		require.EqualError(t, err, "query returned 2 series, expected a single value")
+	})
+	t.Run("UnsupportedResultType", func(t *testing.T) {
+		_, err := client.Query(t.Context(), "matrix")
+		require.EqualError(t, err, `unsupported result type "matrix", expected a scalar or a vector`)
+	})
+	t.Run("NaN", func(t *testing.T) {
+		value, err := client.Query(t.Context(), "nan")
+		require.NoError(t, err)
+		assert.True(t, math.IsNaN(value))
+	})
+	t.Run("ResponseTooLarge", func(t *testing.T) {
+		_, err := client.Query(t.Context(), "large")
+		require.EqualError(t, err, "response exceeds the maximum size of 1048576 bytes")
+	})
+	t.Run("QueryError", func(t *testing.T) {
+		_, err := client.Query(t.Context(), "unknown")
+		require.EqualError(t, err, "query failed: unknown query")
+	})
+}
+
+type fakeMetricsClient map[string]float64
+
+func (c fakeMetricsClient) Query(_ context.Context, query string) (float64, error) {
+	value, ok := c[query]
+	if !ok {
+		return 0, errors.New("no data")
+	}
+	return value, nil
+}
+
+func TestAnalyze(t *testing.T) {
+	client := fakeMetricsClient{"error_rate": 0.02, "requests": 120, "no_traffic_error_rate": math.NaN(), "infinite": math.Inf(1)}
+
+	t.Run("WithinRange", func(t *testing.T) {
+		results, violation, err := Analyze(t.Context(), client, []v1alpha1.SyncAnalysisMetric{
+			{Name: "errors", Query: "error_rate", Max: "0.05"},
+			{Name: "traffic", Query: "requests", Min: "100"},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, violation)
+		assert.Equal(t, []v1alpha1.SyncAnalysisResult{{Name: "errors", Value: "0.02"}, {Name: "traffic", Value: "120"}}, results)
+	})
+	t.Run("AboveMaximum", func(t *testing.T) {
+		results, violation, err := Analyze(t.Context(), client, []v1alpha1.SyncAnalysisMetric{
+			{Name: "errors", Query: "error_rate", Max: "0.01"},
+			{Name: "traffic", Query: "requests", Min: "100"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "metric errors is 0.02, above the maximum of 0.01", violation)
+		assert.Len(t, results, 2)
+	})
+	t.Run("BelowMinimum", func(t *testing.T) {
+		_, violation, err := Analyze(t.Context(), client, []v1alpha1.SyncAnalysisMetric{
+			{Name: "traffic", Query: "requests", Min: "200"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "metric traffic is 120, below the minimum of 200", violation)
+	})
+	t.Run("NaN", func(t *testing.T) {
+		results, violation, err := Analyze(t.Context(), client, []v1alpha1.SyncAnalysisMetric{
+			{Name: "errors", Query: "no_traffic_error_rate", Min: "0", Max: "0.01"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "metric errors is NaN, expected a finite number", violation)
+		assert.Equal(t, []v1alpha1.SyncAnalysisResult{{Name: "errors", Value: "NaN"}}, results)
+	})
+	t.Run("Infinity", func(t *testing.T) {
+		_, violation, err := Analyze(t.Context(), client, []v1alpha1.SyncAnalysisMetric{
+			{Name: "latency", Query: "infinite", Min: "0"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "metric latency is +Inf, expected a finite number", violation)
+	})
+	t.Run("QueryError", func(t *testing.T) {
+		_, _, err := Analyze(t.Context(), client, []v1alpha1.SyncAnalysisMetric{
+			{Name: "latency", Query: "latency"},
+		})
+		require.EqualError(t, err, "failed to query metric latency: no data")
+	})
+	t.Run("InvalidLimit", func(t *testing.T) {
+		_, _, err := Analyze(t.Context(), client, []v1alpha1.SyncAnalysisMetric{
+			{Name: "errors", Query: "error_rate", Max: "five"},
+		})
+		require.ErrorContains(t, err, `invalid maximum "five" of metric errors`)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -99,6 +100,32 @@ func CheckOutOfBoundsSymlinks(basePath string) error {
 
 // GetAppRefreshPaths returns the list of paths that should trigger a refresh for an application
 func GetAppRefreshPaths(app *v1alpha1.Application) []string {
+	return getRefreshPaths(app, app.Spec.GetSources())
+}
+
+// GetSourceHydratorRefreshPaths returns the list of paths that should trigger a hydration for an application using the
+// source hydrator. Relative paths are resolved against the paths of the dry sources instead of the sync source. If the
+// annotation is set, the paths of the dry sources are always included, so that an annotation that only lists the
+// hydrated path for the app controller does not prevent hydration.
+func GetSourceHydratorRefreshPaths(app *v1alpha1.Application) []string {
+	if app.Spec.SourceHydrator == nil {
+		return nil
+	}
+	drySources := app.Spec.SourceHydrator.GetDrySources()
+	paths := getRefreshPaths(app, drySources)
+	if len(paths) == 0 {
+		return nil
+	}
+	for _, source := range drySources {
+		if source.Path != "" {
+			paths = append(paths, filepath.Clean(source.Path))
+		}
+	}
+	slices.Sort(paths)
+	return slices.Compact(paths)
+}
+
+func getRefreshPaths(app *v1alpha1.Application, sources v1alpha1.ApplicationSources) []string {
 	var paths []string
 	if val, ok := app.Annotations[v1alpha1.AnnotationKeyManifestGeneratePaths]; ok && val != "" {
 		for _, item := range strings.Split(val, ";") {
@@ -108,7 +135,7 @@ func GetAppRefreshPaths(app *v1alpha1.Application) []string {
 			if filepath.IsAbs(item) {
 				paths = append(paths, item[1:])
 			} else {
-				for _, source := range app.Spec.GetSources() {
+				for _, source := range sources {
 					paths = append(paths, filepath.Clean(filepath.Join(source.Path, item)))
 				}
 			}
@@ -218,3 +218,43 @@ func Test_GetAppRefreshPaths(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetSourceHydratorRefreshPaths(t *testing.T) {
+	t.Parallel()
+
+	getHydratorApp := func(annotation string) *v1alpha1.Application {
+		return &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					v1alpha1.AnnotationKeyManifestGeneratePaths: annotation,
+				},
+			},
+			Spec: v1alpha1.ApplicationSpec{
+				SourceHydrator: &v1alpha1.SourceHydrator{
+					DrySource:            v1alpha1.DrySource{Path: "dry/app"},
+					SyncSource:           v1alpha1.SyncSource{Path: "hydrated/app"},
+					AdditionalDrySources: []v1alpha1.DrySource{{Path: "dry/extra"}},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		app           *v1alpha1.Application
+		expectedPaths []string
+	}{
+		{"no source hydrator", getApp(".", "source/path"), nil},
+		{"relative path", getHydratorApp("."), []string{"dry/app", "dry/extra"}},
+		{"absolute path", getHydratorApp("/shared"), []string{"dry/app", "dry/extra", "shared"}},
+		{"no annotation", getHydratorApp(""), nil},
+		{"relative and absolute paths", getHydratorApp(".;/shared"), []string{"dry/app", "dry/extra", "shared"}},
+	}
+	for _, tt := range tests {
+		ttc := tt
+		t.Run(ttc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.ElementsMatch(t, ttc.expectedPaths, GetSourceHydratorRefreshPaths(ttc.app), "GetSourceHydratorRefreshPaths()")
+		})
+	}
+}
@@ -32,7 +32,9 @@ import (
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/glob"
+	hydratorutil "github.com/argoproj/argo-cd/v3/util/hydrator"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/rand"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
@@ -480,10 +482,10 @@ func validateRepo(ctx context.Context,
 		}
 	}
 
-	// If using the source hydrator, check the dry source instead of the sync source, since the sync source branch may
+	// If using the source hydrator, check the dry sources instead of the sync source, since the sync source branch may
 	// not exist yet.
 	if app.Spec.SourceHydrator != nil {
-		sources = []argoappv1.ApplicationSource{app.Spec.SourceHydrator.GetDrySource()}
+		sources = app.Spec.SourceHydrator.GetDrySources()
 	}
 
 	refSources, err := GetRefSources(ctx, sources, app.Spec.Project, db.GetRepository, []string{})
@@ -597,6 +599,14 @@ func validateSourceHydrator(hydrator *argoappv1.SourceHydrator) []argoappv1.Appl
 			Message: "spec.sourceHydrator.drySource.repoURL is required",
 		})
 	}
+	for i, drySource := range hydrator.AdditionalDrySources {
+		if drySource.RepoURL == "" || (drySource.Path == "" && drySource.Ref == "") {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.sourceHydrator.additionalDrySources[%d].repoURL and either path or ref are required", i),
+			})
+		}
+	}
 	if hydrator.SyncSource.TargetBranch == "" {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
 			Type:    argoappv1.ApplicationConditionInvalidSpecError,
@@ -609,6 +619,36 @@ func validateSourceHydrator(hydrator *argoappv1.SourceHydrator) []argoappv1.Appl
 			Message: "when spec.sourceHydrator.hydrateTo is set, spec.sourceHydrator.hydrateTo.path is required",
 		})
 	}
+	// The repository root is never cleared before the hydrated manifests are written, so files written by a previous
+	// hydration, e.g. of a deleted resource, would be left behind unless they are overwritten.
+	if hydratorutil.IsRootPath(hydrator.SyncSource.Path) {
+		if layout := hydrator.SyncSource.Layout; layout != "" && layout != argoappv1.HydratedManifestsLayoutSingle {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.sourceHydrator.syncSource.layout %s is not supported when spec.sourceHydrator.syncSource.path is the repository root", layout),
+			})
+		}
+		if hydrator.SyncSource.DisableReadme {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: "spec.sourceHydrator.syncSource.disableReadme is not supported when spec.sourceHydrator.syncSource.path is the repository root",
+			})
+		}
+	}
+	if strings.HasPrefix(hydrator.SyncSource.RepoURL, "s3://") {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: "spec.sourceHydrator.syncSource.repoURL cannot be an S3 bucket, since Argo CD cannot sync from it",
+		})
+	}
+	if hydrator.HydrateTo != nil && hydrator.HydrateTo.PullRequest != nil {
+		if repoURL := hydrator.GetHydrateToRepoURL(); strings.HasPrefix(repoURL, "oci://") || strings.HasPrefix(repoURL, "s3://") {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.sourceHydrator.hydrateTo.pullRequest requires a git repository, but hydrated manifests are pushed to %s", repoURL),
+			})
+		}
+	}
 	return conditions
 }
 
@@ -623,11 +663,21 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 			conditions = append(conditions, condition...)
 			return conditions, nil
 		}
-		if !proj.IsSourcePermitted(spec.SourceHydrator.GetDrySource()) {
-			conditions = append(conditions, argoappv1.ApplicationCondition{
-				Type:    argoappv1.ApplicationConditionInvalidSpecError,
-				Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", spec.SourceHydrator.GetDrySource().RepoURL, proj.Name),
-			})
+		sources := spec.SourceHydrator.GetDrySources()
+		// Hydrated manifests may be pushed to and synced from repositories other than the dry source repository.
+		sources = append(sources, spec.SourceHydrator.GetSyncSource(), spec.GetHydrateToSource())
+		checked := map[string]bool{}
+		for _, source := range sources {
+			if checked[source.RepoURL] {
+				continue
+			}
+			checked[source.RepoURL] = true
+			if !proj.IsSourcePermitted(source) {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", source.RepoURL, proj.Name),
+				})
+			}
 		}
 	case spec.HasMultipleSources():
 		for _, source := range spec.Sources {
@@ -801,6 +851,9 @@ func verifyGenerateManifests(
 		return conditions // Can't perform the next check without settings.
 	}
 
+	// A source hydrator with additional dry sources is rendered like a multi-source app.
+	hasMultipleSources := app.Spec.HasMultipleSources() || (app.Spec.SourceHydrator != nil && len(sources) > 1)
+
 	for _, source := range sources {
 		repoRes, err := db.GetRepository(ctx, source.RepoURL, proj.Name)
 		if err != nil {
@@ -877,7 +930,7 @@ func verifyGenerateManifests(
 			TrackingMethod:                  trackingMethod,
 			EnabledSourceTypes:              enableGenerateManifests,
 			NoRevisionCache:                 true,
-			HasMultipleSources:              app.Spec.HasMultipleSources(),
+			HasMultipleSources:              hasMultipleSources,
 			RefSources:                      refSources,
 			ProjectName:                     proj.Name,
 			ProjectSourceRepos:              proj.Spec.SourceRepos,
@@ -910,7 +963,8 @@ func SetAppOperation(appIf v1alpha1.ApplicationInterface, appName string, op *ar
 			return nil, fmt.Errorf("error getting application %q: %w", appName, err)
 		}
 		a = a.DeepCopy()
-		if a.Operation != nil {
+		// Queued operations are started before any new operation, so the application is busy until they are drained.
+		if a.Operation != nil || len(a.Status.OperationQueue) > 0 {
 			return nil, ErrAnotherOperationInProgress
 		}
 		a.Operation = op
@@ -929,6 +983,76 @@ func SetAppOperation(appIf v1alpha1.ApplicationInterface, appName string, op *ar
 	}
 }
 
+// SetOrQueueAppOperation sets the operation of the application like SetAppOperation. If another operation is in
+// progress or operations are already queued, and the sync policy of the application enables the operation queue, the
+// operation is queued instead. It returns whether the operation was queued.
+func SetOrQueueAppOperation(appIf v1alpha1.ApplicationInterface, appName string, op *argoappv1.Operation) (*argoappv1.Application, bool, error) {
+	if op.Sync == nil {
+		return nil, false, status.Errorf(codes.InvalidArgument, "Operation unspecified")
+	}
+	for {
+		a, err := appIf.Get(context.Background(), appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, false, fmt.Errorf("error getting application %q: %w", appName, err)
+		}
+		a = a.DeepCopy()
+		// The operation is queued behind any queued operations, even if the previous operation just completed and the
+		// controller has not started the next queued operation yet.
+		queued := a.Operation != nil || len(a.Status.OperationQueue) > 0
+		if queued {
+			if a.Spec.SyncPolicy == nil || a.Spec.SyncPolicy.OperationQueue == nil {
+				return nil, false, ErrAnotherOperationInProgress
+			}
+			if limit := a.Spec.SyncPolicy.OperationQueue.GetLimit(); len(a.Status.OperationQueue) >= limit {
+				return nil, false, status.Errorf(codes.FailedPrecondition, "another operation is already in progress and the operation queue is full (limit %d)", limit)
+			}
+			id, err := rand.RandHex(8)
+			if err != nil {
+				return nil, false, fmt.Errorf("error generating queued operation ID: %w", err)
+			}
+			a.Status.OperationQueue = append(a.Status.OperationQueue, argoappv1.QueuedOperation{ID: id, Operation: *op, QueuedAt: metav1.Now()})
+		} else {
+			a.Operation = op
+			a.Status.OperationState = nil
+		}
+		a, err = appIf.Update(context.Background(), a, metav1.UpdateOptions{})
+		if err == nil {
+			return a, queued, nil
+		}
+		if !apierrors.IsConflict(err) {
+			return nil, false, fmt.Errorf("error updating application %q: %w", appName, err)
+		}
+		log.Warnf("Failed to set operation for app '%s' due to update conflict. Retrying again...", appName)
+	}
+}
+
+// StartQueuedAppOperation starts the first operation of the operation queue of the application, if no operation is in
+// progress. It returns the started operation, or nil if no operation was started.
+func StartQueuedAppOperation(appIf v1alpha1.ApplicationInterface, appName string) (*argoappv1.Application, *argoappv1.QueuedOperation, error) {
+	for {
+		a, err := appIf.Get(context.Background(), appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting application %q: %w", appName, err)
+		}
+		if a.Operation != nil || len(a.Status.OperationQueue) == 0 {
+			return a, nil, nil
+		}
+		a = a.DeepCopy()
+		next := a.Status.OperationQueue[0]
+		a.Operation = &next.Operation
+		a.Status.OperationQueue = a.Status.OperationQueue[1:]
+		a.Status.OperationState = nil
+		a, err = appIf.Update(context.Background(), a, metav1.UpdateOptions{})
+		if err == nil {
+			return a, &next, nil
+		}
+		if !apierrors.IsConflict(err) {
+			return nil, nil, fmt.Errorf("error updating application %q: %w", appName, err)
+		}
+		log.Warnf("Failed to start queued operation for app '%s' due to update conflict. Retrying again...", appName)
+	}
+}
+
 // ContainsSyncResource determines if the given resource exists in the provided slice of sync operation resources.
 func ContainsSyncResource(name string, namespace string, gvk schema.GroupVersionKind, rr []argoappv1.SyncOperationResource) bool {
 	for _, r := range rr {
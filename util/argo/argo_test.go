@@ -304,6 +304,90 @@ func TestValidatePermissionsEmptyDestination(t *testing.T) {
 	assert.ElementsMatch(t, conditions, []argoappv1.ApplicationCondition{{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "Destination server missing from app spec"}})
 }
 
+func TestValidatePermissionsSourceHydratorRepos(t *testing.T) {
+	proj := &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos:  []string{"https://github.com/argoproj/*", "oci://registry.example.com/*"},
+			Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}
+	newSpec := func() *argoappv1.ApplicationSpec {
+		return &argoappv1.ApplicationSpec{
+			SourceHydrator: &argoappv1.SourceHydrator{
+				DrySource: argoappv1.DrySource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook", TargetRevision: "HEAD"},
+				SyncSource: argoappv1.SyncSource{
+					RepoURL:      "oci://registry.example.com/hydrated",
+					TargetBranch: "environments-dev",
+					Path:         "guestbook",
+				},
+			},
+		}
+	}
+	messages := func(conditions []argoappv1.ApplicationCondition) []string {
+		var m []string
+		for _, c := range conditions {
+			m = append(m, c.Message)
+		}
+		return m
+	}
+
+	t.Run("permitted OCI sync repo", func(t *testing.T) {
+		conditions, err := ValidatePermissions(t.Context(), newSpec(), proj, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Destination server missing from app spec"}, messages(conditions))
+	})
+
+	t.Run("sync repo not permitted", func(t *testing.T) {
+		spec := newSpec()
+		spec.SourceHydrator.SyncSource.RepoURL = "oci://other.example.com/hydrated"
+		conditions, err := ValidatePermissions(t.Context(), spec, proj, nil)
+		require.NoError(t, err)
+		assert.Contains(t, messages(conditions), "application repo oci://other.example.com/hydrated is not permitted in project 'default'")
+	})
+
+	t.Run("S3 sync repo", func(t *testing.T) {
+		spec := newSpec()
+		spec.SourceHydrator.SyncSource.RepoURL = "s3://hydrated/guestbook"
+		conditions, err := ValidatePermissions(t.Context(), spec, proj, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"spec.sourceHydrator.syncSource.repoURL cannot be an S3 bucket, since Argo CD cannot sync from it"}, messages(conditions))
+	})
+
+	t.Run("PerResource layout at the repository root", func(t *testing.T) {
+		spec := newSpec()
+		spec.SourceHydrator.SyncSource.Path = "./"
+		spec.SourceHydrator.SyncSource.Layout = argoappv1.HydratedManifestsLayoutPerResource
+		spec.SourceHydrator.SyncSource.DisableReadme = true
+		conditions, err := ValidatePermissions(t.Context(), spec, proj, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"spec.sourceHydrator.syncSource.layout PerResource is not supported when spec.sourceHydrator.syncSource.path is the repository root",
+			"spec.sourceHydrator.syncSource.disableReadme is not supported when spec.sourceHydrator.syncSource.path is the repository root",
+		}, messages(conditions))
+	})
+
+	t.Run("PerResource layout in a directory", func(t *testing.T) {
+		spec := newSpec()
+		spec.SourceHydrator.SyncSource.Layout = argoappv1.HydratedManifestsLayoutPerResource
+		spec.SourceHydrator.SyncSource.DisableReadme = true
+		conditions, err := ValidatePermissions(t.Context(), spec, proj, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Destination server missing from app spec"}, messages(conditions))
+	})
+
+	t.Run("pull request to OCI repo", func(t *testing.T) {
+		spec := newSpec()
+		spec.SourceHydrator.HydrateTo = &argoappv1.HydrateTo{
+			TargetBranch: "environments-dev-next",
+			PullRequest:  &argoappv1.HydrateToPullRequest{Provider: argoappv1.HydrateToPullRequestProviderGitHub},
+		}
+		conditions, err := ValidatePermissions(t.Context(), spec, proj, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"spec.sourceHydrator.hydrateTo.pullRequest requires a git repository, but hydrated manifests are pushed to oci://registry.example.com/hydrated"}, messages(conditions))
+	})
+}
+
 func TestValidateChartWithoutRevision(t *testing.T) {
 	appSpec := &argoappv1.ApplicationSpec{
 		Source: &argoappv1.ApplicationSource{RepoURL: "https://charts.helm.sh/incubator/", Chart: "myChart", TargetRevision: ""},
@@ -972,6 +1056,20 @@ func TestSetAppOperations(t *testing.T) {
 		assert.Nil(t, app)
 	})
 
+	t.Run("Queued operations pending", func(t *testing.T) {
+		a := argoappv1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "someapp",
+				Namespace: "default",
+			},
+			Status: argoappv1.ApplicationStatus{OperationQueue: []argoappv1.QueuedOperation{{ID: "first"}}},
+		}
+		appIf := appclientset.NewSimpleClientset(&a).ArgoprojV1alpha1().Applications("default")
+		app, err := SetAppOperation(appIf, "someapp", &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "aaa"}})
+		require.ErrorIs(t, err, ErrAnotherOperationInProgress)
+		assert.Nil(t, app)
+	})
+
 	t.Run("Operation unspecified", func(t *testing.T) {
 		a := argoappv1.Application{
 			ObjectMeta: metav1.ObjectMeta{
@@ -999,6 +1097,109 @@ func TestSetAppOperations(t *testing.T) {
 	})
 }
 
+func TestSetOrQueueAppOperation(t *testing.T) {
+	newApp := func(queue *argoappv1.SyncPolicyOperationQueue, queued int) *argoappv1.Application {
+		a := &argoappv1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "someapp",
+				Namespace: "default",
+			},
+			Spec:      argoappv1.ApplicationSpec{SyncPolicy: &argoappv1.SyncPolicy{OperationQueue: queue}},
+			Operation: &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "aaa"}},
+		}
+		for i := 0; i < queued; i++ {
+			a.Status.OperationQueue = append(a.Status.OperationQueue, argoappv1.QueuedOperation{ID: fmt.Sprintf("op-%d", i)})
+		}
+		return a
+	}
+	op := &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "bbb"}}
+
+	t.Run("Queue disabled", func(t *testing.T) {
+		appIf := appclientset.NewSimpleClientset(newApp(nil, 0)).ArgoprojV1alpha1().Applications("default")
+		_, _, err := SetOrQueueAppOperation(appIf, "someapp", op)
+		require.ErrorContains(t, err, "operation is already in progress")
+	})
+
+	t.Run("No operation in progress", func(t *testing.T) {
+		a := newApp(&argoappv1.SyncPolicyOperationQueue{}, 0)
+		a.Operation = nil
+		appIf := appclientset.NewSimpleClientset(a).ArgoprojV1alpha1().Applications("default")
+		app, queued, err := SetOrQueueAppOperation(appIf, "someapp", op)
+		require.NoError(t, err)
+		assert.False(t, queued)
+		assert.Equal(t, op, app.Operation)
+		assert.Empty(t, app.Status.OperationQueue)
+	})
+
+	t.Run("Queued", func(t *testing.T) {
+		appIf := appclientset.NewSimpleClientset(newApp(&argoappv1.SyncPolicyOperationQueue{}, 1)).ArgoprojV1alpha1().Applications("default")
+		app, queued, err := SetOrQueueAppOperation(appIf, "someapp", op)
+		require.NoError(t, err)
+		assert.True(t, queued)
+		assert.Equal(t, "aaa", app.Operation.Sync.Revision)
+		require.Len(t, app.Status.OperationQueue, 2)
+		assert.Equal(t, *op, app.Status.OperationQueue[1].Operation)
+		assert.NotEmpty(t, app.Status.OperationQueue[1].ID)
+	})
+
+	t.Run("Queued behind pending queued operations", func(t *testing.T) {
+		a := newApp(&argoappv1.SyncPolicyOperationQueue{}, 1)
+		a.Operation = nil
+		appIf := appclientset.NewSimpleClientset(a).ArgoprojV1alpha1().Applications("default")
+		app, queued, err := SetOrQueueAppOperation(appIf, "someapp", op)
+		require.NoError(t, err)
+		assert.True(t, queued)
+		assert.Nil(t, app.Operation)
+		require.Len(t, app.Status.OperationQueue, 2)
+		assert.Equal(t, "op-0", app.Status.OperationQueue[0].ID)
+		assert.Equal(t, *op, app.Status.OperationQueue[1].Operation)
+	})
+
+	t.Run("Queue full", func(t *testing.T) {
+		appIf := appclientset.NewSimpleClientset(newApp(&argoappv1.SyncPolicyOperationQueue{Limit: 2}, 2)).ArgoprojV1alpha1().Applications("default")
+		_, _, err := SetOrQueueAppOperation(appIf, "someapp", op)
+		require.ErrorContains(t, err, "the operation queue is full (limit 2)")
+	})
+}
+
+func TestStartQueuedAppOperation(t *testing.T) {
+	queue := []argoappv1.QueuedOperation{
+		{ID: "first", Operation: argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "bbb"}}},
+		{ID: "second", Operation: argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "ccc"}}},
+	}
+
+	t.Run("Operation in progress", func(t *testing.T) {
+		a := &argoappv1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "someapp", Namespace: "default"},
+			Operation:  &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "aaa"}},
+			Status:     argoappv1.ApplicationStatus{OperationQueue: queue},
+		}
+		appIf := appclientset.NewSimpleClientset(a).ArgoprojV1alpha1().Applications("default")
+		app, started, err := StartQueuedAppOperation(appIf, "someapp")
+		require.NoError(t, err)
+		assert.Nil(t, started)
+		assert.Len(t, app.Status.OperationQueue, 2)
+	})
+
+	t.Run("Started", func(t *testing.T) {
+		a := &argoappv1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "someapp", Namespace: "default"},
+			Status: argoappv1.ApplicationStatus{
+				OperationQueue: queue,
+				OperationState: &argoappv1.OperationState{Phase: common.OperationSucceeded},
+			},
+		}
+		appIf := appclientset.NewSimpleClientset(a).ArgoprojV1alpha1().Applications("default")
+		app, started, err := StartQueuedAppOperation(appIf, "someapp")
+		require.NoError(t, err)
+		require.NotNil(t, started)
+		assert.Equal(t, "first", started.ID)
+		assert.Equal(t, "bbb", app.Operation.Sync.Revision)
+		assert.Nil(t, app.Status.OperationState)
+		assert.Equal(t, []argoappv1.QueuedOperation{queue[1]}, app.Status.OperationQueue)
+	})
+}
+
 func TestGetDestinationCluster(t *testing.T) {
 	t.Run("Validate destination with server url", func(t *testing.T) {
 		dest := argoappv1.ApplicationDestination{
@@ -0,0 +1,84 @@
+package argo
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applicationsv1 "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
+)
+
+// IsDependencyMet returns whether the application is Synced and Healthy, so that the applications which depend on it
+// can be synced
+func IsDependencyMet(app *argoappv1.Application) bool {
+	return app.Status.Sync.Status == argoappv1.SyncStatusCodeSynced && app.Status.Health.Status == health.HealthStatusHealthy
+}
+
+// GetUnmetDependencies returns messages describing the dependencies of the application which are not Synced and
+// Healthy, and the dependency cycles the application is part of. Only applications of the same project can be
+// dependencies, so that the messages do not disclose the status of applications outside the project.
+func GetUnmetDependencies(app *argoappv1.Application, appLister applicationsv1.ApplicationLister) []string {
+	var messages []string
+	for _, dep := range app.Spec.DependsOn {
+		namespace := dep.GetNamespace(app.Namespace)
+		depApp, err := getDependency(app, dep, appLister)
+		switch {
+		case apierrors.IsNotFound(err):
+			messages = append(messages, fmt.Sprintf("application %s/%s does not exist in project %s", namespace, dep.Name, app.Spec.GetProject()))
+		case err != nil:
+			messages = append(messages, fmt.Sprintf("failed to get application %s/%s: %v", namespace, dep.Name, err))
+		case !IsDependencyMet(depApp):
+			messages = append(messages, fmt.Sprintf("application %s/%s is %s and %s", namespace, dep.Name, depApp.Status.Sync.Status, depApp.Status.Health.Status))
+		}
+	}
+	if cycle := findDependencyCycle(app, appLister); len(cycle) > 0 {
+		messages = append(messages, "dependency cycle: "+strings.Join(cycle, " -> "))
+	}
+	return messages
+}
+
+// getDependency returns the application of the dependency of the given application. A not found error is returned if
+// the application does not exist or belongs to another project.
+func getDependency(app *argoappv1.Application, dep argoappv1.ApplicationDependency, appLister applicationsv1.ApplicationLister) (*argoappv1.Application, error) {
+	depApp, err := appLister.Applications(dep.GetNamespace(app.Namespace)).Get(dep.Name)
+	if err != nil {
+		return nil, err
+	}
+	if depApp.Spec.GetProject() != app.Spec.GetProject() {
+		return nil, apierrors.NewNotFound(argoappv1.Resource(application.ApplicationPlural), dep.Name)
+	}
+	return depApp, nil
+}
+
+// findDependencyCycle returns the qualified names of the applications of a dependency cycle which starts and ends
+// with the application, if any
+func findDependencyCycle(app *argoappv1.Application, appLister applicationsv1.ApplicationLister) []string {
+	start := app.Namespace + "/" + app.Name
+	visited := map[string]bool{}
+	var visit func(current *argoappv1.Application, path []string) []string
+	visit = func(current *argoappv1.Application, path []string) []string {
+		for _, dep := range current.Spec.DependsOn {
+			key := dep.GetNamespace(current.Namespace) + "/" + dep.Name
+			if key == start {
+				return append(path, key)
+			}
+			if visited[key] {
+				continue
+			}
+			visited[key] = true
+			depApp, err := getDependency(current, dep, appLister)
+			if err != nil {
+				continue
+			}
+			if cycle := visit(depApp, append(path, key)); cycle != nil {
+				return cycle
+			}
+		}
+		return nil
+	}
+	return visit(app, []string{start})
+}
@@ -0,0 +1,78 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applicationsv1 "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
+)
+
+func newDependencyApp(namespace, name string, sync argoappv1.SyncStatusCode, healthStatus health.HealthStatusCode, dependsOn ...argoappv1.ApplicationDependency) *argoappv1.Application {
+	return &argoappv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       argoappv1.ApplicationSpec{Project: "default", DependsOn: dependsOn},
+		Status: argoappv1.ApplicationStatus{
+			Sync:   argoappv1.SyncStatus{Status: sync},
+			Health: argoappv1.AppHealthStatus{Status: healthStatus},
+		},
+	}
+}
+
+func newDependencyLister(t *testing.T, apps ...*argoappv1.Application) applicationsv1.ApplicationLister {
+	t.Helper()
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, app := range apps {
+		require.NoError(t, indexer.Add(app))
+	}
+	return applicationsv1.NewApplicationLister(indexer)
+}
+
+func TestGetUnmetDependencies(t *testing.T) {
+	crds := newDependencyApp("argocd", "crds", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+	operators := newDependencyApp("platform", "operators", argoappv1.SyncStatusCodeOutOfSync, health.HealthStatusProgressing)
+
+	t.Run("NoDependencies", func(t *testing.T) {
+		app := newDependencyApp("argocd", "tenants", argoappv1.SyncStatusCodeOutOfSync, health.HealthStatusMissing)
+		assert.Empty(t, GetUnmetDependencies(app, newDependencyLister(t, crds)))
+	})
+
+	t.Run("Met", func(t *testing.T) {
+		app := newDependencyApp("argocd", "tenants", argoappv1.SyncStatusCodeOutOfSync, health.HealthStatusMissing,
+			argoappv1.ApplicationDependency{Name: "crds"})
+		assert.Empty(t, GetUnmetDependencies(app, newDependencyLister(t, crds, operators)))
+	})
+
+	t.Run("Unmet", func(t *testing.T) {
+		app := newDependencyApp("argocd", "tenants", argoappv1.SyncStatusCodeOutOfSync, health.HealthStatusMissing,
+			argoappv1.ApplicationDependency{Name: "crds"},
+			argoappv1.ApplicationDependency{Name: "operators", Namespace: "platform"},
+			argoappv1.ApplicationDependency{Name: "monitoring"})
+		assert.Equal(t, []string{
+			"application platform/operators is OutOfSync and Progressing",
+			"application argocd/monitoring does not exist in project default",
+		}, GetUnmetDependencies(app, newDependencyLister(t, crds, operators)))
+	})
+
+	t.Run("OtherProject", func(t *testing.T) {
+		other := newDependencyApp("argocd", "other", argoappv1.SyncStatusCodeOutOfSync, health.HealthStatusDegraded, argoappv1.ApplicationDependency{Name: "tenants"})
+		other.Spec.Project = "other"
+		app := newDependencyApp("argocd", "tenants", argoappv1.SyncStatusCodeOutOfSync, health.HealthStatusMissing,
+			argoappv1.ApplicationDependency{Name: "other"})
+		assert.Equal(t, []string{
+			"application argocd/other does not exist in project default",
+		}, GetUnmetDependencies(app, newDependencyLister(t, app, other)))
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		a := newDependencyApp("argocd", "a", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy, argoappv1.ApplicationDependency{Name: "b"})
+		b := newDependencyApp("argocd", "b", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy, argoappv1.ApplicationDependency{Name: "c"})
+		c := newDependencyApp("argocd", "c", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy, argoappv1.ApplicationDependency{Name: "a"})
+		assert.Equal(t, []string{"dependency cycle: argocd/a -> argocd/b -> argocd/c -> argocd/a"}, GetUnmetDependencies(a, newDependencyLister(t, a, b, c)))
+	})
+}
@@ -295,6 +295,28 @@ func StateDiff(live, config *unstructured.Unstructured, diffConfig DiffConfig) (
 	return results.Diffs[0], nil
 }
 
+// FieldOwners returns the field managers which own the fields of the live resource which differ between the
+// normalized and the predicted live states of the given diff result.
+func FieldOwners(live *unstructured.Unstructured, res *diff.DiffResult, gvkParser *k8smanagedfields.GvkParser) ([]v1alpha1.ResourceFieldOwner, error) {
+	owners, err := diff.FieldOwners(live, res, gvkParser)
+	if err != nil {
+		return nil, fmt.Errorf("error getting field owners: %w", err)
+	}
+	if len(owners) == 0 {
+		return nil, nil
+	}
+	result := make([]v1alpha1.ResourceFieldOwner, len(owners))
+	for i, owner := range owners {
+		result[i] = v1alpha1.ResourceFieldOwner{
+			Path:        owner.Path,
+			Manager:     owner.Manager,
+			Operation:   owner.Operation,
+			Subresource: owner.Subresource,
+		}
+	}
+	return result, nil
+}
+
 // StateDiffs will apply all required normalizations and calculate the diffs between
 // the live and the config/desired states.
 func StateDiffs(lives, configs []*unstructured.Unstructured, diffConfig DiffConfig) (*diff.DiffResultList, error) {
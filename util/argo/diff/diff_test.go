@@ -3,6 +3,7 @@ package diff_test
 import (
 	"testing"
 
+	"github.com/argoproj/gitops-engine/pkg/diff"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -151,6 +152,28 @@ func TestStateDiff(t *testing.T) {
 	}
 }
 
+func TestFieldOwners(t *testing.T) {
+	diffConfig, err := argo.NewDiffConfigBuilder().
+		WithDiffSettings(nil, nil, true, normalizers.IgnoreNormalizerOpts{}).
+		WithTracking("", "").
+		WithNoCache().
+		Build()
+	require.NoError(t, err)
+	live := testutil.YamlToUnstructured(testdata.LiveDeploymentWithManagedReplicaYaml)
+	result, err := argo.StateDiff(live, testutil.YamlToUnstructured(testdata.DesiredDeploymentYaml), diffConfig)
+	require.NoError(t, err)
+	require.True(t, result.Modified)
+
+	owners, err := argo.FieldOwners(live, &result, nil)
+	require.NoError(t, err)
+	assert.Contains(t, owners, v1alpha1.ResourceFieldOwner{Path: ".spec.replicas", Manager: "kube-controller-manager", Operation: "Update"})
+	assert.Contains(t, owners, v1alpha1.ResourceFieldOwner{Path: ".spec.revisionHistoryLimit", Manager: "revision-history-manager", Operation: "Apply"})
+
+	owners, err = argo.FieldOwners(live, &diff.DiffResult{}, nil)
+	require.NoError(t, err)
+	assert.Nil(t, owners)
+}
+
 func TestDiffConfigBuilder(t *testing.T) {
 	type fixture struct {
 		ignores        []v1alpha1.ResourceIgnoreDifferences
@@ -0,0 +1,171 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/util/argo/jsondiff"
+)
+
+// PatchOperation is a JSON patch operation as defined in RFC 6902
+type PatchOperation struct {
+	// Op is the operation, one of add, remove or replace
+	Op string `json:"op"`
+	// Path is the JSON pointer of the field the operation applies to
+	Path string `json:"path"`
+	// Value is the JSON encoded value of add and replace operations
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// JSONPatch returns the JSON patch operations which transform the live state into the target state. Lists of different
+// lengths are replaced as a whole and the managed fields are skipped.
+func JSONPatch(live, target *unstructured.Unstructured) ([]PatchOperation, error) {
+	var ops []PatchOperation
+	err := jsondiff.Walk(live.Object, target.Object, func(op, pointer string, value any) error {
+		if op == jsondiff.OpRemove {
+			ops = append(ops, PatchOperation{Op: op, Path: pointer})
+			return nil
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("error marshaling value of %s: %w", pointer, err)
+		}
+		ops = append(ops, PatchOperation{Op: op, Path: pointer, Value: data})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ops, nil
+}
+
+var (
+	// quantityMapFields holds the paths of the fields whose values are maps of quantities, e.g. the resource limits
+	// of a container or the capacity of a persistent volume
+	quantityMapFields = [][]string{
+		{"resources", "limits"},
+		{"resources", "requests"},
+		{"capacity"},
+		{"allocatable"},
+		{"hard"},
+		{"used"},
+	}
+	// quantityFields holds the names of the fields whose values are quantities
+	quantityFields = []string{"sizeLimit"}
+	// durationFields holds the names of the fields whose values are commonly durations, e.g. the interval and timeout
+	// of Flux resources or the renewBefore of cert-manager certificates
+	durationFields = []string{
+		"duration",
+		"evaluationInterval",
+		"interval",
+		"renewBefore",
+		"retryInterval",
+		"scrapeInterval",
+		"scrapeTimeout",
+		"timeout",
+	}
+)
+
+// NormalizeEquivalentValues replaces the values of the target state which are equivalent to the values of the live
+// state with the live values. Values of quantity fields, e.g. resources.limits, are equivalent if they are equal
+// quantities, e.g. 1000m and 1. Values of duration fields, e.g. timeout, are equivalent if they are equal durations,
+// e.g. 60s and 1m. Values of different types, e.g. the string "1" and the number 1, are never equivalent.
+func NormalizeEquivalentValues(live, target *unstructured.Unstructured) {
+	if live == nil || target == nil {
+		return
+	}
+	normalizeEquivalentValues(nil, live.Object, target.Object)
+}
+
+// normalizeEquivalentValues normalizes the target value at the given path of field names. The items of a list have
+// the path of the list.
+func normalizeEquivalentValues(path []string, live, target any) any {
+	switch l := live.(type) {
+	case map[string]any:
+		if t, ok := target.(map[string]any); ok {
+			for k, tv := range t {
+				if lv, ok := l[k]; ok {
+					t[k] = normalizeEquivalentValues(append(path[:len(path):len(path)], k), lv, tv)
+				}
+			}
+		}
+	case []any:
+		if t, ok := target.([]any); ok && len(l) == len(t) {
+			for i := range t {
+				t[i] = normalizeEquivalentValues(path, l[i], t[i])
+			}
+		}
+	default:
+		if equivalentValues(path, live, target) {
+			return live
+		}
+	}
+	return target
+}
+
+func equivalentValues(path []string, a, b any) bool {
+	as, aString, ok := scalarString(a)
+	if !ok {
+		return false
+	}
+	bs, bString, ok := scalarString(b)
+	if !ok || aString != bString {
+		return false
+	}
+	switch {
+	case isQuantityField(path):
+		aq, err := resource.ParseQuantity(as)
+		if err != nil {
+			return false
+		}
+		bq, err := resource.ParseQuantity(bs)
+		return err == nil && aq.Cmp(bq) == 0
+	case isDurationField(path) && aString:
+		ad, err := time.ParseDuration(as)
+		if err != nil {
+			return false
+		}
+		bd, err := time.ParseDuration(bs)
+		return err == nil && ad == bd
+	}
+	return false
+}
+
+func isQuantityField(path []string) bool {
+	if len(path) == 0 {
+		return false
+	}
+	if slices.Contains(quantityFields, path[len(path)-1]) {
+		return true
+	}
+	parent := path[:len(path)-1]
+	for _, field := range quantityMapFields {
+		if len(parent) >= len(field) && slices.Equal(parent[len(parent)-len(field):], field) {
+			return true
+		}
+	}
+	return false
+}
+
+func isDurationField(path []string) bool {
+	return len(path) > 0 && slices.Contains(durationFields, path[len(path)-1])
+}
+
+// scalarString returns the string representation of a string or number, and whether the value is a string
+func scalarString(v any) (string, bool, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true, true
+	case int64:
+		return strconv.FormatInt(v, 10), false, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), false, true
+	}
+	return "", false, false
+}
@@ -0,0 +1,142 @@
+package diff_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/util/argo/diff"
+)
+
+func TestJSONPatch(t *testing.T) {
+	live := &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{
+			"name":   "guestbook",
+			"labels": map[string]any{"app": "guestbook", "team/owner": "a"},
+		},
+		"spec": map[string]any{
+			"replicas": int64(1),
+			"paused":   true,
+			"ports":    []any{int64(80), int64(443)},
+			"args":     []any{"a"},
+		},
+	}}
+	target := &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{
+			"name":   "guestbook",
+			"labels": map[string]any{"app": "guestbook"},
+		},
+		"spec": map[string]any{
+			"replicas": int64(2),
+			"paused":   false,
+			"ports":    []any{int64(80), int64(8443)},
+			"args":     []any{"a", "b"},
+			"selector": map[string]any{"app": "guestbook"},
+		},
+	}}
+
+	ops, err := diff.JSONPatch(live, target)
+	require.NoError(t, err)
+	assert.Equal(t, []diff.PatchOperation{
+		{Op: "remove", Path: "/metadata/labels/team~1owner"},
+		{Op: "replace", Path: "/spec/args", Value: json.RawMessage(`["a","b"]`)},
+		{Op: "replace", Path: "/spec/paused", Value: json.RawMessage(`false`)},
+		{Op: "replace", Path: "/spec/ports/1", Value: json.RawMessage(`8443`)},
+		{Op: "replace", Path: "/spec/replicas", Value: json.RawMessage(`2`)},
+		{Op: "add", Path: "/spec/selector", Value: json.RawMessage(`{"app":"guestbook"}`)},
+	}, ops)
+
+	ops, err = diff.JSONPatch(live, live)
+	require.NoError(t, err)
+	assert.Empty(t, ops)
+
+	live.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "kubectl"}})
+	ops, err = diff.JSONPatch(live, live.DeepCopy())
+	require.NoError(t, err)
+	assert.Empty(t, ops)
+	ops, err = diff.JSONPatch(live, &unstructured.Unstructured{Object: map[string]any{"metadata": map[string]any{}}})
+	require.NoError(t, err)
+	assert.Equal(t, []diff.PatchOperation{
+		{Op: "remove", Path: "/metadata/labels"},
+		{Op: "remove", Path: "/metadata/name"},
+		{Op: "remove", Path: "/spec"},
+	}, ops)
+}
+
+func TestNormalizeEquivalentValues(t *testing.T) {
+	live := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"containers": []any{map[string]any{
+				"resources": map[string]any{
+					"limits":   map[string]any{"cpu": "1000m", "memory": "1Gi"},
+					"requests": map[string]any{"cpu": "500m", "memory": "512Mi"},
+				},
+			}},
+			"capacity": map[string]any{"storage": "1Gi"},
+			"timeout":  "60s",
+			"interval": "1m",
+			"image":    "nginx:1.0",
+		},
+	}}
+	target := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"containers": []any{map[string]any{
+				"resources": map[string]any{
+					"limits":   map[string]any{"cpu": "1", "memory": "1024Mi"},
+					"requests": map[string]any{"cpu": "0.5", "memory": "1Gi"},
+				},
+			}},
+			"capacity": map[string]any{"storage": "1024Mi"},
+			"timeout":  "1m",
+			"interval": "2m",
+			"image":    "nginx:1",
+		},
+	}}
+
+	diff.NormalizeEquivalentValues(live, target)
+	assert.Equal(t, map[string]any{
+		"spec": map[string]any{
+			"containers": []any{map[string]any{
+				"resources": map[string]any{
+					"limits":   map[string]any{"cpu": "1000m", "memory": "1Gi"},
+					"requests": map[string]any{"cpu": "500m", "memory": "1Gi"},
+				},
+			}},
+			"capacity": map[string]any{"storage": "1Gi"},
+			"timeout":  "60s",
+			"interval": "2m",
+			"image":    "nginx:1",
+		},
+	}, target.Object)
+}
+
+func TestNormalizeEquivalentValues_NotEquivalent(t *testing.T) {
+	live := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"version":   "1.10",
+			"mode":      "0100",
+			"replicas":  int64(1),
+			"resources": map[string]any{"limits": map[string]any{"cpu": "1"}},
+			"timeout":   "60",
+			"args":      []any{"60s"},
+		},
+	}}
+	target := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"version":   "1.1",
+			"mode":      "100",
+			"replicas":  "1",
+			"resources": map[string]any{"limits": map[string]any{"cpu": int64(1)}},
+			"timeout":   int64(60),
+			"args":      []any{"1m"},
+		},
+	}}
+	expected := target.DeepCopy().Object
+
+	diff.NormalizeEquivalentValues(live, target)
+	assert.Equal(t, expected, target.Object)
+}
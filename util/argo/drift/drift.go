@@ -0,0 +1,300 @@
+package drift
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo/jsondiff"
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
+)
+
+const (
+	// maxRecords is the maximum number of records which are kept; the records with the least observations are dropped
+	maxRecords = 1000
+	// maxRecordApps is the maximum number of applications which are tracked per record
+	maxRecordApps = 50
+	// maxShards is the maximum number of controller shards whose records are read
+	maxShards = 1024
+)
+
+// Record holds the evidence that a field of a resource kind keeps differing between the desired and the live state
+type Record struct {
+	// Group is the API group of the resources
+	Group string `json:"group,omitempty"`
+	// Kind is the kind of the resources
+	Kind string `json:"kind"`
+	// JSONPointer is the JSON pointer of the differing field
+	JSONPointer string `json:"jsonPointer"`
+	// Observations is the number of reconciliations in which the field differed
+	Observations int64 `json:"observations"`
+	// Apps holds the applications in which the field differed, capped at maxRecordApps
+	Apps []string `json:"apps,omitempty"`
+	// Managers holds the field managers which owned the field in the live resources
+	Managers []string `json:"managers,omitempty"`
+	// LastObserved is the time the field was last observed to differ
+	LastObserved time.Time `json:"lastObserved"`
+}
+
+// IgnoreDifferences returns the ignoreDifferences rule which ignores the differing field
+func (r *Record) IgnoreDifferences() v1alpha1.ResourceIgnoreDifferences {
+	return v1alpha1.ResourceIgnoreDifferences{Group: r.Group, Kind: r.Kind, JSONPointers: []string{r.JSONPointer}}
+}
+
+type recordKey struct {
+	group       string
+	kind        string
+	jsonPointer string
+}
+
+func (r *Record) key() recordKey {
+	return recordKey{group: r.Group, kind: r.Kind, jsonPointer: r.JSONPointer}
+}
+
+// merge adds the evidence of the other record to the record
+func (r *Record) merge(other *Record) {
+	r.Observations += other.Observations
+	for _, app := range other.Apps {
+		if len(r.Apps) < maxRecordApps && !slices.Contains(r.Apps, app) {
+			r.Apps = append(r.Apps, app)
+		}
+	}
+	for _, manager := range other.Managers {
+		if !slices.Contains(r.Managers, manager) {
+			r.Managers = append(r.Managers, manager)
+		}
+	}
+	slices.Sort(r.Apps)
+	slices.Sort(r.Managers)
+	if other.LastObserved.After(r.LastObserved) {
+		r.LastObserved = other.LastObserved
+	}
+}
+
+// Store persists the records of the analyzer. The records of every controller shard are stored separately, so that
+// the shards do not overwrite each other's records.
+type Store interface {
+	StoreReader
+	SetDriftRecords(shard int, records []*Record) error
+}
+
+// StoreReader reads the records persisted by the analyzer
+type StoreReader interface {
+	GetDriftRecords(shard int, res *[]*Record) error
+}
+
+// Analyzer tracks which fields of which resource kinds keep differing between the desired and the live state across
+// reconciliations and applications. Such fields are typically set by mutating webhooks or controllers and are
+// candidates for ignoreDifferences rules.
+type Analyzer struct {
+	lock    sync.Mutex
+	pending map[recordKey]*Record
+	now     func() time.Time
+}
+
+// NewAnalyzer returns a new drift analyzer
+func NewAnalyzer() *Analyzer {
+	return &Analyzer{pending: make(map[recordKey]*Record), now: time.Now}
+}
+
+// Observe records the fields which differ in the given resource diffs of an application
+func (a *Analyzer) Observe(appName string, diffs []*v1alpha1.ResourceDiff) {
+	now := a.now()
+	var observed []*Record
+	for _, d := range diffs {
+		if d == nil || !d.Modified || d.Hook {
+			continue
+		}
+		pointers, err := DiffPointers(d.NormalizedLiveState, d.PredictedLiveState)
+		if err != nil || len(pointers) == 0 {
+			continue
+		}
+		for _, pointer := range pointers {
+			observed = append(observed, &Record{
+				Group:        d.Group,
+				Kind:         d.Kind,
+				JSONPointer:  pointer,
+				Observations: 1,
+				Apps:         []string{appName},
+				Managers:     fieldManagers(d.FieldOwners, pointer),
+				LastObserved: now,
+			})
+		}
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	for _, r := range observed {
+		if existing, ok := a.pending[r.key()]; ok {
+			existing.merge(r)
+		} else if len(a.pending) < maxRecords {
+			a.pending[r.key()] = r
+		}
+	}
+}
+
+// Flush merges the pending observations into the records of the given controller shard persisted in the given store.
+// The records are written even if there are no pending observations, so that GetRecords finds the records of every
+// running shard.
+func (a *Analyzer) Flush(store Store, shard int) error {
+	a.lock.Lock()
+	pending := a.pending
+	a.pending = make(map[recordKey]*Record)
+	a.lock.Unlock()
+
+	var records []*Record
+	if err := store.GetDriftRecords(shard, &records); err != nil && !errors.Is(err, cacheutil.ErrCacheMiss) {
+		return fmt.Errorf("error getting drift records of shard %d: %w", shard, err)
+	}
+	records = mergeRecords(records, pending)
+	if err := store.SetDriftRecords(shard, records); err != nil {
+		return fmt.Errorf("error setting drift records of shard %d: %w", shard, err)
+	}
+	return nil
+}
+
+// GetRecords returns the records of all controller shards persisted in the given store. The shards are read in order
+// until the first shard without records.
+func GetRecords(store StoreReader) ([]*Record, error) {
+	var records []*Record
+	for shard := 0; shard < maxShards; shard++ {
+		var shardRecords []*Record
+		err := store.GetDriftRecords(shard, &shardRecords)
+		if errors.Is(err, cacheutil.ErrCacheMiss) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error getting drift records of shard %d: %w", shard, err)
+		}
+		byKey := make(map[recordKey]*Record, len(shardRecords))
+		for _, r := range shardRecords {
+			if existing, ok := byKey[r.key()]; ok {
+				existing.merge(r)
+			} else {
+				byKey[r.key()] = r
+			}
+		}
+		records = mergeRecords(records, byKey)
+	}
+	return records, nil
+}
+
+// mergeRecords merges the pending records into the given records and returns the records sorted by the number of
+// observations. Only the maxRecords records with the most observations are kept.
+func mergeRecords(records []*Record, pending map[recordKey]*Record) []*Record {
+	byKey := make(map[recordKey]*Record, len(records)+len(pending))
+	for _, r := range records {
+		byKey[r.key()] = r
+	}
+	for key, r := range pending {
+		if existing, ok := byKey[key]; ok {
+			existing.merge(r)
+		} else {
+			byKey[key] = r
+		}
+	}
+	merged := make([]*Record, 0, len(byKey))
+	for _, r := range byKey {
+		merged = append(merged, r)
+	}
+	sortRecords(merged)
+	if len(merged) > maxRecords {
+		merged = merged[:maxRecords]
+	}
+	return merged
+}
+
+// Suggest returns the records of the fields which differed in at least the given number of reconciliations and
+// applications, sorted by the number of observations
+func Suggest(records []*Record, minObservations int64, minApps int) []*Record {
+	var suggestions []*Record
+	for _, r := range records {
+		if r.Observations >= minObservations && len(r.Apps) >= minApps {
+			suggestions = append(suggestions, r)
+		}
+	}
+	sortRecords(suggestions)
+	return suggestions
+}
+
+// IgnoreDifferences returns the ignoreDifferences rules which ignore the fields of the given records, combined by
+// resource kind
+func IgnoreDifferences(records []*Record) []v1alpha1.ResourceIgnoreDifferences {
+	var rules []v1alpha1.ResourceIgnoreDifferences
+	indexes := make(map[recordKey]int)
+	for _, r := range records {
+		key := recordKey{group: r.Group, kind: r.Kind}
+		i, ok := indexes[key]
+		if !ok {
+			i = len(rules)
+			indexes[key] = i
+			rules = append(rules, v1alpha1.ResourceIgnoreDifferences{Group: r.Group, Kind: r.Kind})
+		}
+		if !slices.Contains(rules[i].JSONPointers, r.JSONPointer) {
+			rules[i].JSONPointers = append(rules[i].JSONPointers, r.JSONPointer)
+		}
+	}
+	for i := range rules {
+		slices.Sort(rules[i].JSONPointers)
+	}
+	return rules
+}
+
+func sortRecords(records []*Record) {
+	slices.SortFunc(records, func(a, b *Record) int {
+		return cmp.Or(
+			cmp.Compare(b.Observations, a.Observations),
+			cmp.Compare(a.Group, b.Group),
+			cmp.Compare(a.Kind, b.Kind),
+			cmp.Compare(a.JSONPointer, b.JSONPointer),
+		)
+	})
+}
+
+// DiffPointers returns the JSON pointers of the fields which differ between the given JSON documents. Lists of
+// different lengths are reported as a whole and the managed fields are skipped.
+func DiffPointers(liveJSON, predictedJSON string) ([]string, error) {
+	if liveJSON == "" || liveJSON == "null" || predictedJSON == "" || predictedJSON == "null" {
+		return nil, nil
+	}
+	var live, predicted any
+	if err := json.Unmarshal([]byte(liveJSON), &live); err != nil {
+		return nil, fmt.Errorf("error unmarshaling live state: %w", err)
+	}
+	if err := json.Unmarshal([]byte(predictedJSON), &predicted); err != nil {
+		return nil, fmt.Errorf("error unmarshaling predicted live state: %w", err)
+	}
+	var pointers []string
+	_ = jsondiff.Walk(live, predicted, func(_, pointer string, _ any) error {
+		pointers = append(pointers, pointer)
+		return nil
+	})
+	return pointers, nil
+}
+
+// fieldManagers returns the managers of the field with the given JSON pointer. Only fields which are not nested in
+// lists can be matched with the field owners.
+func fieldManagers(owners []v1alpha1.ResourceFieldOwner, pointer string) []string {
+	if len(owners) == 0 {
+		return nil
+	}
+	var managers []string
+	var path strings.Builder
+	for _, segment := range strings.Split(pointer, "/")[1:] {
+		path.WriteString(".")
+		path.WriteString(jsondiff.Unescape(segment))
+	}
+	for _, owner := range owners {
+		if owner.Path == path.String() && owner.Manager != "" && !slices.Contains(managers, owner.Manager) {
+			managers = append(managers, owner.Manager)
+		}
+	}
+	slices.Sort(managers)
+	return managers
+}
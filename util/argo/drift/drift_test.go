@@ -0,0 +1,137 @@
+package drift
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
+)
+
+type fakeStore struct {
+	records map[int][]*Record
+}
+
+func (s *fakeStore) GetDriftRecords(shard int, res *[]*Record) error {
+	records, ok := s.records[shard]
+	if !ok {
+		return cacheutil.ErrCacheMiss
+	}
+	*res = records
+	return nil
+}
+
+func (s *fakeStore) SetDriftRecords(shard int, records []*Record) error {
+	if s.records == nil {
+		s.records = make(map[int][]*Record)
+	}
+	s.records[shard] = records
+	return nil
+}
+
+func TestDiffPointers(t *testing.T) {
+	live := `{"metadata":{"annotations":{"sidecar.istio.io/status":"injected"},"managedFields":[{"manager":"a"}]},"spec":{"replicas":3,"template":{"spec":{"containers":[{"name":"app","image":"app:v2"}]}}}}`
+	predicted := `{"metadata":{"managedFields":[{"manager":"b"}]},"spec":{"replicas":1,"template":{"spec":{"containers":[{"name":"app","image":"app:v1"}]}}}}`
+
+	pointers, err := DiffPointers(live, predicted)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"/metadata/annotations",
+		"/spec/replicas",
+		"/spec/template/spec/containers/0/image",
+	}, pointers)
+
+	pointers, err = DiffPointers(`{"metadata":{"annotations":{"a/b":"1"}}}`, `{"metadata":{"annotations":{}}}`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/metadata/annotations/a~1b"}, pointers)
+
+	pointers, err = DiffPointers("null", predicted)
+	require.NoError(t, err)
+	assert.Empty(t, pointers)
+}
+
+func TestAnalyzer(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	analyzer := NewAnalyzer()
+	analyzer.now = func() time.Time { return now }
+	diff := &v1alpha1.ResourceDiff{
+		Group:               "apps",
+		Kind:                "Deployment",
+		Modified:            true,
+		NormalizedLiveState: `{"spec":{"replicas":3}}`,
+		PredictedLiveState:  `{"spec":{"replicas":1}}`,
+		FieldOwners:         []v1alpha1.ResourceFieldOwner{{Path: ".spec.replicas", Manager: "kube-controller-manager"}},
+	}
+	hook := &v1alpha1.ResourceDiff{Kind: "Job", Hook: true, Modified: true, NormalizedLiveState: `{"a":1}`, PredictedLiveState: `{"a":2}`}
+
+	store := &fakeStore{}
+	analyzer.Observe("guestbook", []*v1alpha1.ResourceDiff{diff, hook})
+	analyzer.Observe("guestbook", []*v1alpha1.ResourceDiff{diff})
+	require.NoError(t, analyzer.Flush(store, 0))
+	analyzer.Observe("helm-guestbook", []*v1alpha1.ResourceDiff{diff})
+	require.NoError(t, analyzer.Flush(store, 0))
+
+	records, err := GetRecords(store)
+	require.NoError(t, err)
+	assert.Equal(t, []*Record{{
+		Group:        "apps",
+		Kind:         "Deployment",
+		JSONPointer:  "/spec/replicas",
+		Observations: 3,
+		Apps:         []string{"guestbook", "helm-guestbook"},
+		Managers:     []string{"kube-controller-manager"},
+		LastObserved: now,
+	}}, records)
+
+	assert.Empty(t, Suggest(records, 4, 1))
+	assert.Empty(t, Suggest(records, 1, 3))
+	assert.Len(t, Suggest(records, 3, 2), 1)
+}
+
+func TestAnalyzer_Shards(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	diff := &v1alpha1.ResourceDiff{
+		Kind:                "Service",
+		Modified:            true,
+		NormalizedLiveState: `{"spec":{"clusterIP":"10.0.0.1"}}`,
+		PredictedLiveState:  `{"spec":{}}`,
+	}
+	store := &fakeStore{}
+	for shard, app := range []string{"guestbook", "helm-guestbook"} {
+		analyzer := NewAnalyzer()
+		analyzer.now = func() time.Time { return now }
+		analyzer.Observe(app, []*v1alpha1.ResourceDiff{diff})
+		require.NoError(t, analyzer.Flush(store, shard))
+		// shards without observations keep their records
+		require.NoError(t, analyzer.Flush(store, shard))
+	}
+	require.NoError(t, NewAnalyzer().Flush(store, 2))
+	assert.Len(t, store.records[0], 1)
+	assert.Len(t, store.records[1], 1)
+	assert.Empty(t, store.records[2])
+
+	records, err := GetRecords(store)
+	require.NoError(t, err)
+	assert.Equal(t, []*Record{{
+		Kind:         "Service",
+		JSONPointer:  "/spec/clusterIP",
+		Observations: 2,
+		Apps:         []string{"guestbook", "helm-guestbook"},
+		LastObserved: now,
+	}}, records)
+}
+
+func TestIgnoreDifferences(t *testing.T) {
+	rules := IgnoreDifferences([]*Record{
+		{Group: "apps", Kind: "Deployment", JSONPointer: "/spec/replicas"},
+		{Kind: "Service", JSONPointer: "/spec/clusterIP"},
+		{Group: "apps", Kind: "Deployment", JSONPointer: "/metadata/annotations"},
+	})
+	assert.Equal(t, []v1alpha1.ResourceIgnoreDifferences{
+		{Group: "apps", Kind: "Deployment", JSONPointers: []string{"/metadata/annotations", "/spec/replicas"}},
+		{Kind: "Service", JSONPointers: []string{"/spec/clusterIP"}},
+	}, rules)
+}
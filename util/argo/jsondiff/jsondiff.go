@@ -0,0 +1,100 @@
+package jsondiff
+
+import (
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// OpAdd is the operation of a field which is missing in the live document
+	OpAdd = "add"
+	// OpRemove is the operation of a field which is missing in the target document
+	OpRemove = "remove"
+	// OpReplace is the operation of a field whose value differs between the documents
+	OpReplace = "replace"
+)
+
+// managedFieldsPointer is the JSON pointer of the managed fields, which are owned by the API server and never compared
+const managedFieldsPointer = "/metadata/managedFields"
+
+// WalkFunc is called for every difference with the JSON patch operation, as defined in RFC 6902, which transforms the
+// live value at the given JSON pointer into the target value. The value is nil for remove operations.
+type WalkFunc func(op, pointer string, value any) error
+
+// Walk calls fn for every difference between the given decoded JSON documents in the order of the JSON pointers.
+// Lists of different lengths and values of different types are replaced as a whole. The managed fields are skipped.
+func Walk(live, target any, fn WalkFunc) error {
+	return walk("", live, target, fn)
+}
+
+func walk(pointer string, live, target any, fn WalkFunc) error {
+	if pointer == managedFieldsPointer {
+		return nil
+	}
+	switch l := live.(type) {
+	case map[string]any:
+		t, ok := target.(map[string]any)
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(l)+len(t))
+		for k := range l {
+			keys = append(keys, k)
+		}
+		for k := range t {
+			if _, ok := l[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			childPointer := pointer + "/" + Escape(k)
+			if childPointer == managedFieldsPointer {
+				continue
+			}
+			lv, lok := l[k]
+			tv, tok := t[k]
+			var err error
+			switch {
+			case !tok:
+				err = fn(OpRemove, childPointer, nil)
+			case !lok:
+				err = fn(OpAdd, childPointer, tv)
+			default:
+				err = walk(childPointer, lv, tv, fn)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case []any:
+		t, ok := target.([]any)
+		if !ok || len(l) != len(t) {
+			break
+		}
+		for i := range l {
+			if err := walk(pointer+"/"+strconv.Itoa(i), l[i], t[i], fn); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		if reflect.DeepEqual(live, target) {
+			return nil
+		}
+	}
+	return fn(OpReplace, pointer, target)
+}
+
+// Escape escapes a field name for use as a JSON pointer segment
+func Escape(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1")
+}
+
+// Unescape returns the field name of an escaped JSON pointer segment
+func Unescape(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~1", "/"), "~0", "~")
+}
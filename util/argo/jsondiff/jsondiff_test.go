@@ -0,0 +1,68 @@
+package jsondiff
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type difference struct {
+	op      string
+	pointer string
+	value   any
+}
+
+func TestWalk(t *testing.T) {
+	live := map[string]any{
+		"metadata": map[string]any{
+			"labels":        map[string]any{"app": "guestbook", "team/owner": "a", "a~b": "c"},
+			"managedFields": []any{map[string]any{"manager": "a"}},
+		},
+		"spec": map[string]any{
+			"replicas": int64(1),
+			"ports":    []any{int64(80), int64(443)},
+			"args":     []any{"a"},
+			"selector": "app=guestbook",
+		},
+	}
+	target := map[string]any{
+		"metadata": map[string]any{
+			"labels": map[string]any{"app": "guestbook", "a~b": "d"},
+		},
+		"spec": map[string]any{
+			"replicas": int64(2),
+			"ports":    []any{int64(80), int64(8443)},
+			"args":     []any{"a", "b"},
+			"selector": map[string]any{"app": "guestbook"},
+			"paused":   true,
+		},
+	}
+
+	var differences []difference
+	err := Walk(live, target, func(op, pointer string, value any) error {
+		differences = append(differences, difference{op, pointer, value})
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []difference{
+		{OpReplace, "/metadata/labels/a~0b", "d"},
+		{OpRemove, "/metadata/labels/team~1owner", nil},
+		{OpReplace, "/spec/args", []any{"a", "b"}},
+		{OpAdd, "/spec/paused", true},
+		{OpReplace, "/spec/ports/1", int64(8443)},
+		{OpReplace, "/spec/replicas", int64(2)},
+		{OpReplace, "/spec/selector", map[string]any{"app": "guestbook"}},
+	}, differences)
+
+	err = Walk(live, target, func(_, _ string, _ any) error {
+		return errors.New("stop")
+	})
+	require.EqualError(t, err, "stop")
+}
+
+func TestEscape(t *testing.T) {
+	assert.Equal(t, "a~1b~0c", Escape("a/b~c"))
+	assert.Equal(t, "a/b~c", Unescape(Escape("a/b~c")))
+}
@@ -121,6 +121,33 @@ func UnstructuredToAppInstanceValue(un *unstructured.Unstructured, appName, name
 	}
 }
 
+// IsResourceHandedOff returns whether the live resource, which is tracked by the owner application, is handed off to
+// the claimer application. The owner has to release the resource to the claimer with the release-to annotation and the
+// target resource of the claimer has to claim it from the owner with the claim-from annotation, so that an application
+// can neither take over nor give away a resource on its own. The applications are given by their instance names.
+func IsResourceHandedOff(target, live *unstructured.Unstructured, owner, claimer string) bool {
+	if target == nil || live == nil {
+		return false
+	}
+	claimFrom := target.GetAnnotations()[common.AnnotationKeyClaimFrom]
+	releaseTo := live.GetAnnotations()[common.AnnotationKeyReleaseTo]
+	return claimFrom != "" && claimFrom == handoffAppName(owner) && releaseTo != "" && releaseTo == handoffAppName(claimer)
+}
+
+// IsResourceReleased returns whether the target resource of an application was released to the application with the
+// given instance name, which means that the resource is no longer managed by the application once it is tracked by
+// the application it was released to.
+func IsResourceReleased(target *unstructured.Unstructured, trackedBy string) bool {
+	releaseTo := target.GetAnnotations()[common.AnnotationKeyReleaseTo]
+	return releaseTo != "" && releaseTo == handoffAppName(trackedBy)
+}
+
+// handoffAppName converts the instance name of an application to the name used in the handoff annotations, which is
+// the name of the application, qualified with its namespace if it is not in the control plane namespace.
+func handoffAppName(instanceName string) string {
+	return strings.ReplaceAll(instanceName, "_", "/")
+}
+
 // SetAppInstance set label/annotation base on tracking method
 func (rt *resourceTracking) SetAppInstance(un *unstructured.Unstructured, key, val, namespace string, trackingMethod v1alpha1.TrackingMethod, instanceID string) error {
 	setAppInstanceAnnotation := func() error {
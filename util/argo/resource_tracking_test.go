@@ -391,3 +391,32 @@ func TestResourceIdNormalizer_Normalize_ConfigHasOldLabel(t *testing.T) {
 func TestIsOldTrackingMethod(t *testing.T) {
 	assert.True(t, IsOldTrackingMethod(string(v1alpha1.TrackingMethodLabel)))
 }
+
+func TestIsResourceHandedOff(t *testing.T) {
+	newObj := func(annotations map[string]string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAnnotations(annotations)
+		return obj
+	}
+	live := newObj(map[string]string{common.AnnotationKeyReleaseTo: "team-b/app-b"})
+	target := newObj(map[string]string{common.AnnotationKeyClaimFrom: "app-a"})
+
+	assert.True(t, IsResourceHandedOff(target, live, "app-a", "team-b_app-b"))
+	// the claim and the release have to match both applications
+	assert.False(t, IsResourceHandedOff(target, live, "app-c", "team-b_app-b"))
+	assert.False(t, IsResourceHandedOff(target, live, "app-a", "app-b"))
+	// the resource has to be released by its owner
+	assert.False(t, IsResourceHandedOff(target, newObj(nil), "app-a", "team-b_app-b"))
+	// the resource has to be claimed by the application
+	assert.False(t, IsResourceHandedOff(newObj(nil), live, "app-a", "team-b_app-b"))
+	assert.False(t, IsResourceHandedOff(nil, live, "app-a", "team-b_app-b"))
+}
+
+func TestIsResourceReleased(t *testing.T) {
+	target := &unstructured.Unstructured{}
+	assert.False(t, IsResourceReleased(target, "app-b"))
+
+	target.SetAnnotations(map[string]string{common.AnnotationKeyReleaseTo: "team-b/app-b"})
+	assert.True(t, IsResourceReleased(target, "team-b_app-b"))
+	assert.False(t, IsResourceReleased(target, "app-b"))
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo/drift"
 	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
 	"github.com/argoproj/argo-cd/v3/util/env"
 )
@@ -19,7 +20,8 @@ var (
 )
 
 const (
-	clusterInfoCacheExpiration = 10 * time.Minute
+	clusterInfoCacheExpiration  = 10 * time.Minute
+	driftRecordsCacheExpiration = 7 * 24 * time.Hour
 )
 
 type Cache struct {
@@ -129,3 +131,15 @@ func (c *Cache) GetClusterInfo(server string, res *appv1.ClusterInfo) error {
 	err := c.GetItem(clusterInfoKey(server), &res)
 	return err
 }
+
+func driftRecordsKey(shard int) string {
+	return fmt.Sprintf("drift|records|%d", shard)
+}
+
+func (c *Cache) SetDriftRecords(shard int, records []*drift.Record) error {
+	return c.SetItem(driftRecordsKey(shard), records, driftRecordsCacheExpiration, records == nil)
+}
+
+func (c *Cache) GetDriftRecords(shard int, res *[]*drift.Record) error {
+	return c.GetItem(driftRecordsKey(shard), res)
+}
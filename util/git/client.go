@@ -136,6 +136,8 @@ type Client interface {
 	IsRevisionPresent(revision string) bool
 	// SetAuthor sets the author name and email in the git configuration.
 	SetAuthor(name, email string) (string, error)
+	// SetSigningKey configures the repository to sign commits with the given GnuPG key.
+	SetSigningKey(keyID string) (string, error)
 	// CheckoutOrOrphan checks out the branch. If the branch does not exist, it creates an orphan branch.
 	CheckoutOrOrphan(branch string, submoduleEnabled bool) (string, error)
 	// CheckoutOrNew checks out the given branch. If the branch does not exist, it creates an empty branch based on
@@ -177,6 +179,8 @@ type nativeGitClient struct {
 	noProxy string
 	// git configuration environment variables
 	gitConfigEnv []string
+	// GnuPG key ID used to sign commits, if any
+	signingKey string
 }
 
 type runOpts struct {
@@ -1016,6 +1020,29 @@ func (m *nativeGitClient) SetAuthor(name, email string) (string, error) {
 	return "", nil
 }
 
+// SetSigningKey configures the repository to sign all subsequent commits with the given GnuPG key. The private key
+// must be present in the keyring at the GnuPG home path.
+func (m *nativeGitClient) SetSigningKey(keyID string) (string, error) {
+	if keyID == "" {
+		return "", errors.New("signing key ID must not be empty")
+	}
+	ctx := context.Background()
+	out, err := m.config(ctx, "--local", "gpg.format", "openpgp")
+	if err != nil {
+		return out, err
+	}
+	out, err = m.config(ctx, "--local", "user.signingkey", keyID)
+	if err != nil {
+		return out, err
+	}
+	out, err = m.config(ctx, "--local", "commit.gpgsign", "true")
+	if err != nil {
+		return out, err
+	}
+	m.signingKey = keyID
+	return "", nil
+}
+
 // CheckoutOrOrphan checks out the branch. If the branch does not exist, it creates an orphan branch.
 func (m *nativeGitClient) CheckoutOrOrphan(branch string, submoduleEnabled bool) (string, error) {
 	out, err := m.Checkout(branch, submoduleEnabled)
@@ -1160,6 +1187,10 @@ func (m *nativeGitClient) runCmdOutput(cmd *exec.Cmd, ropts runOpts) (string, er
 	cmd.Env = append(cmd.Env, "GIT_TERMINAL_PROMPT=false")
 	// Add Git configuration options that are essential for ArgoCD operation
 	cmd.Env = append(cmd.Env, m.gitConfigEnv...)
+	// Git invokes gpg to sign commits, which needs to find the signing key in our keyring
+	if m.signingKey != "" {
+		cmd.Env = append(cmd.Env, "GNUPGHOME="+common.GetGnuPGHomePath())
+	}
 
 	// For HTTPS repositories, we need to consider insecure repositories as well
 	// as custom CA bundles from the cert database.
@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/util/workloadidentity"
 	"github.com/argoproj/argo-cd/v3/util/workloadidentity/mocks"
 )
@@ -582,6 +583,46 @@ func Test_nativeGitClient_SetAuthor(t *testing.T) {
 	require.Equal(t, expectedEmail, actualEmail)
 }
 
+func Test_nativeGitClient_SetSigningKey(t *testing.T) {
+	ctx := t.Context()
+
+	// Avoid t.TempDir, its long paths can exceed the maximum length of the gpg-agent socket path.
+	gnuPGHome, err := os.MkdirTemp(os.TempDir(), "")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(gnuPGHome) })
+	t.Setenv(common.EnvGnuPGHome, gnuPGHome)
+	t.Setenv("GNUPGHOME", gnuPGHome)
+	err = runCmd(ctx, "", "gpg", "--batch", "--import", "../gpg/testdata/signing.asc")
+	require.NoError(t, err)
+	fingerprint := "1749CADFDEE429E5B35C047E766D199567C2FE59"
+
+	tempDir, err := _createEmptyGitRepo(ctx)
+	require.NoError(t, err)
+
+	client, err := NewClient("file://"+tempDir, NopCreds{}, true, false, "", "")
+	require.NoError(t, err)
+
+	err = client.Init()
+	require.NoError(t, err)
+
+	out, err := client.SetAuthor("test", "test@example.com")
+	require.NoError(t, err, "error output: ", out)
+
+	_, err = client.SetSigningKey("")
+	require.Error(t, err)
+
+	out, err = client.SetSigningKey(fingerprint)
+	require.NoError(t, err, "error output: ", out)
+
+	out, err = client.CheckoutOrOrphan("signed", false)
+	require.NoError(t, err, "error output: ", out)
+
+	// The initial commit of the orphan branch must be signed with our key
+	signingKey, err := outputCmd(ctx, client.Root(), "git", "log", "-1", "--format=%GF")
+	require.NoError(t, err)
+	assert.Equal(t, fingerprint, strings.TrimSpace(string(signingKey)))
+}
+
 func Test_nativeGitClient_CheckoutOrOrphan(t *testing.T) {
 	t.Run("checkout to an existing branch", func(t *testing.T) {
 		// not main or master
@@ -1100,6 +1100,66 @@ func (_c *Client_SetAuthor_Call) RunAndReturn(run func(name string, email string
 	return _c
 }
 
+// SetSigningKey provides a mock function for the type Client
+func (_mock *Client) SetSigningKey(keyID string) (string, error) {
+	ret := _mock.Called(keyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSigningKey")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(keyID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(keyID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(keyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Client_SetSigningKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSigningKey'
+type Client_SetSigningKey_Call struct {
+	*mock.Call
+}
+
+// SetSigningKey is a helper method to define mock.On call
+//   - keyID string
+func (_e *Client_Expecter) SetSigningKey(keyID interface{}) *Client_SetSigningKey_Call {
+	return &Client_SetSigningKey_Call{Call: _e.mock.On("SetSigningKey", keyID)}
+}
+
+func (_c *Client_SetSigningKey_Call) Run(run func(keyID string)) *Client_SetSigningKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *Client_SetSigningKey_Call) Return(s string, err error) *Client_SetSigningKey_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *Client_SetSigningKey_Call) RunAndReturn(run func(keyID string) (string, error)) *Client_SetSigningKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Submodule provides a mock function for the type Client
 func (_mock *Client) Submodule() error {
 	ret := _mock.Called()
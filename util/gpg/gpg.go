@@ -345,6 +345,52 @@ func ImportPGPKeys(keyFile string) ([]*appsv1.GnuPGPublicKey, error) {
 	return keys, nil
 }
 
+// ImportPGPSigningKey imports a single private key from a file into the local keyring, so that it can be
+// used for signing commits. It returns the fingerprint of the imported key.
+func ImportPGPSigningKey(keyFile string) (string, error) {
+	ctx := context.Background()
+
+	// Inspect the key file first, so we know which key we are going to import
+	cmd := exec.CommandContext(ctx, "gpg", "--no-permission-warning", "--batch", "--with-colons", "--import-options", "show-only", "--import", keyFile)
+	cmd.Env = getGPGEnviron()
+	out, err := executil.Run(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	fingerprint := ""
+	secretKeys := 0
+	inSecretKey := false
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), ":")
+		switch fields[0] {
+		case "sec":
+			secretKeys++
+			inSecretKey = true
+		case "fpr":
+			if inSecretKey && fingerprint == "" && len(fields) > 9 {
+				fingerprint = fields[9]
+			}
+			inSecretKey = false
+		default:
+			inSecretKey = false
+		}
+	}
+	if secretKeys != 1 || fingerprint == "" {
+		return "", fmt.Errorf("expected exactly one private key in %s, found %d", keyFile, secretKeys)
+	}
+
+	cmd = exec.CommandContext(ctx, "gpg", "--no-permission-warning", "--batch", "--import", keyFile)
+	cmd.Env = getGPGEnviron()
+	_, err = executil.Run(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	return fingerprint, nil
+}
+
 func ValidatePGPKeysFromString(keyData string) (map[string]*appsv1.GnuPGPublicKey, error) {
 	f, err := writeKeyToFile(keyData)
 	if err != nil {
@@ -246,6 +246,25 @@ func Test_ImportPGPKeysFromString(t *testing.T) {
 	assert.Equal(t, "unknown", keys[0].SubType)
 }
 
+func Test_ImportPGPSigningKey(t *testing.T) {
+	initTempDir(t)
+
+	t.Run("Import private key", func(t *testing.T) {
+		fingerprint, err := ImportPGPSigningKey("testdata/signing.asc")
+		require.NoError(t, err)
+		assert.Equal(t, "1749CADFDEE429E5B35C047E766D199567C2FE59", fingerprint)
+
+		secret, err := IsSecretKey(KeyID(fingerprint))
+		require.NoError(t, err)
+		assert.True(t, secret)
+	})
+
+	t.Run("Public key only", func(t *testing.T) {
+		_, err := ImportPGPSigningKey("testdata/janedoe.asc")
+		require.ErrorContains(t, err, "expected exactly one private key")
+	})
+}
+
 func Test_ValidateGPGKeysFromString(t *testing.T) {
 	initTempDir(t)
 
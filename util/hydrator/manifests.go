@@ -0,0 +1,428 @@
+package hydrator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	goio "io"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/common"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/io/files"
+)
+
+const gitAttributesContents = `*/README.md linguist-generated=true
+*/hydrator.metadata linguist-generated=true`
+
+// TODO: make this configurable via ConfigMap.
+var manifestHydrationReadmeTemplate = `# Manifest Hydration
+
+To hydrate the manifests in this repository, run the following commands:
+
+` + "```shell" + `
+git clone {{ .RepoURL }}
+# cd into the cloned directory
+git checkout {{ .DrySHA }}
+{{ range $command := .Commands -}}
+{{ $command }}
+{{ end -}}` + "```" + `
+{{ if .References -}}
+
+## References
+
+{{ range $ref := .References -}}
+{{ if $ref.Commit -}}
+* [{{ $ref.Commit.SHA | mustRegexFind "[0-9a-f]+" | trunc 7 }}]({{ $ref.Commit.RepoURL }}): {{ $ref.Commit.Subject }} ({{ $ref.Commit.Author }})
+{{ end -}}
+{{ end -}}
+{{ end -}}`
+
+// WriteForPaths writes the manifests, hydrator.metadata, and README.md files for each path in the provided paths. It
+// also writes a root-level hydrator.metadata file containing the repo URL and dry SHA.
+func WriteForPaths(root *os.Root, repoUrl, drySha string, dryCommitMetadata *appv1.RevisionMetadata, paths []*apiclient.PathDetails) error { //nolint:revive //FIXME(var-naming)
+	hydratorMetadata, err := GetCommitMetadata(repoUrl, drySha, dryCommitMetadata)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve hydrator metadata: %w", err)
+	}
+
+	// Write the top-level readme.
+	err = writeMetadata(root, "", hydratorMetadata)
+	if err != nil {
+		return fmt.Errorf("failed to write top-level hydrator metadata: %w", err)
+	}
+
+	// Write .gitattributes
+	err = writeGitAttributes(root)
+	if err != nil {
+		return fmt.Errorf("failed to write git attributes: %w", err)
+	}
+
+	for _, p := range paths {
+		hydratePath := p.Path
+		if hydratePath == "." {
+			hydratePath = ""
+		}
+
+		// Only create directory if path is not empty (root directory case)
+		if hydratePath != "" {
+			err = root.MkdirAll(hydratePath, 0o755)
+			if err != nil {
+				return fmt.Errorf("failed to create path: %w", err)
+			}
+		}
+
+		// Write the manifests
+		err = writeManifests(root, hydratePath, p.Manifests, appv1.HydratedManifestsLayout(p.Layout))
+		if err != nil {
+			return fmt.Errorf("failed to write manifests: %w", err)
+		}
+
+		// Write hydrator.metadata containing information about the hydration process.
+		hydratorMetadata := HydratorCommitMetadata{
+			Commands:          p.Commands,
+			DrySHA:            drySha,
+			AdditionalDrySHAs: p.AdditionalDrySHAs,
+			RepoURL:           repoUrl,
+		}
+		err = writeMetadata(root, hydratePath, hydratorMetadata)
+		if err != nil {
+			return fmt.Errorf("failed to write hydrator metadata: %w", err)
+		}
+
+		if p.DisableReadme {
+			continue
+		}
+
+		// Write README
+		err = writeReadme(root, hydratePath, hydratorMetadata)
+		if err != nil {
+			return fmt.Errorf("failed to write readme: %w", err)
+		}
+	}
+	return nil
+}
+
+// RenderForPaths returns the files WriteForPaths writes for the given paths, keyed by their slash-separated path
+// relative to the root of the repository. The files are written to a temporary directory, which is removed before
+// returning.
+func RenderForPaths(repoUrl, drySha string, dryCommitMetadata *appv1.RevisionMetadata, paths []*apiclient.PathDetails) (map[string]string, error) { //nolint:revive //FIXME(var-naming)
+	dirPath, err := files.CreateTempDir("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dirPath); err != nil {
+			log.WithError(err).Error("failed to cleanup temp dir")
+		}
+	}()
+
+	root, err := os.OpenRoot(dirPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open root dir: %w", err)
+	}
+	defer io.Close(root)
+
+	err = WriteForPaths(root, repoUrl, drySha, dryCommitMetadata, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered := make(map[string]string)
+	err = fs.WalkDir(root.FS(), ".", func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := root.ReadFile(filePath)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", filePath, err)
+		}
+		rendered[filePath] = string(content)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rendered files: %w", err)
+	}
+	return rendered, nil
+}
+
+// writeMetadata writes the metadata to the hydrator.metadata file.
+func writeMetadata(root *os.Root, dirPath string, metadata HydratorCommitMetadata) error {
+	hydratorMetadataPath := filepath.Join(dirPath, "hydrator.metadata")
+	f, err := root.Create(hydratorMetadataPath)
+	if err != nil {
+		return fmt.Errorf("failed to create hydrator metadata file: %w", err)
+	}
+	defer io.Close(f)
+	e := json.NewEncoder(f)
+	e.SetIndent("", "  ")
+	// We don't need to escape HTML, because we're not embedding this JSON in HTML.
+	e.SetEscapeHTML(false)
+	err = e.Encode(metadata)
+	if err != nil {
+		return fmt.Errorf("failed to encode hydrator metadata: %w", err)
+	}
+	return nil
+}
+
+// writeReadme writes the readme to the README.md file.
+func writeReadme(root *os.Root, dirPath string, metadata HydratorCommitMetadata) error {
+	readmeTemplate, err := template.New("readme").Funcs(sprigFuncMap).Parse(manifestHydrationReadmeTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse readme template: %w", err)
+	}
+	// Create writer to template into
+	// No need to use SecureJoin here, as the path is already sanitized.
+	readmePath := filepath.Join(dirPath, "README.md")
+	readmeFile, err := root.Create(readmePath)
+	if err != nil && !os.IsExist(err) {
+		return fmt.Errorf("failed to create README file: %w", err)
+	}
+	err = readmeTemplate.Execute(readmeFile, metadata)
+	closeErr := readmeFile.Close()
+	if closeErr != nil {
+		log.WithError(closeErr).Error("failed to close README file")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to execute readme template: %w", err)
+	}
+	return nil
+}
+
+func writeGitAttributes(root *os.Root) error {
+	gitAttributesFile, err := root.Create(".gitattributes")
+	if err != nil {
+		return fmt.Errorf("failed to create git attributes file: %w", err)
+	}
+
+	defer func() {
+		err = gitAttributesFile.Close()
+		if err != nil {
+			log.WithFields(log.Fields{
+				common.SecurityField:    common.SecurityMedium,
+				common.SecurityCWEField: common.SecurityCWEMissingReleaseOfFileDescriptor,
+			}).Errorf("error closing file %q: %v", gitAttributesFile.Name(), err)
+		}
+	}()
+
+	_, err = gitAttributesFile.WriteString(gitAttributesContents)
+	if err != nil {
+		return fmt.Errorf("failed to write git attributes: %w", err)
+	}
+
+	return nil
+}
+
+// writeManifests writes the manifests to the files of the given layout, truncating the files if they exist and
+// appending the manifests in the order they are provided.
+func writeManifests(root *os.Root, dirPath string, manifests []*apiclient.HydratedManifestDetails, layout appv1.HydratedManifestsLayout) error {
+	objs, err := decodeManifests(manifests)
+	if err != nil {
+		return err
+	}
+	manifestFiles, err := getManifestFiles(objs, layout)
+	if err != nil {
+		return err
+	}
+	for _, name := range slices.Sorted(maps.Keys(manifestFiles)) {
+		err = writeManifestFile(root, filepath.Join(dirPath, name), manifestFiles[name])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeManifestFile writes the manifests to the given file, truncating the file if it exists.
+func writeManifestFile(root *os.Root, manifestPath string, objs []*unstructured.Unstructured) error {
+	file, err := root.OpenFile(manifestPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.ModePerm)
+	if err != nil {
+		return fmt.Errorf("failed to open manifest file: %w", err)
+	}
+	defer func() {
+		err := file.Close()
+		if err != nil {
+			log.WithError(err).Error("failed to close file")
+		}
+	}()
+
+	return encodeManifests(file, objs)
+}
+
+// getManifestFiles returns the manifests to write to each file for the given layout, keyed by file name. The manifests
+// of a file are in the order they are provided.
+func getManifestFiles(objs []*unstructured.Unstructured, layout appv1.HydratedManifestsLayout) (map[string][]*unstructured.Unstructured, error) {
+	manifestFiles := make(map[string][]*unstructured.Unstructured)
+	switch layout {
+	case "", appv1.HydratedManifestsLayoutSingle:
+		// The file is written even if there are no manifests.
+		manifestFiles["manifest.yaml"] = objs
+	case appv1.HydratedManifestsLayoutPerResource:
+		// Resources sharing a group, kind and name, e.g. in different namespaces, include the namespace in the file name.
+		names := make([]string, len(objs))
+		counts := make(map[string]int)
+		for i, obj := range objs {
+			name, err := resourceName(obj)
+			if err != nil {
+				return nil, err
+			}
+			fileName, err := resourceFileName(obj, name)
+			if err != nil {
+				return nil, err
+			}
+			names[i] = name
+			counts[fileName]++
+		}
+		for i, obj := range objs {
+			name, err := resourceFileName(obj, names[i])
+			if err != nil {
+				return nil, err
+			}
+			if namespace := obj.GetNamespace(); counts[name] > 1 && namespace != "" {
+				if err := validateFileNamePart("namespace", namespace, validation.IsDNS1123Label(namespace)); err != nil {
+					return nil, err
+				}
+				name, err = resourceFileName(obj, namespace+"-"+names[i])
+				if err != nil {
+					return nil, err
+				}
+			}
+			if _, ok := manifestFiles[name]; ok {
+				return nil, fmt.Errorf("more than one resource would be written to %s", name)
+			}
+			manifestFiles[name] = []*unstructured.Unstructured{obj}
+		}
+	case appv1.HydratedManifestsLayoutPerKind:
+		for _, obj := range objs {
+			kind, err := qualifiedKind(obj)
+			if err != nil {
+				return nil, err
+			}
+			name := kind + ".yaml"
+			manifestFiles[name] = append(manifestFiles[name], obj)
+		}
+	default:
+		return nil, fmt.Errorf("unknown manifests layout %q", layout)
+	}
+	return manifestFiles, nil
+}
+
+// resourceName returns the name of a resource used in its PerResource file name, falling back to the generateName of
+// resources which get their name generated by the API server. The name must be a DNS-1123 subdomain, and so can't
+// contain path separators or "..".
+func resourceName(obj *unstructured.Unstructured) (string, error) {
+	if name := obj.GetName(); name != "" {
+		return name, validateFileNamePart("name", name, apimachineryvalidation.NameIsDNSSubdomain(name, false))
+	}
+	if generateName := obj.GetGenerateName(); generateName != "" {
+		return generateName, validateFileNamePart("generateName", generateName, apimachineryvalidation.NameIsDNSSubdomain(generateName, true))
+	}
+	return "", fmt.Errorf("resource of kind %s has neither a name nor a generateName", obj.GetKind())
+}
+
+// resourceFileName returns the name of the file a resource is written to with the PerResource layout, e.g.
+// deployment.apps-guestbook.yaml.
+func resourceFileName(obj *unstructured.Unstructured, name string) (string, error) {
+	kind, err := qualifiedKind(obj)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s-%s.yaml", kind, name), nil
+}
+
+// qualifiedKind returns the lowercase kind of a resource qualified with its group, unless the resource is in the core
+// group, e.g. deployment.apps or service. It keeps the files of kinds sharing a name in different groups apart. The
+// kind must be a DNS-1035 label once lowercased, as required for CRDs, and the group a DNS-1123 subdomain.
+func qualifiedKind(obj *unstructured.Unstructured) (string, error) {
+	kind := strings.ToLower(obj.GetKind())
+	if err := validateFileNamePart("kind", obj.GetKind(), validation.IsDNS1035Label(kind)); err != nil {
+		return "", err
+	}
+	if group := obj.GroupVersionKind().Group; group != "" {
+		if err := validateFileNamePart("group", group, validation.IsDNS1123Subdomain(group)); err != nil {
+			return "", err
+		}
+		kind += "." + group
+	}
+	return kind, nil
+}
+
+// validateFileNamePart returns an error if a value used in a manifest file name failed the validation of its format,
+// or could otherwise escape the directory of the application, e.g. by containing a path separator or "..".
+func validateFileNamePart(field, value string, errs []string) error {
+	if strings.ContainsAny(value, `/\`) || strings.Contains(value, "..") {
+		errs = append(errs, "must not contain '/', '\\' or '..'")
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid resource %s %q: %s", field, value, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// RenderManifests returns the content of a manifest file written for the given manifests.
+func RenderManifests(manifests []*apiclient.HydratedManifestDetails) (string, error) {
+	objs, err := decodeManifests(manifests)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	err = encodeManifests(&buf, objs)
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// decodeManifests decodes the JSON of the manifests, in the order they are provided.
+func decodeManifests(manifests []*apiclient.HydratedManifestDetails) ([]*unstructured.Unstructured, error) {
+	objs := make([]*unstructured.Unstructured, 0, len(manifests))
+	for _, m := range manifests {
+		obj := &unstructured.Unstructured{}
+		err := json.Unmarshal([]byte(m.ManifestJSON), obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal manifest: %w", err)
+		}
+		objs = append(objs, obj)
+	}
+	return objs, nil
+}
+
+// encodeManifests encodes the manifests as a YAML stream, in the order they are provided.
+func encodeManifests(w goio.Writer, objs []*unstructured.Unstructured) error {
+	enc := yaml.NewEncoder(w)
+	defer func() {
+		err := enc.Close()
+		if err != nil {
+			log.WithError(err).Error("failed to close yaml encoder")
+		}
+	}()
+	enc.SetIndent(2)
+
+	for _, obj := range objs {
+		err := enc.Encode(&obj.Object)
+		if err != nil {
+			return fmt.Errorf("failed to encode manifest: %w", err)
+		}
+	}
+	return nil
+}
+
+// IsRootPath returns whether the path references a root path
+func IsRootPath(path string) bool {
+	clean := filepath.Clean(path)
+	return clean == "" || clean == "." || clean == string(filepath.Separator)
+}
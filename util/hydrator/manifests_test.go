@@ -0,0 +1,480 @@
+package hydrator
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// tempRoot creates a temporary directory and returns an os.Root object for it.
+// We use this instead of t.TempDir() because OSX does weird things with temp directories, and it triggers
+// the os.Root protections.
+func tempRoot(t *testing.T) *os.Root {
+	t.Helper()
+
+	dir, err := os.MkdirTemp(".", "")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := os.RemoveAll(dir)
+		require.NoError(t, err)
+	})
+	root, err := os.OpenRoot(dir)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := root.Close()
+		require.NoError(t, err)
+	})
+	return root
+}
+
+func TestWriteForPaths(t *testing.T) {
+	root := tempRoot(t)
+
+	repoURL := "https://github.com/example/repo"
+	drySha := "abc123"
+	paths := []*apiclient.PathDetails{
+		{
+			Path: "path1",
+			Manifests: []*apiclient.HydratedManifestDetails{
+				{ManifestJSON: `{"kind":"Pod","apiVersion":"v1"}`},
+			},
+			Commands: []string{"command1", "command2"},
+		},
+		{
+			Path: "path2",
+			Manifests: []*apiclient.HydratedManifestDetails{
+				{ManifestJSON: `{"kind":"Service","apiVersion":"v1"}`},
+			},
+			Commands:          []string{"command3"},
+			AdditionalDrySHAs: []string{"def456"},
+		},
+		{
+			Path: "path3/nested",
+			Manifests: []*apiclient.HydratedManifestDetails{
+				{ManifestJSON: `{"kind":"Deployment","apiVersion":"apps/v1"}`},
+			},
+			Commands: []string{"command4"},
+		},
+	}
+
+	now := metav1.NewTime(time.Now())
+	metadata := &appsv1.RevisionMetadata{
+		Author: "test-author",
+		Date:   &now,
+		Message: `test-message
+
+Signed-off-by: Test User <test@example.com>
+Argocd-reference-commit-sha: abc123
+`,
+		References: []appsv1.RevisionReference{
+			{
+				Commit: &appsv1.CommitMetadata{
+					Author:  "test-code-author <test-email-author@example.com>",
+					Date:    now.Format(time.RFC3339),
+					Subject: "test-code-subject",
+					SHA:     "test-code-sha",
+					RepoURL: "https://example.com/test/repo.git",
+				},
+			},
+		},
+	}
+
+	err := WriteForPaths(root, repoURL, drySha, metadata, paths)
+	require.NoError(t, err)
+
+	// Check if the top-level hydrator.metadata exists and contains the repo URL and dry SHA
+	topMetadataPath := filepath.Join(root.Name(), "hydrator.metadata")
+	topMetadataBytes, err := os.ReadFile(topMetadataPath)
+	require.NoError(t, err)
+
+	var topMetadata HydratorCommitMetadata
+	err = json.Unmarshal(topMetadataBytes, &topMetadata)
+	require.NoError(t, err)
+	assert.Equal(t, repoURL, topMetadata.RepoURL)
+	assert.Equal(t, drySha, topMetadata.DrySHA)
+	assert.Equal(t, metadata.Author, topMetadata.Author)
+	assert.Equal(t, "test-message", topMetadata.Subject)
+	// The body should exclude the Argocd- trailers.
+	assert.Equal(t, "Signed-off-by: Test User <test@example.com>\n", topMetadata.Body)
+	assert.Equal(t, metadata.Date.Format(time.RFC3339), topMetadata.Date)
+	assert.Equal(t, metadata.References, topMetadata.References)
+
+	for _, p := range paths {
+		fullHydratePath := filepath.Join(root.Name(), p.Path)
+
+		// Check if each path directory exists
+		assert.DirExists(t, fullHydratePath)
+
+		// Check if each path contains a hydrator.metadata file and contains the repo URL
+		metadataPath := path.Join(fullHydratePath, "hydrator.metadata")
+		metadataBytes, err := os.ReadFile(metadataPath)
+		require.NoError(t, err)
+
+		var readMetadata HydratorCommitMetadata
+		err = json.Unmarshal(metadataBytes, &readMetadata)
+		require.NoError(t, err)
+		assert.Equal(t, repoURL, readMetadata.RepoURL)
+		assert.Equal(t, p.AdditionalDrySHAs, readMetadata.AdditionalDrySHAs)
+		// Check if each path contains a README.md file and contains the repo URL
+		readmePath := path.Join(fullHydratePath, "README.md")
+		readmeBytes, err := os.ReadFile(readmePath)
+		require.NoError(t, err)
+		assert.Contains(t, string(readmeBytes), repoURL)
+
+		// Check if each path contains a manifest.yaml file and contains the word kind
+		manifestPath := path.Join(fullHydratePath, "manifest.yaml")
+		manifestBytes, err := os.ReadFile(manifestPath)
+		require.NoError(t, err)
+		assert.Contains(t, string(manifestBytes), "kind")
+	}
+}
+
+func TestWriteForPaths_DisableReadme(t *testing.T) {
+	root := tempRoot(t)
+
+	paths := []*apiclient.PathDetails{
+		{
+			Path: "path1",
+			Manifests: []*apiclient.HydratedManifestDetails{
+				{ManifestJSON: `{"kind":"Pod","apiVersion":"v1","metadata":{"name":"pod"}}`},
+			},
+			Layout:        string(appsv1.HydratedManifestsLayoutPerResource),
+			DisableReadme: true,
+		},
+	}
+
+	err := WriteForPaths(root, "https://github.com/example/repo", "abc123", &appsv1.RevisionMetadata{}, paths)
+	require.NoError(t, err)
+
+	assert.FileExists(t, filepath.Join(root.Name(), "path1", "pod-pod.yaml"))
+	assert.FileExists(t, filepath.Join(root.Name(), "path1", "hydrator.metadata"))
+	assert.NoFileExists(t, filepath.Join(root.Name(), "path1", "manifest.yaml"))
+	assert.NoFileExists(t, filepath.Join(root.Name(), "path1", "README.md"))
+}
+
+func TestRenderForPaths(t *testing.T) {
+	paths := []*apiclient.PathDetails{
+		{
+			Path: "path1",
+			Manifests: []*apiclient.HydratedManifestDetails{
+				{ManifestJSON: `{"kind":"Pod","apiVersion":"v1"}`},
+			},
+			Commands: []string{"command1"},
+		},
+		{
+			Path: "path2/nested",
+			Manifests: []*apiclient.HydratedManifestDetails{
+				{ManifestJSON: `{"kind":"Service","apiVersion":"v1"}`},
+			},
+		},
+	}
+
+	rendered, err := RenderForPaths("https://github.com/example/repo", "abc123", &appsv1.RevisionMetadata{}, paths)
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{
+		".gitattributes",
+		"hydrator.metadata",
+		"path1/README.md",
+		"path1/hydrator.metadata",
+		"path1/manifest.yaml",
+		"path2/nested/README.md",
+		"path2/nested/hydrator.metadata",
+		"path2/nested/manifest.yaml",
+	}, slices.Collect(maps.Keys(rendered)))
+	assert.Equal(t, "apiVersion: v1\nkind: Pod\n", rendered["path1/manifest.yaml"])
+	manifests, err := RenderManifests(paths[0].Manifests)
+	require.NoError(t, err)
+	assert.Equal(t, rendered["path1/manifest.yaml"], manifests)
+	assert.Contains(t, rendered["path1/hydrator.metadata"], `"drySha": "abc123"`)
+	assert.Contains(t, rendered["path2/nested/README.md"], "https://github.com/example/repo")
+}
+
+func TestWriteMetadata(t *testing.T) {
+	root := tempRoot(t)
+
+	metadata := HydratorCommitMetadata{
+		RepoURL: "https://github.com/example/repo",
+		DrySHA:  "abc123",
+	}
+
+	err := writeMetadata(root, "", metadata)
+	require.NoError(t, err)
+
+	metadataPath := filepath.Join(root.Name(), "hydrator.metadata")
+	metadataBytes, err := os.ReadFile(metadataPath)
+	require.NoError(t, err)
+
+	var readMetadata HydratorCommitMetadata
+	err = json.Unmarshal(metadataBytes, &readMetadata)
+	require.NoError(t, err)
+	assert.Equal(t, metadata, readMetadata)
+}
+
+func TestWriteReadme(t *testing.T) {
+	root := tempRoot(t)
+
+	randomData := make([]byte, 32)
+	_, err := rand.Read(randomData)
+	require.NoError(t, err)
+	hash := sha256.Sum256(randomData)
+	sha := hex.EncodeToString(hash[:])
+
+	metadata := HydratorCommitMetadata{
+		RepoURL: "https://github.com/example/repo",
+		DrySHA:  "abc123",
+		References: []appsv1.RevisionReference{
+			{
+				Commit: &appsv1.CommitMetadata{
+					Author:  "test-code-author <test@example.com>",
+					Date:    time.Now().Format(time.RFC3339),
+					Subject: "test-code-subject",
+					SHA:     sha,
+					RepoURL: "https://example.com/test/repo.git",
+				},
+			},
+		},
+	}
+
+	err = writeReadme(root, "", metadata)
+	require.NoError(t, err)
+
+	readmePath := filepath.Join(root.Name(), "README.md")
+	readmeBytes, err := os.ReadFile(readmePath)
+	require.NoError(t, err)
+	assert.Equal(t, `# Manifest Hydration
+
+To hydrate the manifests in this repository, run the following commands:
+
+`+"```shell"+`
+git clone https://github.com/example/repo
+# cd into the cloned directory
+git checkout abc123
+`+"```"+fmt.Sprintf(`
+## References
+
+* [%s](https://example.com/test/repo.git): test-code-subject (test-code-author <test@example.com>)
+`, sha[:7]), string(readmeBytes))
+}
+
+func TestWriteManifests(t *testing.T) {
+	root := tempRoot(t)
+
+	manifests := []*apiclient.HydratedManifestDetails{
+		{ManifestJSON: `{"kind":"Pod","apiVersion":"v1"}`},
+	}
+
+	err := writeManifests(root, "", manifests, "")
+	require.NoError(t, err)
+
+	manifestPath := path.Join(root.Name(), "manifest.yaml")
+	manifestBytes, err := os.ReadFile(manifestPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(manifestBytes), "kind")
+}
+
+func TestWriteManifests_Layouts(t *testing.T) {
+	manifests := []*apiclient.HydratedManifestDetails{
+		{ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config","namespace":"a"}}`},
+		{ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config","namespace":"b"}}`},
+		{ManifestJSON: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"app","namespace":"a"}}`},
+		{ManifestJSON: `{"apiVersion":"rbac.authorization.k8s.io/v1","kind":"ClusterRole","metadata":{"name":"app"}}`},
+	}
+
+	readFiles := func(t *testing.T, root *os.Root) map[string]string {
+		t.Helper()
+		entries, err := os.ReadDir(root.Name())
+		require.NoError(t, err)
+		files := make(map[string]string)
+		for _, entry := range entries {
+			content, err := os.ReadFile(filepath.Join(root.Name(), entry.Name()))
+			require.NoError(t, err)
+			files[entry.Name()] = string(content)
+		}
+		return files
+	}
+
+	t.Run("Single", func(t *testing.T) {
+		root := tempRoot(t)
+
+		err := writeManifests(root, "", manifests, appsv1.HydratedManifestsLayoutSingle)
+		require.NoError(t, err)
+
+		files := readFiles(t, root)
+		require.Len(t, files, 1)
+		assert.Equal(t, 4, strings.Count(files["manifest.yaml"], "kind:"))
+	})
+
+	t.Run("PerResource", func(t *testing.T) {
+		root := tempRoot(t)
+
+		err := writeManifests(root, "", manifests, appsv1.HydratedManifestsLayoutPerResource)
+		require.NoError(t, err)
+
+		files := readFiles(t, root)
+		assert.ElementsMatch(t, []string{"configmap-a-config.yaml", "configmap-b-config.yaml", "deployment.apps-app.yaml", "clusterrole.rbac.authorization.k8s.io-app.yaml"}, slices.Collect(maps.Keys(files)))
+		assert.Contains(t, files["configmap-b-config.yaml"], "namespace: b")
+		assert.Equal(t, 1, strings.Count(files["deployment.apps-app.yaml"], "kind:"))
+	})
+
+	t.Run("PerResource with same kind and name in different groups", func(t *testing.T) {
+		root := tempRoot(t)
+
+		err := writeManifests(root, "", []*apiclient.HydratedManifestDetails{
+			{ManifestJSON: `{"apiVersion":"networking.k8s.io/v1","kind":"Ingress","metadata":{"name":"app","namespace":"a"}}`},
+			{ManifestJSON: `{"apiVersion":"example.com/v1","kind":"Ingress","metadata":{"name":"app","namespace":"a"}}`},
+		}, appsv1.HydratedManifestsLayoutPerResource)
+		require.NoError(t, err)
+
+		files := readFiles(t, root)
+		assert.ElementsMatch(t, []string{"ingress.networking.k8s.io-app.yaml", "ingress.example.com-app.yaml"}, slices.Collect(maps.Keys(files)))
+	})
+
+	t.Run("PerResource with generateName", func(t *testing.T) {
+		root := tempRoot(t)
+
+		err := writeManifests(root, "", []*apiclient.HydratedManifestDetails{
+			{ManifestJSON: `{"apiVersion":"batch/v1","kind":"Job","metadata":{"generateName":"migrate-"}}`},
+		}, appsv1.HydratedManifestsLayoutPerResource)
+		require.NoError(t, err)
+
+		files := readFiles(t, root)
+		assert.ElementsMatch(t, []string{"job.batch-migrate-.yaml"}, slices.Collect(maps.Keys(files)))
+	})
+
+	t.Run("PerResource without name", func(t *testing.T) {
+		root := tempRoot(t)
+
+		err := writeManifests(root, "", []*apiclient.HydratedManifestDetails{
+			{ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{}}`},
+		}, appsv1.HydratedManifestsLayoutPerResource)
+		require.ErrorContains(t, err, "resource of kind ConfigMap has neither a name nor a generateName")
+	})
+
+	t.Run("PerResource with duplicate resources", func(t *testing.T) {
+		root := tempRoot(t)
+
+		err := writeManifests(root, "", []*apiclient.HydratedManifestDetails{manifests[3], manifests[3]}, appsv1.HydratedManifestsLayoutPerResource)
+		require.ErrorContains(t, err, "more than one resource would be written to clusterrole.rbac.authorization.k8s.io-app.yaml")
+	})
+
+	t.Run("PerKind", func(t *testing.T) {
+		root := tempRoot(t)
+
+		err := writeManifests(root, "", manifests, appsv1.HydratedManifestsLayoutPerKind)
+		require.NoError(t, err)
+
+		files := readFiles(t, root)
+		assert.ElementsMatch(t, []string{"configmap.yaml", "deployment.apps.yaml", "clusterrole.rbac.authorization.k8s.io.yaml"}, slices.Collect(maps.Keys(files)))
+		assert.Equal(t, 2, strings.Count(files["configmap.yaml"], "kind: ConfigMap"))
+	})
+
+	t.Run("PerKind with same kind in different groups", func(t *testing.T) {
+		root := tempRoot(t)
+
+		err := writeManifests(root, "", []*apiclient.HydratedManifestDetails{
+			{ManifestJSON: `{"apiVersion":"networking.k8s.io/v1","kind":"Ingress","metadata":{"name":"app","namespace":"a"}}`},
+			{ManifestJSON: `{"apiVersion":"example.com/v1","kind":"Ingress","metadata":{"name":"app","namespace":"a"}}`},
+		}, appsv1.HydratedManifestsLayoutPerKind)
+		require.NoError(t, err)
+
+		files := readFiles(t, root)
+		assert.ElementsMatch(t, []string{"ingress.networking.k8s.io.yaml", "ingress.example.com.yaml"}, slices.Collect(maps.Keys(files)))
+		assert.Contains(t, files["ingress.example.com.yaml"], "apiVersion: example.com/v1")
+	})
+
+	t.Run("invalid file name parts", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			manifest string
+			layout   appsv1.HydratedManifestsLayout
+			err      string
+		}{
+			{"name with path traversal", `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"x/../../other-app/y"}}`, appsv1.HydratedManifestsLayoutPerResource, `invalid resource name "x/../../other-app/y"`},
+			{"name with backslash", `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"x\\y"}}`, appsv1.HydratedManifestsLayoutPerResource, `invalid resource name "x\\y"`},
+			{"name with dots", `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":".."}}`, appsv1.HydratedManifestsLayoutPerResource, `invalid resource name ".."`},
+			{"generateName with path traversal", `{"apiVersion":"batch/v1","kind":"Job","metadata":{"generateName":"../migrate-"}}`, appsv1.HydratedManifestsLayoutPerResource, `invalid resource generateName "../migrate-"`},
+			{"kind with path traversal", `{"apiVersion":"v1","kind":"../ConfigMap","metadata":{"name":"config"}}`, appsv1.HydratedManifestsLayoutPerKind, `invalid resource kind "../ConfigMap"`},
+			{"group with dots", `{"apiVersion":"../v1","kind":"ConfigMap","metadata":{"name":"config"}}`, appsv1.HydratedManifestsLayoutPerKind, `invalid resource group ".."`},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				root := tempRoot(t)
+
+				err := writeManifests(root, "", []*apiclient.HydratedManifestDetails{{ManifestJSON: tc.manifest}}, tc.layout)
+				require.ErrorContains(t, err, tc.err)
+				entries, err := os.ReadDir(root.Name())
+				require.NoError(t, err)
+				assert.Empty(t, entries)
+			})
+		}
+	})
+
+	t.Run("invalid namespace of resources sharing a name", func(t *testing.T) {
+		root := tempRoot(t)
+
+		err := writeManifests(root, "", []*apiclient.HydratedManifestDetails{
+			{ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config","namespace":"a"}}`},
+			{ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config","namespace":"../b"}}`},
+		}, appsv1.HydratedManifestsLayoutPerResource)
+		require.ErrorContains(t, err, `invalid resource namespace "../b"`)
+	})
+
+	t.Run("unknown layout", func(t *testing.T) {
+		root := tempRoot(t)
+
+		err := writeManifests(root, "", manifests, "Unknown")
+		require.ErrorContains(t, err, `unknown manifests layout "Unknown"`)
+	})
+}
+
+func TestWriteGitAttributes(t *testing.T) {
+	root := tempRoot(t)
+
+	err := writeGitAttributes(root)
+	require.NoError(t, err)
+
+	gitAttributesPath := filepath.Join(root.Name(), ".gitattributes")
+	gitAttributesBytes, err := os.ReadFile(gitAttributesPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(gitAttributesBytes), "*/README.md linguist-generated=true")
+	assert.Contains(t, string(gitAttributesBytes), "*/hydrator.metadata linguist-generated=true")
+}
+
+func TestIsRootPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		expected bool
+	}{
+		{"empty string", "", true},
+		{"dot path", ".", true},
+		{"slash", string(filepath.Separator), true},
+		{"nested path", "app", false},
+		{"nested path with slash", "app/", false},
+		{"deep path", "app/config", false},
+		{"current dir with trailing slash", "./", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := IsRootPath(tt.path)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
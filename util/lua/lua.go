@@ -50,6 +50,9 @@ func (overrides ResourceHealthOverrides) GetResourceHealth(obj *unstructured.Uns
 		return nil, err
 	}
 	if script == "" {
+		if overrides.useConditionsHealth(obj.GroupVersionKind()) {
+			return health.GetConditionsHealth(obj)
+		}
 		return nil, nil
 	}
 	// enable/disable the usage of lua standard library
@@ -61,6 +64,21 @@ func (overrides ResourceHealthOverrides) GetResourceHealth(obj *unstructured.Uns
 	return result, nil
 }
 
+// useConditionsHealth returns whether the health of resources of the given kind is assessed using their Ready
+// condition. Built-in health checks take precedence over the conditions.
+func (overrides ResourceHealthOverrides) useConditionsHealth(gvk schema.GroupVersionKind) bool {
+	if health.GetHealthCheckFunc(gvk) != nil {
+		return false
+	}
+	key := GetConfigMapKey(gvk)
+	for k, override := range overrides {
+		if override.UseConditionsHealth && (k == key || argoglob.Match(k, key)) {
+			return true
+		}
+	}
+	return false
+}
+
 // VM Defines a struct that implements the luaVM
 type VM struct {
 	ResourceOverrides map[string]appv1.ResourceOverride
@@ -73,6 +91,22 @@ func (vm VM) runLua(obj *unstructured.Unstructured, script string) (*lua.LState,
 }
 
 func (vm VM) runLuaWithResourceActionParameters(obj *unstructured.Unstructured, script string, resourceActionParameters []*applicationpkg.ResourceActionParameters) (*lua.LState, error) {
+	return vm.runLuaWithGlobals(script, func(l *lua.LState) {
+		// Inject action parameters as a hash table global variable
+		actionParams := l.CreateTable(0, len(resourceActionParameters))
+		for _, resourceActionParameter := range resourceActionParameters {
+			value := decodeValue(l, resourceActionParameter.GetValue())
+			actionParams.RawSetH(lua.LString(resourceActionParameter.GetName()), value)
+		}
+		l.SetGlobal("actionParams", actionParams) // Set the actionParams table as a global variable
+
+		objectValue := decodeValue(l, obj.Object)
+		l.SetGlobal("obj", objectValue)
+	})
+}
+
+// runLuaWithGlobals runs the script after setGlobals has injected the global variables the script expects
+func (vm VM) runLuaWithGlobals(script string, setGlobals func(l *lua.LState)) (*lua.LState, error) {
 	l := lua.NewState(lua.Options{
 		SkipOpenLibs: !vm.UseOpenLibs,
 	})
@@ -103,16 +137,7 @@ func (vm VM) runLuaWithResourceActionParameters(obj *unstructured.Unstructured,
 	defer cancel()
 	l.SetContext(ctx)
 
-	// Inject action parameters as a hash table global variable
-	actionParams := l.CreateTable(0, len(resourceActionParameters))
-	for _, resourceActionParameter := range resourceActionParameters {
-		value := decodeValue(l, resourceActionParameter.GetValue())
-		actionParams.RawSetH(lua.LString(resourceActionParameter.GetName()), value)
-	}
-	l.SetGlobal("actionParams", actionParams) // Set the actionParams table as a global variable
-
-	objectValue := decodeValue(l, obj.Object)
-	l.SetGlobal("obj", objectValue)
+	setGlobals(l)
 	err := l.DoString(script)
 
 	// Remove the default lua stack trace from execution errors since these
@@ -164,6 +189,40 @@ func (vm VM) ExecuteHealthLua(obj *unstructured.Unstructured, script string) (*h
 	return nil, fmt.Errorf(incorrectReturnType, "table", returnValue.Type().String())
 }
 
+// ExecuteReadinessLua runs the lua script to check whether the resources of a sync wave, which are available to the
+// script as the objs array, are ready. The script returns either a boolean or a table with the ready and message fields.
+func (vm VM) ExecuteReadinessLua(objs []*unstructured.Unstructured, script string) (bool, string, error) {
+	l, err := vm.runLuaWithGlobals(script, func(l *lua.LState) {
+		objsValue := l.CreateTable(len(objs), 0)
+		for _, obj := range objs {
+			objsValue.Append(decodeValue(l, obj.Object))
+		}
+		l.SetGlobal("objs", objsValue)
+	})
+	if err != nil {
+		return false, "", err
+	}
+	returnValue := l.Get(-1)
+	switch returnValue.Type() {
+	case lua.LTBool:
+		return lua.LVAsBool(returnValue), "", nil
+	case lua.LTTable:
+		jsonBytes, err := luajson.Encode(returnValue)
+		if err != nil {
+			return false, "", err
+		}
+		readiness := &struct {
+			Ready   bool   `json:"ready"`
+			Message string `json:"message"`
+		}{}
+		if err := json.Unmarshal(jsonBytes, readiness); err != nil {
+			return false, "", err
+		}
+		return readiness.Ready, readiness.Message, nil
+	}
+	return false, "", fmt.Errorf(incorrectReturnType, "table or boolean", returnValue.Type().String())
+}
+
 // GetHealthScript attempts to read lua script from config and then filesystem for that resource. If none exists, return
 // an empty string.
 func (vm VM) GetHealthScript(obj *unstructured.Unstructured) (script string, useOpenLibs bool, err error) {
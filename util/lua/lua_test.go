@@ -122,6 +122,36 @@ func TestFailLuaReturnNonTable(t *testing.T) {
 	assert.Equal(t, fmt.Errorf(incorrectReturnType, "table", "number"), err)
 }
 
+func TestExecuteReadinessLua(t *testing.T) {
+	objs := []*unstructured.Unstructured{StrToUnstructured(objJSON), StrToUnstructured(ec2AWSCrossplaneObjJSON)}
+	vm := VM{}
+
+	t.Run("Boolean", func(t *testing.T) {
+		ready, message, err := vm.ExecuteReadinessLua(objs, `return #objs == 2`)
+		require.NoError(t, err)
+		assert.True(t, ready)
+		assert.Empty(t, message)
+	})
+
+	t.Run("Table", func(t *testing.T) {
+		ready, message, err := vm.ExecuteReadinessLua(objs, `return {ready = false, message = "waiting for " .. objs[2].metadata.name}`)
+		require.NoError(t, err)
+		assert.False(t, ready)
+		assert.Equal(t, "waiting for sample-crosspalne-ec2-instance", message)
+	})
+
+	t.Run("NoObjects", func(t *testing.T) {
+		ready, _, err := vm.ExecuteReadinessLua(nil, `return #objs == 0`)
+		require.NoError(t, err)
+		assert.True(t, ready)
+	})
+
+	t.Run("IncorrectReturnType", func(t *testing.T) {
+		_, _, err := vm.ExecuteReadinessLua(objs, returnInt)
+		assert.Equal(t, fmt.Errorf(incorrectReturnType, "table or boolean", "number"), err)
+	})
+}
+
 const invalidHealthStatusStatus = `local healthStatus = {}
 healthStatus.status = "test"
 return healthStatus
@@ -996,6 +1026,43 @@ return hs`
 		require.NoError(t, err)
 		assert.Nil(t, status)
 	})
+
+	t.Run("Resource health using conditions", func(t *testing.T) {
+		testObj := StrToUnstructured(objWithNoScriptJSON)
+		testObj.Object["status"] = map[string]any{
+			"conditions": []any{map[string]any{"type": "Ready", "status": "True", "message": "Test is ready"}},
+		}
+		overrides := ResourceHealthOverrides{
+			"not-an-endpoint.io/Test": appv1.ResourceOverride{UseConditionsHealth: true},
+		}
+		status, err := overrides.GetResourceHealth(testObj)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusHealthy, Message: "Test is ready"}, status)
+	})
+
+	t.Run("Resource health using conditions for wildcard override", func(t *testing.T) {
+		testObj := StrToUnstructured(objWithNoScriptJSON)
+		overrides := ResourceHealthOverrides{
+			"*.io/*": appv1.ResourceOverride{UseConditionsHealth: true},
+		}
+		status, err := overrides.GetResourceHealth(testObj)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusProgressing, Message: "Waiting for Ready condition"}, status)
+	})
+
+	t.Run("Resource health using conditions does not override built-in health check", func(t *testing.T) {
+		testObj := StrToUnstructured(`
+apiVersion: gateway.networking.k8s.io/v1
+kind: Gateway
+metadata:
+  name: test`)
+		overrides := ResourceHealthOverrides{
+			"gateway.networking.k8s.io/Gateway": appv1.ResourceOverride{UseConditionsHealth: true},
+		}
+		status, err := overrides.GetResourceHealth(testObj)
+		require.NoError(t, err)
+		assert.Nil(t, status)
+	})
 }
 
 func TestExecuteResourceActionWithParams(t *testing.T) {
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/opencontainers/go-digest"
 	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"oras.land/oras-go/v2/content/oci"
 
@@ -44,6 +45,9 @@ var (
 	indexLock  = sync.NewKeyLock()
 )
 
+// hydratedManifestsArtifactType is the artifact type of OCI artifacts containing hydrated manifests.
+const hydratedManifestsArtifactType = "application/vnd.argoproj.argo-cd.hydrated-manifests.v1"
+
 var _ Client = &nativeOCIClient{}
 
 type tagsCache interface {
@@ -74,6 +78,10 @@ type Client interface {
 
 	// GetTags retrieves the list of tags for the repository.
 	GetTags(ctx context.Context, noCache bool) ([]string, error)
+
+	// Push packs the contents of the given directory into a single tar+gzip layer, pushes it to the repository and
+	// tags it with the given tag. The annotations are set on the OCI manifest. It returns the digest of the manifest.
+	Push(ctx context.Context, dir string, tag string, annotations map[string]string) (string, error)
 }
 
 type Creds struct {
@@ -374,6 +382,42 @@ func (c *nativeOCIClient) GetTags(ctx context.Context, noCache bool) ([]string,
 	return tags, nil
 }
 
+// Push packs the given directory into a single layer and pushes it to the remote repository with the given tag.
+func (c *nativeOCIClient) Push(ctx context.Context, dir string, tag string, annotations map[string]string) (string, error) {
+	target, ok := c.repo.(oras.Target)
+	if !ok {
+		return "", fmt.Errorf("oci repository %s does not support pushing", c.repoURL)
+	}
+
+	tempDir, err := files.CreateTempDir(os.TempDir())
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tempDir)
+
+	layerPath := filepath.Join(tempDir, "layer.tar.gz")
+	if err = createTgzFile(dir, layerPath); err != nil {
+		return "", fmt.Errorf("failed to pack %s: %w", dir, err)
+	}
+	layerDesc, err := pushFile(ctx, target, imagev1.MediaTypeImageLayerGzip, layerPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to push layer: %w", err)
+	}
+
+	manifestDesc, err := oras.PackManifest(ctx, target, oras.PackManifestVersion1_1, hydratedManifestsArtifactType, oras.PackManifestOptions{
+		Layers:              []imagev1.Descriptor{layerDesc},
+		ManifestAnnotations: annotations,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to push manifest: %w", err)
+	}
+
+	if err = target.Tag(ctx, manifestDesc, tag); err != nil {
+		return "", fmt.Errorf("failed to tag manifest %s with %s: %w", manifestDesc.Digest, tag, err)
+	}
+	return manifestDesc.Digest.String(), nil
+}
+
 // resolveDigest resolves a digest from a tag.
 func (c *nativeOCIClient) resolveDigest(ctx context.Context, revision string) (string, error) {
 	descriptor, err := c.repo.Resolve(ctx, revision)
@@ -433,6 +477,53 @@ func isCompressedLayer(mediaType string) bool {
 	return strings.HasSuffix(mediaType, "tar+gzip") || strings.HasSuffix(mediaType, "tar")
 }
 
+func createTgzFile(from, to string) error {
+	f, err := os.Create(to)
+	if err != nil {
+		return err
+	}
+	if _, err = files.Tgz(from, nil, nil, f); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// pushFile pushes the file at the given path as a blob with the given media type.
+func pushFile(ctx context.Context, target oras.Target, mediaType, filePath string) (imagev1.Descriptor, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return imagev1.Descriptor{}, err
+	}
+	defer utilio.Close(f)
+
+	dgst, err := digest.FromReader(f)
+	if err != nil {
+		return imagev1.Descriptor{}, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return imagev1.Descriptor{}, err
+	}
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		return imagev1.Descriptor{}, err
+	}
+
+	desc := imagev1.Descriptor{
+		MediaType: mediaType,
+		Digest:    dgst,
+		Size:      info.Size(),
+	}
+	exists, err := target.Exists(ctx, desc)
+	if err != nil {
+		return imagev1.Descriptor{}, err
+	}
+	if exists {
+		return desc, nil
+	}
+	return desc, target.Push(ctx, desc, f)
+}
+
 func createTarFile(from, to string) error {
 	f, err := os.Create(to)
 	if err != nil {
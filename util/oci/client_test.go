@@ -450,3 +450,48 @@ func Test_nativeOCIClient_ResolveRevision(t *testing.T) {
 		})
 	}
 }
+
+func Test_nativeOCIClient_Push(t *testing.T) {
+	srcDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(srcDir, "dev"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "dev", "manifest.yaml"), []byte("kind: ConfigMap\n"), 0o644))
+
+	store := memory.New()
+	c := newClientWithLock("example.com/hydrated", globalLock, store, nil, func(_ context.Context) error {
+		return nil
+	}, []string{imagev1.MediaTypeImageLayerGzip}, WithImagePaths(utilio.NewRandomizedTempPaths(t.TempDir())), WithDisableManifestMaxExtractedSize(true))
+
+	sha, err := c.Push(t.Context(), srcDir, "environments-dev", map[string]string{imagev1.AnnotationRevision: "abc123"})
+	require.NoError(t, err)
+
+	desc, err := store.Resolve(t.Context(), "environments-dev")
+	require.NoError(t, err)
+	require.Equal(t, sha, desc.Digest.String())
+	// The memory store only resolves tags, so tag the manifest with its digest like generateManifest does.
+	require.NoError(t, store.Tag(t.Context(), desc, sha))
+
+	manifest, err := getOCIManifest(t.Context(), sha, store)
+	require.NoError(t, err)
+	require.Equal(t, "abc123", manifest.Annotations[imagev1.AnnotationRevision])
+
+	path, closer, err := c.Extract(t.Context(), sha)
+	require.NoError(t, err)
+	defer utilio.Close(closer)
+	b, err := os.ReadFile(filepath.Join(path, "dev", "manifest.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, "kind: ConfigMap\n", string(b))
+}
+
+func Test_nativeOCIClient_Push_ReadOnly(t *testing.T) {
+	store := memory.New()
+	c := newClientWithLock("example.com/hydrated", globalLock, readOnlyTarget{store}, nil, func(_ context.Context) error {
+		return nil
+	}, nil)
+
+	_, err := c.Push(t.Context(), t.TempDir(), "latest", nil)
+	require.EqualError(t, err, "oci repository example.com/hydrated does not support pushing")
+}
+
+type readOnlyTarget struct {
+	oras.ReadOnlyTarget
+}
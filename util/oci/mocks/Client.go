@@ -300,6 +300,84 @@ func (_c *Client_GetTags_Call) RunAndReturn(run func(ctx context.Context, noCach
 	return _c
 }
 
+// Push provides a mock function for the type Client
+func (_mock *Client) Push(ctx context.Context, dir string, tag string, annotations map[string]string) (string, error) {
+	ret := _mock.Called(ctx, dir, tag, annotations)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Push")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, map[string]string) (string, error)); ok {
+		return returnFunc(ctx, dir, tag, annotations)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, map[string]string) string); ok {
+		r0 = returnFunc(ctx, dir, tag, annotations)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, map[string]string) error); ok {
+		r1 = returnFunc(ctx, dir, tag, annotations)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Client_Push_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Push'
+type Client_Push_Call struct {
+	*mock.Call
+}
+
+// Push is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dir string
+//   - tag string
+//   - annotations map[string]string
+func (_e *Client_Expecter) Push(ctx interface{}, dir interface{}, tag interface{}, annotations interface{}) *Client_Push_Call {
+	return &Client_Push_Call{Call: _e.mock.On("Push", ctx, dir, tag, annotations)}
+}
+
+func (_c *Client_Push_Call) Run(run func(ctx context.Context, dir string, tag string, annotations map[string]string)) *Client_Push_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 map[string]string
+		if args[3] != nil {
+			arg3 = args[3].(map[string]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Client_Push_Call) Return(s string, err error) *Client_Push_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *Client_Push_Call) RunAndReturn(run func(ctx context.Context, dir string, tag string, annotations map[string]string) (string, error)) *Client_Push_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResolveRevision provides a mock function for the type Client
 func (_mock *Client) ResolveRevision(ctx context.Context, revision string, noCache bool) (string, error) {
 	ret := _mock.Called(ctx, revision, noCache)
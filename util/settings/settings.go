@@ -552,6 +552,8 @@ const (
 	impersonationEnabledKey = "application.sync.impersonation.enabled"
 	// requireOverridePrivilegeForRevisionSyncKey is the key to configure whether giving an external revision during sync is considered an override
 	requireOverridePrivilegeForRevisionSyncKey = "application.sync.requireOverridePrivilegeForRevisionSync"
+	// syncAnalysisAddressKey is the key to configure the address of the Prometheus compatible API used to analyze syncs
+	syncAnalysisAddressKey = "application.sync.analysis.address"
 )
 
 const (
@@ -1067,7 +1069,16 @@ func (mgr *SettingsManager) appendResourceOverridesFromSplitKeys(cmData map[stri
 			continue
 		}
 
-		overrideKey, err := convertToOverrideKey(parts[3])
+		customizationType := parts[2]
+		groupKind := parts[3]
+		// config map key of the condition based health check is of format
+		// resource.customizations.health.useConditions.<group_kind>
+		if customizationType == "health" && strings.HasPrefix(groupKind, "useConditions.") {
+			customizationType = "health.useConditions"
+			groupKind = strings.TrimPrefix(groupKind, "useConditions.")
+		}
+
+		overrideKey, err := convertToOverrideKey(groupKind)
 		if err != nil {
 			return err
 		}
@@ -1081,7 +1092,6 @@ func (mgr *SettingsManager) appendResourceOverridesFromSplitKeys(cmData map[stri
 			overrideVal = v1alpha1.ResourceOverride{}
 		}
 
-		customizationType := parts[2]
 		switch customizationType {
 		case "health":
 			overrideVal.HealthLua = v
@@ -1091,6 +1101,12 @@ func (mgr *SettingsManager) appendResourceOverridesFromSplitKeys(cmData map[stri
 				return err
 			}
 			overrideVal.UseOpenLibs = useOpenLibs
+		case "health.useConditions":
+			useConditionsHealth, err := strconv.ParseBool(v)
+			if err != nil {
+				return err
+			}
+			overrideVal.UseConditionsHealth = useConditionsHealth
 		case "actions":
 			overrideVal.Actions = v
 		case "ignoreDifferences":
@@ -2358,6 +2374,15 @@ func (mgr *SettingsManager) IsImpersonationEnabled() (bool, error) {
 	return cm.Data[impersonationEnabledKey] == "true", nil
 }
 
+// GetSyncAnalysisAddress returns the address of the Prometheus compatible API used to analyze syncs
+func (mgr *SettingsManager) GetSyncAnalysisAddress() (string, error) {
+	cm, err := mgr.getConfigMap()
+	if err != nil {
+		return "", fmt.Errorf("error checking %s property in configmap: %w", syncAnalysisAddressKey, err)
+	}
+	return cm.Data[syncAnalysisAddressKey], nil
+}
+
 func (mgr *SettingsManager) GetAllowedNodeLabels() []string {
 	labelKeys := []string{}
 	argoCDCM, err := mgr.getConfigMap()
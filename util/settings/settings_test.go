@@ -455,6 +455,7 @@ func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
       health.lua: |
         foo
     cert-manager.io/Certificate:
+      health.useConditions: true
       health.lua: |
         foo
     apps/Deployment:
@@ -476,6 +477,7 @@ func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
 		assert.True(t, overrides["certmanager.k8s.io/Certificate"].UseOpenLibs)
 		assert.Equal(t, "foo\n", overrides["cert-manager.io/Certificate"].HealthLua)
 		assert.False(t, overrides["cert-manager.io/Certificate"].UseOpenLibs)
+		assert.True(t, overrides["cert-manager.io/Certificate"].UseConditionsHealth)
 		assert.Equal(t, "foo", overrides["apps/Deployment"].Actions)
 	})
 
@@ -491,14 +493,15 @@ func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
 			"resource.customizations.knownTypeFields.admissionregistration.k8s.io_MutatingWebhookConfiguration": `
 - field: foo
   type: bar`,
-			"resource.customizations.health.certmanager.k8s.io_Certificate":      "bar",
-			"resource.customizations.health.cert-manager.io_Certificate":         "bar",
-			"resource.customizations.useOpenLibs.certmanager.k8s.io_Certificate": "false",
-			"resource.customizations.useOpenLibs.cert-manager.io_Certificate":    "true",
-			"resource.customizations.actions.apps_Deployment":                    "bar",
-			"resource.customizations.actions.Deployment":                         "bar",
-			"resource.customizations.health.iam-manager.k8s.io_Iamrole":          "bar",
-			"resource.customizations.health.Iamrole":                             "bar",
+			"resource.customizations.health.certmanager.k8s.io_Certificate":            "bar",
+			"resource.customizations.health.cert-manager.io_Certificate":               "bar",
+			"resource.customizations.useOpenLibs.certmanager.k8s.io_Certificate":       "false",
+			"resource.customizations.useOpenLibs.cert-manager.io_Certificate":          "true",
+			"resource.customizations.health.useConditions.cert-manager.io_Certificate": "true",
+			"resource.customizations.actions.apps_Deployment":                          "bar",
+			"resource.customizations.actions.Deployment":                               "bar",
+			"resource.customizations.health.iam-manager.k8s.io_Iamrole":                "bar",
+			"resource.customizations.health.Iamrole":                                   "bar",
 			"resource.customizations.ignoreDifferences.iam-manager.k8s.io_Iamrole": `jsonPointers:
         - bar`,
 			"resource.customizations.ignoreDifferences.apps_Deployment": `jqPathExpressions:
@@ -528,6 +531,8 @@ func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
 		assert.Equal(t, "bar", overrides["cert-manager.io/Certificate"].HealthLua)
 		assert.False(t, overrides["certmanager.k8s.io/Certificate"].UseOpenLibs)
 		assert.True(t, overrides["cert-manager.io/Certificate"].UseOpenLibs)
+		assert.False(t, overrides["certmanager.k8s.io/Certificate"].UseConditionsHealth)
+		assert.True(t, overrides["cert-manager.io/Certificate"].UseConditionsHealth)
 		assert.Equal(t, "bar", overrides["apps/Deployment"].Actions)
 		assert.Equal(t, "bar", overrides["Deployment"].Actions)
 		assert.Equal(t, "bar", overrides["iam-manager.k8s.io/Iamrole"].HealthLua)
@@ -2168,6 +2173,25 @@ func TestIsImpersonationEnabled(t *testing.T) {
 		"when user enables the flag in argocd-cm config map, IsImpersonationEnabled() must not return any error")
 }
 
+func TestGetSyncAnalysisAddress(t *testing.T) {
+	kubeClient := fake.NewClientset()
+	settingsManager := NewSettingsManager(t.Context(), kubeClient, "default")
+	_, err := settingsManager.GetSyncAnalysisAddress()
+	require.ErrorContains(t, err, "configmap \"argocd-cm\" not found")
+
+	_, settingsManager = fixtures(t.Context(), map[string]string{})
+	address, err := settingsManager.GetSyncAnalysisAddress()
+	require.NoError(t, err)
+	assert.Empty(t, address)
+
+	_, settingsManager = fixtures(t.Context(), map[string]string{
+		"application.sync.analysis.address": "http://prometheus:9090",
+	})
+	address, err = settingsManager.GetSyncAnalysisAddress()
+	require.NoError(t, err)
+	assert.Equal(t, "http://prometheus:9090", address)
+}
+
 func TestRequireOverridePrivilegeForRevisionSyncNoConfigMap(t *testing.T) {
 	// When there is no argocd-cm itself,
 	// Then RequireOverridePrivilegeForRevisionSync() must return false (default value) and an error with appropriate error message.
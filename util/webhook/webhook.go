@@ -367,16 +367,19 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload any) {
 		}
 		for _, app := range filteredApps {
 			if app.Spec.SourceHydrator != nil {
-				drySource := app.Spec.SourceHydrator.GetDrySource()
-				if sourceRevisionHasChanged(drySource, revision, touchedHead) && sourceUsesURL(drySource, webURL, repoRegexp) {
-					refreshPaths := path.GetAppRefreshPaths(&app)
-					if path.AppFilesHaveChanged(refreshPaths, changedFiles) {
-						namespacedAppInterface := a.appClientset.ArgoprojV1alpha1().Applications(app.Namespace)
-						log.Infof("webhook trigger refresh app to hydrate '%s'", app.Name)
-						_, err = argo.RefreshApp(namespacedAppInterface, app.Name, v1alpha1.RefreshTypeNormal, true)
-						if err != nil {
-							log.Warnf("Failed to hydrate app '%s' for controller reprocessing: %v", app.Name, err)
-							continue
+				for _, drySource := range app.Spec.SourceHydrator.GetDrySources() {
+					if sourceRevisionHasChanged(drySource, revision, touchedHead) && sourceUsesURL(drySource, webURL, repoRegexp) {
+						refreshPaths := path.GetAppRefreshPaths(&app)
+						if path.AppFilesHaveChanged(refreshPaths, changedFiles) {
+							namespacedAppInterface := a.appClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+							log.Infof("webhook trigger refresh app to hydrate '%s'", app.Name)
+							_, err = argo.RefreshApp(namespacedAppInterface, app.Name, v1alpha1.RefreshTypeNormal, true)
+							if err != nil {
+								log.Warnf("Failed to hydrate app '%s' for controller reprocessing: %v", app.Name, err)
+								continue
+							}
+							// No need to hydrate multiple times if multiple dry sources match.
+							break
 						}
 					}
 				}